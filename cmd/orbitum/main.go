@@ -1,8 +1,30 @@
 package main
 
 import (
+	"context"
+	"encoding/base64"
+	"errors"
 	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/configs"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/handlers"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories/db"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services/cache"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services/cache/local"
+	rediscache "github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services/cache/redis"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services/mail"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/pkg/api"
 	"github.com/BetelgeuseTb/betelgeuse-orbitum/pkg/utils/reader"
+	"github.com/labstack/echo/v4"
+	"github.com/redis/go-redis/v9"
+	"github.com/rs/zerolog"
 )
 
 const (
@@ -10,6 +32,203 @@ const (
 )
 
 func main() {
+	logger := zerolog.New(os.Stdout).With().Timestamp().Logger()
+
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		if err := runMigrate(logger, os.Args[2:]); err != nil {
+			logger.Fatal().Err(err).Msg("migration failed")
+		}
+		return
+	}
+
 	data, _ := reader.NewFileReader().ReadFile(LOGO_PATH)
 	fmt.Println(string(data))
+
+	if err := run(logger); err != nil {
+		logger.Fatal().Err(err).Msg("orbitum stopped")
+	}
+}
+
+func run(logger zerolog.Logger) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	appCfg := configs.GetAppConfig()
+	if appCfg.SessionSecretKey == "" {
+		return errors.New("SESSION_SECRET_KEY must be set")
+	}
+	if appCfg.UserTokenSecretKey == "" {
+		return errors.New("USER_TOKEN_SECRET_KEY must be set")
+	}
+	mailCfg := configs.GetMailConfig()
+	mailer, err := newMailer(mailCfg, logger)
+	if err != nil {
+		return fmt.Errorf("mailer: %w", err)
+	}
+	encryptionKey, err := base64.StdEncoding.DecodeString(appCfg.DataEncryptionKey)
+	if err != nil {
+		return fmt.Errorf("DATA_ENCRYPTION_KEY: %w", err)
+	}
+	secretCipher, err := services.NewAESGCMCipher(encryptionKey)
+	if err != nil {
+		return fmt.Errorf("DATA_ENCRYPTION_KEY: %w", err)
+	}
+
+	pgCfg := configs.GetPostgresConfig()
+	pool, err := db.NewPool(ctx, pgCfg.ConnString(), pgCfg.Schema)
+	if err != nil {
+		return err
+	}
+	defer pool.Close()
+	dbConn := db.New(pool, logger)
+	if err := dbConn.CheckSchema(ctx, repositories.Columns); err != nil {
+		return fmt.Errorf("%w\nrun \"orbitum migrate up\" to apply pending migrations", err)
+	}
+
+	cacheManager := newCacheManager(appCfg)
+	defer func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_ = cacheManager.Shutdown(shutdownCtx)
+	}()
+
+	orbitService := services.NewOrbitService(dbConn, repositories.NewOrbitRepository(dbConn.Exec(), logger), cacheManager, logger)
+	clientService := services.NewClientService(dbConn, cacheManager, logger)
+	securityEventService := services.NewSecurityEventService(dbConn, logger)
+	registrationService := services.NewClientRegistrationService(dbConn, clientService, securityEventService, logger)
+
+	breached, err := newBreachedChecker(appCfg.BreachedPasswordsDir, logger)
+	if err != nil {
+		return fmt.Errorf("BREACHED_PASSWORDS_DIR: %w", err)
+	}
+	userService := services.NewUserService(dbConn, repositories.NewUserRepository(dbConn.Exec(), logger), cacheManager, breached, logger)
+	userTokenService := services.NewUserTokenService(dbConn, userService, securityEventService, mailer, []byte(appCfg.UserTokenSecretKey), mailCfg.From, logger)
+	defer func() {
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cancel()
+		if err := userTokenService.Shutdown(shutdownCtx); err != nil {
+			logger.Warn().Err(err).Msg("password reset mails still pending at shutdown")
+		}
+	}()
+	lockoutService := services.NewLockoutService(cacheManager, userService, securityEventService, logger)
+	sessionService := services.NewSessionService(dbConn, userService, logger)
+	authCodeService := services.NewAuthCodeService(dbConn, cacheManager, logger)
+	loginService := services.NewLoginService(dbConn, cacheManager, userService, sessionService, lockoutService, authCodeService, logger)
+	keyService := services.NewJWKService(dbConn, cacheManager, secretCipher, logger)
+	resourceService := services.NewResourceServerService(dbConn, cacheManager, logger)
+	roleService := services.NewRoleService(dbConn, cacheManager, logger)
+	tokenService := services.NewTokenService(dbConn, userService, authCodeService, services.NewAccessTokenService(dbConn, cacheManager, logger), resourceService, keyService, roleService, logger)
+
+	consentService := services.NewConsentService(dbConn, cacheManager, logger)
+	totpService := services.NewTOTPService(dbConn, secretCipher, userService, logger)
+	recoveryCodeService := services.NewRecoveryCodeService(dbConn, securityEventService, logger)
+	webAuthnService := services.NewWebAuthnService(dbConn, cacheManager, userService, securityEventService, logger)
+	scopeService := services.NewScopeService(dbConn, logger)
+	groupService := services.NewGroupService(dbConn, cacheManager, roleService, logger)
+	permissionService := services.NewPermissionService(dbConn, cacheManager, logger)
+	policyService := services.NewPolicyService(dbConn, cacheManager, userService, roleService, logger)
+	auditLogService := services.NewAuditLogService(dbConn, logger)
+	authorizer := services.NewAuthorizer(roleService, int64(appCfg.MasterOrbitID))
+
+	server := handlers.NewServer(
+		orbitService,
+		clientService,
+		registrationService,
+		loginService,
+		sessionService,
+		consentService,
+		totpService,
+		recoveryCodeService,
+		webAuthnService,
+		lockoutService,
+		userTokenService,
+		userService,
+		scopeService,
+		roleService,
+		groupService,
+		permissionService,
+		policyService,
+		keyService,
+		resourceService,
+		tokenService,
+		auditLogService,
+		authorizer,
+		[]byte(appCfg.SessionSecretKey),
+		logger,
+	)
+
+	e := echo.New()
+	e.HideBanner = true
+	e.Use(handlers.OrbitResolver(orbitService), server.AdminAuth(appCfg.AdminAPIToken))
+	api.RegisterHandlers(e, server)
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- e.Start(":" + appCfg.ServerPort)
+	}()
+
+	select {
+	case err := <-errCh:
+		if !errors.Is(err, http.ErrServerClosed) {
+			return err
+		}
+		return nil
+	case <-ctx.Done():
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	return e.Shutdown(shutdownCtx)
+}
+
+func newCacheManager(cfg configs.AppConfig) cache.Manager {
+	if cfg.CacheBackend == "redis" {
+		redisCfg := configs.GetRedisConfig()
+		// Cluster mode has no numbered databases; the DB option is ignored
+		// there.
+		client := redis.NewUniversalClient(&redis.UniversalOptions{
+			Addrs:         redisCfg.Addrs(),
+			Password:      redisCfg.RedisPass,
+			DB:            redisCfg.RedisDB,
+			IsClusterMode: redisCfg.RedisCluster,
+		})
+		return rediscache.NewManager(client)
+	}
+	return local.NewManager()
+}
+
+// newBreachedChecker opens the breached password corpus. Without one the
+// check_breached policy orbits have by default passes every password, which
+// is worth a warning at startup.
+func newBreachedChecker(dir string, logger zerolog.Logger) (services.BreachedPasswordChecker, error) {
+	if dir == "" {
+		logger.Warn().Msg("BREACHED_PASSWORDS_DIR is not set, password policies with check_breached accept breached passwords")
+		return nil, nil
+	}
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%s is not a directory", dir)
+	}
+	return services.NewPwnedRangeCorpus(dir), nil
+}
+
+func newMailer(cfg configs.MailConfig, logger zerolog.Logger) (mail.Mailer, error) {
+	switch cfg.Backend {
+	case "smtp":
+		return mail.NewSMTPMailer(mail.SMTPConfig{
+			Host:     cfg.SMTPHost,
+			Port:     cfg.SMTPPort,
+			Username: cfg.SMTPUser,
+			Password: cfg.SMTPPass,
+			TLS:      cfg.SMTPTLS,
+		}), nil
+	case "file":
+		return mail.NewFileMailer(cfg.FileDir)
+	case "log":
+		return mail.NewLogMailer(logger), nil
+	}
+	return nil, fmt.Errorf("unknown MAIL_BACKEND %q", cfg.Backend)
 }
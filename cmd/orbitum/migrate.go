@@ -0,0 +1,85 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"strconv"
+	"syscall"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/configs"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+)
+
+const migrateUsage = "usage: orbitum migrate up [version] | down [steps] | status"
+
+// runMigrate handles "orbitum migrate". DDL usually needs more rights than
+// the service has, so ORBITUM_MIGRATOR_CONN_STRING, the variable the tern
+// image reads, takes precedence over the regular Postgres settings.
+func runMigrate(logger zerolog.Logger, args []string) error {
+	if len(args) == 0 || len(args) > 2 {
+		return errors.New(migrateUsage)
+	}
+	var arg int64
+	if len(args) == 2 {
+		n, err := strconv.ParseInt(args[1], 10, 32)
+		if err != nil || n < 0 {
+			return fmt.Errorf("invalid number %q: %s", args[1], migrateUsage)
+		}
+		arg = n
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	pgCfg := configs.GetPostgresConfig()
+	connString := os.Getenv("ORBITUM_MIGRATOR_CONN_STRING")
+	if connString == "" {
+		connString = pgCfg.ConnString()
+	}
+	conn, err := pgx.Connect(ctx, connString)
+	if err != nil {
+		return err
+	}
+	defer conn.Close(context.Background())
+
+	migrator, err := db.NewMigrator(ctx, conn, pgCfg.Schema, logger)
+	if err != nil {
+		return err
+	}
+
+	switch args[0] {
+	case "up":
+		if err := migrator.Up(ctx, int32(arg)); err != nil {
+			return err
+		}
+	case "down":
+		steps := int32(1)
+		if len(args) == 2 {
+			steps = int32(arg)
+		}
+		if err := migrator.Down(ctx, steps); err != nil {
+			return err
+		}
+	case "status":
+		if len(args) != 1 {
+			return errors.New(migrateUsage)
+		}
+	default:
+		return errors.New(migrateUsage)
+	}
+
+	status, err := migrator.Status(ctx)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("version %d of %d\n", status.Current, status.Latest)
+	for _, name := range status.Pending {
+		fmt.Printf("pending  %s\n", name)
+	}
+	return nil
+}
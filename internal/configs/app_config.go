@@ -3,11 +3,35 @@ package configs
 type AppConfig struct {
 	ServerPort   string
 	JwtSecretKey string
+	CacheBackend string
+	// Key for signing session cookies, shared by all instances.
+	SessionSecretKey string
+	// Key for signing the tokens mailed for email verification and password
+	// resets.
+	UserTokenSecretKey string
+	// Base64 encoded 32 byte key for secrets stored encrypted, such as TOTP
+	// seeds.
+	DataEncryptionKey string
+	// Directory holding a k-anonymity range corpus of breached passwords.
+	// Without it the check_breached password policy passes every password.
+	BreachedPasswordsDir string
+	// Bearer token for the admin API, which only signed-in users can use
+	// when empty.
+	AdminAPIToken string
+	// Orbit whose users may hold admin permissions in every orbit; 0 for none.
+	MasterOrbitID int
 }
 
 func GetAppConfig() AppConfig {
 	return AppConfig{
-		ServerPort:   getEnv("SERVER_PORT", "8080"),
-		JwtSecretKey: getEnv("JWT_SECRET_KEY", ""),
+		ServerPort:           getEnv("SERVER_PORT", "8080"),
+		JwtSecretKey:         getEnv("JWT_SECRET_KEY", ""),
+		CacheBackend:         getEnv("CACHE_BACKEND", "local"),
+		SessionSecretKey:     getEnv("SESSION_SECRET_KEY", ""),
+		UserTokenSecretKey:   getEnv("USER_TOKEN_SECRET_KEY", ""),
+		DataEncryptionKey:    getEnv("DATA_ENCRYPTION_KEY", ""),
+		BreachedPasswordsDir: getEnv("BREACHED_PASSWORDS_DIR", ""),
+		AdminAPIToken:        getEnv("ADMIN_API_TOKEN", ""),
+		MasterOrbitID:        getInt("MASTER_ORBIT_ID", 0),
 	}
 }
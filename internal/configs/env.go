@@ -23,3 +23,14 @@ func getInt(k string, def int) int {
 	}
 	return def
 }
+
+func getBool(k string, def bool) bool {
+	v := os.Getenv(k)
+	if v != "" {
+		b, err := strconv.ParseBool(v)
+		if err == nil {
+			return b
+		}
+	}
+	return def
+}
@@ -0,0 +1,27 @@
+package configs
+
+type MailConfig struct {
+	// smtp, file or log.
+	Backend string
+	// Default sender; orbits may override it.
+	From     string
+	SMTPHost string
+	SMTPPort int
+	SMTPUser string
+	SMTPPass string
+	SMTPTLS  string
+	FileDir  string
+}
+
+func GetMailConfig() MailConfig {
+	return MailConfig{
+		Backend:  getEnv("MAIL_BACKEND", "log"),
+		From:     getEnv("MAIL_FROM", ""),
+		SMTPHost: getEnv("SMTP_HOST", "localhost"),
+		SMTPPort: getInt("SMTP_PORT", 587),
+		SMTPUser: getEnv("SMTP_USER", ""),
+		SMTPPass: getEnv("SMTP_PASSWORD", ""),
+		SMTPTLS:  getEnv("SMTP_TLS", "starttls"),
+		FileDir:  getEnv("MAIL_FILE_DIR", "mail"),
+	}
+}
@@ -1,15 +1,41 @@
 package configs
 
+import (
+	"fmt"
+	"net/url"
+)
+
 type PostgresConfig struct {
+	Host         string
+	Port         int
 	DatabaseName string
 	UserName     string
 	Password     string
+	SSLMode      string
+	// Schema is the search_path of the pool; tests run side by side in
+	// schemas of their own.
+	Schema string
 }
 
 func GetPostgresConfig() PostgresConfig {
 	return PostgresConfig{
-		DatabaseName: getEnv("POSTGRES_DB", "8080"),
+		Host:         getEnv("POSTGRES_HOST", "localhost"),
+		Port:         getInt("POSTGRES_PORT", 5432),
+		DatabaseName: getEnv("POSTGRES_DB", "orbitum"),
 		UserName:     getEnv("POSTGRES_USER", ""),
 		Password:     getEnv("POSTGRES_PASS", ""),
+		SSLMode:      getEnv("POSTGRES_SSLMODE", "disable"),
+		Schema:       getEnv("POSTGRES_SCHEMA", "orbitum"),
+	}
+}
+
+func (c PostgresConfig) ConnString() string {
+	u := url.URL{
+		Scheme:   "postgres",
+		User:     url.UserPassword(c.UserName, c.Password),
+		Host:     fmt.Sprintf("%s:%d", c.Host, c.Port),
+		Path:     c.DatabaseName,
+		RawQuery: "sslmode=" + url.QueryEscape(c.SSLMode),
 	}
+	return u.String()
 }
@@ -1,15 +1,31 @@
 package configs
 
+import "strings"
+
 type RedisConfig struct {
-	RedisAddr string
-	RedisDB   int
-	RedisPass string
+	// Comma separated list of host:port. More than one address, or
+	// RedisCluster, connects to a Redis Cluster.
+	RedisAddr    string
+	RedisDB      int
+	RedisPass    string
+	RedisCluster bool
 }
 
 func GetRedisConfig() RedisConfig {
 	return RedisConfig{
-		RedisAddr: getEnv("REDIS_URL", "127.0.0.1:6379"),
-		RedisDB:   getInt("REDIS_DB", 0),
-		RedisPass: getEnv("REDIS_PASSWORD", ""),
+		RedisAddr:    getEnv("REDIS_URL", "127.0.0.1:6379"),
+		RedisDB:      getInt("REDIS_DB", 0),
+		RedisPass:    getEnv("REDIS_PASSWORD", ""),
+		RedisCluster: getBool("REDIS_CLUSTER", false),
+	}
+}
+
+func (c RedisConfig) Addrs() []string {
+	var addrs []string
+	for _, a := range strings.Split(c.RedisAddr, ",") {
+		if a = strings.TrimSpace(a); a != "" {
+			addrs = append(addrs, a)
+		}
 	}
+	return addrs
 }
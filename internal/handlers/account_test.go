@@ -0,0 +1,123 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories/db"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/testutil"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/pkg/api"
+	"github.com/labstack/echo/v4"
+)
+
+func accountEcho(t *testing.T, dbConn *db.DB) (*echo.Echo, *Server) {
+	t.Helper()
+	cacheManager := testutil.LocalCache(t)
+	secretCipher, err := services.NewAESGCMCipher(testutil.EncryptionKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	orbits := services.NewOrbitService(dbConn, repositories.NewOrbitRepository(dbConn.Exec(), nop), cacheManager, nop)
+	users := services.NewUserService(dbConn, repositories.NewUserRepository(dbConn.Exec(), nop), cacheManager, nil, nop)
+	events := services.NewSecurityEventService(dbConn, nop)
+	s := &Server{
+		orbits:        orbits,
+		sessions:      services.NewSessionService(dbConn, users, nop),
+		totp:          services.NewTOTPService(dbConn, secretCipher, users, nop),
+		recoveryCodes: services.NewRecoveryCodeService(dbConn, events, nop),
+		users:         users,
+		cookies:       sessionCookies{key: []byte("session-test-key")},
+		logger:        nop,
+	}
+	e := echo.New()
+	e.Use(OrbitResolver(orbits))
+	api.RegisterHandlers(e, s)
+	return e, s
+}
+
+// stepUp adds a one-time code to the session behind cookie, as the second
+// factor page does.
+func stepUp(t *testing.T, s *Server, orbit *models.Orbit, cookie *http.Cookie) {
+	t.Helper()
+	id, _ := s.cookies.decode(cookie.Value)
+	session, _, err := s.sessions.Active(t.Context(), orbit, id)
+	if err != nil || session == nil {
+		t.Fatalf("Active = %+v, %v", session, err)
+	}
+	if _, err := s.sessions.StepUp(t.Context(), session, services.AMROTP); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// A session that only proved the password of a user with MFA must not reach
+// the account API, where it could switch the second factor off.
+func TestAccountRequiresSecondFactor(t *testing.T) {
+	dbConn, fx := setup(t)
+	e, s := accountEcho(t, dbConn)
+	orbit := fx.Orbit()
+	user := fx.User(orbit, func(u *models.User) { u.MFAEnabled = true })
+	cookie := signIn(t, s, orbit, user)
+	origin := "http://" + orbit.Domain
+
+	for _, req := range []adminRequest{
+		{method: http.MethodGet, path: "/account/sessions"},
+		{method: http.MethodGet, path: "/account/totp"},
+		{method: http.MethodDelete, path: "/account/totp/1"},
+		{method: http.MethodPost, path: "/account/recovery-codes"},
+	} {
+		req.host, req.cookie, req.origin = orbit.Domain, cookie, origin
+		if code, body := req.do(e); code != http.StatusUnauthorized || body.Error != "insufficient_user_authentication" {
+			t.Errorf("%s %s with a password-only session = %d %q, want 401 insufficient_user_authentication", req.method, req.path, code, body.Error)
+		}
+	}
+
+	stepUp(t, s, orbit, cookie)
+	req := adminRequest{method: http.MethodGet, host: orbit.Domain, path: "/account/totp", cookie: cookie}
+	if code, _ := req.do(e); code != http.StatusOK {
+		t.Fatalf("GET /account/totp after step-up = %d, want 200", code)
+	}
+
+	// Users without MFA have nothing to step up to.
+	plain := fx.User(orbit)
+	req.cookie = signIn(t, s, orbit, plain)
+	if code, _ := req.do(e); code != http.StatusOK {
+		t.Fatalf("GET /account/totp for a user without MFA = %d, want 200", code)
+	}
+}
+
+// Second factors are only changed from a session that signed in recently,
+// however strong it is.
+func TestAccountSecondFactorsNeedRecentSignIn(t *testing.T) {
+	dbConn, fx := setup(t)
+	e, s := accountEcho(t, dbConn)
+	orbit := fx.Orbit()
+	user := fx.User(orbit, func(u *models.User) { u.MFAEnabled = true })
+	origin := "http://" + orbit.Domain
+
+	stale := signInAt(t, s, orbit, user, time.Now().UTC().Add(-time.Hour))
+	stepUp(t, s, orbit, stale)
+	fresh := signIn(t, s, orbit, user)
+	stepUp(t, s, orbit, fresh)
+
+	for _, tc := range []struct {
+		req    adminRequest
+		status int
+	}{
+		{adminRequest{method: http.MethodPost, path: "/account/totp", body: `{"name":"phone"}`, cookie: stale}, http.StatusUnauthorized},
+		{adminRequest{method: http.MethodDelete, path: "/account/totp/1", cookie: stale}, http.StatusUnauthorized},
+		{adminRequest{method: http.MethodPost, path: "/account/recovery-codes", cookie: stale}, http.StatusUnauthorized},
+		{adminRequest{method: http.MethodGet, path: "/account/totp", cookie: stale}, http.StatusOK},
+		{adminRequest{method: http.MethodDelete, path: "/account/totp/1", cookie: fresh}, http.StatusNotFound},
+		{adminRequest{method: http.MethodPost, path: "/account/totp", body: `{"name":"phone"}`, cookie: fresh}, http.StatusCreated},
+		{adminRequest{method: http.MethodPost, path: "/account/recovery-codes", cookie: fresh}, http.StatusCreated},
+	} {
+		tc.req.host, tc.req.origin = orbit.Domain, origin
+		if code, body := tc.req.do(e); code != tc.status {
+			t.Errorf("%s %s: status = %d (%+v), want %d", tc.req.method, tc.req.path, code, body, tc.status)
+		}
+	}
+}
@@ -0,0 +1,372 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/pkg/api"
+	"github.com/labstack/echo/v4"
+)
+
+var (
+	errPreconditionRequired = errors.New("updates must carry the ETag they are based on in If-Match")
+	errInvalidAdminInput    = errors.New("invalid request")
+	errInvalidPageLimit     = fmt.Errorf("limit must be between 1 and %d", repositories.MaxPageLimit)
+)
+
+func (s *Server) GetAdminV1Orbits(c echo.Context, params api.GetAdminV1OrbitsParams) error {
+	page, err := adminPage(params.Limit, params.Cursor)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	orbits, next, err := s.orbits.List(c.Request().Context(), page)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	out := api.OrbitList{Items: make([]api.Orbit, 0, len(orbits)), NextCursor: optional(next)}
+	for _, o := range orbits {
+		out.Items = append(out.Items, toOrbit(o))
+	}
+	return c.JSON(http.StatusOK, out)
+}
+
+func (s *Server) PostAdminV1Orbits(c echo.Context) error {
+	var body api.OrbitInput
+	if err := c.Bind(&body); err != nil {
+		return oauthError(c, http.StatusBadRequest, "invalid_request", "malformed request body")
+	}
+	o := &models.Orbit{}
+	if err := applyOrbitInput(o, body); err != nil {
+		return s.adminError(c, err)
+	}
+
+	created, err := s.orbits.Create(c.Request().Context(), o)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	s.audit(c, created.ID, "orbit.create", orbitTarget(created.ID), nil)
+	return adminJSON(c, http.StatusCreated, created.UpdatedAt, toOrbit(created))
+}
+
+func (s *Server) GetAdminV1OrbitsOrbitId(c echo.Context, orbitId api.OrbitId) error {
+	orbit, err := s.adminOrbit(c, orbitId)
+	if orbit == nil {
+		return err
+	}
+	return adminJSON(c, http.StatusOK, orbit.UpdatedAt, toOrbit(orbit))
+}
+
+func (s *Server) PutAdminV1OrbitsOrbitId(c echo.Context, orbitId api.OrbitId, params api.PutAdminV1OrbitsOrbitIdParams) error {
+	orbit, err := s.adminOrbit(c, orbitId)
+	if orbit == nil {
+		return err
+	}
+	var body api.OrbitInput
+	if err := c.Bind(&body); err != nil {
+		return oauthError(c, http.StatusBadRequest, "invalid_request", "malformed request body")
+	}
+
+	before := toOrbit(orbit)
+	o := *orbit
+	if err := ifMatch(params.IfMatch, &o.UpdatedAt); err != nil {
+		return s.adminError(c, err)
+	}
+	if err := applyOrbitInput(&o, body); err != nil {
+		return s.adminError(c, err)
+	}
+	updated, err := s.orbits.UpdateIfUnmodified(c.Request().Context(), &o)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	if updated == nil {
+		return oauthError(c, http.StatusNotFound, "not_found", "unknown orbit")
+	}
+	after := toOrbit(updated)
+	s.audit(c, updated.ID, "orbit.update", orbitTarget(updated.ID), changes(before, after))
+	return adminJSON(c, http.StatusOK, updated.UpdatedAt, after)
+}
+
+func (s *Server) DeleteAdminV1OrbitsOrbitId(c echo.Context, orbitId api.OrbitId) error {
+	orbit, err := s.adminOrbit(c, orbitId)
+	if orbit == nil {
+		return err
+	}
+	if err := s.orbits.Delete(c.Request().Context(), orbit.ID); err != nil {
+		return s.adminError(c, err)
+	}
+	s.audit(c, orbit.ID, "orbit.delete", orbitTarget(orbit.ID), nil)
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (s *Server) GetAdminV1OrbitsOrbitIdAuditLogs(c echo.Context, orbitId api.OrbitId, params api.GetAdminV1OrbitsOrbitIdAuditLogsParams) error {
+	orbit, err := s.adminOrbit(c, orbitId)
+	if orbit == nil {
+		return err
+	}
+	page, err := adminPage(params.Limit, params.Cursor)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	filter := repositories.AuditLogFilter{
+		Action:  deref(params.Action),
+		ActorID: params.ActorId,
+		Since:   params.Since,
+		Until:   params.Until,
+	}
+	entries, next, err := s.audits.ListByOrbit(c.Request().Context(), orbit.ID, filter, page)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	out := api.AuditLogList{Items: make([]api.AuditLog, 0, len(entries)), NextCursor: optional(next)}
+	for _, entry := range entries {
+		out.Items = append(out.Items, toAuditLog(entry))
+	}
+	return c.JSON(http.StatusOK, out)
+}
+
+// adminOrbit loads the orbit named in an admin path, or writes the response
+// for an unknown one.
+func (s *Server) adminOrbit(c echo.Context, id int64) (*models.Orbit, error) {
+	orbit, err := s.orbits.GetByID(c.Request().Context(), id)
+	if err != nil {
+		return nil, s.serverError(c, err)
+	}
+	if orbit == nil || orbit.DeletedAt != nil {
+		return nil, oauthError(c, http.StatusNotFound, "not_found", "unknown orbit")
+	}
+	return orbit, nil
+}
+
+func (s *Server) adminError(c echo.Context, err error) error {
+	var policy *services.PasswordPolicyError
+	switch {
+	case errors.Is(err, errPreconditionRequired):
+		return oauthError(c, http.StatusPreconditionRequired, "precondition_required", err.Error())
+	case errors.Is(err, services.ErrVersionMismatch):
+		return oauthError(c, http.StatusPreconditionFailed, "precondition_failed", err.Error())
+	case errors.Is(err, services.ErrAlreadyExists),
+		errors.Is(err, services.ErrUserAlreadyExists),
+		errors.Is(err, services.ErrRoleCycle):
+		return oauthError(c, http.StatusConflict, "conflict", err.Error())
+	case errors.As(err, &policy):
+		return oauthError(c, http.StatusBadRequest, "invalid_password", err.Error())
+	case errors.Is(err, services.ErrInvalidRedirectURI):
+		return oauthError(c, http.StatusBadRequest, "invalid_redirect_uri", err.Error())
+	case errors.Is(err, services.ErrInvalidClientMetadata):
+		return oauthError(c, http.StatusBadRequest, "invalid_client_metadata", err.Error())
+	case errors.Is(err, errInvalidAdminInput),
+		errors.Is(err, errInvalidPageLimit),
+		errors.Is(err, repositories.ErrInvalidCursor),
+		errors.Is(err, services.ErrInvalidPolicy),
+		errors.Is(err, services.ErrUnsupportedKeyAlgorithm),
+		errors.Is(err, services.ErrClientNotConfidential):
+		return oauthError(c, http.StatusBadRequest, "invalid_request", err.Error())
+	case errors.Is(err, services.ErrClientNotFound),
+		errors.Is(err, services.ErrUserNotFound),
+		errors.Is(err, services.ErrRoleNotFound),
+		errors.Is(err, services.ErrPermissionNotFound),
+		errors.Is(err, services.ErrGroupNotFound):
+		return oauthError(c, http.StatusNotFound, "not_found", err.Error())
+	}
+	return s.serverError(c, err)
+}
+
+func invalidAdminInput(format string, args ...any) error {
+	return fmt.Errorf("%w: %s", errInvalidAdminInput, fmt.Sprintf(format, args...))
+}
+
+func adminPage(limit *api.Limit, cursor *api.Cursor) (repositories.Page, error) {
+	page := repositories.Page{Cursor: deref(cursor)}
+	if limit != nil {
+		if *limit < 1 || *limit > repositories.MaxPageLimit {
+			return page, errInvalidPageLimit
+		}
+		page.Limit = *limit
+	}
+	return page, nil
+}
+
+// etag names a version of a resource by its update time, at the microsecond
+// precision Postgres keeps.
+func etag(updatedAt time.Time) string {
+	return `"` + strconv.FormatInt(updatedAt.UnixMicro(), 36) + `"`
+}
+
+// ifMatch sets version to the one the If-Match header names. "*" keeps the
+// version already read; anything that is not one of our ETags, weak ones
+// included, names no version at all and fails the update.
+func ifMatch(header *api.IfMatch, version *time.Time) error {
+	value := strings.TrimSpace(deref(header))
+	switch value {
+	case "":
+		return errPreconditionRequired
+	case "*":
+		return nil
+	}
+	raw, ok := strings.CutPrefix(value, `"`)
+	if ok {
+		raw, ok = strings.CutSuffix(raw, `"`)
+	}
+	if !ok {
+		return services.ErrVersionMismatch
+	}
+	micros, err := strconv.ParseInt(raw, 36, 64)
+	if err != nil {
+		return services.ErrVersionMismatch
+	}
+	*version = time.UnixMicro(micros).UTC()
+	return nil
+}
+
+func adminJSON(c echo.Context, status int, updatedAt time.Time, body any) error {
+	c.Response().Header().Set("ETag", etag(updatedAt))
+	return c.JSON(status, body)
+}
+
+// audit records a change made through the admin API. Changes made with the
+// admin token rather than as a signed-in user have no actor.
+func (s *Server) audit(c echo.Context, orbitID int64, action, target string, metadata map[string]any) {
+	var actorID *int64
+	if actor := actorFrom(c); actor != nil {
+		actorID = &actor.ID
+	}
+	s.audits.Record(c.Request().Context(), &models.AuditLog{
+		OrbitID:  orbitID,
+		ActorID:  actorID,
+		Action:   action,
+		Target:   target,
+		Metadata: metadata,
+	})
+}
+
+// changes describes an update for the audit log by the top-level fields that
+// differ between the representations before and after it.
+func changes(before, after any) map[string]any {
+	var b, a map[string]json.RawMessage
+	if !decodeFields(before, &b) || !decodeFields(after, &a) {
+		return nil
+	}
+	changed := []string{}
+	for name, value := range a {
+		if name != "updated_at" && !bytes.Equal(b[name], value) {
+			changed = append(changed, name)
+		}
+	}
+	for name := range b {
+		if _, ok := a[name]; !ok {
+			changed = append(changed, name)
+		}
+	}
+	slices.Sort(changed)
+	return map[string]any{"changed": changed}
+}
+
+func decodeFields(v any, fields *map[string]json.RawMessage) bool {
+	data, err := json.Marshal(v)
+	return err == nil && json.Unmarshal(data, fields) == nil
+}
+
+func orbitTarget(id int64) string {
+	return "orbit:" + strconv.FormatInt(id, 10)
+}
+
+func applyOrbitInput(o *models.Orbit, body api.OrbitInput) error {
+	o.Name = strings.TrimSpace(body.Name)
+	o.Domain = strings.ToLower(strings.TrimSpace(body.Domain))
+	o.Issuer = strings.TrimSpace(body.Issuer)
+	o.DisplayName = deref(body.DisplayName)
+	o.Description = deref(body.Description)
+	if o.Name == "" || o.Domain == "" {
+		return invalidAdminInput("name and domain are required")
+	}
+	if u, err := url.Parse(o.Issuer); err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" || u.RawQuery != "" || u.Fragment != "" {
+		return invalidAdminInput("issuer must be an http or https URL without query or fragment")
+	}
+
+	o.Config = json.RawMessage(`{}`)
+	if body.Config != nil {
+		o.Config, _ = json.Marshal(*body.Config)
+	}
+	if _, err := services.ParseOrbitConfig(o); err != nil {
+		return invalidAdminInput("config: %v", err)
+	}
+	o.DefaultScopes, _ = json.Marshal(nonNil(derefList(body.DefaultScopes)))
+	return nil
+}
+
+func toOrbit(o *models.Orbit) api.Orbit {
+	return api.Orbit{
+		Id:            o.ID,
+		Name:          o.Name,
+		DisplayName:   optional(o.DisplayName),
+		Description:   optional(o.Description),
+		Issuer:        o.Issuer,
+		Domain:        o.Domain,
+		Config:        jsonObject(o.Config),
+		DefaultScopes: jsonStrings(o.DefaultScopes),
+		CreatedAt:     o.CreatedAt,
+		UpdatedAt:     o.UpdatedAt,
+	}
+}
+
+func toAuditLog(entry *models.AuditLog) api.AuditLog {
+	out := api.AuditLog{
+		Id:        entry.ID,
+		ActorId:   entry.ActorID,
+		Action:    entry.Action,
+		Target:    entry.Target,
+		CreatedAt: entry.CreatedAt,
+	}
+	if len(entry.Metadata) > 0 {
+		out.Metadata = &entry.Metadata
+	}
+	return out
+}
+
+// jsonObject decodes a JSON object column, reading anything else as empty.
+func jsonObject(raw json.RawMessage) map[string]any {
+	out := map[string]any{}
+	if len(raw) > 0 {
+		_ = json.Unmarshal(raw, &out)
+	}
+	if out == nil {
+		out = map[string]any{}
+	}
+	return out
+}
+
+// optionalObject is jsonObject for optional fields, which are left out when
+// empty.
+func optionalObject(raw json.RawMessage) *map[string]any {
+	out := jsonObject(raw)
+	if len(out) == 0 {
+		return nil
+	}
+	return &out
+}
+
+func jsonStrings(raw json.RawMessage) []string {
+	var out []string
+	if len(raw) > 0 {
+		_ = json.Unmarshal(raw, &out)
+	}
+	return nonNil(out)
+}
+
+func nonNil(list []string) []string {
+	if list == nil {
+		return []string{}
+	}
+	return list
+}
@@ -0,0 +1,229 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/pkg/api"
+	"github.com/labstack/echo/v4"
+)
+
+func (s *Server) GetAdminV1OrbitsOrbitIdClients(c echo.Context, orbitId api.OrbitId, params api.GetAdminV1OrbitsOrbitIdClientsParams) error {
+	orbit, err := s.adminOrbit(c, orbitId)
+	if orbit == nil {
+		return err
+	}
+	page, err := adminPage(params.Limit, params.Cursor)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	filter := repositories.ClientFilter{AppType: deref(params.ApplicationType), IsActive: params.IsActive}
+	clients, next, err := s.clients.ListByOrbit(c.Request().Context(), orbit.ID, filter, page)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	out := api.OAuthClientList{Items: make([]api.OAuthClient, 0, len(clients)), NextCursor: optional(next)}
+	for _, client := range clients {
+		out.Items = append(out.Items, toOAuthClient(client))
+	}
+	return c.JSON(http.StatusOK, out)
+}
+
+func (s *Server) PostAdminV1OrbitsOrbitIdClients(c echo.Context, orbitId api.OrbitId) error {
+	orbit, err := s.adminOrbit(c, orbitId)
+	if orbit == nil {
+		return err
+	}
+	var body api.OAuthClientInput
+	if err := c.Bind(&body); err != nil {
+		return oauthError(c, http.StatusBadRequest, "invalid_request", "malformed request body")
+	}
+
+	created, secret, err := s.clients.CreateManaged(c.Request().Context(), orbit, toClientSettings(body))
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	s.audit(c, orbit.ID, "client.create", clientTarget(created.ClientID), nil)
+	out := toOAuthClient(created)
+	if secret != nil {
+		out.ClientSecret = &secret.Secret
+		out.ClientSecretExpiresAt = secret.ExpiresAt
+	}
+	return adminJSON(c, http.StatusCreated, created.UpdatedAt, out)
+}
+
+func (s *Server) GetAdminV1OrbitsOrbitIdClientsClientId(c echo.Context, orbitId api.OrbitId, clientId string) error {
+	client, err := s.adminClient(c, orbitId, clientId)
+	if client == nil {
+		return err
+	}
+	return adminJSON(c, http.StatusOK, client.UpdatedAt, toOAuthClient(client))
+}
+
+func (s *Server) PutAdminV1OrbitsOrbitIdClientsClientId(c echo.Context, orbitId api.OrbitId, clientId string, params api.PutAdminV1OrbitsOrbitIdClientsClientIdParams) error {
+	client, err := s.adminClient(c, orbitId, clientId)
+	if client == nil {
+		return err
+	}
+	var body api.OAuthClientInput
+	if err := c.Bind(&body); err != nil {
+		return oauthError(c, http.StatusBadRequest, "invalid_request", "malformed request body")
+	}
+
+	before := toOAuthClient(client)
+	if err := ifMatch(params.IfMatch, &client.UpdatedAt); err != nil {
+		return s.adminError(c, err)
+	}
+	updated, err := s.clients.UpdateManaged(c.Request().Context(), client, toClientSettings(body))
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	if updated == nil {
+		return oauthError(c, http.StatusNotFound, "not_found", services.ErrClientNotFound.Error())
+	}
+	after := toOAuthClient(updated)
+	s.audit(c, updated.OrbitID, "client.update", clientTarget(updated.ClientID), changes(before, after))
+	return adminJSON(c, http.StatusOK, updated.UpdatedAt, after)
+}
+
+func (s *Server) DeleteAdminV1OrbitsOrbitIdClientsClientId(c echo.Context, orbitId api.OrbitId, clientId string) error {
+	client, err := s.adminClient(c, orbitId, clientId)
+	if client == nil {
+		return err
+	}
+	if err := s.clients.Delete(c.Request().Context(), client.ID); err != nil {
+		return s.adminError(c, err)
+	}
+	s.audit(c, client.OrbitID, "client.delete", clientTarget(client.ClientID), nil)
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (s *Server) PostAdminV1OrbitsOrbitIdClientsClientIdSecret(c echo.Context, orbitId api.OrbitId, clientId string) error {
+	orbit, err := s.adminOrbit(c, orbitId)
+	if orbit == nil {
+		return err
+	}
+	issued, err := s.clients.RotateSecret(c.Request().Context(), orbit, clientId)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	s.audit(c, orbit.ID, "client.rotate_secret", clientTarget(clientId), nil)
+	return c.JSON(http.StatusCreated, api.ClientSecret{
+		ClientSecret:          issued.Secret,
+		ClientSecretExpiresAt: issued.ExpiresAt,
+	})
+}
+
+func (s *Server) adminClient(c echo.Context, orbitID int64, clientID string) (*models.Client, error) {
+	orbit, err := s.adminOrbit(c, orbitID)
+	if orbit == nil {
+		return nil, err
+	}
+	client, err := s.clients.GetByClientID(c.Request().Context(), orbit.ID, clientID)
+	if err != nil {
+		return nil, s.serverError(c, err)
+	}
+	if client == nil {
+		return nil, oauthError(c, http.StatusNotFound, "not_found", services.ErrClientNotFound.Error())
+	}
+	return client, nil
+}
+
+func clientTarget(clientID string) string {
+	return "client:" + clientID
+}
+
+func toClientSettings(body api.OAuthClientInput) services.ClientSettings {
+	md := services.ClientMetadata{
+		ClientID:                deref(body.ClientId),
+		RedirectURIs:            derefList(body.RedirectUris),
+		PostLogoutRedirectURIs:  derefList(body.PostLogoutRedirectUris),
+		GrantTypes:              derefList(body.GrantTypes),
+		ResponseTypes:           derefList(body.ResponseTypes),
+		TokenEndpointAuthMethod: string(deref(body.TokenEndpointAuthMethod)),
+		ApplicationType:         string(deref(body.ApplicationType)),
+		ClientName:              deref(body.ClientName),
+		ClientURI:               deref(body.ClientUri),
+		LogoURI:                 deref(body.LogoUri),
+		Scope:                   strings.Join(derefList(body.AllowedScopes), " "),
+		Contacts:                derefList(body.Contacts),
+		JWKSURI:                 deref(body.JwksUri),
+	}
+	if body.Jwks != nil {
+		md.JWKS, _ = json.Marshal(*body.Jwks)
+	}
+	return services.ClientSettings{
+		Metadata:           md,
+		Description:        deref(body.Description),
+		IsActive:           body.IsActive == nil || *body.IsActive,
+		AllowedCORSOrigins: derefList(body.AllowedCorsOrigins),
+		ClaimMappers:       toClaimMappers(deref(body.ClaimMappers)),
+	}
+}
+
+func toClaimMappers(in []api.ClaimMapper) []services.ClaimMapper {
+	out := make([]services.ClaimMapper, 0, len(in))
+	for _, m := range in {
+		mapper := services.ClaimMapper{Source: string(m.Source), Claim: m.Claim, Prefix: deref(m.Prefix)}
+		for _, t := range deref(m.Tokens) {
+			mapper.Tokens = append(mapper.Tokens, string(t))
+		}
+		out = append(out, mapper)
+	}
+	return out
+}
+
+func fromClaimMappers(in []services.ClaimMapper) []api.ClaimMapper {
+	out := make([]api.ClaimMapper, 0, len(in))
+	for _, m := range in {
+		mapper := api.ClaimMapper{Source: api.ClaimMapperSource(m.Source), Claim: m.Claim, Prefix: optional(m.Prefix)}
+		if len(m.Tokens) > 0 {
+			tokens := make([]api.ClaimMapperTokens, 0, len(m.Tokens))
+			for _, t := range m.Tokens {
+				tokens = append(tokens, api.ClaimMapperTokens(t))
+			}
+			mapper.Tokens = &tokens
+		}
+		out = append(out, mapper)
+	}
+	return out
+}
+
+func toOAuthClient(client *models.Client) api.OAuthClient {
+	md := services.ClientMetadataOf(client)
+	out := api.OAuthClient{
+		ClientId:                client.ClientID,
+		ClientName:              optional(md.ClientName),
+		Description:             optional(client.Description),
+		RedirectUris:            nonNil(md.RedirectURIs),
+		GrantTypes:              nonNil(md.GrantTypes),
+		ResponseTypes:           nonNil(md.ResponseTypes),
+		TokenEndpointAuthMethod: md.TokenEndpointAuthMethod,
+		ApplicationType:         md.ApplicationType,
+		IsPublic:                client.IsPublic,
+		IsActive:                client.IsActive,
+		AllowedScopes:           jsonStrings(client.AllowedScopes),
+		AllowedCorsOrigins:      jsonStrings(client.AllowedCORSOrigins),
+		ClientUri:               optional(md.ClientURI),
+		LogoUri:                 optional(md.LogoURI),
+		JwksUri:                 optional(md.JWKSURI),
+		Jwks:                    optionalObject(md.JWKS),
+		CreatedAt:               client.CreatedAt,
+		UpdatedAt:               client.UpdatedAt,
+	}
+	if len(md.PostLogoutRedirectURIs) > 0 {
+		out.PostLogoutRedirectUris = &md.PostLogoutRedirectURIs
+	}
+	if len(md.Contacts) > 0 {
+		out.Contacts = &md.Contacts
+	}
+	if mappers := services.ClaimMappersOf(client); len(mappers) > 0 {
+		claimMappers := fromClaimMappers(mappers)
+		out.ClaimMappers = &claimMappers
+	}
+	return out
+}
@@ -0,0 +1,184 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/pkg/api"
+	"github.com/labstack/echo/v4"
+)
+
+func (s *Server) GetAdminV1OrbitsOrbitIdGroups(c echo.Context, orbitId api.OrbitId, params api.GetAdminV1OrbitsOrbitIdGroupsParams) error {
+	orbit, err := s.adminOrbit(c, orbitId)
+	if orbit == nil {
+		return err
+	}
+	page, err := adminPage(params.Limit, params.Cursor)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	groups, next, err := s.groups.ListByOrbit(c.Request().Context(), orbit.ID, page)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	out := api.GroupList{Items: make([]api.Group, 0, len(groups)), NextCursor: optional(next)}
+	for _, group := range groups {
+		out.Items = append(out.Items, toGroup(group))
+	}
+	return c.JSON(http.StatusOK, out)
+}
+
+func (s *Server) PostAdminV1OrbitsOrbitIdGroups(c echo.Context, orbitId api.OrbitId) error {
+	orbit, err := s.adminOrbit(c, orbitId)
+	if orbit == nil {
+		return err
+	}
+	var body api.GroupInput
+	if err := c.Bind(&body); err != nil {
+		return oauthError(c, http.StatusBadRequest, "invalid_request", "malformed request body")
+	}
+	group := &models.Group{OrbitID: orbit.ID, Name: strings.TrimSpace(body.Name), Metadata: deref(body.Metadata)}
+	if group.Name == "" {
+		return s.adminError(c, invalidAdminInput("name is required"))
+	}
+
+	created, err := s.groups.Create(c.Request().Context(), group)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	s.audit(c, orbit.ID, "group.create", groupTarget(created.ID), nil)
+	return c.JSON(http.StatusCreated, toGroup(created))
+}
+
+func (s *Server) GetAdminV1OrbitsOrbitIdGroupsGroupId(c echo.Context, orbitId api.OrbitId, groupId int64) error {
+	group, err := s.adminGroup(c, orbitId, groupId)
+	if group == nil {
+		return err
+	}
+	return c.JSON(http.StatusOK, toGroup(group))
+}
+
+func (s *Server) DeleteAdminV1OrbitsOrbitIdGroupsGroupId(c echo.Context, orbitId api.OrbitId, groupId int64) error {
+	group, err := s.adminGroup(c, orbitId, groupId)
+	if group == nil {
+		return err
+	}
+	if err := s.groups.Delete(c.Request().Context(), group.OrbitID, group.ID); err != nil {
+		return s.adminError(c, err)
+	}
+	s.audit(c, group.OrbitID, "group.delete", groupTarget(group.ID), map[string]any{"name": group.Name})
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (s *Server) GetAdminV1OrbitsOrbitIdGroupsGroupIdMembers(c echo.Context, orbitId api.OrbitId, groupId int64, params api.GetAdminV1OrbitsOrbitIdGroupsGroupIdMembersParams) error {
+	orbit, err := s.adminOrbit(c, orbitId)
+	if orbit == nil {
+		return err
+	}
+	page, err := adminPage(params.Limit, params.Cursor)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	users, next, err := s.groups.ListMembers(c.Request().Context(), orbit.ID, groupId, page)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	out := api.UserList{Items: make([]api.User, 0, len(users)), NextCursor: optional(next)}
+	for _, user := range users {
+		out.Items = append(out.Items, toUser(user))
+	}
+	return c.JSON(http.StatusOK, out)
+}
+
+func (s *Server) PutAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserId(c echo.Context, orbitId api.OrbitId, groupId int64, userId int64) error {
+	orbit, err := s.adminOrbit(c, orbitId)
+	if orbit == nil {
+		return err
+	}
+	if err := s.groups.AddMember(c.Request().Context(), orbit.ID, groupId, userId); err != nil {
+		return s.adminError(c, err)
+	}
+	s.audit(c, orbit.ID, "group.add_member", groupTarget(groupId), map[string]any{"user": userTarget(userId)})
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (s *Server) DeleteAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserId(c echo.Context, orbitId api.OrbitId, groupId int64, userId int64) error {
+	orbit, err := s.adminOrbit(c, orbitId)
+	if orbit == nil {
+		return err
+	}
+	if err := s.groups.RemoveMember(c.Request().Context(), orbit.ID, groupId, userId); err != nil {
+		return s.adminError(c, err)
+	}
+	s.audit(c, orbit.ID, "group.remove_member", groupTarget(groupId), map[string]any{"user": userTarget(userId)})
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (s *Server) GetAdminV1OrbitsOrbitIdGroupsGroupIdRoles(c echo.Context, orbitId api.OrbitId, groupId int64) error {
+	orbit, err := s.adminOrbit(c, orbitId)
+	if orbit == nil {
+		return err
+	}
+	roles, err := s.groups.ListRoles(c.Request().Context(), orbit.ID, groupId)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	out := make([]api.Role, 0, len(roles))
+	for _, role := range roles {
+		out = append(out, toRole(role))
+	}
+	return c.JSON(http.StatusOK, out)
+}
+
+func (s *Server) PutAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleId(c echo.Context, orbitId api.OrbitId, groupId int64, roleId int64) error {
+	orbit, err := s.adminOrbit(c, orbitId)
+	if orbit == nil {
+		return err
+	}
+	if err := s.groups.AssignRole(c.Request().Context(), orbit.ID, groupId, roleId); err != nil {
+		return s.adminError(c, err)
+	}
+	s.audit(c, orbit.ID, "group.assign_role", groupTarget(groupId), map[string]any{"role": roleTarget(roleId)})
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (s *Server) DeleteAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleId(c echo.Context, orbitId api.OrbitId, groupId int64, roleId int64) error {
+	orbit, err := s.adminOrbit(c, orbitId)
+	if orbit == nil {
+		return err
+	}
+	if err := s.groups.RevokeRole(c.Request().Context(), orbit.ID, groupId, roleId); err != nil {
+		return s.adminError(c, err)
+	}
+	s.audit(c, orbit.ID, "group.revoke_role", groupTarget(groupId), map[string]any{"role": roleTarget(roleId)})
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (s *Server) adminGroup(c echo.Context, orbitID, groupID int64) (*models.Group, error) {
+	orbit, err := s.adminOrbit(c, orbitID)
+	if orbit == nil {
+		return nil, err
+	}
+	group, err := s.groups.GetByID(c.Request().Context(), orbit.ID, groupID)
+	if err != nil {
+		return nil, s.serverError(c, err)
+	}
+	if group == nil {
+		return nil, oauthError(c, http.StatusNotFound, "not_found", "unknown group")
+	}
+	return group, nil
+}
+
+func groupTarget(id int64) string {
+	return "group:" + strconv.FormatInt(id, 10)
+}
+
+func toGroup(group *models.Group) api.Group {
+	out := api.Group{Id: group.ID, Name: group.Name, CreatedAt: group.CreatedAt}
+	if len(group.Metadata) > 0 {
+		out.Metadata = &group.Metadata
+	}
+	return out
+}
@@ -0,0 +1,163 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/pkg/api"
+	"github.com/labstack/echo/v4"
+)
+
+func (s *Server) GetAdminV1OrbitsOrbitIdKeys(c echo.Context, orbitId api.OrbitId, params api.GetAdminV1OrbitsOrbitIdKeysParams) error {
+	orbit, err := s.adminOrbit(c, orbitId)
+	if orbit == nil {
+		return err
+	}
+	page, err := adminPage(params.Limit, params.Cursor)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	keys, next, err := s.keys.ListByOrbit(c.Request().Context(), orbit.ID, page)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	out := api.SigningKeyList{Items: make([]api.SigningKey, 0, len(keys)), NextCursor: optional(next)}
+	for _, key := range keys {
+		out.Items = append(out.Items, toSigningKey(key))
+	}
+	return c.JSON(http.StatusOK, out)
+}
+
+// PostAdminV1OrbitsOrbitIdKeys generates a key pair. The private key never
+// leaves the server.
+func (s *Server) PostAdminV1OrbitsOrbitIdKeys(c echo.Context, orbitId api.OrbitId) error {
+	orbit, err := s.adminOrbit(c, orbitId)
+	if orbit == nil {
+		return err
+	}
+	var body api.SigningKeyInput
+	if err := c.Bind(&body); err != nil {
+		return oauthError(c, http.StatusBadRequest, "invalid_request", "malformed request body")
+	}
+	if err := checkKeyValidity(body); err != nil {
+		return s.adminError(c, err)
+	}
+	alg := services.KeyAlgES256
+	if body.Alg != nil {
+		alg = string(*body.Alg)
+	}
+
+	ctx := c.Request().Context()
+	created, err := s.keys.Generate(ctx, orbit.ID, alg, body.NotBefore, body.ExpiresAt)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	// Keys are generated active; one staged for a later rollover is turned
+	// off right away.
+	if body.IsActive != nil && !*body.IsActive {
+		created.IsActive = false
+		if created, err = s.keys.Update(ctx, created); err != nil {
+			return s.adminError(c, err)
+		}
+	}
+	s.audit(c, orbit.ID, "key.create", keyTarget(created.ID), map[string]any{"kid": created.Kid, "alg": created.Alg})
+	return adminJSON(c, http.StatusCreated, created.UpdatedAt, toSigningKey(created))
+}
+
+func (s *Server) GetAdminV1OrbitsOrbitIdKeysKeyId(c echo.Context, orbitId api.OrbitId, keyId int64) error {
+	key, err := s.adminKey(c, orbitId, keyId)
+	if key == nil {
+		return err
+	}
+	return adminJSON(c, http.StatusOK, key.UpdatedAt, toSigningKey(key))
+}
+
+func (s *Server) PutAdminV1OrbitsOrbitIdKeysKeyId(c echo.Context, orbitId api.OrbitId, keyId int64, params api.PutAdminV1OrbitsOrbitIdKeysKeyIdParams) error {
+	key, err := s.adminKey(c, orbitId, keyId)
+	if key == nil {
+		return err
+	}
+	var body api.SigningKeyInput
+	if err := c.Bind(&body); err != nil {
+		return oauthError(c, http.StatusBadRequest, "invalid_request", "malformed request body")
+	}
+	if body.Alg != nil && string(*body.Alg) != key.Alg {
+		return s.adminError(c, invalidAdminInput("the algorithm of a key cannot be changed"))
+	}
+	if err := checkKeyValidity(body); err != nil {
+		return s.adminError(c, err)
+	}
+
+	before := toSigningKey(key)
+	if err := ifMatch(params.IfMatch, &key.UpdatedAt); err != nil {
+		return s.adminError(c, err)
+	}
+	key.IsActive = body.IsActive == nil || *body.IsActive
+	key.NotBefore = body.NotBefore
+	key.ExpiresAt = body.ExpiresAt
+	updated, err := s.keys.UpdateIfUnmodified(c.Request().Context(), key)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	if updated == nil {
+		return oauthError(c, http.StatusNotFound, "not_found", "unknown signing key")
+	}
+	after := toSigningKey(updated)
+	s.audit(c, updated.OrbitID, "key.update", keyTarget(updated.ID), changes(before, after))
+	return adminJSON(c, http.StatusOK, updated.UpdatedAt, after)
+}
+
+func (s *Server) DeleteAdminV1OrbitsOrbitIdKeysKeyId(c echo.Context, orbitId api.OrbitId, keyId int64) error {
+	key, err := s.adminKey(c, orbitId, keyId)
+	if key == nil {
+		return err
+	}
+	if err := s.keys.Delete(c.Request().Context(), key.ID, key.OrbitID); err != nil {
+		return s.adminError(c, err)
+	}
+	s.audit(c, key.OrbitID, "key.delete", keyTarget(key.ID), map[string]any{"kid": key.Kid})
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (s *Server) adminKey(c echo.Context, orbitID, keyID int64) (*models.JWKey, error) {
+	orbit, err := s.adminOrbit(c, orbitID)
+	if orbit == nil {
+		return nil, err
+	}
+	key, err := s.keys.GetByID(c.Request().Context(), keyID)
+	if err != nil {
+		return nil, s.serverError(c, err)
+	}
+	if key == nil || key.OrbitID != orbit.ID {
+		return nil, oauthError(c, http.StatusNotFound, "not_found", "unknown signing key")
+	}
+	return key, nil
+}
+
+func checkKeyValidity(body api.SigningKeyInput) error {
+	if body.NotBefore != nil && body.ExpiresAt != nil && !body.ExpiresAt.After(*body.NotBefore) {
+		return invalidAdminInput("expires_at must be after not_before")
+	}
+	return nil
+}
+
+func keyTarget(id int64) string {
+	return "key:" + strconv.FormatInt(id, 10)
+}
+
+func toSigningKey(key *models.JWKey) api.SigningKey {
+	return api.SigningKey{
+		Id:        key.ID,
+		Kid:       key.Kid,
+		Alg:       key.Alg,
+		Use:       key.Use,
+		PublicJwk: jsonObject(key.PublicKeyJWK),
+		IsActive:  key.IsActive,
+		NotBefore: key.NotBefore,
+		ExpiresAt: key.ExpiresAt,
+		CreatedAt: key.CreatedAt,
+		UpdatedAt: key.UpdatedAt,
+	}
+}
@@ -0,0 +1,187 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/pkg/api"
+	"github.com/labstack/echo/v4"
+)
+
+func (s *Server) GetAdminV1OrbitsOrbitIdPolicies(c echo.Context, orbitId api.OrbitId, params api.GetAdminV1OrbitsOrbitIdPoliciesParams) error {
+	orbit, err := s.adminOrbit(c, orbitId)
+	if orbit == nil {
+		return err
+	}
+	page, err := adminPage(params.Limit, params.Cursor)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	policies, next, err := s.policies.ListByOrbit(c.Request().Context(), orbit.ID, page)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	out := api.PolicyList{Items: make([]api.Policy, 0, len(policies)), NextCursor: optional(next)}
+	for _, p := range policies {
+		out.Items = append(out.Items, toPolicy(p))
+	}
+	return c.JSON(http.StatusOK, out)
+}
+
+func (s *Server) PostAdminV1OrbitsOrbitIdPolicies(c echo.Context, orbitId api.OrbitId) error {
+	orbit, err := s.adminOrbit(c, orbitId)
+	if orbit == nil {
+		return err
+	}
+	var body api.PolicyInput
+	if err := c.Bind(&body); err != nil {
+		return oauthError(c, http.StatusBadRequest, "invalid_request", "malformed request body")
+	}
+	p := &models.Policy{OrbitID: orbit.ID}
+	applyPolicyInput(p, body)
+
+	created, err := s.policies.Create(c.Request().Context(), p)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	s.audit(c, orbit.ID, "policy.create", "policy:"+created.Name, nil)
+	return adminJSON(c, http.StatusCreated, created.UpdatedAt, toPolicy(created))
+}
+
+func (s *Server) GetAdminV1OrbitsOrbitIdPoliciesPolicyId(c echo.Context, orbitId api.OrbitId, policyId int64) error {
+	p, err := s.adminPolicy(c, orbitId, policyId)
+	if p == nil {
+		return err
+	}
+	return adminJSON(c, http.StatusOK, p.UpdatedAt, toPolicy(p))
+}
+
+func (s *Server) PutAdminV1OrbitsOrbitIdPoliciesPolicyId(c echo.Context, orbitId api.OrbitId, policyId int64, params api.PutAdminV1OrbitsOrbitIdPoliciesPolicyIdParams) error {
+	p, err := s.adminPolicy(c, orbitId, policyId)
+	if p == nil {
+		return err
+	}
+	var body api.PolicyInput
+	if err := c.Bind(&body); err != nil {
+		return oauthError(c, http.StatusBadRequest, "invalid_request", "malformed request body")
+	}
+
+	before := toPolicy(p)
+	if err := ifMatch(params.IfMatch, &p.UpdatedAt); err != nil {
+		return s.adminError(c, err)
+	}
+	applyPolicyInput(p, body)
+	updated, err := s.policies.UpdateIfUnmodified(c.Request().Context(), p)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	if updated == nil {
+		return oauthError(c, http.StatusNotFound, "not_found", "unknown policy")
+	}
+	after := toPolicy(updated)
+	s.audit(c, updated.OrbitID, "policy.update", "policy:"+updated.Name, changes(before, after))
+	return adminJSON(c, http.StatusOK, updated.UpdatedAt, after)
+}
+
+func (s *Server) DeleteAdminV1OrbitsOrbitIdPoliciesPolicyId(c echo.Context, orbitId api.OrbitId, policyId int64) error {
+	p, err := s.adminPolicy(c, orbitId, policyId)
+	if p == nil {
+		return err
+	}
+	if err := s.policies.Delete(c.Request().Context(), p.OrbitID, p.ID); err != nil {
+		return s.adminError(c, err)
+	}
+	s.audit(c, p.OrbitID, "policy.delete", "policy:"+p.Name, nil)
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (s *Server) GetAdminV1OrbitsOrbitIdPolicyDecisions(c echo.Context, orbitId api.OrbitId, params api.GetAdminV1OrbitsOrbitIdPolicyDecisionsParams) error {
+	orbit, err := s.adminOrbit(c, orbitId)
+	if orbit == nil {
+		return err
+	}
+	page, err := adminPage(params.Limit, params.Cursor)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	filter := repositories.PolicyDecisionFilter{
+		Action:   deref(params.Action),
+		Decision: models.PolicyEffect(deref(params.Decision)),
+		UserID:   params.UserId,
+		Since:    params.Since,
+		Until:    params.Until,
+	}
+	decisions, next, err := s.policies.ListDecisions(c.Request().Context(), orbit.ID, filter, page)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	out := api.PolicyDecisionList{Items: make([]api.PolicyDecision, 0, len(decisions)), NextCursor: optional(next)}
+	for _, d := range decisions {
+		out.Items = append(out.Items, toPolicyDecision(d))
+	}
+	return c.JSON(http.StatusOK, out)
+}
+
+func (s *Server) adminPolicy(c echo.Context, orbitID, policyID int64) (*models.Policy, error) {
+	orbit, err := s.adminOrbit(c, orbitID)
+	if orbit == nil {
+		return nil, err
+	}
+	p, err := s.policies.GetByID(c.Request().Context(), orbit.ID, policyID)
+	if err != nil {
+		return nil, s.serverError(c, err)
+	}
+	if p == nil {
+		return nil, oauthError(c, http.StatusNotFound, "not_found", "unknown policy")
+	}
+	return p, nil
+}
+
+func applyPolicyInput(p *models.Policy, body api.PolicyInput) {
+	p.Name = body.Name
+	p.Description = deref(body.Description)
+	p.Effect = models.PolicyEffect(body.Effect)
+	p.Actions = body.Actions
+	p.Condition = deref(body.Condition)
+	p.Obligations = deref(body.Obligations)
+	p.IsActive = body.IsActive == nil || *body.IsActive
+}
+
+func toPolicy(p *models.Policy) api.Policy {
+	obligations := p.Obligations
+	if obligations == nil {
+		obligations = []map[string]any{}
+	}
+	return api.Policy{
+		Id:          p.ID,
+		Name:        p.Name,
+		Description: optional(p.Description),
+		Effect:      api.PolicyEffect(p.Effect),
+		Actions:     nonNil(p.Actions),
+		Condition:   p.Condition,
+		Obligations: obligations,
+		IsActive:    p.IsActive,
+		CreatedAt:   p.CreatedAt,
+		UpdatedAt:   p.UpdatedAt,
+	}
+}
+
+func toPolicyDecision(d *models.PolicyDecision) api.PolicyDecision {
+	out := api.PolicyDecision{
+		Id:        d.ID,
+		ClientId:  d.ClientID,
+		UserId:    d.UserID,
+		Action:    d.Action,
+		Decision:  api.PolicyDecisionDecision(d.Decision),
+		Policies:  nonNil(d.Policies),
+		CreatedAt: d.CreatedAt,
+	}
+	if len(d.Request) > 0 {
+		out.Request = &d.Request
+	}
+	if len(d.Errors) > 0 {
+		out.Errors = &d.Errors
+	}
+	return out
+}
@@ -0,0 +1,323 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/pkg/api"
+	"github.com/labstack/echo/v4"
+)
+
+func (s *Server) GetAdminV1OrbitsOrbitIdRoles(c echo.Context, orbitId api.OrbitId, params api.GetAdminV1OrbitsOrbitIdRolesParams) error {
+	orbit, err := s.adminOrbit(c, orbitId)
+	if orbit == nil {
+		return err
+	}
+	page, err := adminPage(params.Limit, params.Cursor)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	roles, next, err := s.roles.ListByOrbit(c.Request().Context(), orbit.ID, page)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	out := api.RoleList{Items: make([]api.Role, 0, len(roles)), NextCursor: optional(next)}
+	for _, role := range roles {
+		out.Items = append(out.Items, toRole(role))
+	}
+	return c.JSON(http.StatusOK, out)
+}
+
+func (s *Server) PostAdminV1OrbitsOrbitIdRoles(c echo.Context, orbitId api.OrbitId) error {
+	orbit, err := s.adminOrbit(c, orbitId)
+	if orbit == nil {
+		return err
+	}
+	var body api.RoleInput
+	if err := c.Bind(&body); err != nil {
+		return oauthError(c, http.StatusBadRequest, "invalid_request", "malformed request body")
+	}
+	role := &models.Role{OrbitID: orbit.ID, Name: strings.TrimSpace(body.Name), Metadata: deref(body.Metadata)}
+	if role.Name == "" {
+		return s.adminError(c, invalidAdminInput("name is required"))
+	}
+
+	created, err := s.roles.Create(c.Request().Context(), role)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	s.audit(c, orbit.ID, "role.create", roleTarget(created.ID), nil)
+	return c.JSON(http.StatusCreated, toRole(created))
+}
+
+func (s *Server) GetAdminV1OrbitsOrbitIdRolesRoleId(c echo.Context, orbitId api.OrbitId, roleId int64) error {
+	orbit, err := s.adminOrbit(c, orbitId)
+	if orbit == nil {
+		return err
+	}
+	role, err := s.roles.GetByID(c.Request().Context(), orbit.ID, roleId)
+	if err != nil {
+		return s.serverError(c, err)
+	}
+	if role == nil {
+		return oauthError(c, http.StatusNotFound, "not_found", "unknown role")
+	}
+	return c.JSON(http.StatusOK, toRole(role))
+}
+
+func (s *Server) DeleteAdminV1OrbitsOrbitIdRolesRoleId(c echo.Context, orbitId api.OrbitId, roleId int64) error {
+	orbit, err := s.adminOrbit(c, orbitId)
+	if orbit == nil {
+		return err
+	}
+	ctx := c.Request().Context()
+	role, err := s.roles.GetByID(ctx, orbit.ID, roleId)
+	if err != nil {
+		return s.serverError(c, err)
+	}
+	if role == nil {
+		return oauthError(c, http.StatusNotFound, "not_found", "unknown role")
+	}
+	if err := s.roles.Delete(ctx, orbit.ID, role.ID); err != nil {
+		return s.adminError(c, err)
+	}
+	s.audit(c, orbit.ID, "role.delete", roleTarget(role.ID), map[string]any{"name": role.Name})
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (s *Server) GetAdminV1OrbitsOrbitIdRolesRoleIdPermissions(c echo.Context, orbitId api.OrbitId, roleId int64) error {
+	orbit, err := s.adminOrbit(c, orbitId)
+	if orbit == nil {
+		return err
+	}
+	permissions, err := s.roles.ListPermissions(c.Request().Context(), orbit.ID, roleId)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	out := make([]api.Permission, 0, len(permissions))
+	for _, p := range permissions {
+		out = append(out, toPermission(p))
+	}
+	return c.JSON(http.StatusOK, out)
+}
+
+func (s *Server) PutAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionId(c echo.Context, orbitId api.OrbitId, roleId int64, permissionId int64) error {
+	orbit, err := s.adminOrbit(c, orbitId)
+	if orbit == nil {
+		return err
+	}
+	if err := s.roles.GrantPermission(c.Request().Context(), orbit.ID, roleId, permissionId); err != nil {
+		return s.adminError(c, err)
+	}
+	s.audit(c, orbit.ID, "role.grant_permission", roleTarget(roleId), map[string]any{"permission": permissionTarget(permissionId)})
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (s *Server) DeleteAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionId(c echo.Context, orbitId api.OrbitId, roleId int64, permissionId int64) error {
+	orbit, err := s.adminOrbit(c, orbitId)
+	if orbit == nil {
+		return err
+	}
+	if err := s.roles.RevokePermission(c.Request().Context(), orbit.ID, roleId, permissionId); err != nil {
+		return s.adminError(c, err)
+	}
+	s.audit(c, orbit.ID, "role.revoke_permission", roleTarget(roleId), map[string]any{"permission": permissionTarget(permissionId)})
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (s *Server) GetAdminV1OrbitsOrbitIdRolesRoleIdIncludes(c echo.Context, orbitId api.OrbitId, roleId int64) error {
+	orbit, err := s.adminOrbit(c, orbitId)
+	if orbit == nil {
+		return err
+	}
+	roles, err := s.roles.ListIncluded(c.Request().Context(), orbit.ID, roleId)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	out := make([]api.Role, 0, len(roles))
+	for _, role := range roles {
+		out = append(out, toRole(role))
+	}
+	return c.JSON(http.StatusOK, out)
+}
+
+func (s *Server) PutAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleId(c echo.Context, orbitId api.OrbitId, roleId int64, includedRoleId int64) error {
+	orbit, err := s.adminOrbit(c, orbitId)
+	if orbit == nil {
+		return err
+	}
+	if err := s.roles.IncludeRole(c.Request().Context(), orbit.ID, roleId, includedRoleId); err != nil {
+		return s.adminError(c, err)
+	}
+	s.audit(c, orbit.ID, "role.include_role", roleTarget(roleId), map[string]any{"role": roleTarget(includedRoleId)})
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (s *Server) DeleteAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleId(c echo.Context, orbitId api.OrbitId, roleId int64, includedRoleId int64) error {
+	orbit, err := s.adminOrbit(c, orbitId)
+	if orbit == nil {
+		return err
+	}
+	if err := s.roles.ExcludeRole(c.Request().Context(), orbit.ID, roleId, includedRoleId); err != nil {
+		return s.adminError(c, err)
+	}
+	s.audit(c, orbit.ID, "role.exclude_role", roleTarget(roleId), map[string]any{"role": roleTarget(includedRoleId)})
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (s *Server) GetAdminV1OrbitsOrbitIdUsersUserIdRoles(c echo.Context, orbitId api.OrbitId, userId int64) error {
+	user, err := s.adminUser(c, orbitId, userId)
+	if user == nil {
+		return err
+	}
+	roles, err := s.roles.ListByUser(c.Request().Context(), user.OrbitID, user.ID)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	out := make([]api.Role, 0, len(roles))
+	for _, role := range roles {
+		out = append(out, toRole(role))
+	}
+	return c.JSON(http.StatusOK, out)
+}
+
+func (s *Server) PutAdminV1OrbitsOrbitIdUsersUserIdRolesRoleId(c echo.Context, orbitId api.OrbitId, userId int64, roleId int64) error {
+	orbit, err := s.adminOrbit(c, orbitId)
+	if orbit == nil {
+		return err
+	}
+	if err := s.roles.AssignToUser(c.Request().Context(), orbit.ID, roleId, userId); err != nil {
+		return s.adminError(c, err)
+	}
+	s.audit(c, orbit.ID, "user.assign_role", userTarget(userId), map[string]any{"role": roleTarget(roleId)})
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (s *Server) DeleteAdminV1OrbitsOrbitIdUsersUserIdRolesRoleId(c echo.Context, orbitId api.OrbitId, userId int64, roleId int64) error {
+	orbit, err := s.adminOrbit(c, orbitId)
+	if orbit == nil {
+		return err
+	}
+	if err := s.roles.RevokeFromUser(c.Request().Context(), orbit.ID, roleId, userId); err != nil {
+		return s.adminError(c, err)
+	}
+	s.audit(c, orbit.ID, "user.revoke_role", userTarget(userId), map[string]any{"role": roleTarget(roleId)})
+	return c.NoContent(http.StatusNoContent)
+}
+
+// GetAdminV1OrbitsOrbitIdUsersUserIdPermissions lists what the user holds
+// through its roles, the same set admin requests are authorized against.
+func (s *Server) GetAdminV1OrbitsOrbitIdUsersUserIdPermissions(c echo.Context, orbitId api.OrbitId, userId int64) error {
+	user, err := s.adminUser(c, orbitId, userId)
+	if user == nil {
+		return err
+	}
+	names, err := s.roles.EffectivePermissions(c.Request().Context(), user.OrbitID, user.ID)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	return c.JSON(http.StatusOK, names)
+}
+
+func (s *Server) GetAdminV1OrbitsOrbitIdPermissions(c echo.Context, orbitId api.OrbitId, params api.GetAdminV1OrbitsOrbitIdPermissionsParams) error {
+	orbit, err := s.adminOrbit(c, orbitId)
+	if orbit == nil {
+		return err
+	}
+	page, err := adminPage(params.Limit, params.Cursor)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	permissions, next, err := s.permissions.ListByOrbit(c.Request().Context(), orbit.ID, page)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	out := api.PermissionList{Items: make([]api.Permission, 0, len(permissions)), NextCursor: optional(next)}
+	for _, p := range permissions {
+		out.Items = append(out.Items, toPermission(p))
+	}
+	return c.JSON(http.StatusOK, out)
+}
+
+func (s *Server) PostAdminV1OrbitsOrbitIdPermissions(c echo.Context, orbitId api.OrbitId) error {
+	orbit, err := s.adminOrbit(c, orbitId)
+	if orbit == nil {
+		return err
+	}
+	var body api.PermissionInput
+	if err := c.Bind(&body); err != nil {
+		return oauthError(c, http.StatusBadRequest, "invalid_request", "malformed request body")
+	}
+	p := &models.Permission{OrbitID: orbit.ID, Name: strings.TrimSpace(body.Name), Metadata: deref(body.Metadata)}
+	if p.Name == "" {
+		return s.adminError(c, invalidAdminInput("name is required"))
+	}
+
+	created, err := s.permissions.Create(c.Request().Context(), p)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	s.audit(c, orbit.ID, "permission.create", permissionTarget(created.ID), nil)
+	return c.JSON(http.StatusCreated, toPermission(created))
+}
+
+func (s *Server) GetAdminV1OrbitsOrbitIdPermissionsPermissionId(c echo.Context, orbitId api.OrbitId, permissionId int64) error {
+	orbit, err := s.adminOrbit(c, orbitId)
+	if orbit == nil {
+		return err
+	}
+	p, err := s.permissions.GetByID(c.Request().Context(), orbit.ID, permissionId)
+	if err != nil {
+		return s.serverError(c, err)
+	}
+	if p == nil {
+		return oauthError(c, http.StatusNotFound, "not_found", "unknown permission")
+	}
+	return c.JSON(http.StatusOK, toPermission(p))
+}
+
+func (s *Server) DeleteAdminV1OrbitsOrbitIdPermissionsPermissionId(c echo.Context, orbitId api.OrbitId, permissionId int64) error {
+	orbit, err := s.adminOrbit(c, orbitId)
+	if orbit == nil {
+		return err
+	}
+	ctx := c.Request().Context()
+	p, err := s.permissions.GetByID(ctx, orbit.ID, permissionId)
+	if err != nil {
+		return s.serverError(c, err)
+	}
+	if p == nil {
+		return oauthError(c, http.StatusNotFound, "not_found", "unknown permission")
+	}
+	if err := s.permissions.Delete(ctx, orbit.ID, p.ID); err != nil {
+		return s.adminError(c, err)
+	}
+	s.audit(c, orbit.ID, "permission.delete", permissionTarget(p.ID), map[string]any{"name": p.Name})
+	return c.NoContent(http.StatusNoContent)
+}
+
+func roleTarget(id int64) string {
+	return "role:" + strconv.FormatInt(id, 10)
+}
+
+func permissionTarget(id int64) string {
+	return "permission:" + strconv.FormatInt(id, 10)
+}
+
+func toRole(role *models.Role) api.Role {
+	out := api.Role{Id: role.ID, Name: role.Name, CreatedAt: role.CreatedAt}
+	if len(role.Metadata) > 0 {
+		out.Metadata = &role.Metadata
+	}
+	return out
+}
+
+func toPermission(p *models.Permission) api.Permission {
+	out := api.Permission{Id: p.ID, Name: p.Name, CreatedAt: p.CreatedAt}
+	if len(p.Metadata) > 0 {
+		out.Metadata = &p.Metadata
+	}
+	return out
+}
@@ -0,0 +1,137 @@
+package handlers
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/pkg/api"
+	"github.com/labstack/echo/v4"
+)
+
+func (s *Server) GetAdminV1OrbitsOrbitIdScopes(c echo.Context, orbitId api.OrbitId, params api.GetAdminV1OrbitsOrbitIdScopesParams) error {
+	orbit, err := s.adminOrbit(c, orbitId)
+	if orbit == nil {
+		return err
+	}
+	page, err := adminPage(params.Limit, params.Cursor)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	scopes, next, err := s.scopes.ListByOrbit(c.Request().Context(), orbit.ID, page)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	out := api.ScopeList{Items: make([]api.Scope, 0, len(scopes)), NextCursor: optional(next)}
+	for _, scope := range scopes {
+		out.Items = append(out.Items, toScope(scope))
+	}
+	return c.JSON(http.StatusOK, out)
+}
+
+func (s *Server) PostAdminV1OrbitsOrbitIdScopes(c echo.Context, orbitId api.OrbitId) error {
+	orbit, err := s.adminOrbit(c, orbitId)
+	if orbit == nil {
+		return err
+	}
+	var body api.ScopeInput
+	if err := c.Bind(&body); err != nil {
+		return oauthError(c, http.StatusBadRequest, "invalid_request", "malformed request body")
+	}
+	scope := &models.Scope{OrbitID: orbit.ID, Name: strings.TrimSpace(body.Name)}
+	if scope.Name == "" || strings.ContainsAny(scope.Name, " \t\r\n\"\\") {
+		return s.adminError(c, invalidAdminInput("name must be a non-empty scope token"))
+	}
+	applyScopeInput(scope, body)
+
+	created, err := s.scopes.Create(c.Request().Context(), scope)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	s.audit(c, orbit.ID, "scope.create", "scope:"+created.Name, nil)
+	return adminJSON(c, http.StatusCreated, created.UpdatedAt, toScope(created))
+}
+
+func (s *Server) GetAdminV1OrbitsOrbitIdScopesScopeId(c echo.Context, orbitId api.OrbitId, scopeId int64) error {
+	scope, err := s.adminScope(c, orbitId, scopeId)
+	if scope == nil {
+		return err
+	}
+	return adminJSON(c, http.StatusOK, scope.UpdatedAt, toScope(scope))
+}
+
+func (s *Server) PutAdminV1OrbitsOrbitIdScopesScopeId(c echo.Context, orbitId api.OrbitId, scopeId int64, params api.PutAdminV1OrbitsOrbitIdScopesScopeIdParams) error {
+	scope, err := s.adminScope(c, orbitId, scopeId)
+	if scope == nil {
+		return err
+	}
+	var body api.ScopeInput
+	if err := c.Bind(&body); err != nil {
+		return oauthError(c, http.StatusBadRequest, "invalid_request", "malformed request body")
+	}
+	// Tokens and consents refer to scopes by name.
+	if strings.TrimSpace(body.Name) != scope.Name {
+		return s.adminError(c, invalidAdminInput("the name of a scope cannot be changed"))
+	}
+
+	before := toScope(scope)
+	if err := ifMatch(params.IfMatch, &scope.UpdatedAt); err != nil {
+		return s.adminError(c, err)
+	}
+	applyScopeInput(scope, body)
+	updated, err := s.scopes.UpdateIfUnmodified(c.Request().Context(), scope)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	if updated == nil {
+		return oauthError(c, http.StatusNotFound, "not_found", "unknown scope")
+	}
+	after := toScope(updated)
+	s.audit(c, updated.OrbitID, "scope.update", "scope:"+updated.Name, changes(before, after))
+	return adminJSON(c, http.StatusOK, updated.UpdatedAt, after)
+}
+
+func (s *Server) DeleteAdminV1OrbitsOrbitIdScopesScopeId(c echo.Context, orbitId api.OrbitId, scopeId int64) error {
+	scope, err := s.adminScope(c, orbitId, scopeId)
+	if scope == nil {
+		return err
+	}
+	if err := s.scopes.Delete(c.Request().Context(), scope.OrbitID, scope.ID); err != nil {
+		return s.adminError(c, err)
+	}
+	s.audit(c, scope.OrbitID, "scope.delete", "scope:"+scope.Name, nil)
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (s *Server) adminScope(c echo.Context, orbitID, scopeID int64) (*models.Scope, error) {
+	orbit, err := s.adminOrbit(c, orbitID)
+	if orbit == nil {
+		return nil, err
+	}
+	scope, err := s.scopes.GetByID(c.Request().Context(), orbit.ID, scopeID)
+	if err != nil {
+		return nil, s.serverError(c, err)
+	}
+	if scope == nil || scope.DeletedAt != nil {
+		return nil, oauthError(c, http.StatusNotFound, "not_found", "unknown scope")
+	}
+	return scope, nil
+}
+
+func applyScopeInput(scope *models.Scope, body api.ScopeInput) {
+	scope.Description = deref(body.Description)
+	scope.IsDefault = deref(body.IsDefault)
+	scope.IsActive = body.IsActive == nil || *body.IsActive
+}
+
+func toScope(scope *models.Scope) api.Scope {
+	return api.Scope{
+		Id:          scope.ID,
+		Name:        scope.Name,
+		Description: optional(scope.Description),
+		IsDefault:   scope.IsDefault,
+		IsActive:    scope.IsActive,
+		CreatedAt:   scope.CreatedAt,
+		UpdatedAt:   scope.UpdatedAt,
+	}
+}
@@ -0,0 +1,184 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/pkg/api"
+	"github.com/labstack/echo/v4"
+)
+
+func (s *Server) GetAdminV1OrbitsOrbitIdUsers(c echo.Context, orbitId api.OrbitId, params api.GetAdminV1OrbitsOrbitIdUsersParams) error {
+	orbit, err := s.adminOrbit(c, orbitId)
+	if orbit == nil {
+		return err
+	}
+	page, err := adminPage(params.Limit, params.Cursor)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	filter := repositories.UserFilter{
+		EmailPrefix:   deref(params.EmailPrefix),
+		IsActive:      params.IsActive,
+		CreatedAfter:  params.CreatedAfter,
+		CreatedBefore: params.CreatedBefore,
+	}
+	users, next, err := s.users.ListByOrbit(c.Request().Context(), orbit.ID, filter, page)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	out := api.UserList{Items: make([]api.User, 0, len(users)), NextCursor: optional(next)}
+	for _, user := range users {
+		out.Items = append(out.Items, toUser(user))
+	}
+	return c.JSON(http.StatusOK, out)
+}
+
+func (s *Server) PostAdminV1OrbitsOrbitIdUsers(c echo.Context, orbitId api.OrbitId) error {
+	orbit, err := s.adminOrbit(c, orbitId)
+	if orbit == nil {
+		return err
+	}
+	var body api.UserInput
+	if err := c.Bind(&body); err != nil {
+		return oauthError(c, http.StatusBadRequest, "invalid_request", "malformed request body")
+	}
+	user := &models.User{OrbitID: orbit.ID}
+	if err := applyUserInput(user, body); err != nil {
+		return s.adminError(c, err)
+	}
+
+	ctx := c.Request().Context()
+	var created *models.User
+	if password := deref(body.Password); password != "" {
+		created, err = s.users.CreateWithPassword(ctx, orbit, user, password)
+	} else {
+		created, err = s.users.Create(ctx, user)
+	}
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	s.audit(c, orbit.ID, "user.create", userTarget(created.ID), nil)
+	return adminJSON(c, http.StatusCreated, created.UpdatedAt, toUser(created))
+}
+
+func (s *Server) GetAdminV1OrbitsOrbitIdUsersUserId(c echo.Context, orbitId api.OrbitId, userId int64) error {
+	user, err := s.adminUser(c, orbitId, userId)
+	if user == nil {
+		return err
+	}
+	return adminJSON(c, http.StatusOK, user.UpdatedAt, toUser(user))
+}
+
+func (s *Server) PutAdminV1OrbitsOrbitIdUsersUserId(c echo.Context, orbitId api.OrbitId, userId int64, params api.PutAdminV1OrbitsOrbitIdUsersUserIdParams) error {
+	user, err := s.adminUser(c, orbitId, userId)
+	if user == nil {
+		return err
+	}
+	var body api.UserInput
+	if err := c.Bind(&body); err != nil {
+		return oauthError(c, http.StatusBadRequest, "invalid_request", "malformed request body")
+	}
+	if body.Password != nil {
+		return s.adminError(c, invalidAdminInput("password can only be set when the user is created"))
+	}
+
+	before := toUser(user)
+	if err := ifMatch(params.IfMatch, &user.UpdatedAt); err != nil {
+		return s.adminError(c, err)
+	}
+	if err := applyUserInput(user, body); err != nil {
+		return s.adminError(c, err)
+	}
+	updated, err := s.users.UpdateIfUnmodified(c.Request().Context(), user)
+	if err != nil {
+		return s.adminError(c, err)
+	}
+	if updated == nil {
+		return oauthError(c, http.StatusNotFound, "not_found", services.ErrUserNotFound.Error())
+	}
+	after := toUser(updated)
+	s.audit(c, updated.OrbitID, "user.update", userTarget(updated.ID), changes(before, after))
+	return adminJSON(c, http.StatusOK, updated.UpdatedAt, after)
+}
+
+func (s *Server) DeleteAdminV1OrbitsOrbitIdUsersUserId(c echo.Context, orbitId api.OrbitId, userId int64) error {
+	user, err := s.adminUser(c, orbitId, userId)
+	if user == nil {
+		return err
+	}
+	if err := s.users.Delete(c.Request().Context(), user.ID); err != nil {
+		return s.adminError(c, err)
+	}
+	s.audit(c, user.OrbitID, "user.delete", userTarget(user.ID), nil)
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (s *Server) PostAdminV1OrbitsOrbitIdUsersUserIdUnlock(c echo.Context, orbitId api.OrbitId, userId int64) error {
+	orbit, err := s.adminOrbit(c, orbitId)
+	if orbit == nil {
+		return err
+	}
+	if err := s.lockout.Unlock(c.Request().Context(), orbit, userId); err != nil {
+		return s.adminError(c, err)
+	}
+	s.audit(c, orbit.ID, "user.unlock", userTarget(userId), nil)
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (s *Server) adminUser(c echo.Context, orbitID, userID int64) (*models.User, error) {
+	orbit, err := s.adminOrbit(c, orbitID)
+	if orbit == nil {
+		return nil, err
+	}
+	user, err := s.users.GetByID(c.Request().Context(), userID)
+	if err != nil {
+		return nil, s.serverError(c, err)
+	}
+	if user == nil || user.OrbitID != orbit.ID || user.DeletedAt != nil {
+		return nil, oauthError(c, http.StatusNotFound, "not_found", services.ErrUserNotFound.Error())
+	}
+	return user, nil
+}
+
+func userTarget(id int64) string {
+	return "user:" + strconv.FormatInt(id, 10)
+}
+
+func applyUserInput(user *models.User, body api.UserInput) error {
+	user.Username = strings.TrimSpace(body.Username)
+	if user.Username == "" {
+		return invalidAdminInput("username is required")
+	}
+	user.Email = strings.TrimSpace(deref(body.Email))
+	user.EmailVerified = user.Email != "" && deref(body.EmailVerified)
+	user.DisplayName = deref(body.DisplayName)
+	user.IsActive = body.IsActive == nil || *body.IsActive
+	user.Profile = nil
+	if body.Profile != nil {
+		user.Profile, _ = json.Marshal(*body.Profile)
+	}
+	return nil
+}
+
+func toUser(user *models.User) api.User {
+	return api.User{
+		Id:                 user.ID,
+		Username:           user.Username,
+		Email:              optional(user.Email),
+		EmailVerified:      user.EmailVerified,
+		DisplayName:        optional(user.DisplayName),
+		Profile:            optionalObject(user.Profile),
+		IsActive:           user.IsActive,
+		IsLocked:           user.IsLocked,
+		MfaEnabled:         user.MFAEnabled,
+		LastPasswordChange: user.LastPasswordChange,
+		CreatedAt:          user.CreatedAt,
+		UpdatedAt:          user.UpdatedAt,
+	}
+}
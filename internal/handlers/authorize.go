@@ -0,0 +1,217 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/pkg/api"
+	"github.com/labstack/echo/v4"
+)
+
+// reauthMaxAge is how recently the user must have signed in to change their
+// second factors.
+const reauthMaxAge = 10 * time.Minute
+
+func (s *Server) GetAuthorize(c echo.Context, params api.GetAuthorizeParams) error {
+	client, err := s.activeClient(c, params.ClientId)
+	if err != nil {
+		return s.serverError(c, err)
+	}
+	if client == nil {
+		return oauthError(c, http.StatusBadRequest, "invalid_client", "unknown client")
+	}
+
+	// Until the redirect URI is validated errors must not be sent to it.
+	redirectURI, err := services.ResolveRedirectURI(client, params.RedirectUri)
+	if err != nil {
+		return oauthError(c, http.StatusBadRequest, "invalid_request", err.Error())
+	}
+	state := deref(params.State)
+
+	if params.ResponseType != api.Code {
+		return redirectError(c, redirectURI, "unsupported_response_type", "only the code response type is supported", state)
+	}
+	prompt, err := services.ParsePrompt(deref(params.Prompt))
+	if err != nil {
+		return redirectError(c, redirectURI, "invalid_request", err.Error(), state)
+	}
+	if params.MaxAge != nil && *params.MaxAge < 0 {
+		return redirectError(c, redirectURI, "invalid_request", "max_age must not be negative", state)
+	}
+	scopes := strings.Fields(deref(params.Scope))
+	if err := services.CheckScopes(client, scopes); err != nil {
+		return redirectError(c, redirectURI, "invalid_scope", err.Error(), state)
+	}
+	// Resource indicators (RFC 8707) must name active resource servers that
+	// accept the same token format, or no single token could serve them.
+	audience, err := s.resources.ResolveAudience(c.Request().Context(), orbitFrom(c).ID, derefList(params.Resource), scopes)
+	if errors.Is(err, services.ErrInvalidTarget) {
+		return redirectError(c, redirectURI, "invalid_target", err.Error(), state)
+	}
+	if err != nil {
+		return s.serverError(c, err)
+	}
+
+	req := &services.AuthorizationRequest{
+		OrbitID:         orbitFrom(c).ID,
+		ClientID:        client.ID,
+		ClientName:      client.Name,
+		FirstParty:      services.IsFirstParty(client),
+		RedirectURI:     redirectURI,
+		RedirectURISent: params.RedirectUri != "",
+		Scopes:          scopes,
+		Resources:       audience.Audience,
+		State:           state,
+		Nonce:           deref(params.Nonce),
+		CodeChallenge:   deref(params.CodeChallenge),
+		Prompt:          prompt,
+		MaxAge:          params.MaxAge,
+		LoginHint:       deref(params.LoginHint),
+		ACRValues:       strings.Fields(deref(params.AcrValues)),
+		MinACR:          services.ClientMinimumACR(client),
+	}
+	if req.CodeChallenge != "" {
+		switch method := deref(params.CodeChallengeMethod); method {
+		case "":
+			req.CodeChallengeMethod = string(api.Plain)
+		case api.Plain, api.S256:
+			req.CodeChallengeMethod = string(method)
+		default:
+			return redirectError(c, redirectURI, "invalid_request", "unsupported code_challenge_method", state)
+		}
+	}
+
+	session, user, err := s.currentSession(c)
+	if err != nil {
+		return s.serverError(c, err)
+	}
+	if !s.login.NeedsLogin(req, session, user, time.Now()) {
+		return s.authorizeSession(c, req, session)
+	}
+	if req.HasPrompt(services.PromptNone) {
+		return redirectError(c, redirectURI, "login_required", "", state)
+	}
+
+	binding, err := s.loginBinding(c)
+	if err != nil {
+		return s.serverError(c, err)
+	}
+	req.BindBrowser(binding)
+	requestID, err := s.login.SavePending(c.Request().Context(), req)
+	if err != nil {
+		return s.serverError(c, err)
+	}
+	return c.Redirect(http.StatusFound, withQuery("/login", map[string]string{"request_id": requestID}))
+}
+
+// authorizeSession continues an authorization request once the user is
+// authenticated, detouring through the second factor and consent screens when
+// required.
+func (s *Server) authorizeSession(c echo.Context, req *services.AuthorizationRequest, session *models.Session) error {
+	ctx := c.Request().Context()
+	stepUp, err := s.login.StepUpRequired(ctx, req, session)
+	if errors.Is(err, services.ErrMFANotConfigured) {
+		return redirectError(c, req.RedirectURI, "access_denied", err.Error(), req.State)
+	}
+	if err != nil {
+		return s.serverError(c, err)
+	}
+	if stepUp {
+		if req.HasPrompt(services.PromptNone) {
+			return redirectError(c, req.RedirectURI, "interaction_required", "", req.State)
+		}
+		return s.parkForSession(c, req, session, "/login/mfa")
+	}
+
+	_, required, err := s.consents.Required(ctx, req, session.UserID)
+	if err != nil {
+		return s.serverError(c, err)
+	}
+	if !required {
+		return s.issueCode(c, req, session)
+	}
+	if req.HasPrompt(services.PromptNone) {
+		return redirectError(c, req.RedirectURI, "consent_required", "", req.State)
+	}
+	return s.parkForSession(c, req, session, "/consent")
+}
+
+// parkForSession saves a request bound to the session that authenticated it
+// and sends the browser to the page that continues it.
+func (s *Server) parkForSession(c echo.Context, req *services.AuthorizationRequest, session *models.Session, page string) error {
+	req.SessionID = session.ID
+	requestID, err := s.login.SavePending(c.Request().Context(), req)
+	if err != nil {
+		return s.serverError(c, err)
+	}
+	return c.Redirect(http.StatusFound, withQuery(page, map[string]string{"request_id": requestID}))
+}
+
+// currentSession resolves the session cookie. A missing or unusable session is
+// not an error.
+func (s *Server) currentSession(c echo.Context) (*models.Session, *models.User, error) {
+	id, ok := s.sessionID(c)
+	if !ok {
+		return nil, nil, nil
+	}
+	return s.sessions.Active(c.Request().Context(), orbitFrom(c), id)
+}
+
+// accountSession authenticates the account endpoints by session cookie. When
+// it returns a nil session the error response has already been written.
+func (s *Server) accountSession(c echo.Context) (*models.Session, *models.User, error) {
+	session, user, err := s.currentSession(c)
+	if err != nil {
+		return nil, nil, s.serverError(c, err)
+	}
+	if session == nil {
+		return nil, nil, oauthError(c, http.StatusUnauthorized, "login_required", "")
+	}
+	if !services.SessionComplete(session, user) {
+		return nil, nil, oauthError(c, http.StatusUnauthorized, "insufficient_user_authentication", "the second factor has not been provided")
+	}
+	return session, user, nil
+}
+
+// recentAccountSession is accountSession for changes to the user's second
+// factors, which also need a sign-in within reauthMaxAge so that a session
+// left open somewhere cannot replace or remove them.
+func (s *Server) recentAccountSession(c echo.Context) (*models.Session, *models.User, error) {
+	session, user, err := s.accountSession(c)
+	if session == nil {
+		return nil, nil, err
+	}
+	authTime := time.Unix(services.SessionAuthentication(session).AuthTime, 0)
+	if time.Since(authTime) > reauthMaxAge {
+		return nil, nil, oauthError(c, http.StatusUnauthorized, "insufficient_user_authentication", "sign in again to change second factors")
+	}
+	return session, user, nil
+}
+
+// issueCode answers a pending authorization request for an authenticated
+// session by redirecting back to the client with a fresh code.
+func (s *Server) issueCode(c echo.Context, req *services.AuthorizationRequest, session *models.Session) error {
+	code, err := s.login.IssueCode(c.Request().Context(), req, session)
+	if err != nil {
+		return s.serverError(c, err)
+	}
+	return c.Redirect(http.StatusFound, withQuery(req.RedirectURI, map[string]string{
+		"code":  code.Code,
+		"state": req.State,
+	}))
+}
+
+func (s *Server) activeClient(c echo.Context, clientID string) (*models.Client, error) {
+	client, err := s.clients.GetByClientID(c.Request().Context(), orbitFrom(c).ID, clientID)
+	if err != nil {
+		return nil, err
+	}
+	if client == nil || !client.IsActive {
+		return nil, nil
+	}
+	return client, nil
+}
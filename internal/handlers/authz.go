@@ -0,0 +1,78 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/pkg/api"
+	"github.com/labstack/echo/v4"
+)
+
+func (s *Server) PostAuthzEvaluate(c echo.Context) error {
+	client, err := s.basicClient(c)
+	if client == nil {
+		return err
+	}
+	var body api.AuthzRequest
+	if err := c.Bind(&body); err != nil {
+		return oauthError(c, http.StatusBadRequest, "invalid_request", "malformed request body")
+	}
+	body.Action = strings.TrimSpace(body.Action)
+	if body.Action == "" || len(body.Action) > 200 {
+		return oauthError(c, http.StatusBadRequest, "invalid_request", "action must have 1 to 200 characters")
+	}
+
+	decision, err := s.policies.Evaluate(c.Request().Context(), services.AuthzRequest{
+		Client:   client,
+		UserID:   body.UserId,
+		Action:   body.Action,
+		Resource: deref(body.Resource),
+		Context:  deref(body.Context),
+	})
+	if errors.Is(err, services.ErrUserNotFound) {
+		return oauthError(c, http.StatusBadRequest, "invalid_request", "unknown user")
+	}
+	if err != nil {
+		return s.serverError(c, err)
+	}
+	out := api.AuthzDecision{
+		Decision:    api.AuthzDecisionDecision(decision.Decision),
+		Policies:    nonNil(decision.Policies),
+		Obligations: decision.Obligations,
+	}
+	if decision.ID != 0 {
+		out.DecisionId = &decision.ID
+	}
+	return c.JSON(http.StatusOK, out)
+}
+
+// basicClient authenticates a confidential client of the request orbit by
+// HTTP Basic, with its id and secret form-encoded first as RFC 6749 section
+// 2.3.1 requires, or writes the response for a failed authentication.
+func (s *Server) basicClient(c echo.Context) (*models.Client, error) {
+	fail := func() (*models.Client, error) {
+		c.Response().Header().Set(echo.HeaderWWWAuthenticate, `Basic realm="orbitum"`)
+		return nil, oauthError(c, http.StatusUnauthorized, "invalid_client", "")
+	}
+	rawID, rawSecret, ok := c.Request().BasicAuth()
+	if !ok {
+		return fail()
+	}
+	clientID, err1 := url.QueryUnescape(rawID)
+	secret, err2 := url.QueryUnescape(rawSecret)
+	if err1 != nil || err2 != nil {
+		return fail()
+	}
+	client, err := s.clients.GetByClientID(c.Request().Context(), orbitFrom(c).ID, clientID)
+	if err != nil {
+		return nil, s.serverError(c, err)
+	}
+	if client == nil || client.IsPublic || !client.IsActive || client.DeletedAt != nil || !s.clients.VerifySecret(client, secret) {
+		return fail()
+	}
+	return client, nil
+}
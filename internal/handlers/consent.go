@@ -0,0 +1,120 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/pkg/api"
+	"github.com/labstack/echo/v4"
+)
+
+type consentPage struct {
+	RequestID  string
+	ClientName string
+	Scopes     []string
+}
+
+func (s *Server) GetConsent(c echo.Context, params api.GetConsentParams) error {
+	req, session, err := s.pendingForSession(c, params.RequestId)
+	if err != nil {
+		return s.pendingRequestError(c, err)
+	}
+	scopes, _, err := s.consents.Required(c.Request().Context(), req, session.UserID)
+	if err != nil {
+		return s.serverError(c, err)
+	}
+	return renderPage(c, http.StatusOK, consentTemplate, consentPage{
+		RequestID:  params.RequestId,
+		ClientName: req.ClientName,
+		Scopes:     scopes,
+	})
+}
+
+func (s *Server) PostConsent(c echo.Context) error {
+	var body api.PostConsentFormdataBody
+	if err := c.Bind(&body); err != nil {
+		return oauthError(c, http.StatusBadRequest, "invalid_request", "malformed request body")
+	}
+
+	ctx := c.Request().Context()
+	req, session, err := s.pendingForSession(c, body.RequestId)
+	if err != nil {
+		return s.pendingRequestError(c, err)
+	}
+	s.login.DropPending(ctx, body.RequestId)
+
+	if body.Decision != api.PostConsentFormdataBodyDecisionAllow {
+		return redirectError(c, req.RedirectURI, "access_denied", "the user denied the request", req.State)
+	}
+	if _, err := s.consents.Grant(ctx, orbitFrom(c), session.UserID, req.ClientID, req.Scopes); err != nil {
+		return s.serverError(c, err)
+	}
+	return s.issueCode(c, req, session)
+}
+
+// pendingForSession loads a request parked by parkForSession and makes sure it
+// is answered by the session that started it.
+func (s *Server) pendingForSession(c echo.Context, requestID string) (*services.AuthorizationRequest, *models.Session, error) {
+	req, err := s.login.Pending(c.Request().Context(), orbitFrom(c).ID, requestID)
+	if err != nil {
+		return nil, nil, err
+	}
+	session, _, err := s.currentSession(c)
+	if err != nil {
+		return nil, nil, err
+	}
+	if session == nil || req.SessionID == 0 || session.ID != req.SessionID {
+		return nil, nil, services.ErrAuthorizationRequestNotFound
+	}
+	return req, session, nil
+}
+
+func (s *Server) GetAccountConsents(c echo.Context) error {
+	session, _, err := s.accountSession(c)
+	if session == nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+	consents, err := s.consents.ListByUser(ctx, session.OrbitID, session.UserID)
+	if err != nil {
+		return s.serverError(c, err)
+	}
+	out := make([]api.Consent, 0, len(consents))
+	for _, consent := range consents {
+		client, err := s.clients.GetByID(ctx, consent.ClientID)
+		if err != nil {
+			return s.serverError(c, err)
+		}
+		if client == nil {
+			continue
+		}
+		out = append(out, api.Consent{
+			Id:         consent.ID,
+			ClientId:   client.ClientID,
+			ClientName: optional(client.Name),
+			Scopes:     services.ConsentScopes(consent),
+			GrantedAt:  consent.GrantedAt,
+			ExpiresAt:  consent.ExpiresAt,
+		})
+	}
+	return c.JSON(http.StatusOK, out)
+}
+
+func (s *Server) DeleteAccountConsentsConsentId(c echo.Context, consentId int64) error {
+	session, _, err := s.accountSession(c)
+	if session == nil {
+		return err
+	}
+
+	err = s.consents.Revoke(c.Request().Context(), session.OrbitID, session.UserID, consentId)
+	if errors.Is(err, services.ErrConsentNotFound) {
+		return oauthError(c, http.StatusNotFound, "not_found", err.Error())
+	}
+	if err != nil {
+		return s.serverError(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,45 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/pkg/api"
+	"github.com/labstack/echo/v4"
+)
+
+func (s *Server) GetEmailVerify(c echo.Context, params api.GetEmailVerifyParams) error {
+	user, err := s.userTokens.VerifyEmail(c.Request().Context(), orbitFrom(c), params.Token)
+	if errors.Is(err, services.ErrInvalidUserToken) || errors.Is(err, services.ErrUserNotFound) {
+		return renderPage(c, http.StatusBadRequest, noticeTemplate, noticePage{
+			Title:   "This link cannot be used",
+			Message: "The verification link is invalid, has expired, has already been used or was sent to an address you have since changed.",
+		})
+	}
+	if err != nil {
+		return s.serverError(c, err)
+	}
+	return renderPage(c, http.StatusOK, noticeTemplate, noticePage{
+		Title:   "Email address confirmed",
+		Message: user.Email + " is now verified. You can close this page.",
+	})
+}
+
+func (s *Server) PostAccountEmailVerification(c echo.Context) error {
+	session, user, err := s.accountSession(c)
+	if session == nil {
+		return err
+	}
+
+	err = s.userTokens.SendEmailVerification(c.Request().Context(), orbitFrom(c), user)
+	switch {
+	case errors.Is(err, services.ErrNoEmailAddress):
+		return oauthError(c, http.StatusBadRequest, "invalid_request", err.Error())
+	case errors.Is(err, services.ErrEmailRateLimited):
+		return oauthError(c, http.StatusTooManyRequests, "too_many_requests", err.Error())
+	case err != nil:
+		return s.serverError(c, err)
+	}
+	return c.NoContent(http.StatusAccepted)
+}
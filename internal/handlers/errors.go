@@ -0,0 +1,57 @@
+package handlers
+
+import (
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/pkg/api"
+	"github.com/labstack/echo/v4"
+)
+
+func oauthError(c echo.Context, status int, code, description string) error {
+	body := api.Error{Error: code}
+	if description != "" {
+		body.ErrorDescription = &description
+	}
+	return c.JSON(status, body)
+}
+
+func (s *Server) serverError(c echo.Context, err error) error {
+	s.logger.Error().Err(err).Str("path", c.Path()).Msg("request failed")
+	return oauthError(c, http.StatusInternalServerError, "server_error", "")
+}
+
+func bearerToken(c echo.Context) string {
+	header := c.Request().Header.Get(echo.HeaderAuthorization)
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+	return strings.TrimSpace(token)
+}
+
+// redirectError reports an authorization error to a redirect URI that has
+// already been validated for the client.
+func redirectError(c echo.Context, redirectURI, code, description, state string) error {
+	return c.Redirect(http.StatusFound, withQuery(redirectURI, map[string]string{
+		"error":             code,
+		"error_description": description,
+		"state":             state,
+	}))
+}
+
+func withQuery(rawURL string, params map[string]string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return rawURL
+	}
+	q := u.Query()
+	for k, v := range params {
+		if v != "" {
+			q.Set(k, v)
+		}
+	}
+	u.RawQuery = q.Encode()
+	return u.String()
+}
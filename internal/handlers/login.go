@@ -0,0 +1,156 @@
+package handlers
+
+import (
+	"bytes"
+	"embed"
+	"errors"
+	"html/template"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/pkg/api"
+	"github.com/labstack/echo/v4"
+)
+
+//go:embed templates/*.html
+var templateFS embed.FS
+
+var (
+	loginTemplate   = template.Must(template.ParseFS(templateFS, "templates/login.html"))
+	consentTemplate = template.Must(template.ParseFS(templateFS, "templates/consent.html"))
+	mfaTemplate     = template.Must(template.ParseFS(templateFS, "templates/mfa.html"))
+
+	passwordForgotTemplate = template.Must(template.ParseFS(templateFS, "templates/password_forgot.html"))
+	passwordResetTemplate  = template.Must(template.ParseFS(templateFS, "templates/password_reset.html"))
+	noticeTemplate         = template.Must(template.ParseFS(templateFS, "templates/notice.html"))
+)
+
+type loginPage struct {
+	RequestID  string
+	ClientName string
+	Identity   string
+	Error      string
+	Passkeys   bool
+	// PasswordExpired offers the password reset flow, the only way to
+	// choose a new password without signing in.
+	PasswordExpired bool
+}
+
+func (s *Server) GetLogin(c echo.Context, params api.GetLoginParams) error {
+	req, err := s.pendingForBrowser(c, params.RequestId)
+	if err != nil {
+		return s.pendingRequestError(c, err)
+	}
+	return renderPage(c, http.StatusOK, loginTemplate, s.loginForm(c, params.RequestId, req, req.LoginHint))
+}
+
+func (s *Server) PostLogin(c echo.Context) error {
+	var body api.PostLoginFormdataBody
+	if err := c.Bind(&body); err != nil {
+		return oauthError(c, http.StatusBadRequest, "invalid_request", "malformed request body")
+	}
+
+	ctx := c.Request().Context()
+	orbit := orbitFrom(c)
+	req, err := s.pendingForBrowser(c, body.RequestId)
+	if err != nil {
+		return s.pendingRequestError(c, err)
+	}
+
+	session, err := s.login.Login(ctx, orbit, req, body.Identity, body.Password, c.Request().UserAgent(), c.RealIP())
+	if err != nil {
+		page := s.loginForm(c, body.RequestId, req, body.Identity)
+		var throttled *services.LoginThrottledError
+		switch {
+		case errors.As(err, &throttled):
+			page.Error = throttledMessage(throttled)
+			return renderThrottled(c, loginTemplate, page, throttled)
+		case errors.Is(err, services.ErrInvalidCredentials):
+			page.Error = "The username or password is incorrect."
+		case errors.Is(err, services.ErrUserLocked):
+			page.Error = "This account is locked."
+		case errors.Is(err, services.ErrPasswordExpired):
+			page.Error = "Your password has expired and must be changed."
+			page.PasswordExpired = true
+		default:
+			return s.serverError(c, err)
+		}
+		return renderPage(c, http.StatusUnauthorized, loginTemplate, page)
+	}
+
+	return s.completeLogin(c, body.RequestId, req, session)
+}
+
+// completeLogin replaces any previous session with the new one and resumes
+// the authorization request.
+func (s *Server) completeLogin(c echo.Context, requestID string, req *services.AuthorizationRequest, session *models.Session) error {
+	ctx := c.Request().Context()
+	if previous, ok := s.sessionID(c); ok && previous != session.ID {
+		_ = s.sessions.End(ctx, orbitFrom(c), previous)
+	}
+	s.setSessionCookie(c, session)
+	s.login.DropPending(ctx, requestID)
+	return s.authorizeSession(c, req, session)
+}
+
+// pendingForBrowser loads a request waiting for sign-in and makes sure it is
+// answered from the browser that started it. Otherwise a page elsewhere could
+// post its own request_id with its own credentials and sign the visitor in to
+// the attacker's account.
+func (s *Server) pendingForBrowser(c echo.Context, requestID string) (*services.AuthorizationRequest, error) {
+	req, err := s.login.Pending(c.Request().Context(), orbitFrom(c).ID, requestID)
+	if err != nil {
+		return nil, err
+	}
+	if !req.BoundTo(browserBinding(c)) {
+		return nil, services.ErrAuthorizationRequestNotFound
+	}
+	return req, nil
+}
+
+func (s *Server) loginForm(c echo.Context, requestID string, req *services.AuthorizationRequest, identity string) loginPage {
+	return loginPage{
+		RequestID:  requestID,
+		ClientName: req.ClientName,
+		Identity:   identity,
+		Passkeys:   s.webauthn.Available(orbitFrom(c)),
+	}
+}
+
+func (s *Server) pendingRequestError(c echo.Context, err error) error {
+	if errors.Is(err, services.ErrAuthorizationRequestNotFound) {
+		return oauthError(c, http.StatusBadRequest, "invalid_request", err.Error())
+	}
+	return s.serverError(c, err)
+}
+
+func throttledMessage(err *services.LoginThrottledError) string {
+	minutes := int((err.RetryAfter + time.Minute - 1) / time.Minute)
+	switch {
+	case err.RetryAfter < time.Minute:
+		return "Too many failed attempts. Try again in a few seconds."
+	case minutes == 1:
+		return "Too many failed attempts. Try again in a minute."
+	}
+	return "Too many failed attempts. Try again in " + strconv.Itoa(minutes) + " minutes."
+}
+
+// renderThrottled answers an attempt rejected by the lockout service.
+func renderThrottled(c echo.Context, tmpl *template.Template, page any, err *services.LoginThrottledError) error {
+	c.Response().Header().Set(echo.HeaderRetryAfter, strconv.Itoa(int(err.RetryAfter/time.Second)))
+	return renderPage(c, http.StatusTooManyRequests, tmpl, page)
+}
+
+func renderPage(c echo.Context, status int, tmpl *template.Template, page any) error {
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, page); err != nil {
+		return err
+	}
+	header := c.Response().Header()
+	header.Set(echo.HeaderCacheControl, "no-store")
+	header.Set(echo.HeaderXFrameOptions, "DENY")
+	return c.HTMLBlob(status, buf.Bytes())
+}
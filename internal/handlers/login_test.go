@@ -0,0 +1,179 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories/db"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/testutil"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/pkg/api"
+	"github.com/labstack/echo/v4"
+)
+
+func loginEcho(t *testing.T, dbConn *db.DB) *echo.Echo {
+	t.Helper()
+	cacheManager := testutil.LocalCache(t)
+	orbits := services.NewOrbitService(dbConn, repositories.NewOrbitRepository(dbConn.Exec(), nop), cacheManager, nop)
+	users := services.NewUserService(dbConn, repositories.NewUserRepository(dbConn.Exec(), nop), cacheManager, nil, nop)
+	events := services.NewSecurityEventService(dbConn, nop)
+	sessions := services.NewSessionService(dbConn, users, nop)
+	lockout := services.NewLockoutService(cacheManager, users, events, nop)
+	s := &Server{
+		orbits:    orbits,
+		clients:   services.NewClientService(dbConn, cacheManager, nop),
+		login:     services.NewLoginService(dbConn, cacheManager, users, sessions, lockout, services.NewAuthCodeService(dbConn, cacheManager, nop), nop),
+		sessions:  sessions,
+		consents:  services.NewConsentService(dbConn, cacheManager, nop),
+		webauthn:  services.NewWebAuthnService(dbConn, cacheManager, users, events, nop),
+		lockout:   lockout,
+		users:     users,
+		resources: services.NewResourceServerService(dbConn, cacheManager, nop),
+		cookies:   sessionCookies{key: []byte("session-test-key")},
+		logger:    nop,
+	}
+	e := echo.New()
+	e.Use(OrbitResolver(orbits))
+	api.RegisterHandlers(e, s)
+	return e
+}
+
+// responseCookie returns the cookie named name set by rec, or nil.
+func responseCookie(rec *httptest.ResponseRecorder, name string) *http.Cookie {
+	for _, cookie := range rec.Result().Cookies() {
+		if cookie.Name == name {
+			return cookie
+		}
+	}
+	return nil
+}
+
+func TestLoginBoundToBrowser(t *testing.T) {
+	dbConn, fx := setup(t)
+	e := loginEcho(t, dbConn)
+	orbit := fx.Orbit()
+	client := fx.Client(orbit)
+	user := fx.User(orbit)
+
+	do := func(req *http.Request, cookie *http.Cookie) *httptest.ResponseRecorder {
+		t.Helper()
+		if cookie != nil {
+			req.AddCookie(cookie)
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+	// authorize starts a request, in the browser holding cookie if any, and
+	// returns its id and the login cookie the browser ends up with.
+	authorize := func(cookie *http.Cookie) (string, *http.Cookie) {
+		t.Helper()
+		query := url.Values{"client_id": {client.ClientID}, "response_type": {"code"}, "scope": {"openid"}}
+		rec := do(httptest.NewRequest(http.MethodGet, "http://"+orbit.Domain+"/authorize?"+query.Encode(), nil), cookie)
+		if rec.Code != http.StatusFound {
+			t.Fatalf("authorize status = %d, want 302", rec.Code)
+		}
+		location, err := url.Parse(rec.Header().Get(echo.HeaderLocation))
+		if err != nil || location.Path != "/login" {
+			t.Fatalf("authorize redirected to %q, want /login", rec.Header().Get(echo.HeaderLocation))
+		}
+		if issued := responseCookie(rec, loginCookieName); issued != nil {
+			if !issued.HttpOnly || issued.SameSite != http.SameSiteLaxMode {
+				t.Errorf("login cookie = %+v, want HttpOnly and SameSite=Lax", issued)
+			}
+			cookie = issued
+		}
+		if cookie == nil {
+			t.Fatal("authorize set no login cookie")
+		}
+		return location.Query().Get("request_id"), cookie
+	}
+	login := func(requestID string, cookie *http.Cookie) *httptest.ResponseRecorder {
+		t.Helper()
+		form := url.Values{"request_id": {requestID}, "identity": {user.Username}, "password": {testutil.Password}}
+		req := httptest.NewRequest(http.MethodPost, "http://"+orbit.Domain+"/login", strings.NewReader(form.Encode()))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+		return do(req, cookie)
+	}
+	loginPage := func(requestID string, cookie *http.Cookie) int {
+		t.Helper()
+		return do(httptest.NewRequest(http.MethodGet, "http://"+orbit.Domain+"/login?request_id="+url.QueryEscape(requestID), nil), cookie).Code
+	}
+
+	requestID, cookie := authorize(nil)
+	// The same browser keeps its cookie for every request it starts.
+	if second, again := authorize(cookie); second == requestID || again.Value != cookie.Value {
+		t.Fatalf("second authorize = %q with cookie %q, want a new request bound to %q", second, again.Value, cookie.Value)
+	}
+	_, elsewhere := authorize(nil)
+	if elsewhere.Value == cookie.Value {
+		t.Fatal("two browsers got the same login cookie")
+	}
+
+	for name, other := range map[string]*http.Cookie{
+		"no cookie":       nil,
+		"another browser": elsewhere,
+		"forged cookie":   {Name: loginCookieName, Value: "forged"},
+	} {
+		if code := loginPage(requestID, other); code != http.StatusBadRequest {
+			t.Errorf("login page with %s: status = %d, want 400", name, code)
+		}
+		rec := login(requestID, other)
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("login with %s: status = %d, want 400", name, rec.Code)
+		}
+		if responseCookie(rec, sessionCookieName) != nil {
+			t.Errorf("login with %s set a session cookie", name)
+		}
+	}
+
+	if code := loginPage(requestID, cookie); code != http.StatusOK {
+		t.Fatalf("login page in the starting browser: status = %d, want 200", code)
+	}
+	rec := login(requestID, cookie)
+	if rec.Code != http.StatusFound || responseCookie(rec, sessionCookieName) == nil {
+		t.Fatalf("login in the starting browser: status = %d, want 302 with a session cookie", rec.Code)
+	}
+}
+
+// A user whose password expired is sent on to the reset flow rather than
+// left at a form that cannot succeed.
+func TestLoginPasswordExpiredOffersReset(t *testing.T) {
+	dbConn, fx := setup(t)
+	e := loginEcho(t, dbConn)
+	orbit := fx.Orbit(func(o *models.Orbit) {
+		o.Config = json.RawMessage(`{"password_policy":{"max_age_days":90}}`)
+	})
+	client := fx.Client(orbit)
+	changed := time.Now().UTC().AddDate(0, 0, -100)
+	user := fx.User(orbit, func(u *models.User) { u.LastPasswordChange = &changed })
+
+	query := url.Values{"client_id": {client.ClientID}, "response_type": {"code"}, "scope": {"openid"}}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "http://"+orbit.Domain+"/authorize?"+query.Encode(), nil))
+	location, err := url.Parse(rec.Header().Get(echo.HeaderLocation))
+	cookie := responseCookie(rec, loginCookieName)
+	if err != nil || cookie == nil {
+		t.Fatalf("authorize = %d to %q, want a redirect to /login with a login cookie", rec.Code, rec.Header().Get(echo.HeaderLocation))
+	}
+
+	form := url.Values{"request_id": {location.Query().Get("request_id")}, "identity": {user.Username}, "password": {testutil.Password}}
+	req := httptest.NewRequest(http.MethodPost, "http://"+orbit.Domain+"/login", strings.NewReader(form.Encode()))
+	req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationForm)
+	req.AddCookie(cookie)
+	rec = httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	if rec.Code != http.StatusUnauthorized || responseCookie(rec, sessionCookieName) != nil {
+		t.Fatalf("login with an expired password = %d, want 401 without a session", rec.Code)
+	}
+	if !strings.Contains(rec.Body.String(), `<a href="/password/forgot">Get a link to choose a new password</a>`) {
+		t.Fatalf("login page for an expired password has no link to the reset flow:\n%s", rec.Body.String())
+	}
+}
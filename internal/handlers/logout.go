@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/pkg/api"
+	"github.com/labstack/echo/v4"
+)
+
+// PostLogout ends the session of the request. Everything the request names is
+// checked before anything ends, and the session only ends for an
+// id_token_hint issued to its user or a request from the orbit's own pages,
+// so other sites cannot sign users out.
+func (s *Server) PostLogout(c echo.Context) error {
+	var body api.PostLogoutJSONBody
+	if c.Request().ContentLength != 0 {
+		if err := c.Bind(&body); err != nil {
+			return oauthError(c, http.StatusBadRequest, "invalid_request", "malformed request body")
+		}
+	}
+	ctx := c.Request().Context()
+	orbit := orbitFrom(c)
+
+	var hint *services.IDTokenHint
+	if raw := deref(body.IdTokenHint); raw != "" {
+		var err error
+		hint, err = s.tokens.VerifyIDTokenHint(ctx, orbit, raw)
+		if errors.Is(err, services.ErrInvalidIDTokenHint) {
+			return oauthError(c, http.StatusBadRequest, "invalid_request", err.Error())
+		}
+		if err != nil {
+			return s.serverError(c, err)
+		}
+	}
+	clientID := deref(body.ClientId)
+	if hint != nil {
+		if clientID == "" {
+			clientID = hint.ClientID
+		} else if clientID != hint.ClientID {
+			return oauthError(c, http.StatusBadRequest, "invalid_request", "client_id does not match id_token_hint")
+		}
+	}
+
+	var redirectURI string
+	if requested := deref(body.PostLogoutRedirectUri); requested != "" {
+		if clientID == "" {
+			return oauthError(c, http.StatusBadRequest, "invalid_request", "client_id or id_token_hint is required with post_logout_redirect_uri")
+		}
+		client, err := s.activeClient(c, clientID)
+		if err != nil {
+			return s.serverError(c, err)
+		}
+		if client == nil {
+			return oauthError(c, http.StatusBadRequest, "invalid_client", "unknown client")
+		}
+		if redirectURI, err = services.ResolvePostLogoutRedirectURI(client, requested); err != nil {
+			return oauthError(c, http.StatusBadRequest, "invalid_request", err.Error())
+		}
+	}
+
+	if id, ok := s.sessionID(c); ok {
+		session, _, err := s.sessions.Active(ctx, orbit, id)
+		if err != nil {
+			return s.serverError(c, err)
+		}
+		// A session that is no longer active has no user to match the hint
+		// against; ending it still revokes its refresh tokens.
+		confirmed := fromOwnPage(c) || hint != nil && (session == nil || hint.UserID == session.UserID)
+		switch {
+		case confirmed:
+			if err := s.sessions.End(ctx, orbit, id); err != nil {
+				return s.serverError(c, err)
+			}
+		case session != nil:
+			return oauthError(c, http.StatusForbidden, "access_denied", "logout needs an id_token_hint of the signed-in user or a same-origin request")
+		}
+	}
+	s.clearSessionCookie(c)
+
+	if redirectURI == "" {
+		return c.NoContent(http.StatusNoContent)
+	}
+	return c.Redirect(http.StatusSeeOther, withQuery(redirectURI, map[string]string{"state": deref(body.State)}))
+}
@@ -0,0 +1,197 @@
+package handlers
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories/db"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/testutil"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/pkg/api"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/labstack/echo/v4"
+)
+
+func logoutEcho(t *testing.T, dbConn *db.DB) (*echo.Echo, *Server) {
+	t.Helper()
+	cacheManager := testutil.LocalCache(t)
+	secretCipher, err := services.NewAESGCMCipher(testutil.EncryptionKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	orbits := services.NewOrbitService(dbConn, repositories.NewOrbitRepository(dbConn.Exec(), nop), cacheManager, nop)
+	users := services.NewUserService(dbConn, repositories.NewUserRepository(dbConn.Exec(), nop), cacheManager, nil, nop)
+	keys := services.NewJWKService(dbConn, cacheManager, secretCipher, nop)
+	s := &Server{
+		orbits:   orbits,
+		clients:  services.NewClientService(dbConn, cacheManager, nop),
+		sessions: services.NewSessionService(dbConn, users, nop),
+		users:    users,
+		keys:     keys,
+		tokens: services.NewTokenService(dbConn, users, services.NewAuthCodeService(dbConn, cacheManager, nop),
+			services.NewAccessTokenService(dbConn, cacheManager, nop), services.NewResourceServerService(dbConn, cacheManager, nop),
+			keys, services.NewRoleService(dbConn, cacheManager, nop), nop),
+		cookies: sessionCookies{key: []byte("session-test-key")},
+		logger:  nop,
+	}
+	e := echo.New()
+	e.Use(OrbitResolver(orbits))
+	api.RegisterHandlers(e, s)
+	return e, s
+}
+
+// idToken signs an ID token of user for client with the orbit's key.
+func idToken(t *testing.T, s *Server, orbit *models.Orbit, client *models.Client, userID int64) string {
+	t.Helper()
+	signer, err := s.keys.Signer(t.Context(), orbit.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	token, err := jwt.Signed(signer).Claims(map[string]any{
+		"iss": orbit.Issuer,
+		"sub": strconv.FormatInt(userID, 10),
+		"aud": []string{client.ClientID},
+		"azp": client.ClientID,
+		"iat": time.Now().Add(-2 * time.Hour).Unix(),
+		"exp": time.Now().Add(-time.Hour).Unix(),
+	}).Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func TestPostLogout(t *testing.T) {
+	dbConn, fx := setup(t)
+	e, s := logoutEcho(t, dbConn)
+	orbit := fx.Orbit()
+	fx.Key(orbit)
+	client := fx.Client(orbit, func(c *models.Client) {
+		c.PostLogoutRedirectURIs = json.RawMessage(`["https://app.example.test/signed-out"]`)
+	})
+	user := fx.User(orbit)
+	other := fx.User(orbit)
+
+	logout := func(body map[string]string, cookie *http.Cookie, origin string) *httptest.ResponseRecorder {
+		t.Helper()
+		var raw []byte
+		if body != nil {
+			raw, _ = json.Marshal(body)
+		}
+		req := httptest.NewRequest(http.MethodPost, "http://"+orbit.Domain+"/logout", bytes.NewReader(raw))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		if cookie != nil {
+			req.AddCookie(cookie)
+		}
+		if origin != "" {
+			req.Header.Set(echo.HeaderOrigin, origin)
+		}
+		rec := httptest.NewRecorder()
+		e.ServeHTTP(rec, req)
+		return rec
+	}
+	active := func(cookie *http.Cookie) bool {
+		t.Helper()
+		id, _ := s.cookies.decode(cookie.Value)
+		session, _, err := s.sessions.Active(t.Context(), orbit, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return session != nil
+	}
+	const redirect = "https://app.example.test/signed-out"
+	pageOrigin := "http://" + orbit.Domain
+
+	t.Run("invalid requests end nothing", func(t *testing.T) {
+		cookie := signIn(t, s, orbit, user)
+		for name, body := range map[string]map[string]string{
+			"unregistered redirect":   {"client_id": client.ClientID, "post_logout_redirect_uri": "https://evil.example/"},
+			"redirect without client": {"post_logout_redirect_uri": redirect},
+			"unknown client":          {"client_id": "nobody", "post_logout_redirect_uri": redirect},
+			"malformed hint":          {"id_token_hint": "garbage"},
+			"hint of another client":  {"id_token_hint": idToken(t, s, orbit, client, user.ID), "client_id": "nobody"},
+			"hint of a foreign orbit": {"id_token_hint": foreignIDToken(t, s, fx, client, user.ID)},
+		} {
+			if rec := logout(body, cookie, pageOrigin); rec.Code != http.StatusBadRequest {
+				t.Errorf("%s: status = %d, want 400", name, rec.Code)
+			}
+		}
+		if !active(cookie) {
+			t.Fatal("a rejected logout request ended the session")
+		}
+	})
+
+	t.Run("cross-site without hint", func(t *testing.T) {
+		cookie := signIn(t, s, orbit, user)
+		for name, req := range map[string]struct {
+			body   map[string]string
+			origin string
+		}{
+			"empty body":           {nil, ""},
+			"foreign origin":       {map[string]string{}, "https://evil.example"},
+			"hint of another user": {map[string]string{"id_token_hint": idToken(t, s, orbit, client, other.ID)}, "https://evil.example"},
+			"redirect, no origin":  {map[string]string{"client_id": client.ClientID, "post_logout_redirect_uri": redirect}, ""},
+			"other scheme of host": {map[string]string{}, "https://" + orbit.Domain},
+		} {
+			if rec := logout(req.body, cookie, req.origin); rec.Code != http.StatusForbidden {
+				t.Errorf("%s: status = %d, want 403", name, rec.Code)
+			}
+		}
+		if !active(cookie) {
+			t.Fatal("a cross-site logout ended the session")
+		}
+	})
+
+	t.Run("same origin", func(t *testing.T) {
+		cookie := signIn(t, s, orbit, user)
+		rec := logout(nil, cookie, pageOrigin)
+		if rec.Code != http.StatusNoContent {
+			t.Fatalf("status = %d, want 204", rec.Code)
+		}
+		if active(cookie) {
+			t.Fatal("session still active after logout")
+		}
+		if !strings.Contains(rec.Header().Get(echo.HeaderSetCookie), sessionCookieName+"=;") {
+			t.Errorf("Set-Cookie = %q, want the session cookie cleared", rec.Header().Get(echo.HeaderSetCookie))
+		}
+	})
+
+	t.Run("id_token_hint", func(t *testing.T) {
+		cookie := signIn(t, s, orbit, user)
+		rec := logout(map[string]string{
+			"id_token_hint":            idToken(t, s, orbit, client, user.ID),
+			"post_logout_redirect_uri": redirect,
+			"state":                    "af0ifjsldkj",
+		}, cookie, "https://app.example.test")
+		if rec.Code != http.StatusSeeOther || rec.Header().Get(echo.HeaderLocation) != redirect+"?state=af0ifjsldkj" {
+			t.Fatalf("status = %d, Location %q, want a redirect to %s", rec.Code, rec.Header().Get(echo.HeaderLocation), redirect)
+		}
+		if active(cookie) {
+			t.Fatal("session still active after logout")
+		}
+	})
+
+	t.Run("no session", func(t *testing.T) {
+		rec := logout(map[string]string{"client_id": client.ClientID, "post_logout_redirect_uri": redirect}, nil, "https://app.example.test")
+		if rec.Code != http.StatusSeeOther {
+			t.Fatalf("status = %d, want 303", rec.Code)
+		}
+	})
+}
+
+// foreignIDToken signs an ID token naming client and userID with the key of
+// another orbit.
+func foreignIDToken(t *testing.T, s *Server, fx *testutil.Fixtures, client *models.Client, userID int64) string {
+	t.Helper()
+	foreign := fx.Orbit()
+	fx.Key(foreign)
+	return idToken(t, s, foreign, client, userID)
+}
@@ -0,0 +1,22 @@
+package handlers
+
+import (
+	"os"
+	"testing"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories/db"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/testutil"
+	"github.com/rs/zerolog"
+)
+
+var nop = zerolog.Nop()
+
+func TestMain(m *testing.M) {
+	os.Exit(testutil.Main(m))
+}
+
+func setup(t *testing.T) (*db.DB, *testutil.Fixtures) {
+	t.Helper()
+	dbConn := testutil.Postgres(t)
+	return dbConn, testutil.NewFixtures(t, dbConn)
+}
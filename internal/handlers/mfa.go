@@ -0,0 +1,84 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/pkg/api"
+	"github.com/labstack/echo/v4"
+)
+
+type mfaPage struct {
+	RequestID  string
+	ClientName string
+	Error      string
+}
+
+func (s *Server) GetLoginMfa(c echo.Context, params api.GetLoginMfaParams) error {
+	req, _, err := s.pendingForSession(c, params.RequestId)
+	if err != nil {
+		return s.pendingRequestError(c, err)
+	}
+	return renderPage(c, http.StatusOK, mfaTemplate, mfaPage{RequestID: params.RequestId, ClientName: req.ClientName})
+}
+
+// PostLoginMfa steps the session up with a TOTP code, or with a recovery code
+// when the authenticator is not at hand.
+func (s *Server) PostLoginMfa(c echo.Context) error {
+	var body api.PostLoginMfaFormdataBody
+	if err := c.Bind(&body); err != nil {
+		return oauthError(c, http.StatusBadRequest, "invalid_request", "malformed request body")
+	}
+
+	ctx := c.Request().Context()
+	orbit := orbitFrom(c)
+	req, session, err := s.pendingForSession(c, body.RequestId)
+	if err != nil {
+		return s.pendingRequestError(c, err)
+	}
+
+	attempt, err := s.lockout.BeginSecondFactor(ctx, orbit, session.UserID, c.RealIP())
+	var throttled *services.LoginThrottledError
+	if errors.As(err, &throttled) {
+		return renderThrottled(c, mfaTemplate, mfaPage{
+			RequestID:  body.RequestId,
+			ClientName: req.ClientName,
+			Error:      throttledMessage(throttled),
+		}, throttled)
+	}
+	if err != nil {
+		return s.serverError(c, err)
+	}
+
+	var amr string
+	switch body.Method {
+	case api.Totp:
+		_, err = s.totp.Verify(ctx, orbit, session.UserID, body.Code)
+		amr = services.AMROTP
+	case api.RecoveryCode:
+		err = s.recoveryCodes.Redeem(ctx, orbit, session.UserID, body.Code)
+		amr = services.AMRRecoveryCode
+	default:
+		return oauthError(c, http.StatusBadRequest, "invalid_request", "unsupported method")
+	}
+	if errors.Is(err, services.ErrInvalidTOTPCode) || errors.Is(err, services.ErrInvalidRecoveryCode) {
+		s.lockout.Failed(ctx, attempt)
+		return renderPage(c, http.StatusUnauthorized, mfaTemplate, mfaPage{
+			RequestID:  body.RequestId,
+			ClientName: req.ClientName,
+			Error:      "The code is incorrect or has already been used.",
+		})
+	}
+	if err != nil {
+		return s.serverError(c, err)
+	}
+	s.lockout.Succeeded(ctx, attempt)
+
+	session, err = s.sessions.StepUp(ctx, session, amr)
+	if err != nil {
+		return s.serverError(c, err)
+	}
+	s.login.DropPending(ctx, body.RequestId)
+	return s.authorizeSession(c, req, session)
+}
@@ -0,0 +1,208 @@
+package handlers
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	orbitContextKey = "orbit"
+	actorContextKey = "admin_actor"
+	adminPathPrefix = "/admin/"
+)
+
+// OrbitResolver maps the request host onto an orbit via models.Orbit.Domain.
+// Every protocol endpoint is scoped to the resolved orbit; the admin API names
+// the orbit in its paths instead and is served on hosts of no orbit too, where
+// only the admin token is accepted.
+func OrbitResolver(orbits *services.OrbitService) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			host := c.Request().Host
+			if h, _, err := net.SplitHostPort(host); err == nil {
+				host = h
+			}
+			orbit, err := orbits.GetByDomain(c.Request().Context(), strings.ToLower(host))
+			if err != nil {
+				return oauthError(c, http.StatusInternalServerError, "server_error", "")
+			}
+			if orbit == nil {
+				if isAdminRoute(c) {
+					return next(c)
+				}
+				return oauthError(c, http.StatusNotFound, "invalid_request", "unknown orbit")
+			}
+			c.Set(orbitContextKey, orbit)
+			return next(c)
+		}
+	}
+}
+
+func orbitFrom(c echo.Context) *models.Orbit {
+	orbit, _ := c.Get(orbitContextKey).(*models.Orbit)
+	return orbit
+}
+
+// actorFrom returns the signed-in user an admin request is made as, or nil
+// for requests made with the admin token.
+func actorFrom(c echo.Context) *models.User {
+	user, _ := c.Get(actorContextKey).(*models.User)
+	return user
+}
+
+// AdminAuth guards the admin API. A request is made either with the static
+// admin token, which may do anything, or as the user signed in to the orbit
+// of the request host, who needs the permission adminPermission names for
+// the route in the orbit the path names. An empty token accepts no token.
+func (s *Server) AdminAuth(token string) echo.MiddlewareFunc {
+	want := sha256.Sum256([]byte(token))
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			if !isAdminRoute(c) {
+				return next(c)
+			}
+			if presented := bearerToken(c); presented != "" {
+				// Comparing digests keeps the comparison constant-time
+				// whatever the length of the presented token.
+				got := sha256.Sum256([]byte(presented))
+				if token == "" || subtle.ConstantTimeCompare(got[:], want[:]) != 1 {
+					c.Response().Header().Set(echo.HeaderWWWAuthenticate, `Bearer realm="admin"`)
+					return oauthError(c, http.StatusUnauthorized, "invalid_token", "")
+				}
+				return next(c)
+			}
+
+			if orbitFrom(c) == nil {
+				c.Response().Header().Set(echo.HeaderWWWAuthenticate, `Bearer realm="admin"`)
+				return oauthError(c, http.StatusUnauthorized, "invalid_token", "")
+			}
+			session, user, err := s.currentSession(c)
+			if err != nil {
+				return s.serverError(c, err)
+			}
+			if user == nil {
+				return oauthError(c, http.StatusUnauthorized, "login_required", "")
+			}
+			if !services.SessionComplete(session, user) {
+				return oauthError(c, http.StatusUnauthorized, "insufficient_user_authentication", "the second factor has not been provided")
+			}
+			// The session cookie is sent along with cross-site form posts,
+			// which the admin API would bind like JSON.
+			if !sameOrigin(c) {
+				return oauthError(c, http.StatusForbidden, "access_denied", "cross-origin request")
+			}
+			permission, ok := adminPermission(c)
+			if !ok {
+				return oauthError(c, http.StatusForbidden, "access_denied", "")
+			}
+			var orbitID int64
+			if raw := c.Param("orbit_id"); raw != "" {
+				if orbitID, err = strconv.ParseInt(raw, 10, 64); err != nil {
+					return oauthError(c, http.StatusBadRequest, "invalid_request", "malformed orbit_id")
+				}
+			}
+			allowed, err := s.authorizer.Allowed(c.Request().Context(), user, orbitID, permission)
+			if err != nil {
+				return s.serverError(c, err)
+			}
+			if !allowed {
+				return oauthError(c, http.StatusForbidden, "access_denied", "missing permission "+permission)
+			}
+			c.Set(actorContextKey, user)
+			return next(c)
+		}
+	}
+}
+
+// adminResources maps the routes of the admin API onto the resource their
+// permissions are named after, as in orbit:clients:read and
+// orbit:clients:write.
+var adminResources = map[string]string{
+	"/admin/v1/orbits":                                                     "orbits",
+	"/admin/v1/orbits/:orbit_id":                                           "orbit:settings",
+	"/admin/v1/orbits/:orbit_id/clients":                                   "orbit:clients",
+	"/admin/v1/orbits/:orbit_id/clients/:client_id":                        "orbit:clients",
+	"/admin/v1/orbits/:orbit_id/clients/:client_id/secret":                 "orbit:clients",
+	"/admin/v1/orbits/:orbit_id/users":                                     "orbit:users",
+	"/admin/v1/orbits/:orbit_id/users/:user_id":                            "orbit:users",
+	"/admin/v1/orbits/:orbit_id/users/:user_id/unlock":                     "orbit:users",
+	"/admin/v1/orbits/:orbit_id/users/:user_id/roles":                      "orbit:roles",
+	"/admin/v1/orbits/:orbit_id/users/:user_id/roles/:role_id":             "orbit:roles",
+	"/admin/v1/orbits/:orbit_id/users/:user_id/permissions":                "orbit:roles",
+	"/admin/v1/orbits/:orbit_id/groups":                                    "orbit:roles",
+	"/admin/v1/orbits/:orbit_id/groups/:group_id":                          "orbit:roles",
+	"/admin/v1/orbits/:orbit_id/groups/:group_id/members":                  "orbit:roles",
+	"/admin/v1/orbits/:orbit_id/groups/:group_id/members/:user_id":         "orbit:roles",
+	"/admin/v1/orbits/:orbit_id/groups/:group_id/roles":                    "orbit:roles",
+	"/admin/v1/orbits/:orbit_id/groups/:group_id/roles/:role_id":           "orbit:roles",
+	"/admin/v1/orbits/:orbit_id/scopes":                                    "orbit:scopes",
+	"/admin/v1/orbits/:orbit_id/scopes/:scope_id":                          "orbit:scopes",
+	"/admin/v1/orbits/:orbit_id/roles":                                     "orbit:roles",
+	"/admin/v1/orbits/:orbit_id/roles/:role_id":                            "orbit:roles",
+	"/admin/v1/orbits/:orbit_id/roles/:role_id/includes":                   "orbit:roles",
+	"/admin/v1/orbits/:orbit_id/roles/:role_id/includes/:included_role_id": "orbit:roles",
+	"/admin/v1/orbits/:orbit_id/roles/:role_id/permissions":                "orbit:roles",
+	"/admin/v1/orbits/:orbit_id/roles/:role_id/permissions/:permission_id": "orbit:roles",
+	"/admin/v1/orbits/:orbit_id/permissions":                               "orbit:roles",
+	"/admin/v1/orbits/:orbit_id/permissions/:permission_id":                "orbit:roles",
+	"/admin/v1/orbits/:orbit_id/policies":                                  "orbit:policies",
+	"/admin/v1/orbits/:orbit_id/policies/:policy_id":                       "orbit:policies",
+	"/admin/v1/orbits/:orbit_id/policy-decisions":                          "orbit:policies",
+	"/admin/v1/orbits/:orbit_id/keys":                                      "orbit:keys",
+	"/admin/v1/orbits/:orbit_id/keys/:key_id":                              "orbit:keys",
+	"/admin/v1/orbits/:orbit_id/audit-logs":                                "orbit:audit",
+}
+
+// adminPermission names the permission the admin route of the request
+// requires. Routes missing from adminResources are refused to users.
+func adminPermission(c echo.Context) (string, bool) {
+	path := c.Path()
+	resource, ok := adminResources[path]
+	if !ok {
+		return "", false
+	}
+	method := c.Request().Method
+	// Deleting an orbit changes the set of orbits rather than its settings.
+	if path == "/admin/v1/orbits/:orbit_id" && method == http.MethodDelete {
+		return "orbits:write", true
+	}
+	switch method {
+	case http.MethodGet, http.MethodHead:
+		return resource + ":read", true
+	}
+	return resource + ":write", true
+}
+
+// sameOrigin reports whether a request is safe or, when it carries an
+// Origin, comes from a page of the host it is sent to.
+func sameOrigin(c echo.Context) bool {
+	switch c.Request().Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	origin := c.Request().Header.Get(echo.HeaderOrigin)
+	if origin == "" {
+		return true
+	}
+	return strings.EqualFold(origin, c.Scheme()+"://"+c.Request().Host)
+}
+
+// fromOwnPage reports whether a request carries the Origin of the host it is
+// sent to. Unlike sameOrigin it refuses requests without one.
+func fromOwnPage(c echo.Context) bool {
+	origin := c.Request().Header.Get(echo.HeaderOrigin)
+	return origin != "" && strings.EqualFold(origin, c.Scheme()+"://"+c.Request().Host)
+}
+
+// isAdminRoute reports whether the request was routed to the admin API.
+func isAdminRoute(c echo.Context) bool {
+	return strings.HasPrefix(c.Path(), adminPathPrefix)
+}
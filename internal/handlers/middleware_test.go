@@ -0,0 +1,283 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories/db"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/testutil"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/pkg/api"
+	"github.com/labstack/echo/v4"
+)
+
+// A route missing from adminResources is refused to every signed-in user,
+// so each admin route in the spec needs an entry.
+func TestAdminRoutesHaveResources(t *testing.T) {
+	e := echo.New()
+	api.RegisterHandlers(e, &Server{})
+
+	routed := map[string]bool{}
+	for _, r := range e.Routes() {
+		if !strings.HasPrefix(r.Path, adminPathPrefix) {
+			continue
+		}
+		routed[r.Path] = true
+		if _, ok := adminResources[r.Path]; !ok {
+			t.Errorf("%s %s has no adminResources entry", r.Method, r.Path)
+		}
+	}
+	if len(routed) == 0 {
+		t.Fatal("no admin routes registered")
+	}
+	for path := range adminResources {
+		if !routed[path] {
+			t.Errorf("adminResources entry %s matches no route", path)
+		}
+	}
+}
+
+func TestAdminPermission(t *testing.T) {
+	e := echo.New()
+	for _, tc := range []struct {
+		method, path string
+		want         string
+	}{
+		{http.MethodGet, "/admin/v1/orbits", "orbits:read"},
+		{http.MethodPost, "/admin/v1/orbits", "orbits:write"},
+		{http.MethodGet, "/admin/v1/orbits/:orbit_id", "orbit:settings:read"},
+		{http.MethodPut, "/admin/v1/orbits/:orbit_id", "orbit:settings:write"},
+		{http.MethodDelete, "/admin/v1/orbits/:orbit_id", "orbits:write"},
+		{http.MethodHead, "/admin/v1/orbits/:orbit_id/clients", "orbit:clients:read"},
+		{http.MethodPatch, "/admin/v1/orbits/:orbit_id/users/:user_id", "orbit:users:write"},
+		{http.MethodDelete, "/admin/v1/orbits/:orbit_id/groups/:group_id/members/:user_id", "orbit:roles:write"},
+		{http.MethodGet, "/admin/v1/orbits/:orbit_id/audit-logs", "orbit:audit:read"},
+		{http.MethodGet, "/admin/v1/unmapped", ""},
+	} {
+		c := e.NewContext(httptest.NewRequest(tc.method, "/", nil), httptest.NewRecorder())
+		c.SetPath(tc.path)
+		got, ok := adminPermission(c)
+		if ok != (tc.want != "") || got != tc.want {
+			t.Errorf("adminPermission(%s %s) = %q, %v, want %q", tc.method, tc.path, got, ok, tc.want)
+		}
+	}
+}
+
+func TestSameOrigin(t *testing.T) {
+	e := echo.New()
+	for _, tc := range []struct {
+		method, origin string
+		want           bool
+	}{
+		{http.MethodGet, "https://evil.example", true},
+		{http.MethodHead, "https://evil.example", true},
+		{http.MethodPost, "", true},
+		{http.MethodPost, "http://id.example.test", true},
+		{http.MethodPost, "HTTP://ID.EXAMPLE.TEST", true},
+		{http.MethodPost, "https://id.example.test", false},
+		{http.MethodPost, "http://id.example.test:8080", false},
+		{http.MethodPut, "https://evil.example", false},
+		{http.MethodDelete, "null", false},
+	} {
+		req := httptest.NewRequest(tc.method, "http://id.example.test/admin/v1/orbits", nil)
+		if tc.origin != "" {
+			req.Header.Set(echo.HeaderOrigin, tc.origin)
+		}
+		if got := sameOrigin(e.NewContext(req, httptest.NewRecorder())); got != tc.want {
+			t.Errorf("sameOrigin(%s, Origin %q) = %v, want %v", tc.method, tc.origin, got, tc.want)
+		}
+	}
+}
+
+const testAdminToken = "admin-token"
+
+// adminEcho serves the admin API the way main wires it.
+func adminEcho(t *testing.T, dbConn *db.DB, masterOrbitID int64) (*echo.Echo, *Server) {
+	t.Helper()
+	cacheManager := testutil.LocalCache(t)
+	orbits := services.NewOrbitService(dbConn, repositories.NewOrbitRepository(dbConn.Exec(), nop), cacheManager, nop)
+	users := services.NewUserService(dbConn, repositories.NewUserRepository(dbConn.Exec(), nop), cacheManager, nil, nop)
+	roles := services.NewRoleService(dbConn, cacheManager, nop)
+	s := &Server{
+		orbits:      orbits,
+		sessions:    services.NewSessionService(dbConn, users, nop),
+		users:       users,
+		scopes:      services.NewScopeService(dbConn, nop),
+		roles:       roles,
+		permissions: services.NewPermissionService(dbConn, cacheManager, nop),
+		audits:      services.NewAuditLogService(dbConn, nop),
+		authorizer:  services.NewAuthorizer(roles, masterOrbitID),
+		cookies:     sessionCookies{key: []byte("session-test-key")},
+		logger:      nop,
+	}
+	e := echo.New()
+	e.Use(OrbitResolver(orbits), s.AdminAuth(testAdminToken))
+	api.RegisterHandlers(e, s)
+	return e, s
+}
+
+// grant gives user a role holding the named permissions in its orbit.
+func grant(t *testing.T, s *Server, user *models.User, names ...string) {
+	t.Helper()
+	ctx := t.Context()
+	role, err := s.roles.Create(ctx, &models.Role{OrbitID: user.OrbitID, Name: "admin-" + strconv.FormatInt(user.ID, 10)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range names {
+		p, err := s.permissions.Create(ctx, &models.Permission{OrbitID: user.OrbitID, Name: name})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := s.roles.GrantPermission(ctx, user.OrbitID, role.ID, p.ID); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := s.roles.AssignToUser(ctx, user.OrbitID, role.ID, user.ID); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// signIn starts a session for user and returns its cookie.
+func signIn(t *testing.T, s *Server, orbit *models.Orbit, user *models.User) *http.Cookie {
+	t.Helper()
+	return signInAt(t, s, orbit, user, time.Now().UTC())
+}
+
+// signInAt starts a session for user that signed in at the given time.
+func signInAt(t *testing.T, s *Server, orbit *models.Orbit, user *models.User, at time.Time) *http.Cookie {
+	t.Helper()
+	session, err := s.sessions.Start(t.Context(), orbit, &models.Session{
+		OrbitID:      orbit.ID,
+		UserID:       user.ID,
+		StartedAt:    at,
+		LastActiveAt: time.Now().UTC(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &http.Cookie{Name: sessionCookieName, Value: s.cookies.encode(session.ID)}
+}
+
+type adminRequest struct {
+	method, host, path string
+	body               string
+	token              string
+	cookie             *http.Cookie
+	origin             string
+}
+
+func (r adminRequest) do(e *echo.Echo) (int, api.Error) {
+	req := httptest.NewRequest(r.method, "http://"+r.host+r.path, strings.NewReader(r.body))
+	if r.body != "" {
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+	}
+	if r.token != "" {
+		req.Header.Set(echo.HeaderAuthorization, "Bearer "+r.token)
+	}
+	if r.cookie != nil {
+		req.AddCookie(r.cookie)
+	}
+	if r.origin != "" {
+		req.Header.Set(echo.HeaderOrigin, r.origin)
+	}
+	rec := httptest.NewRecorder()
+	e.ServeHTTP(rec, req)
+	var body api.Error
+	_ = json.Unmarshal(rec.Body.Bytes(), &body)
+	return rec.Code, body
+}
+
+func orbitPath(o *models.Orbit, rest string) string {
+	return "/admin/v1/orbits/" + strconv.FormatInt(o.ID, 10) + rest
+}
+
+func TestAdminAuth(t *testing.T) {
+	dbConn, fx := setup(t)
+	master := fx.Orbit()
+	e, s := adminEcho(t, dbConn, master.ID)
+	orbit := fx.Orbit()
+	other := fx.Orbit()
+
+	admin := fx.User(orbit)
+	grant(t, s, admin, "orbit:settings:read", "orbit:scopes:write", "orbits:read")
+	adminCookie := signIn(t, s, orbit, admin)
+	plain := fx.User(orbit)
+	plainCookie := signIn(t, s, orbit, plain)
+	operator := fx.User(master)
+	grant(t, s, operator, "orbit:settings:read")
+	operatorCookie := signIn(t, s, master, operator)
+	// An admin with MFA whose session has only proven the password, and one
+	// who has given the second factor as well.
+	mfaAdmin := fx.User(orbit, func(u *models.User) { u.MFAEnabled = true })
+	grant(t, s, mfaAdmin, "orbit:settings:read")
+	pendingCookie := signIn(t, s, orbit, mfaAdmin)
+	steppedCookie := signIn(t, s, orbit, mfaAdmin)
+	stepUp(t, s, orbit, steppedCookie)
+
+	forged := *adminCookie
+	forged.Value = strings.Replace(forged.Value, ".", "0.", 1)
+	scope := `{"name":"inventory.read"}`
+	pageOrigin := "http://" + orbit.Domain
+
+	for _, tc := range []struct {
+		name        string
+		req         adminRequest
+		status      int
+		description string
+	}{
+		{"token on a host of no orbit", adminRequest{method: http.MethodGet, host: "admin.internal", path: orbitPath(other, ""), token: testAdminToken}, http.StatusOK, ""},
+		{"wrong token", adminRequest{method: http.MethodGet, host: orbit.Domain, path: orbitPath(orbit, ""), token: "guess"}, http.StatusUnauthorized, ""},
+		{"no credentials", adminRequest{method: http.MethodGet, host: orbit.Domain, path: orbitPath(orbit, "")}, http.StatusUnauthorized, ""},
+		{"session on a host of no orbit", adminRequest{method: http.MethodGet, host: "admin.internal", path: orbitPath(orbit, ""), cookie: adminCookie}, http.StatusUnauthorized, ""},
+		{"forged session cookie", adminRequest{method: http.MethodGet, host: orbit.Domain, path: orbitPath(orbit, ""), cookie: &forged}, http.StatusUnauthorized, ""},
+		{"session of another orbit's host", adminRequest{method: http.MethodGet, host: other.Domain, path: orbitPath(orbit, ""), cookie: adminCookie}, http.StatusUnauthorized, ""},
+		{"orbit admin in own orbit", adminRequest{method: http.MethodGet, host: orbit.Domain, path: orbitPath(orbit, ""), cookie: adminCookie}, http.StatusOK, ""},
+		{"orbit admin in another orbit", adminRequest{method: http.MethodGet, host: orbit.Domain, path: orbitPath(other, ""), cookie: adminCookie}, http.StatusForbidden, "missing permission orbit:settings:read"},
+		{"orbit admin listing orbits", adminRequest{method: http.MethodGet, host: orbit.Domain, path: "/admin/v1/orbits", cookie: adminCookie}, http.StatusForbidden, "missing permission orbits:read"},
+		{"orbit admin without the permission", adminRequest{method: http.MethodGet, host: orbit.Domain, path: orbitPath(orbit, "/clients"), cookie: adminCookie}, http.StatusForbidden, "missing permission orbit:clients:read"},
+		{"user without roles", adminRequest{method: http.MethodGet, host: orbit.Domain, path: orbitPath(orbit, ""), cookie: plainCookie}, http.StatusForbidden, "missing permission orbit:settings:read"},
+		{"MFA admin before the second factor", adminRequest{method: http.MethodGet, host: orbit.Domain, path: orbitPath(orbit, ""), cookie: pendingCookie}, http.StatusUnauthorized, "the second factor has not been provided"},
+		{"MFA admin after the second factor", adminRequest{method: http.MethodGet, host: orbit.Domain, path: orbitPath(orbit, ""), cookie: steppedCookie}, http.StatusOK, ""},
+		{"malformed orbit_id", adminRequest{method: http.MethodGet, host: orbit.Domain, path: "/admin/v1/orbits/abc", cookie: adminCookie}, http.StatusBadRequest, "malformed orbit_id"},
+		{"master orbit user in another orbit", adminRequest{method: http.MethodGet, host: master.Domain, path: orbitPath(other, ""), cookie: operatorCookie}, http.StatusOK, ""},
+		{"cross-origin post", adminRequest{method: http.MethodPost, host: orbit.Domain, path: orbitPath(orbit, "/scopes"), body: scope, cookie: adminCookie, origin: "https://evil.example"}, http.StatusForbidden, "cross-origin request"},
+		{"cross-origin post with the token", adminRequest{method: http.MethodPost, host: orbit.Domain, path: orbitPath(other, "/scopes"), body: scope, token: testAdminToken, origin: "https://evil.example"}, http.StatusCreated, ""},
+		{"same-origin post", adminRequest{method: http.MethodPost, host: orbit.Domain, path: orbitPath(orbit, "/scopes"), body: scope, cookie: adminCookie, origin: pageOrigin}, http.StatusCreated, ""},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			status, body := tc.req.do(e)
+			if status != tc.status {
+				t.Fatalf("status = %d (%+v), want %d", status, body, tc.status)
+			}
+			if tc.description != "" && (body.ErrorDescription == nil || *body.ErrorDescription != tc.description) {
+				t.Fatalf("error = %+v, want description %q", body, tc.description)
+			}
+		})
+	}
+}
+
+// With no admin token configured, presenting one is refused rather than
+// matching the empty string.
+func TestAdminAuthWithoutToken(t *testing.T) {
+	dbConn, fx := setup(t)
+	_, s := adminEcho(t, dbConn, 0)
+	orbit := fx.Orbit()
+	e := echo.New()
+	e.Use(OrbitResolver(s.orbits), s.AdminAuth(""))
+	api.RegisterHandlers(e, s)
+
+	for _, token := range []string{testAdminToken, "x"} {
+		req := adminRequest{method: http.MethodGet, host: orbit.Domain, path: orbitPath(orbit, ""), token: token}
+		if status, body := req.do(e); status != http.StatusUnauthorized {
+			t.Fatalf("token %q: status = %d (%+v), want 401", token, status, body)
+		}
+	}
+}
@@ -0,0 +1,174 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/pkg/api"
+	"github.com/labstack/echo/v4"
+)
+
+// maxPasskeyResponseSize bounds authenticator responses. Attestation
+// certificate chains are the largest part and stay well below this.
+const maxPasskeyResponseSize = 64 << 10
+
+func (s *Server) PostLoginPasskeyOptions(c echo.Context) error {
+	var body api.PostLoginPasskeyOptionsJSONBody
+	if err := c.Bind(&body); err != nil {
+		return oauthError(c, http.StatusBadRequest, "invalid_request", "malformed request body")
+	}
+
+	ctx := c.Request().Context()
+	orbit := orbitFrom(c)
+	if _, err := s.pendingForBrowser(c, body.RequestId); err != nil {
+		return s.pendingRequestError(c, err)
+	}
+	ceremonyID, assertion, err := s.webauthn.BeginLogin(ctx, orbit, deref(body.Identity))
+	if err != nil {
+		return s.passkeyError(c, err)
+	}
+	return s.passkeyOptions(c, ceremonyID, assertion)
+}
+
+func (s *Server) PostLoginPasskey(c echo.Context) error {
+	var body api.PostLoginPasskeyFormdataBody
+	if err := c.Bind(&body); err != nil {
+		return oauthError(c, http.StatusBadRequest, "invalid_request", "malformed request body")
+	}
+
+	ctx := c.Request().Context()
+	orbit := orbitFrom(c)
+	req, err := s.pendingForBrowser(c, body.RequestId)
+	if err != nil {
+		return s.pendingRequestError(c, err)
+	}
+
+	user, credential, err := s.webauthn.FinishLogin(ctx, orbit, body.CeremonyId, []byte(body.Credential))
+	if err != nil {
+		page := s.loginForm(c, body.RequestId, req, "")
+		switch {
+		case errors.Is(err, services.ErrUserLocked):
+			page.Error = "This account is locked."
+		case errors.Is(err, services.ErrInvalidWebAuthnAssertion),
+			errors.Is(err, services.ErrWebAuthnCeremonyNotFound),
+			errors.Is(err, services.ErrWebAuthnCredentialCloned),
+			errors.Is(err, services.ErrWebAuthnCredentialNotFound),
+			errors.Is(err, services.ErrWebAuthnUnavailable),
+			errors.Is(err, services.ErrInvalidCredentials):
+			page.Error = "The passkey could not be verified."
+		default:
+			return s.serverError(c, err)
+		}
+		return renderPage(c, http.StatusUnauthorized, loginTemplate, page)
+	}
+
+	session, err := s.login.StartSession(ctx, orbit, req, user, services.WebAuthnAMR(credential), c.Request().UserAgent(), c.RealIP())
+	if err != nil {
+		return s.serverError(c, err)
+	}
+	return s.completeLogin(c, body.RequestId, req, session)
+}
+
+func (s *Server) GetAccountPasskeys(c echo.Context) error {
+	session, _, err := s.accountSession(c)
+	if session == nil {
+		return err
+	}
+
+	list, err := s.webauthn.List(c.Request().Context(), session.UserID)
+	if err != nil {
+		return s.serverError(c, err)
+	}
+	out := make([]api.Passkey, 0, len(list))
+	for _, p := range list {
+		out = append(out, passkeyResponse(p))
+	}
+	return c.JSON(http.StatusOK, out)
+}
+
+func (s *Server) PostAccountPasskeysRegistrations(c echo.Context) error {
+	session, user, err := s.accountSession(c)
+	if session == nil {
+		return err
+	}
+	var body api.PostAccountPasskeysRegistrationsJSONBody
+	if err := c.Bind(&body); err != nil {
+		return oauthError(c, http.StatusBadRequest, "invalid_request", "malformed request body")
+	}
+
+	ceremonyID, creation, err := s.webauthn.BeginRegistration(c.Request().Context(), orbitFrom(c), user, body.Name)
+	if err != nil {
+		return s.passkeyError(c, err)
+	}
+	return s.passkeyOptions(c, ceremonyID, creation)
+}
+
+func (s *Server) PostAccountPasskeysRegistrationsCeremonyId(c echo.Context, ceremonyId string) error {
+	session, user, err := s.accountSession(c)
+	if session == nil {
+		return err
+	}
+	// The library parses the browser's serialisation itself, so the body is
+	// passed through untouched.
+	response, err := io.ReadAll(io.LimitReader(c.Request().Body, maxPasskeyResponseSize))
+	if err != nil {
+		return oauthError(c, http.StatusBadRequest, "invalid_request", "malformed request body")
+	}
+
+	created, err := s.webauthn.FinishRegistration(c.Request().Context(), orbitFrom(c), user, ceremonyId, response)
+	if err != nil {
+		return s.passkeyError(c, err)
+	}
+	return c.JSON(http.StatusCreated, passkeyResponse(created))
+}
+
+func (s *Server) DeleteAccountPasskeysPasskeyId(c echo.Context, passkeyId int64) error {
+	session, _, err := s.accountSession(c)
+	if session == nil {
+		return err
+	}
+
+	if err := s.webauthn.Delete(c.Request().Context(), session.UserID, passkeyId); err != nil {
+		return s.passkeyError(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (s *Server) passkeyOptions(c echo.Context, ceremonyID string, options any) error {
+	raw, err := json.Marshal(options)
+	if err != nil {
+		return s.serverError(c, err)
+	}
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+	return c.JSON(http.StatusOK, api.PasskeyOptions{CeremonyId: ceremonyID, Options: raw})
+}
+
+func passkeyResponse(p *models.WebAuthnCredential) api.Passkey {
+	return api.Passkey{
+		Id:           p.ID,
+		Name:         p.Name,
+		Discoverable: p.Discoverable,
+		BackedUp:     p.BackupState,
+		CreatedAt:    p.CreatedAt,
+		LastUsedAt:   p.LastUsedAt,
+	}
+}
+
+func (s *Server) passkeyError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, services.ErrWebAuthnCredentialNotFound):
+		return oauthError(c, http.StatusNotFound, "not_found", err.Error())
+	case errors.Is(err, services.ErrWebAuthnUnavailable),
+		errors.Is(err, services.ErrWebAuthnCeremonyNotFound),
+		errors.Is(err, services.ErrInvalidWebAuthnRegistration),
+		errors.Is(err, services.ErrUnsupportedAttestationFormat):
+		return oauthError(c, http.StatusBadRequest, "invalid_request", err.Error())
+	case errors.Is(err, services.ErrWebAuthnNameTaken), errors.Is(err, services.ErrWebAuthnLimitReached):
+		return oauthError(c, http.StatusConflict, "conflict", err.Error())
+	}
+	return s.serverError(c, err)
+}
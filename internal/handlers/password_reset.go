@@ -0,0 +1,96 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/pkg/api"
+	"github.com/labstack/echo/v4"
+)
+
+type passwordResetPage struct {
+	Token  string
+	Errors []string
+}
+
+type noticePage struct {
+	Title   string
+	Message string
+}
+
+var invalidResetLink = noticePage{
+	Title:   "This link cannot be used",
+	Message: "The password reset link is invalid, has expired or has already been used. Request a new one from the sign-in page.",
+}
+
+var passwordViolationMessages = map[string]string{
+	services.PasswordViolationTooShort: "The password is too short.",
+	services.PasswordViolationTooLong:  "The password is too long.",
+	services.PasswordViolationLower:    "The password needs a lowercase letter.",
+	services.PasswordViolationUpper:    "The password needs an uppercase letter.",
+	services.PasswordViolationDigit:    "The password needs a digit.",
+	services.PasswordViolationSymbol:   "The password needs a symbol.",
+	services.PasswordViolationIdentity: "The password must not contain your username or email address.",
+	services.PasswordViolationReused:   "The password was used recently. Choose a different one.",
+	services.PasswordViolationBreached: "The password appears in a known data breach. Choose a different one.",
+}
+
+func (s *Server) GetPasswordForgot(c echo.Context) error {
+	return renderPage(c, http.StatusOK, passwordForgotTemplate, nil)
+}
+
+func (s *Server) PostPasswordForgot(c echo.Context) error {
+	var body api.PostPasswordForgotFormdataBody
+	if err := c.Bind(&body); err != nil {
+		return oauthError(c, http.StatusBadRequest, "invalid_request", "malformed request body")
+	}
+
+	if err := s.userTokens.RequestPasswordReset(c.Request().Context(), orbitFrom(c), body.Identity); err != nil {
+		return s.serverError(c, err)
+	}
+	return renderPage(c, http.StatusOK, noticeTemplate, noticePage{
+		Title:   "Check your inbox",
+		Message: "If an account with an email address matches what you entered, we have sent it a link to choose a new password.",
+	})
+}
+
+func (s *Server) GetPasswordReset(c echo.Context, params api.GetPasswordResetParams) error {
+	err := s.userTokens.CheckPasswordReset(c.Request().Context(), orbitFrom(c), params.Token)
+	if errors.Is(err, services.ErrInvalidUserToken) {
+		return renderPage(c, http.StatusBadRequest, noticeTemplate, invalidResetLink)
+	}
+	if err != nil {
+		return s.serverError(c, err)
+	}
+	return renderPage(c, http.StatusOK, passwordResetTemplate, passwordResetPage{Token: params.Token})
+}
+
+func (s *Server) PostPasswordReset(c echo.Context) error {
+	var body api.PostPasswordResetFormdataBody
+	if err := c.Bind(&body); err != nil {
+		return oauthError(c, http.StatusBadRequest, "invalid_request", "malformed request body")
+	}
+
+	_, err := s.userTokens.ResetPassword(c.Request().Context(), orbitFrom(c), body.Token, body.Password)
+	var policy *services.PasswordPolicyError
+	switch {
+	case errors.As(err, &policy):
+		page := passwordResetPage{Token: body.Token}
+		for _, v := range policy.Violations {
+			page.Errors = append(page.Errors, passwordViolationMessages[v])
+		}
+		return renderPage(c, http.StatusBadRequest, passwordResetTemplate, page)
+	case errors.Is(err, services.ErrInvalidUserToken), errors.Is(err, services.ErrUserNotFound):
+		return renderPage(c, http.StatusBadRequest, noticeTemplate, invalidResetLink)
+	case err != nil:
+		return s.serverError(c, err)
+	}
+
+	// The session this browser may hold was revoked with all the others.
+	s.clearSessionCookie(c)
+	return renderPage(c, http.StatusOK, noticeTemplate, noticePage{
+		Title:   "Password changed",
+		Message: "Your password has been changed and you have been signed out on every device. Return to the application to sign in again.",
+	})
+}
@@ -0,0 +1,35 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/pkg/api"
+	"github.com/labstack/echo/v4"
+)
+
+func (s *Server) GetAccountRecoveryCodes(c echo.Context) error {
+	session, _, err := s.accountSession(c)
+	if session == nil {
+		return err
+	}
+
+	remaining, err := s.recoveryCodes.Remaining(c.Request().Context(), session.UserID)
+	if err != nil {
+		return s.serverError(c, err)
+	}
+	return c.JSON(http.StatusOK, api.RecoveryCodeStatus{Remaining: remaining})
+}
+
+func (s *Server) PostAccountRecoveryCodes(c echo.Context) error {
+	session, _, err := s.recentAccountSession(c)
+	if session == nil {
+		return err
+	}
+
+	codes, err := s.recoveryCodes.Generate(c.Request().Context(), orbitFrom(c), session.UserID)
+	if err != nil {
+		return s.serverError(c, err)
+	}
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+	return c.JSON(http.StatusCreated, api.RecoveryCodes{Codes: codes})
+}
@@ -0,0 +1,163 @@
+package handlers
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/pkg/api"
+	"github.com/labstack/echo/v4"
+)
+
+func (s *Server) PostRegister(c echo.Context) error {
+	var body api.ClientMetadata
+	if err := c.Bind(&body); err != nil {
+		return oauthError(c, http.StatusBadRequest, "invalid_client_metadata", "malformed request body")
+	}
+
+	registered, err := s.registration.Register(c.Request().Context(), orbitFrom(c), bearerToken(c), toClientMetadata(body))
+	if err != nil {
+		return s.registrationError(c, err)
+	}
+	return c.JSON(http.StatusCreated, toClientInformation(registered))
+}
+
+func (s *Server) GetRegisterClientId(c echo.Context, clientId string) error {
+	registered, err := s.registration.Read(c.Request().Context(), orbitFrom(c), clientId, bearerToken(c))
+	if err != nil {
+		return s.registrationError(c, err)
+	}
+	return c.JSON(http.StatusOK, toClientInformation(registered))
+}
+
+func (s *Server) PutRegisterClientId(c echo.Context, clientId string) error {
+	var body api.ClientMetadata
+	if err := c.Bind(&body); err != nil {
+		return oauthError(c, http.StatusBadRequest, "invalid_client_metadata", "malformed request body")
+	}
+
+	registered, err := s.registration.Update(c.Request().Context(), orbitFrom(c), clientId, bearerToken(c), toClientMetadata(body))
+	if err != nil {
+		return s.registrationError(c, err)
+	}
+	return c.JSON(http.StatusOK, toClientInformation(registered))
+}
+
+func (s *Server) DeleteRegisterClientId(c echo.Context, clientId string) error {
+	if err := s.registration.Delete(c.Request().Context(), orbitFrom(c), clientId, bearerToken(c)); err != nil {
+		return s.registrationError(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (s *Server) registrationError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, services.ErrInvalidRedirectURI):
+		return oauthError(c, http.StatusBadRequest, "invalid_redirect_uri", err.Error())
+	case errors.Is(err, services.ErrInvalidSoftwareStatement):
+		return oauthError(c, http.StatusBadRequest, "invalid_software_statement", err.Error())
+	case errors.Is(err, services.ErrUnapprovedSoftwareStatement):
+		return oauthError(c, http.StatusBadRequest, "unapproved_software_statement", err.Error())
+	case errors.Is(err, services.ErrInvalidClientMetadata):
+		return oauthError(c, http.StatusBadRequest, "invalid_client_metadata", err.Error())
+	case errors.Is(err, services.ErrInvalidInitialAccessToken), errors.Is(err, services.ErrInvalidRegistrationToken):
+		c.Response().Header().Set(echo.HeaderWWWAuthenticate, `Bearer error="invalid_token"`)
+		return oauthError(c, http.StatusUnauthorized, "invalid_token", "")
+	case errors.Is(err, services.ErrRegistrationDisabled):
+		return oauthError(c, http.StatusForbidden, "access_denied", err.Error())
+	}
+	return s.serverError(c, err)
+}
+
+func toClientMetadata(body api.ClientMetadata) services.ClientMetadata {
+	md := services.ClientMetadata{
+		ClientID:                deref(body.ClientId),
+		RedirectURIs:            derefList(body.RedirectUris),
+		PostLogoutRedirectURIs:  derefList(body.PostLogoutRedirectUris),
+		GrantTypes:              derefList(body.GrantTypes),
+		ResponseTypes:           derefList(body.ResponseTypes),
+		ClientName:              deref(body.ClientName),
+		ClientURI:               deref(body.ClientUri),
+		LogoURI:                 deref(body.LogoUri),
+		Scope:                   deref(body.Scope),
+		Contacts:                derefList(body.Contacts),
+		JWKSURI:                 deref(body.JwksUri),
+		SoftwareStatement:       deref(body.SoftwareStatement),
+		TokenEndpointAuthMethod: string(deref(body.TokenEndpointAuthMethod)),
+		ApplicationType:         string(deref(body.ApplicationType)),
+	}
+	if body.Jwks != nil {
+		md.JWKS, _ = json.Marshal(*body.Jwks)
+	}
+	return md
+}
+
+func toClientInformation(rc *services.RegisteredClient) api.ClientInformation {
+	md := rc.Metadata
+	info := api.ClientInformation{
+		ClientId:                md.ClientID,
+		RedirectUris:            &md.RedirectURIs,
+		GrantTypes:              &md.GrantTypes,
+		ResponseTypes:           &md.ResponseTypes,
+		TokenEndpointAuthMethod: ptr(api.ClientInformationTokenEndpointAuthMethod(md.TokenEndpointAuthMethod)),
+		ApplicationType:         ptr(api.ClientInformationApplicationType(md.ApplicationType)),
+		ClientIdIssuedAt:        ptr(rc.Client.CreatedAt.Unix()),
+		RegistrationClientUri:   optional(rc.RegistrationClientURI),
+		RegistrationAccessToken: optional(rc.RegistrationAccessToken),
+		ClientName:              optional(md.ClientName),
+		ClientUri:               optional(md.ClientURI),
+		LogoUri:                 optional(md.LogoURI),
+		Scope:                   optional(md.Scope),
+		JwksUri:                 optional(md.JWKSURI),
+		SoftwareId:              optional(md.SoftwareID),
+		SoftwareVersion:         optional(md.SoftwareVersion),
+	}
+	if len(md.PostLogoutRedirectURIs) > 0 {
+		info.PostLogoutRedirectUris = &md.PostLogoutRedirectURIs
+	}
+	if len(md.Contacts) > 0 {
+		info.Contacts = &md.Contacts
+	}
+	if len(md.JWKS) > 0 {
+		var jwks map[string]any
+		if json.Unmarshal(md.JWKS, &jwks) == nil {
+			info.Jwks = &jwks
+		}
+	}
+	if rc.ClientSecret != "" {
+		info.ClientSecret = &rc.ClientSecret
+		var expiresAt int64
+		if rc.ClientSecretExpiresAt != nil {
+			expiresAt = rc.ClientSecretExpiresAt.Unix()
+		}
+		info.ClientSecretExpiresAt = &expiresAt
+	}
+	return info
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+func optional(v string) *string {
+	if v == "" {
+		return nil
+	}
+	return &v
+}
+
+func deref[T any](v *T) T {
+	var zero T
+	if v == nil {
+		return zero
+	}
+	return *v
+}
+
+func derefList(v *[]string) []string {
+	if v == nil {
+		return nil
+	}
+	return *v
+}
@@ -0,0 +1,110 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/pkg/api"
+	"github.com/labstack/echo/v4"
+	"github.com/rs/zerolog"
+)
+
+type Server struct {
+	orbits        *services.OrbitService
+	clients       *services.ClientService
+	registration  *services.ClientRegistrationService
+	login         *services.LoginService
+	sessions      *services.SessionService
+	consents      *services.ConsentService
+	totp          *services.TOTPService
+	recoveryCodes *services.RecoveryCodeService
+	webauthn      *services.WebAuthnService
+	lockout       *services.LockoutService
+	userTokens    *services.UserTokenService
+	users         *services.UserService
+	scopes        *services.ScopeService
+	roles         *services.RoleService
+	groups        *services.GroupService
+	permissions   *services.PermissionService
+	policies      *services.PolicyService
+	keys          *services.JWKService
+	resources     *services.ResourceServerService
+	tokens        *services.TokenService
+	audits        *services.AuditLogService
+	authorizer    *services.Authorizer
+	cookies       sessionCookies
+	logger        zerolog.Logger
+}
+
+var _ api.ServerInterface = (*Server)(nil)
+
+// sessionKey signs session cookies and must be shared by every instance.
+func NewServer(
+	orbits *services.OrbitService,
+	clients *services.ClientService,
+	registration *services.ClientRegistrationService,
+	login *services.LoginService,
+	sessions *services.SessionService,
+	consents *services.ConsentService,
+	totp *services.TOTPService,
+	recoveryCodes *services.RecoveryCodeService,
+	webauthn *services.WebAuthnService,
+	lockout *services.LockoutService,
+	userTokens *services.UserTokenService,
+	users *services.UserService,
+	scopes *services.ScopeService,
+	roles *services.RoleService,
+	groups *services.GroupService,
+	permissions *services.PermissionService,
+	policies *services.PolicyService,
+	keys *services.JWKService,
+	resources *services.ResourceServerService,
+	tokens *services.TokenService,
+	audits *services.AuditLogService,
+	authorizer *services.Authorizer,
+	sessionKey []byte,
+	logger zerolog.Logger,
+) *Server {
+	return &Server{
+		orbits:        orbits,
+		clients:       clients,
+		registration:  registration,
+		login:         login,
+		sessions:      sessions,
+		consents:      consents,
+		totp:          totp,
+		recoveryCodes: recoveryCodes,
+		webauthn:      webauthn,
+		lockout:       lockout,
+		userTokens:    userTokens,
+		users:         users,
+		scopes:        scopes,
+		roles:         roles,
+		groups:        groups,
+		permissions:   permissions,
+		policies:      policies,
+		keys:          keys,
+		resources:     resources,
+		tokens:        tokens,
+		audits:        audits,
+		authorizer:    authorizer,
+		cookies:       sessionCookies{key: sessionKey},
+		logger:        logger,
+	}
+}
+
+func (s *Server) GetWellKnownOpenidConfiguration(c echo.Context) error {
+	return notImplemented(c)
+}
+
+func (s *Server) PostRevoke(c echo.Context) error {
+	return notImplemented(c)
+}
+
+func (s *Server) GetUserinfo(c echo.Context) error {
+	return notImplemented(c)
+}
+
+func notImplemented(c echo.Context) error {
+	return oauthError(c, http.StatusNotImplemented, "temporarily_unavailable", "endpoint is not implemented yet")
+}
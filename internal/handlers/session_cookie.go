@@ -0,0 +1,118 @@
+package handlers
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/labstack/echo/v4"
+)
+
+const (
+	sessionCookieName = "orbitum_session"
+	// loginCookieName holds the browser binding of pending authorization
+	// requests until the user has signed in.
+	loginCookieName = "orbitum_login"
+)
+
+// sessionCookies signs the session id so a client cannot point its cookie at
+// somebody else's session. The cookie is host-only, which keeps it scoped to
+// the orbit it was issued by.
+type sessionCookies struct {
+	key []byte
+}
+
+func (k sessionCookies) sign(value string) string {
+	mac := hmac.New(sha256.New, k.key)
+	mac.Write([]byte(value))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func (k sessionCookies) encode(sessionID int64) string {
+	value := strconv.FormatInt(sessionID, 10)
+	return value + "." + k.sign(value)
+}
+
+func (k sessionCookies) decode(raw string) (int64, bool) {
+	value, sig, ok := strings.Cut(raw, ".")
+	if !ok || !hmac.Equal([]byte(sig), []byte(k.sign(value))) {
+		return 0, false
+	}
+	id, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0, false
+	}
+	return id, true
+}
+
+func (s *Server) sessionID(c echo.Context) (int64, bool) {
+	cookie, err := c.Cookie(sessionCookieName)
+	if err != nil {
+		return 0, false
+	}
+	return s.cookies.decode(cookie.Value)
+}
+
+func (s *Server) setSessionCookie(c echo.Context, session *models.Session) {
+	cookie := &http.Cookie{
+		Name:     sessionCookieName,
+		Value:    s.cookies.encode(session.ID),
+		Path:     "/",
+		HttpOnly: true,
+		Secure:   c.Scheme() == "https",
+		// Lax keeps the cookie on the top-level redirects clients send users
+		// to /authorize with.
+		SameSite: http.SameSiteLaxMode,
+	}
+	if session.ExpiresAt != nil {
+		cookie.Expires = *session.ExpiresAt
+	}
+	c.SetCookie(cookie)
+}
+
+func (s *Server) clearSessionCookie(c echo.Context) {
+	c.SetCookie(&http.Cookie{
+		Name:     sessionCookieName,
+		Path:     "/",
+		HttpOnly: true,
+		Secure:   c.Scheme() == "https",
+		SameSite: http.SameSiteLaxMode,
+		MaxAge:   -1,
+		Expires:  time.Unix(0, 0),
+	})
+}
+
+// loginBinding returns the browser's login cookie, issuing one if it has none.
+// The value is kept across requests so several tabs can sign in at once.
+func (s *Server) loginBinding(c echo.Context) (string, error) {
+	if binding := browserBinding(c); binding != "" {
+		return binding, nil
+	}
+	binding, err := services.NewBrowserBinding()
+	if err != nil {
+		return "", err
+	}
+	c.SetCookie(&http.Cookie{
+		Name:     loginCookieName,
+		Value:    binding,
+		Path:     "/login",
+		HttpOnly: true,
+		Secure:   c.Scheme() == "https",
+		SameSite: http.SameSiteLaxMode,
+	})
+	return binding, nil
+}
+
+func browserBinding(c echo.Context) string {
+	cookie, err := c.Cookie(loginCookieName)
+	if err != nil {
+		return ""
+	}
+	return cookie.Value
+}
@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/pkg/api"
+	"github.com/labstack/echo/v4"
+)
+
+func (s *Server) GetAccountSessions(c echo.Context) error {
+	session, _, err := s.accountSession(c)
+	if session == nil {
+		return err
+	}
+
+	ctx := c.Request().Context()
+	sessions, err := s.sessions.ListByUser(ctx, orbitFrom(c), session.UserID)
+	if err != nil {
+		return s.serverError(c, err)
+	}
+	clients := map[int64]*models.Client{}
+	out := make([]api.Session, 0, len(sessions))
+	for _, other := range sessions {
+		item := api.Session{
+			Id:           other.ID,
+			Current:      other.ID == session.ID,
+			DeviceInfo:   optional(other.DeviceInfo),
+			Ip:           optional(other.IP),
+			StartedAt:    other.StartedAt,
+			LastActiveAt: other.LastActiveAt,
+			ExpiresAt:    other.ExpiresAt,
+		}
+		if other.ClientID != nil {
+			client, ok := clients[*other.ClientID]
+			if !ok {
+				if client, err = s.clients.GetByID(ctx, *other.ClientID); err != nil {
+					return s.serverError(c, err)
+				}
+				clients[*other.ClientID] = client
+			}
+			if client != nil {
+				item.ClientId = &client.ClientID
+				item.ClientName = optional(client.Name)
+			}
+		}
+		out = append(out, item)
+	}
+	return c.JSON(http.StatusOK, out)
+}
+
+// DeleteAccountSessions signs out everywhere but the session making the
+// request; that one ends with POST /logout.
+func (s *Server) DeleteAccountSessions(c echo.Context) error {
+	session, _, err := s.accountSession(c)
+	if session == nil {
+		return err
+	}
+
+	if _, err := s.sessions.RevokeAll(c.Request().Context(), orbitFrom(c), session.UserID, session.ID); err != nil {
+		return s.serverError(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (s *Server) DeleteAccountSessionsSessionId(c echo.Context, sessionId int64) error {
+	session, _, err := s.accountSession(c)
+	if session == nil {
+		return err
+	}
+
+	err = s.sessions.Revoke(c.Request().Context(), orbitFrom(c), session.UserID, sessionId)
+	if errors.Is(err, services.ErrSessionNotFound) {
+		return oauthError(c, http.StatusNotFound, "not_found", err.Error())
+	}
+	if err != nil {
+		return s.serverError(c, err)
+	}
+	if sessionId == session.ID {
+		s.clearSessionCookie(c)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,169 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/pkg/api"
+	"github.com/labstack/echo/v4"
+)
+
+func (s *Server) PostToken(c echo.Context) error {
+	// The generated form body is not bound: echo takes its form tags,
+	// omitempty included, for the parameter names.
+	form, err := c.FormParams()
+	if err != nil {
+		return oauthError(c, http.StatusBadRequest, "invalid_request", "malformed token request")
+	}
+	// Responses carry tokens and must not be cached (RFC 6749 section 5.1).
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+	c.Response().Header().Set("Pragma", "no-cache")
+
+	client, err := s.tokenClient(c, form.Get("client_id"), form.Get("client_secret"))
+	if client == nil {
+		return err
+	}
+	req := &services.TokenRequest{
+		Client:       client,
+		GrantType:    form.Get("grant_type"),
+		Code:         form.Get("code"),
+		RedirectURI:  form.Get("redirect_uri"),
+		CodeVerifier: form.Get("code_verifier"),
+		RefreshToken: form.Get("refresh_token"),
+		Resources:    form["resource"],
+	}
+	if form.Has("scope") {
+		req.Scopes = strings.Fields(form.Get("scope"))
+	}
+
+	out, err := s.tokens.Exchange(c.Request().Context(), orbitFrom(c), req)
+	switch {
+	case errors.Is(err, services.ErrInvalidGrant):
+		return oauthError(c, http.StatusBadRequest, "invalid_grant", err.Error())
+	case errors.Is(err, services.ErrInvalidTarget):
+		return oauthError(c, http.StatusBadRequest, "invalid_target", err.Error())
+	case errors.Is(err, services.ErrInvalidScope):
+		return oauthError(c, http.StatusBadRequest, "invalid_scope", err.Error())
+	case errors.Is(err, services.ErrUnauthorizedClient):
+		return oauthError(c, http.StatusBadRequest, "unauthorized_client", err.Error())
+	case errors.Is(err, services.ErrUnsupportedGrantType):
+		return oauthError(c, http.StatusBadRequest, "unsupported_grant_type", err.Error())
+	case err != nil:
+		return s.serverError(c, err)
+	}
+	return c.JSON(http.StatusOK, api.TokenResponse{
+		AccessToken:  out.AccessToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    out.ExpiresIn,
+		RefreshToken: optional(out.RefreshToken),
+		IdToken:      optional(out.IDToken),
+		Scope:        optional(strings.Join(out.Scopes, " ")),
+	})
+}
+
+// tokenClient authenticates the client of a token request by the method it
+// registered: HTTP Basic, the client_secret form parameters, or for public
+// clients the client_id alone. When it returns a nil client the error
+// response has already been written.
+func (s *Server) tokenClient(c echo.Context, clientID, secret string) (*models.Client, error) {
+	if _, _, ok := c.Request().BasicAuth(); ok {
+		client, err := s.basicClient(c)
+		if client == nil {
+			return nil, err
+		}
+		if client.TokenEndpointAuthMethod != "" && client.TokenEndpointAuthMethod != services.AuthMethodClientSecretBasic {
+			return nil, oauthError(c, http.StatusUnauthorized, "invalid_client", "client must authenticate with "+client.TokenEndpointAuthMethod)
+		}
+		return client, nil
+	}
+
+	client, err := s.activeClient(c, clientID)
+	if err != nil {
+		return nil, s.serverError(c, err)
+	}
+	if client == nil || client.DeletedAt != nil {
+		return nil, oauthError(c, http.StatusUnauthorized, "invalid_client", "")
+	}
+	if client.IsPublic {
+		if secret != "" {
+			return nil, oauthError(c, http.StatusUnauthorized, "invalid_client", "public clients have no secret")
+		}
+		return client, nil
+	}
+	if client.TokenEndpointAuthMethod != services.AuthMethodClientSecretPost || !s.clients.VerifySecret(client, secret) {
+		return nil, oauthError(c, http.StatusUnauthorized, "invalid_client", "")
+	}
+	return client, nil
+}
+
+// PostIntrospect answers resource servers, which authenticate as confidential
+// clients of the orbit. Tokens that are unknown, expired, revoked or issued
+// for another resource are all reported as merely inactive.
+func (s *Server) PostIntrospect(c echo.Context) error {
+	client, err := s.basicClient(c)
+	if client == nil {
+		return err
+	}
+	form, err := c.FormParams()
+	if err != nil {
+		return oauthError(c, http.StatusBadRequest, "invalid_request", "malformed introspection request")
+	}
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+
+	ctx := c.Request().Context()
+	orbit := orbitFrom(c)
+	info, err := s.tokens.Introspect(ctx, orbit, form.Get("token"), form.Get("resource"))
+	if err != nil {
+		return s.serverError(c, err)
+	}
+	active := info != nil
+	out := api.Introspection{Active: &active}
+	if !active {
+		return c.JSON(http.StatusOK, out)
+	}
+
+	owner, err := s.clients.GetByID(ctx, info.ClientID)
+	if err != nil {
+		return s.serverError(c, err)
+	}
+	if owner != nil {
+		out.ClientId = &owner.ClientID
+	}
+	if info.UserID != nil {
+		sub := strconv.FormatInt(*info.UserID, 10)
+		out.Sub = &sub
+		user, err := s.users.GetByID(ctx, *info.UserID)
+		if err != nil {
+			return s.serverError(c, err)
+		}
+		if user != nil {
+			out.Username = &user.Username
+		}
+	} else if owner != nil {
+		out.Sub = &owner.ClientID
+	}
+	exp, iat := int(info.ExpiresAt.Unix()), int(info.IssuedAt.Unix())
+	iss := strings.TrimRight(orbit.Issuer, "/")
+	out.Exp, out.Iat, out.Iss = &exp, &iat, &iss
+	out.TokenType = &info.TokenType
+	out.Scope = optional(strings.Join(info.Scopes, " "))
+	out.Jti = optional(info.JTI)
+	if len(info.Audience) > 0 {
+		out.Aud = &info.Audience
+	}
+	return c.JSON(http.StatusOK, out)
+}
+
+// GetWellKnownJwksJson publishes the public halves of the orbit's active
+// signing keys, so keys announced ahead of rotation are already trusted.
+func (s *Server) GetWellKnownJwksJson(c echo.Context) error {
+	keys, err := s.keys.PublicKeys(c.Request().Context(), orbitFrom(c).ID)
+	if err != nil {
+		return s.serverError(c, err)
+	}
+	return c.JSON(http.StatusOK, keys)
+}
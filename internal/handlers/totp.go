@@ -0,0 +1,95 @@
+package handlers
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/pkg/api"
+	"github.com/labstack/echo/v4"
+)
+
+func (s *Server) GetAccountTotp(c echo.Context) error {
+	session, _, err := s.accountSession(c)
+	if session == nil {
+		return err
+	}
+
+	list, err := s.totp.List(c.Request().Context(), session.UserID)
+	if err != nil {
+		return s.serverError(c, err)
+	}
+	out := make([]api.TOTPAuthenticator, 0, len(list))
+	for _, t := range list {
+		out = append(out, api.TOTPAuthenticator{
+			Id:        t.ID,
+			Name:      t.Name,
+			Confirmed: t.IsConfirmed,
+			CreatedAt: t.CreatedAt,
+		})
+	}
+	return c.JSON(http.StatusOK, out)
+}
+
+func (s *Server) PostAccountTotp(c echo.Context) error {
+	session, user, err := s.recentAccountSession(c)
+	if session == nil {
+		return err
+	}
+	var body api.PostAccountTotpJSONBody
+	if err := c.Bind(&body); err != nil {
+		return oauthError(c, http.StatusBadRequest, "invalid_request", "malformed request body")
+	}
+
+	enrollment, err := s.totp.Enroll(c.Request().Context(), orbitFrom(c), user, body.Name)
+	if err != nil {
+		return s.totpError(c, err)
+	}
+	c.Response().Header().Set(echo.HeaderCacheControl, "no-store")
+	return c.JSON(http.StatusCreated, api.TOTPEnrollment{
+		Id:         enrollment.TOTP.ID,
+		Name:       enrollment.TOTP.Name,
+		Secret:     enrollment.Secret,
+		OtpauthUri: enrollment.URI,
+	})
+}
+
+func (s *Server) PostAccountTotpTotpIdConfirm(c echo.Context, totpId int64) error {
+	session, _, err := s.accountSession(c)
+	if session == nil {
+		return err
+	}
+	var body api.PostAccountTotpTotpIdConfirmJSONBody
+	if err := c.Bind(&body); err != nil {
+		return oauthError(c, http.StatusBadRequest, "invalid_request", "malformed request body")
+	}
+
+	if err := s.totp.Confirm(c.Request().Context(), orbitFrom(c), session.UserID, totpId, body.Code); err != nil {
+		return s.totpError(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (s *Server) DeleteAccountTotpTotpId(c echo.Context, totpId int64) error {
+	session, _, err := s.recentAccountSession(c)
+	if session == nil {
+		return err
+	}
+
+	if err := s.totp.Delete(c.Request().Context(), session.UserID, totpId); err != nil {
+		return s.totpError(c, err)
+	}
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (s *Server) totpError(c echo.Context, err error) error {
+	switch {
+	case errors.Is(err, services.ErrTOTPNotFound):
+		return oauthError(c, http.StatusNotFound, "not_found", err.Error())
+	case errors.Is(err, services.ErrInvalidTOTPEnrollment), errors.Is(err, services.ErrInvalidTOTPCode), errors.Is(err, services.ErrTOTPAlreadyConfirmed):
+		return oauthError(c, http.StatusBadRequest, "invalid_request", err.Error())
+	case errors.Is(err, services.ErrTOTPNameTaken), errors.Is(err, services.ErrTOTPLimitReached):
+		return oauthError(c, http.StatusConflict, "conflict", err.Error())
+	}
+	return s.serverError(c, err)
+}
@@ -14,6 +14,7 @@ type AccessToken struct {
 	IsJWT          bool
 	TokenString    string
 	Scope          json.RawMessage
+	Audience       json.RawMessage
 	IssuedAt       time.Time
 	TokenType      string
 	Revoked        bool
@@ -13,6 +13,7 @@ type AuthCode struct {
 	UserID              *int64
 	RedirectURI         string
 	Scope               json.RawMessage
+	Resources           json.RawMessage
 	CodeChallenge       string
 	CodeChallengeMethod string
 	Used                bool
@@ -10,6 +10,9 @@ type Client struct {
 	OrbitID                 int64
 	ClientID                string
 	ClientSecretHash        string
+	ClientSecretExpiresAt   *time.Time
+	PreviousSecretHash      string
+	PreviousSecretExpiresAt *time.Time
 	Name                    string
 	Description             string
 	RedirectURIs            json.RawMessage
@@ -24,6 +27,9 @@ type Client struct {
 	IsActive                bool
 	AllowedCORSOrigins      json.RawMessage
 	AllowedScopes           json.RawMessage
+	JWKS                    json.RawMessage
+	JWKSURI                 string
+	RegistrationTokenHash   string
 	Metadata                json.RawMessage
 	CreatedAt               time.Time
 	UpdatedAt               time.Time
@@ -0,0 +1,11 @@
+package models
+
+import "time"
+
+type Group struct {
+	ID        int64
+	OrbitID   int64
+	Name      string
+	Metadata  map[string]any
+	CreatedAt time.Time
+}
@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+type InitialAccessToken struct {
+	ID          int64
+	OrbitID     int64
+	TokenHash   string
+	Description string
+	MaxUses     int
+	UseCount    int
+	ExpiresAt   *time.Time
+	Revoked     bool
+	CreatedBy   *int64
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
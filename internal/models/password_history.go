@@ -6,5 +6,6 @@ type PasswordHistory struct {
 	ID           int64
 	UserID       int64
 	PasswordHash string
+	PasswordAlgo string
 	CreatedAt    time.Time
 }
@@ -0,0 +1,37 @@
+package models
+
+import "time"
+
+type PolicyEffect string
+
+const (
+	PolicyEffectAllow PolicyEffect = "allow"
+	PolicyEffectDeny  PolicyEffect = "deny"
+)
+
+type Policy struct {
+	ID          int64
+	OrbitID     int64
+	Name        string
+	Description string
+	Effect      PolicyEffect
+	Actions     []string
+	Condition   string
+	Obligations []map[string]any
+	IsActive    bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+type PolicyDecision struct {
+	ID        int64
+	OrbitID   int64
+	ClientID  *int64
+	UserID    *int64
+	Action    string
+	Decision  PolicyEffect
+	Policies  []string
+	Request   map[string]any
+	Errors    []string
+	CreatedAt time.Time
+}
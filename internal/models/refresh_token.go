@@ -13,10 +13,12 @@ type RefreshToken struct {
 	OrbitID       int64
 	ClientID      int64
 	UserID        *int64
+	SessionID     *int64
 	Revoked       bool
 	RotatedFromID *int64
 	RotatedToID   *int64
 	Scopes        json.RawMessage
+	Resources     json.RawMessage
 	Metadata      json.RawMessage
 	LastUsedAt    *time.Time
 	UseCount      int
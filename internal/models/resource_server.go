@@ -0,0 +1,29 @@
+package models
+
+import (
+	"encoding/json"
+	"time"
+)
+
+type ResourceServer struct {
+	ID             int64
+	OrbitID        int64
+	Identifier     string
+	Name           string
+	Description    string
+	AllowedScopes  json.RawMessage
+	TokenFormat    TokenFormat
+	AccessTokenTTL int
+	IsActive       bool
+	Metadata       json.RawMessage
+	CreatedAt      time.Time
+	UpdatedAt      time.Time
+	DeletedAt      *time.Time
+}
+
+type TokenFormat string
+
+const (
+	TokenFormatJWT    TokenFormat = "jwt"
+	TokenFormatOpaque TokenFormat = "opaque"
+)
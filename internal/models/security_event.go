@@ -11,3 +11,25 @@ type SecurityEvent struct {
 	Metadata  map[string]any
 	CreatedAt time.Time
 }
+
+const (
+	SecuritySeverityInfo     = "info"
+	SecuritySeverityWarning  = "warning"
+	SecuritySeverityCritical = "critical"
+)
+
+const (
+	SecurityEventSoftwareStatementRejected    = "software_statement_rejected"
+	SecurityEventRecoveryCodesGenerated       = "recovery_codes_generated"
+	SecurityEventRecoveryCodeUsed             = "recovery_code_used"
+	SecurityEventRecoveryCodesLow             = "recovery_codes_low"
+	SecurityEventWebAuthnCredentialRegistered = "webauthn_credential_registered"
+	SecurityEventWebAuthnCloneSuspected       = "webauthn_clone_suspected"
+	SecurityEventAccountLockedTemporarily     = "account_locked_temporarily"
+	SecurityEventAccountLocked                = "account_locked"
+	SecurityEventAccountUnlocked              = "account_unlocked"
+	SecurityEventLoginIPBlocked               = "login_ip_blocked"
+	SecurityEventEmailVerified                = "email_verified"
+	SecurityEventPasswordResetRequested       = "password_reset_requested"
+	SecurityEventPasswordReset                = "password_reset"
+)
@@ -0,0 +1,22 @@
+package models
+
+import "time"
+
+// UserToken is a single-use token mailed to a user. Email is the address it
+// was sent to, so a verification cannot be applied to a changed address.
+type UserToken struct {
+	ID         int64
+	OrbitID    int64
+	UserID     int64
+	Purpose    string
+	TokenHash  string
+	Email      string
+	ExpiresAt  time.Time
+	ConsumedAt *time.Time
+	CreatedAt  time.Time
+}
+
+const (
+	UserTokenPurposeEmailVerification = "email_verification"
+	UserTokenPurposePasswordReset     = "password_reset"
+)
@@ -0,0 +1,26 @@
+package models
+
+import "time"
+
+type WebAuthnCredential struct {
+	ID      int64
+	OrbitID int64
+	UserID  int64
+	// UserHandle is the opaque user.id given to authenticators. It is shared
+	// by all credentials of a user and never derived from the database ID.
+	UserHandle        []byte
+	CredentialID      []byte
+	PublicKey         []byte
+	AttestationFormat string
+	AAGUID            []byte
+	SignCount         int64
+	Transports        []string
+	UserVerified      bool
+	BackupEligible    bool
+	BackupState       bool
+	Discoverable      bool
+	Name              string
+	LastUsedAt        *time.Time
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
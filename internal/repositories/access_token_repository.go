@@ -29,15 +29,15 @@ func NewAccessTokenRepository(exec db.Executor, logger zerolog.Logger) *AccessTo
 const (
 	insertAccessTokenSQL = `
 		INSERT INTO access_tokens
-			(jti, orbit_id, client_id, user_id, is_jwt, token_string, scope,
+			(jti, orbit_id, client_id, user_id, is_jwt, token_string, scope, audience,
 			 issued_at, token_type, revoked, metadata, refresh_token_id, created_at, expires_at)
 		VALUES
-			($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14)
+			($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15)
 		RETURNING id, created_at, expires_at
 	`
 
 	selectAccessTokenByIDSQL = `
-		SELECT id, jti, orbit_id, client_id, user_id, is_jwt, token_string, scope,
+		SELECT id, jti, orbit_id, client_id, user_id, is_jwt, token_string, scope, audience,
 		       issued_at, token_type, revoked, metadata, refresh_token_id, created_at, expires_at
 		FROM access_tokens
 		WHERE id = $1
@@ -45,7 +45,7 @@ const (
 	`
 
 	selectAccessTokenByJTISQL = `
-		SELECT id, jti, orbit_id, client_id, user_id, is_jwt, token_string, scope,
+		SELECT id, jti, orbit_id, client_id, user_id, is_jwt, token_string, scope, audience,
 		       issued_at, token_type, revoked, metadata, refresh_token_id, created_at, expires_at
 		FROM access_tokens
 		WHERE jti = $1
@@ -62,7 +62,7 @@ const (
 			issued_at = $6,
 			expires_at = $7
 		WHERE id = $1
-		RETURNING id, jti, orbit_id, client_id, user_id, is_jwt, token_string, scope,
+		RETURNING id, jti, orbit_id, client_id, user_id, is_jwt, token_string, scope, audience,
 		         issued_at, token_type, revoked, metadata, refresh_token_id, created_at, expires_at
 	`
 
@@ -90,6 +90,7 @@ func (r *AccessTokenRepository) Create(ctx context.Context, token *models.Access
 		token.IsJWT,
 		token.TokenString,
 		token.Scope,
+		token.Audience,
 		token.IssuedAt,
 		token.TokenType,
 		token.Revoked,
@@ -164,6 +165,7 @@ func (r *AccessTokenRepository) Update(ctx context.Context, token *models.Access
 		&updated.IsJWT,
 		&updated.TokenString,
 		&updated.Scope,
+		&updated.Audience,
 		&updated.IssuedAt,
 		&updated.TokenType,
 		&updated.Revoked,
@@ -213,6 +215,7 @@ func scanAccessTokenRow(scanner interface{ Scan(dest ...any) error }) (*models.A
 		&at.IsJWT,
 		&at.TokenString,
 		&at.Scope,
+		&at.Audience,
 		&at.IssuedAt,
 		&at.TokenType,
 		&at.Revoked,
@@ -0,0 +1,87 @@
+package repositories_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+)
+
+func TestAccessTokenRepositoryRoundTrip(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	orbit := fx.Orbit()
+	client := fx.Client(orbit)
+	user := fx.User(orbit)
+	repo := repositories.NewAccessTokenRepository(dbConn.Exec(), nop)
+
+	now := time.Now().UTC()
+	created, err := repo.Create(ctx, &models.AccessToken{
+		JTI:       "at-1",
+		OrbitID:   orbit.ID,
+		ClientID:  client.ID,
+		UserID:    &user.ID,
+		IsJWT:     true,
+		Scope:     json.RawMessage(`["openid"]`),
+		Audience:  json.RawMessage(`["https://api.example.test"]`),
+		IssuedAt:  now,
+		TokenType: "Bearer",
+		ExpiresAt: now.Add(time.Hour),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if created.ID == 0 {
+		t.Fatal("Create did not assign an id")
+	}
+
+	got, err := repo.GetByJTI(ctx, "at-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got == nil || got.ID != created.ID || got.UserID == nil || *got.UserID != user.ID {
+		t.Fatalf("GetByJTI = %+v, want token %d of user %d", got, created.ID, user.ID)
+	}
+	if !jsonEqual(t, got.Audience, `["https://api.example.test"]`) {
+		t.Errorf("Audience = %s", got.Audience)
+	}
+
+	got.TokenString = "opaque"
+	got.Metadata = json.RawMessage(`{"k":"v"}`)
+	updated, err := repo.Update(ctx, got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated == nil || updated.TokenString != "opaque" {
+		t.Fatalf("Update = %+v", updated)
+	}
+
+	revoked, err := repo.RevokeByJTI(ctx, "at-1")
+	if err != nil || !revoked {
+		t.Fatalf("RevokeByJTI = %v, %v, want true", revoked, err)
+	}
+	revoked, err = repo.RevokeByJTI(ctx, "at-1")
+	if err != nil || revoked {
+		t.Fatalf("second RevokeByJTI = %v, %v, want false", revoked, err)
+	}
+	got, err = repo.GetByID(ctx, created.ID)
+	if err != nil || got == nil || !got.Revoked {
+		t.Fatalf("GetByID after revoke = %+v, %v", got, err)
+	}
+}
+
+func TestAccessTokenRepositoryMissing(t *testing.T) {
+	dbConn, _ := setup(t)
+	repo := repositories.NewAccessTokenRepository(dbConn.Exec(), nop)
+
+	got, err := repo.GetByJTI(t.Context(), "missing")
+	if err != nil || got != nil {
+		t.Fatalf("GetByJTI = %+v, %v, want nil, nil", got, err)
+	}
+	updated, err := repo.Update(t.Context(), &models.AccessToken{ID: 404})
+	if err != nil || updated != nil {
+		t.Fatalf("Update = %+v, %v, want nil, nil", updated, err)
+	}
+}
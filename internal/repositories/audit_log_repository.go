@@ -27,6 +27,10 @@ func NewAuditLogRepository(exec db.Executor, logger zerolog.Logger) *AuditLogRep
 }
 
 const (
+	auditLogColumns = `
+		id, orbit_id, actor_id, action, target, metadata, created_at
+	`
+
 	insertAuditLogSQL = `
 		INSERT INTO audit_logs (
 			orbit_id, actor_id, action, target, metadata, created_at
@@ -34,14 +38,6 @@ const (
 		VALUES ($1,$2,$3,$4,$5,$6)
 		RETURNING id
 	`
-
-	listAuditLogsByOrbitSQL = `
-		SELECT id, orbit_id, actor_id, action, target, metadata, created_at
-		FROM audit_logs
-		WHERE orbit_id = $1
-		ORDER BY id DESC
-		LIMIT $2 OFFSET $3
-	`
 )
 
 func (r *AuditLogRepository) Create(ctx context.Context, log *models.AuditLog) (*models.AuditLog, error) {
@@ -64,31 +60,51 @@ func (r *AuditLogRepository) Create(ctx context.Context, log *models.AuditLog) (
 	return log, nil
 }
 
-func (r *AuditLogRepository) ListByOrbit(ctx context.Context, orbitID int64, limit, offset int) ([]*models.AuditLog, error) {
+// AuditLogFilter narrows ListByOrbit. Zero fields do not filter.
+type AuditLogFilter struct {
+	Action  string
+	ActorID *int64
+	Since   *time.Time
+	Until   *time.Time
+}
+
+// ListByOrbit returns the newest entries first.
+func (r *AuditLogRepository) ListByOrbit(ctx context.Context, orbitID int64, filter AuditLogFilter, page Page) ([]*models.AuditLog, string, error) {
 	ctx, span := r.tracer.Start(ctx, "ListByOrbit")
 	defer span.End()
 
-	rows, err := r.exec.Query(ctx, listAuditLogsByOrbitSQL, orbitID, limit, offset)
-	if err != nil {
-		return nil, err
+	q := newListQuery("audit_logs", auditLogColumns, "audit_logs").newest().
+		and("orbit_id = ?", orbitID)
+	if filter.Action != "" {
+		q.and("action = ?", filter.Action)
+	}
+	if filter.ActorID != nil {
+		q.and("actor_id = ?", *filter.ActorID)
+	}
+	if filter.Since != nil {
+		q.and("created_at >= ?", *filter.Since)
+	}
+	if filter.Until != nil {
+		q.and("created_at < ?", *filter.Until)
 	}
-	defer rows.Close()
 
-	var result []*models.AuditLog
-	for rows.Next() {
-		a := &models.AuditLog{}
-		if err := rows.Scan(
-			&a.ID,
-			&a.OrbitID,
-			&a.ActorID,
-			&a.Action,
-			&a.Target,
-			&a.Metadata,
-			&a.CreatedAt,
-		); err != nil {
-			return nil, err
-		}
-		result = append(result, a)
+	logs, next, err := listPage(ctx, r.exec, q, page, scanAuditLogRow, func(a *models.AuditLog) int64 { return a.ID })
+	if err != nil && err != ErrInvalidCursor {
+		r.logger.Error().Err(err).Int64("orbit_id", orbitID).Msg("list audit logs query failed")
 	}
-	return result, rows.Err()
+	return logs, next, err
+}
+
+func scanAuditLogRow(scanner interface{ Scan(dest ...any) error }) (*models.AuditLog, error) {
+	a := &models.AuditLog{}
+	err := scanner.Scan(
+		&a.ID,
+		&a.OrbitID,
+		&a.ActorID,
+		&a.Action,
+		&a.Target,
+		&a.Metadata,
+		&a.CreatedAt,
+	)
+	return a, err
 }
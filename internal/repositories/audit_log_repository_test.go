@@ -0,0 +1,95 @@
+package repositories_test
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+)
+
+func TestAuditLogRepositoryRoundTrip(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	orbit := fx.Orbit()
+	other := fx.Orbit()
+	actor := fx.User(orbit)
+	repo := repositories.NewAuditLogRepository(dbConn.Exec(), nop)
+
+	for _, action := range []string{"client.create", "client.update"} {
+		if _, err := repo.Create(ctx, &models.AuditLog{
+			OrbitID:  orbit.ID,
+			ActorID:  &actor.ID,
+			Action:   action,
+			Target:   "client:1",
+			Metadata: map[string]any{"ip": "192.0.2.1"},
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := repo.Create(ctx, &models.AuditLog{OrbitID: other.ID, Action: "client.create"}); err != nil {
+		t.Fatal(err)
+	}
+
+	logs, _, err := repo.ListByOrbit(ctx, orbit.ID, repositories.AuditLogFilter{}, repositories.Page{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(logs) != 2 {
+		t.Fatalf("ListByOrbit returned %d entries, want 2", len(logs))
+	}
+	// Newest first.
+	if logs[0].Action != "client.update" || logs[1].Action != "client.create" {
+		t.Errorf("order = %s, %s", logs[0].Action, logs[1].Action)
+	}
+	if logs[0].ActorID == nil || *logs[0].ActorID != actor.ID || logs[0].Target != "client:1" {
+		t.Errorf("entry = %+v", logs[0])
+	}
+	if ip, _ := logs[0].Metadata["ip"].(string); ip != "192.0.2.1" {
+		metadata, _ := json.Marshal(logs[0].Metadata)
+		t.Errorf("Metadata = %s", metadata)
+	}
+}
+
+func TestAuditLogRepositoryPagesNewestFirst(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	orbit := fx.Orbit()
+	actor := fx.User(orbit)
+	repo := repositories.NewAuditLogRepository(dbConn.Exec(), nop)
+
+	var want []int64
+	for i := range 5 {
+		log := &models.AuditLog{OrbitID: orbit.ID, Action: "user.update"}
+		if i%2 == 0 {
+			log.ActorID = &actor.ID
+		}
+		created, err := repo.Create(ctx, log)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if i%2 == 0 {
+			want = append([]int64{created.ID}, want...)
+		}
+	}
+
+	var got []int64
+	page := repositories.Page{Limit: 2}
+	for {
+		logs, next, err := repo.ListByOrbit(ctx, orbit.ID, repositories.AuditLogFilter{ActorID: &actor.ID, Action: "user.update"}, page)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, l := range logs {
+			got = append(got, l.ID)
+		}
+		if next == "" {
+			break
+		}
+		page.Cursor = next
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Fatalf("pages = %v, want %v", got, want)
+	}
+}
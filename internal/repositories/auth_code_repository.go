@@ -29,32 +29,32 @@ func NewAuthCodeRepository(exec db.Executor, logger zerolog.Logger) *AuthCodeRep
 const (
 	insertAuthCodeSQL = `
 		INSERT INTO auth_codes
-			(code, orbit_id, client_id, user_id, redirect_uri, scope, code_challenge, code_challenge_method, used, metadata, created_at, expires_at)
+			(code, orbit_id, client_id, user_id, redirect_uri, scope, resources, code_challenge, code_challenge_method, used, metadata, created_at, expires_at)
 		VALUES
-			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+			($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
 		RETURNING id, created_at, expires_at
 	`
 
 	selectAuthCodeByIDSQL = `
-		SELECT id, code, orbit_id, client_id, user_id, redirect_uri, scope,
+		SELECT id, code, orbit_id, client_id, user_id, redirect_uri, scope, resources,
 		       code_challenge, code_challenge_method, used, metadata, created_at, expires_at
 		FROM auth_codes
-		WHERE id = $1
+		WHERE id = $1 AND deleted_at IS NULL
 		LIMIT 1
 	`
 
 	selectAuthCodeByCodeSQL = `
-		SELECT id, code, orbit_id, client_id, user_id, redirect_uri, scope,
+		SELECT id, code, orbit_id, client_id, user_id, redirect_uri, scope, resources,
 		       code_challenge, code_challenge_method, used, metadata, created_at, expires_at
 		FROM auth_codes
-		WHERE code = $1
+		WHERE code = $1 AND deleted_at IS NULL
 		LIMIT 1
 	`
 
 	updateAuthCodeSetUsedByCodeSQL = `
 		UPDATE auth_codes
 		SET used = TRUE
-		WHERE code = $1 AND used = FALSE
+		WHERE code = $1 AND used = FALSE AND deleted_at IS NULL
 		RETURNING id
 	`
 
@@ -68,7 +68,7 @@ const (
 	updateAuthCodeSQL = `
 		UPDATE auth_codes
 		SET redirect_uri = $2, scope = $3, metadata = $4, expires_at = $5
-		WHERE id = $1
+		WHERE id = $1 AND deleted_at IS NULL
 		RETURNING id, expires_at
 	`
 )
@@ -88,6 +88,7 @@ func (r *AuthCodeRepository) Create(ctx context.Context, code *models.AuthCode)
 		code.UserID,
 		code.RedirectURI,
 		code.Scope,
+		code.Resources,
 		code.CodeChallenge,
 		code.CodeChallengeMethod,
 		code.Used,
@@ -208,6 +209,7 @@ func scanAuthCodeRow(scanner interface{ Scan(dest ...any) error }) (*models.Auth
 		&ac.UserID,
 		&ac.RedirectURI,
 		&ac.Scope,
+		&ac.Resources,
 		&ac.CodeChallenge,
 		&ac.CodeChallengeMethod,
 		&ac.Used,
@@ -0,0 +1,96 @@
+package repositories_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+)
+
+func TestAuthCodeRepositoryRoundTrip(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	orbit := fx.Orbit()
+	client := fx.Client(orbit)
+	user := fx.User(orbit)
+	repo := repositories.NewAuthCodeRepository(dbConn.Exec(), nop)
+
+	created, err := repo.Create(ctx, &models.AuthCode{
+		Code:                "code-1",
+		OrbitID:             orbit.ID,
+		ClientID:            client.ID,
+		UserID:              &user.ID,
+		RedirectURI:         "https://app.example.test/callback",
+		Scope:               json.RawMessage(`["openid"]`),
+		Resources:           json.RawMessage(`["https://api.example.test"]`),
+		CodeChallenge:       "challenge",
+		CodeChallengeMethod: "S256",
+		ExpiresAt:           time.Now().UTC().Add(time.Minute),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := repo.GetByCode(ctx, "code-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got == nil || got.ID != created.ID || got.CodeChallenge != "challenge" || got.Used {
+		t.Fatalf("GetByCode = %+v", got)
+	}
+
+	got.RedirectURI = "https://app.example.test/other"
+	if updated, err := repo.Update(ctx, got); err != nil || updated == nil {
+		t.Fatalf("Update = %+v, %v", updated, err)
+	}
+	got, err = repo.GetByID(ctx, created.ID)
+	if err != nil || got == nil || got.RedirectURI != "https://app.example.test/other" {
+		t.Fatalf("GetByID after update = %+v, %v", got, err)
+	}
+
+	used, err := repo.SetUsedByCode(ctx, "code-1")
+	if err != nil || !used {
+		t.Fatalf("SetUsedByCode = %v, %v, want true", used, err)
+	}
+	used, err = repo.SetUsedByCode(ctx, "code-1")
+	if err != nil || used {
+		t.Fatalf("second SetUsedByCode = %v, %v, want false", used, err)
+	}
+}
+
+func TestAuthCodeRepositorySoftDelete(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	orbit := fx.Orbit()
+	client := fx.Client(orbit)
+	repo := repositories.NewAuthCodeRepository(dbConn.Exec(), nop)
+
+	created, err := repo.Create(ctx, &models.AuthCode{
+		Code:        "code-2",
+		OrbitID:     orbit.ID,
+		ClientID:    client.ID,
+		RedirectURI: "https://app.example.test/callback",
+		ExpiresAt:   time.Now().UTC().Add(time.Minute),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.SoftDelete(ctx, created.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, err := repo.GetByCode(ctx, "code-2"); err != nil || got != nil {
+		t.Fatalf("GetByCode after delete = %+v, %v, want nil", got, err)
+	}
+	if got, err := repo.GetByID(ctx, created.ID); err != nil || got != nil {
+		t.Fatalf("GetByID after delete = %+v, %v, want nil", got, err)
+	}
+	if used, err := repo.SetUsedByCode(ctx, "code-2"); err != nil || used {
+		t.Fatalf("SetUsedByCode after delete = %v, %v, want false", used, err)
+	}
+	if err := repo.SoftDelete(ctx, created.ID); err != nil {
+		t.Fatalf("second SoftDelete = %v", err)
+	}
+}
@@ -28,15 +28,25 @@ func NewClientRepository(exec db.Executor, logger zerolog.Logger) *ClientReposit
 }
 
 const (
+	clientColumns = `
+		id, orbit_id, client_id, client_secret_hash, name, description,
+		redirect_uris, post_logout_redirect_uris, grant_types, response_types,
+		token_endpoint_auth_method, contacts, logo_uri, app_type, is_public,
+		is_active, allowed_cors_origins, allowed_scopes, jwks, jwks_uri, registration_access_token_hash,
+		client_secret_expires_at, previous_secret_hash, previous_secret_expires_at,
+		metadata, created_at, updated_at, deleted_at
+	`
+
 	insertClientSQL = `
 		INSERT INTO clients (
 			orbit_id, client_id, client_secret_hash, name, description,
 			redirect_uris, post_logout_redirect_uris, grant_types, response_types,
 			token_endpoint_auth_method, contacts, logo_uri, app_type, is_public,
-			is_active, allowed_cors_origins, allowed_scopes, metadata, created_at, updated_at
+			is_active, allowed_cors_origins, allowed_scopes, jwks, jwks_uri, registration_access_token_hash,
+			client_secret_expires_at, metadata, created_at, updated_at
 		)
 		VALUES (
-			$1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19,$20
+			$1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16,$17,$18,$19,$20,$21,$22,$23,$24
 		)
 		RETURNING id, created_at, updated_at, deleted_at
 	`
@@ -45,7 +55,9 @@ const (
 		SELECT id, orbit_id, client_id, client_secret_hash, name, description,
 		       redirect_uris, post_logout_redirect_uris, grant_types, response_types,
 		       token_endpoint_auth_method, contacts, logo_uri, app_type, is_public,
-		       is_active, allowed_cors_origins, allowed_scopes, metadata, created_at, updated_at, deleted_at
+		       is_active, allowed_cors_origins, allowed_scopes, jwks, jwks_uri, registration_access_token_hash,
+		       client_secret_expires_at, previous_secret_hash, previous_secret_expires_at,
+		       metadata, created_at, updated_at, deleted_at
 		FROM clients
 		WHERE id = $1 AND deleted_at IS NULL
 	`
@@ -54,7 +66,9 @@ const (
 		SELECT id, orbit_id, client_id, client_secret_hash, name, description,
 		       redirect_uris, post_logout_redirect_uris, grant_types, response_types,
 		       token_endpoint_auth_method, contacts, logo_uri, app_type, is_public,
-		       is_active, allowed_cors_origins, allowed_scopes, metadata, created_at, updated_at, deleted_at
+		       is_active, allowed_cors_origins, allowed_scopes, jwks, jwks_uri, registration_access_token_hash,
+		       client_secret_expires_at, previous_secret_hash, previous_secret_expires_at,
+		       metadata, created_at, updated_at, deleted_at
 		FROM clients
 		WHERE orbit_id = $1 AND client_id = $2 AND deleted_at IS NULL
 		LIMIT 1
@@ -66,7 +80,16 @@ const (
 		    redirect_uris = $6, post_logout_redirect_uris = $7, grant_types = $8, response_types = $9,
 		    token_endpoint_auth_method = $10, contacts = $11, logo_uri = $12, app_type = $13,
 		    is_public = $14, is_active = $15, allowed_cors_origins = $16, allowed_scopes = $17,
-		    metadata = $18, updated_at = $19
+		    jwks = $18, jwks_uri = $19, registration_access_token_hash = $20,
+		    metadata = $21, updated_at = $22
+		WHERE id = $1 AND orbit_id = $2 AND deleted_at IS NULL
+		RETURNING updated_at
+	`
+
+	updateClientSecretSQL = `
+		UPDATE clients
+		SET client_secret_hash = $3, client_secret_expires_at = $4,
+		    previous_secret_hash = $5, previous_secret_expires_at = $6, updated_at = $7
 		WHERE id = $1 AND orbit_id = $2 AND deleted_at IS NULL
 		RETURNING updated_at
 	`
@@ -78,15 +101,11 @@ const (
 		RETURNING id
 	`
 
-	listClientsByOrbitSQL = `
-		SELECT id, orbit_id, client_id, client_secret_hash, name, description,
-		       redirect_uris, post_logout_redirect_uris, grant_types, response_types,
-		       token_endpoint_auth_method, contacts, logo_uri, app_type, is_public,
-		       is_active, allowed_cors_origins, allowed_scopes, metadata, created_at, updated_at, deleted_at
+	lockClientSQL = `
+		SELECT updated_at
 		FROM clients
-		WHERE orbit_id = $1 AND deleted_at IS NULL
-		ORDER BY id
-		LIMIT $2 OFFSET $3
+		WHERE id = $1 AND deleted_at IS NULL
+		FOR UPDATE
 	`
 )
 
@@ -113,6 +132,10 @@ func (r *ClientRepository) Create(ctx context.Context, c *models.Client) (*model
 		c.IsActive,
 		c.AllowedCORSOrigins,
 		c.AllowedScopes,
+		c.JWKS,
+		c.JWKSURI,
+		c.RegistrationTokenHash,
+		c.ClientSecretExpiresAt,
 		c.Metadata,
 		now,
 		now,
@@ -171,6 +194,9 @@ func (r *ClientRepository) Update(ctx context.Context, c *models.Client) (*model
 		c.IsActive,
 		c.AllowedCORSOrigins,
 		c.AllowedScopes,
+		c.JWKS,
+		c.JWKSURI,
+		c.RegistrationTokenHash,
 		c.Metadata,
 		now,
 	)
@@ -192,6 +218,48 @@ func (r *ClientRepository) Update(ctx context.Context, c *models.Client) (*model
 	return c, nil
 }
 
+// UpdateSecret replaces only the secret columns so a rotation never races with
+// a concurrent metadata update.
+func (r *ClientRepository) UpdateSecret(ctx context.Context, c *models.Client) (*models.Client, error) {
+	ctx, span := r.tracer.Start(ctx, "UpdateSecret")
+	defer span.End()
+
+	row := r.exec.QueryRow(ctx, updateClientSecretSQL,
+		c.ID,
+		c.OrbitID,
+		c.ClientSecretHash,
+		c.ClientSecretExpiresAt,
+		c.PreviousSecretHash,
+		c.PreviousSecretExpiresAt,
+		time.Now().UTC(),
+	)
+	if err := row.Scan(&c.UpdatedAt); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		r.logger.Error().Err(err).Int64("client_id", c.ID).Msg("client secret update failed")
+		return nil, err
+	}
+	return c, nil
+}
+
+// LockVersion locks the client until the transaction ends and returns when it
+// was last updated, or nil when there is no such client.
+func (r *ClientRepository) LockVersion(ctx context.Context, id int64) (*time.Time, error) {
+	ctx, span := r.tracer.Start(ctx, "LockVersion")
+	defer span.End()
+
+	var updatedAt time.Time
+	if err := r.exec.QueryRow(ctx, lockClientSQL, id).Scan(&updatedAt); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		r.logger.Error().Err(err).Int64("client_db_id", id).Msg("client lock failed")
+		return nil, err
+	}
+	return &updatedAt, nil
+}
+
 func (r *ClientRepository) Delete(ctx context.Context, id int64) error {
 	ctx, span := r.tracer.Start(ctx, "Delete")
 	defer span.End()
@@ -210,29 +278,31 @@ func (r *ClientRepository) Delete(ctx context.Context, id int64) error {
 	return nil
 }
 
-func (r *ClientRepository) ListByOrbit(ctx context.Context, orbitID int64, limit, offset int) ([]*models.Client, error) {
+// ClientFilter narrows ListByOrbit. Zero fields do not filter.
+type ClientFilter struct {
+	AppType  string
+	IsActive *bool
+}
+
+func (r *ClientRepository) ListByOrbit(ctx context.Context, orbitID int64, filter ClientFilter, page Page) ([]*models.Client, string, error) {
 	ctx, span := r.tracer.Start(ctx, "ListByOrbit")
 	defer span.End()
 
-	rows, err := r.exec.Query(ctx, listClientsByOrbitSQL, orbitID, limit, offset)
-	if err != nil {
-		r.logger.Error().Err(err).Int64("orbit_id", orbitID).Msg("list clients query failed")
-		return nil, err
+	q := newListQuery("clients", clientColumns, "clients").
+		and("orbit_id = ?", orbitID).
+		and("deleted_at IS NULL")
+	if filter.AppType != "" {
+		q.and("app_type = ?", filter.AppType)
 	}
-	defer rows.Close()
-
-	var clients []*models.Client
-	for rows.Next() {
-		c, err := scanClientRow(rows)
-		if err != nil {
-			return nil, err
-		}
-		clients = append(clients, c)
+	if filter.IsActive != nil {
+		q.and("is_active = ?", *filter.IsActive)
 	}
-	if err := rows.Err(); err != nil {
-		return nil, err
+
+	clients, next, err := listPage(ctx, r.exec, q, page, scanClientRow, func(c *models.Client) int64 { return c.ID })
+	if err != nil && err != ErrInvalidCursor {
+		r.logger.Error().Err(err).Int64("orbit_id", orbitID).Msg("list clients query failed")
 	}
-	return clients, nil
+	return clients, next, err
 }
 
 func scanClientRow(scanner interface{ Scan(dest ...any) error }) (*models.Client, error) {
@@ -256,6 +326,12 @@ func scanClientRow(scanner interface{ Scan(dest ...any) error }) (*models.Client
 		&c.IsActive,
 		&c.AllowedCORSOrigins,
 		&c.AllowedScopes,
+		&c.JWKS,
+		&c.JWKSURI,
+		&c.RegistrationTokenHash,
+		&c.ClientSecretExpiresAt,
+		&c.PreviousSecretHash,
+		&c.PreviousSecretExpiresAt,
 		&c.Metadata,
 		&c.CreatedAt,
 		&c.UpdatedAt,
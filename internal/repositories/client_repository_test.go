@@ -0,0 +1,118 @@
+package repositories_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+	"github.com/jackc/pgx/v5"
+)
+
+func TestClientRepositoryRoundTrip(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	orbit := fx.Orbit()
+	repo := repositories.NewClientRepository(dbConn.Exec(), nop)
+	created := fx.Client(orbit, func(c *models.Client) {
+		c.ClientSecretHash = "hash-1"
+		c.Metadata = json.RawMessage(`{"software_id":"payments-app"}`)
+	})
+
+	got, err := repo.GetByClientID(ctx, orbit.ID, created.ClientID)
+	if err != nil || got == nil || got.ID != created.ID || got.ClientSecretHash != "hash-1" {
+		t.Fatalf("GetByClientID = %+v, %v", got, err)
+	}
+	if !jsonEqual(t, got.RedirectURIs, `["https://app.example.test/callback"]`) || !jsonEqual(t, got.Metadata, `{"software_id":"payments-app"}`) {
+		t.Fatalf("GetByClientID lost JSON columns: %s, %s", got.RedirectURIs, got.Metadata)
+	}
+	if got, err := repo.GetByClientID(ctx, fx.Orbit().ID, created.ClientID); err != nil || got != nil {
+		t.Fatalf("GetByClientID in another orbit = %+v, %v, want nil", got, err)
+	}
+
+	got.Name = "Renamed"
+	got.RedirectURIs = json.RawMessage(`["https://app.example.test/other"]`)
+	if updated, err := repo.Update(ctx, got); err != nil || updated == nil {
+		t.Fatalf("Update = %+v, %v", updated, err)
+	}
+
+	// Rotation keeps the previous secret valid for a grace period.
+	expires := time.Now().UTC().Add(time.Hour).Truncate(time.Microsecond)
+	got.PreviousSecretHash, got.PreviousSecretExpiresAt = got.ClientSecretHash, &expires
+	got.ClientSecretHash = "hash-2"
+	if updated, err := repo.UpdateSecret(ctx, got); err != nil || updated == nil {
+		t.Fatalf("UpdateSecret = %+v, %v", updated, err)
+	}
+	got, err = repo.GetByID(ctx, created.ID)
+	if err != nil || got == nil {
+		t.Fatalf("GetByID = %+v, %v", got, err)
+	}
+	if got.Name != "Renamed" || got.ClientSecretHash != "hash-2" || got.PreviousSecretHash != "hash-1" ||
+		got.PreviousSecretExpiresAt == nil || !got.PreviousSecretExpiresAt.Equal(expires) {
+		t.Fatalf("GetByID after updates = %+v", got)
+	}
+
+	err = dbConn.WithTx(ctx, func(tx pgx.Tx) error {
+		version, err := repositories.NewClientRepository(tx, nop).LockVersion(ctx, created.ID)
+		if err != nil {
+			return err
+		}
+		if version == nil || !version.Equal(got.UpdatedAt) {
+			t.Errorf("LockVersion = %v, want %v", version, got.UpdatedAt)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestClientRepositoryDeleteAndList(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	orbit := fx.Orbit()
+	repo := repositories.NewClientRepository(dbConn.Exec(), nop)
+
+	web := fx.Client(orbit)
+	native := fx.Client(orbit, func(c *models.Client) { c.AppType = "native"; c.IsPublic = true })
+	inactive := fx.Client(orbit, func(c *models.Client) { c.IsActive = false })
+	fx.Client(fx.Orbit())
+
+	list, next, err := repo.ListByOrbit(ctx, orbit.ID, repositories.ClientFilter{}, repositories.Page{})
+	if err != nil || next != "" || len(list) != 3 {
+		t.Fatalf("ListByOrbit = %d clients, %q, %v, want 3", len(list), next, err)
+	}
+	list, _, err = repo.ListByOrbit(ctx, orbit.ID, repositories.ClientFilter{AppType: "native"}, repositories.Page{})
+	if err != nil || len(list) != 1 || list[0].ID != native.ID || !list[0].IsPublic {
+		t.Fatalf("native clients = %+v, %v", list, err)
+	}
+	list, _, err = repo.ListByOrbit(ctx, orbit.ID, repositories.ClientFilter{IsActive: ptr(false)}, repositories.Page{})
+	if err != nil || len(list) != 1 || list[0].ID != inactive.ID {
+		t.Fatalf("inactive clients = %+v, %v", list, err)
+	}
+
+	if err := repo.Delete(ctx, web.ID); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := repo.GetByID(ctx, web.ID); err != nil || got != nil {
+		t.Fatalf("GetByID after delete = %+v, %v, want nil", got, err)
+	}
+	if got, err := repo.GetByClientID(ctx, orbit.ID, web.ClientID); err != nil || got != nil {
+		t.Fatalf("GetByClientID after delete = %+v, %v, want nil", got, err)
+	}
+	web.Name = "Revived"
+	if updated, err := repo.Update(ctx, web); err != nil || updated != nil {
+		t.Fatalf("Update after delete = %+v, %v, want nil", updated, err)
+	}
+	if version, err := repo.LockVersion(ctx, web.ID); err != nil || version != nil {
+		t.Fatalf("LockVersion after delete = %v, %v, want nil", version, err)
+	}
+	list, _, err = repo.ListByOrbit(ctx, orbit.ID, repositories.ClientFilter{}, repositories.Page{})
+	if err != nil || len(list) != 2 {
+		t.Fatalf("ListByOrbit after delete = %d clients, %v, want 2", len(list), err)
+	}
+	if err := repo.Delete(ctx, web.ID); err != nil {
+		t.Fatalf("second Delete = %v", err)
+	}
+}
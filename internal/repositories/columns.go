@@ -0,0 +1,139 @@
+package repositories
+
+// Columns lists, per table, the columns the repositories read or write. The
+// startup drift check compares it with the live schema, so update it together
+// with the SQL of a repository.
+var Columns = map[string][]string{
+	"access_tokens": {
+		"id", "created_at", "expires_at", "jti", "orbit_id", "client_id", "user_id", "is_jwt",
+		"token_string", "scope", "issued_at", "token_type", "revoked", "metadata",
+		"refresh_token_id", "audience",
+	},
+	"audit_logs": {
+		"id", "created_at", "actor_id", "action", "orbit_id", "metadata", "target",
+	},
+	"auth_codes": {
+		"id", "created_at", "expires_at", "code", "orbit_id", "client_id", "user_id",
+		"redirect_uri", "scope", "code_challenge", "code_challenge_method", "used", "metadata",
+		"resources", "deleted_at",
+	},
+	"clients": {
+		"id", "created_at", "updated_at", "deleted_at", "orbit_id", "client_id",
+		"client_secret_hash", "name", "description", "redirect_uris",
+		"post_logout_redirect_uris", "grant_types", "response_types",
+		"token_endpoint_auth_method", "contacts", "logo_uri", "app_type", "is_public",
+		"is_active", "allowed_cors_origins", "allowed_scopes", "metadata", "jwks", "jwks_uri",
+		"registration_access_token_hash", "client_secret_expires_at", "previous_secret_hash",
+		"previous_secret_expires_at",
+	},
+	"consents": {
+		"id", "created_at", "updated_at", "orbit_id", "user_id", "client_id", "scopes",
+		"granted_at", "expires_at", "revoked",
+	},
+	"device_codes": {
+		"id", "created_at", "updated_at", "orbit_id", "client_id", "device_code_hash",
+		"user_code", "scopes", "expires_at", "poll_interval_sec", "status", "user_id",
+		"metadata",
+	},
+	"group_members": {
+		"group_id", "user_id", "created_at",
+	},
+	"group_roles": {
+		"group_id", "role_id", "created_at",
+	},
+	"groups": {
+		"id", "created_at", "orbit_id", "name", "metadata",
+	},
+	"initial_access_tokens": {
+		"id", "created_at", "updated_at", "orbit_id", "token_hash", "description", "max_uses",
+		"use_count", "expires_at", "revoked", "created_by",
+	},
+	"jwks": {
+		"id", "created_at", "updated_at", "orbit_id", "kid", "use", "alg", "kty",
+		"public_key_jwk", "private_key_cipher", "is_active", "not_before", "expires_at",
+		"metadata",
+	},
+	"orbits": {
+		"id", "created_at", "updated_at", "deleted_at", "name", "display_name", "description",
+		"issuer", "domain", "config", "default_scopes",
+	},
+	"password_history": {
+		"id", "created_at", "user_id", "password_hash", "password_algo",
+	},
+	"permissions": {
+		"id", "created_at", "orbit_id", "name", "metadata",
+	},
+	"policies": {
+		"id", "created_at", "updated_at", "orbit_id", "name", "description", "effect",
+		"actions", "condition", "obligations", "is_active",
+	},
+	"policy_decisions": {
+		"id", "created_at", "orbit_id", "client_id", "user_id", "action", "decision",
+		"policies", "request", "errors",
+	},
+	"recovery_codes": {
+		"id", "created_at", "user_id", "code_hash", "used_at",
+	},
+	"refresh_tokens": {
+		"id", "created_at", "expires_at", "token_string", "jti", "orbit_id", "client_id",
+		"user_id", "revoked", "rotated_from_id", "rotated_to_id", "scopes", "metadata",
+		"last_used_at", "use_count", "resources", "session_id",
+	},
+	"resource_servers": {
+		"id", "created_at", "updated_at", "deleted_at", "orbit_id", "identifier", "name",
+		"description", "allowed_scopes", "token_format", "access_token_ttl", "is_active",
+		"metadata",
+	},
+	"revoked_tokens": {
+		"id", "created_at", "jti", "expires_at", "orbit_id", "reason",
+	},
+	"role_inclusions": {
+		"role_id", "included_role_id", "created_at",
+	},
+	"role_permissions": {
+		"role_id", "permission_id", "created_at",
+	},
+	"roles": {
+		"id", "created_at", "orbit_id", "name", "metadata",
+	},
+	"scopes": {
+		"id", "created_at", "updated_at", "orbit_id", "name", "description", "is_default",
+		"is_active", "metadata", "deleted_at",
+	},
+	"security_events": {
+		"id", "created_at", "orbit_id", "user_id", "event_type", "severity", "metadata",
+	},
+	"sessions": {
+		"id", "created_at", "updated_at", "orbit_id", "user_id", "client_id", "started_at",
+		"last_active_at", "expires_at", "revoked", "device_info", "ip", "metadata",
+	},
+	"token_introspections": {
+		"id", "created_at", "orbit_id", "token_jti", "active", "response", "expires_at",
+	},
+	"token_revocations": {
+		"id", "orbit_id", "token_jti", "token_type", "reason", "revoked_at", "revoked_by",
+		"metadata",
+	},
+	"totps": {
+		"id", "created_at", "updated_at", "user_id", "orbit_id", "secret_cipher", "algorithm",
+		"digits", "period", "issuer", "label", "last_used_step", "is_confirmed", "name",
+	},
+	"user_roles": {
+		"user_id", "role_id", "created_at",
+	},
+	"user_tokens": {
+		"id", "created_at", "orbit_id", "user_id", "purpose", "token_hash", "email",
+		"expires_at", "consumed_at",
+	},
+	"users": {
+		"id", "created_at", "updated_at", "deleted_at", "orbit_id", "username", "email",
+		"email_verified", "password_hash", "password_algo", "last_password_change",
+		"display_name", "profile", "is_active", "is_locked", "mfa_enabled", "metadata",
+	},
+	"webauthn_credentials": {
+		"id", "created_at", "updated_at", "orbit_id", "user_id", "user_handle",
+		"credential_id", "public_key", "attestation_format", "aaguid", "sign_count",
+		"transports", "user_verified", "backup_eligible", "backup_state", "discoverable",
+		"name", "last_used_at",
+	},
+}
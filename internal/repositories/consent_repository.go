@@ -44,6 +44,37 @@ const (
 		WHERE orbit_id = $1 AND user_id = $2 AND client_id = $3
 	`
 
+	upsertConsentSQL = `
+		INSERT INTO consents (
+			orbit_id, user_id, client_id, scopes, granted_at,
+			expires_at, revoked, created_at, updated_at
+		)
+		VALUES ($1,$2,$3,$4,$5,$6,FALSE,$7,$7)
+		ON CONFLICT (user_id, client_id) DO UPDATE
+		SET scopes = EXCLUDED.scopes,
+		    granted_at = EXCLUDED.granted_at,
+		    expires_at = EXCLUDED.expires_at,
+		    revoked = FALSE,
+		    updated_at = EXCLUDED.updated_at
+		RETURNING id, created_at, updated_at
+	`
+
+	selectConsentByIDSQL = `
+		SELECT id, orbit_id, user_id, client_id, scopes, granted_at,
+		       expires_at, revoked, created_at, updated_at
+		FROM consents
+		WHERE id = $1
+	`
+
+	listActiveConsentsByUserSQL = `
+		SELECT id, orbit_id, user_id, client_id, scopes, granted_at,
+		       expires_at, revoked, created_at, updated_at
+		FROM consents
+		WHERE orbit_id = $1 AND user_id = $2 AND revoked = false
+		  AND (expires_at IS NULL OR expires_at > $3)
+		ORDER BY granted_at DESC
+	`
+
 	revokeConsentSQL = `
 		UPDATE consents
 		SET revoked = true, updated_at = $2
@@ -84,6 +115,60 @@ func (r *ConsentRepository) Get(ctx context.Context, orbitID, userID, clientID i
 	return scanConsent(row)
 }
 
+// Upsert records a grant, replacing any earlier consent of the user for the
+// client, including a revoked one.
+func (r *ConsentRepository) Upsert(ctx context.Context, c *models.Consent) (*models.Consent, error) {
+	ctx, span := r.tracer.Start(ctx, "Upsert")
+	defer span.End()
+
+	row := r.exec.QueryRow(ctx, upsertConsentSQL,
+		c.OrbitID,
+		c.UserID,
+		c.ClientID,
+		c.Scopes,
+		c.GrantedAt,
+		c.ExpiresAt,
+		time.Now().UTC(),
+	)
+
+	if err := row.Scan(&c.ID, &c.CreatedAt, &c.UpdatedAt); err != nil {
+		r.logger.Error().Err(err).Msg("consent upsert failed")
+		return nil, err
+	}
+	c.Revoked = false
+	return c, nil
+}
+
+func (r *ConsentRepository) GetByID(ctx context.Context, id int64) (*models.Consent, error) {
+	ctx, span := r.tracer.Start(ctx, "GetByID")
+	defer span.End()
+
+	row := r.exec.QueryRow(ctx, selectConsentByIDSQL, id)
+	return scanConsent(row)
+}
+
+func (r *ConsentRepository) ListActiveByUser(ctx context.Context, orbitID, userID int64, now time.Time) ([]*models.Consent, error) {
+	ctx, span := r.tracer.Start(ctx, "ListActiveByUser")
+	defer span.End()
+
+	rows, err := r.exec.Query(ctx, listActiveConsentsByUserSQL, orbitID, userID, now)
+	if err != nil {
+		r.logger.Error().Err(err).Int64("user_id", userID).Msg("list consents failed")
+		return nil, err
+	}
+	defer rows.Close()
+
+	var out []*models.Consent
+	for rows.Next() {
+		c, err := scanConsent(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, c)
+	}
+	return out, rows.Err()
+}
+
 func (r *ConsentRepository) Revoke(ctx context.Context, consentID int64) error {
 	ctx, span := r.tracer.Start(ctx, "Revoke")
 	defer span.End()
@@ -0,0 +1,101 @@
+package repositories_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+)
+
+func TestConsentRepositoryRoundTrip(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	orbit := fx.Orbit()
+	client := fx.Client(orbit)
+	user := fx.User(orbit)
+	repo := repositories.NewConsentRepository(dbConn.Exec(), nop)
+
+	now := time.Now().UTC()
+	created, err := repo.Create(ctx, &models.Consent{
+		OrbitID:   orbit.ID,
+		UserID:    user.ID,
+		ClientID:  client.ID,
+		Scopes:    json.RawMessage(`["openid"]`),
+		GrantedAt: now,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := repo.Get(ctx, orbit.ID, user.ID, client.ID)
+	if err != nil || got == nil || got.ID != created.ID {
+		t.Fatalf("Get = %+v, %v", got, err)
+	}
+
+	active, err := repo.ListActiveByUser(ctx, orbit.ID, user.ID, now)
+	if err != nil || len(active) != 1 {
+		t.Fatalf("ListActiveByUser = %d entries, %v, want 1", len(active), err)
+	}
+
+	if err := repo.Revoke(ctx, created.ID); err != nil {
+		t.Fatal(err)
+	}
+	got, err = repo.GetByID(ctx, created.ID)
+	if err != nil || got == nil || !got.Revoked {
+		t.Fatalf("GetByID after revoke = %+v, %v", got, err)
+	}
+	active, err = repo.ListActiveByUser(ctx, orbit.ID, user.ID, now)
+	if err != nil || len(active) != 0 {
+		t.Fatalf("ListActiveByUser after revoke = %d entries, %v, want 0", len(active), err)
+	}
+
+	// A new grant replaces the revoked consent in place.
+	upserted, err := repo.Upsert(ctx, &models.Consent{
+		OrbitID:   orbit.ID,
+		UserID:    user.ID,
+		ClientID:  client.ID,
+		Scopes:    json.RawMessage(`["openid","email"]`),
+		GrantedAt: now,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if upserted.ID != created.ID {
+		t.Errorf("Upsert created consent %d, want %d to be replaced", upserted.ID, created.ID)
+	}
+	got, err = repo.GetByID(ctx, created.ID)
+	if err != nil || got == nil || got.Revoked || !jsonEqual(t, got.Scopes, `["openid","email"]`) {
+		t.Fatalf("GetByID after upsert = %+v, %v", got, err)
+	}
+}
+
+func TestConsentRepositoryExpired(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	orbit := fx.Orbit()
+	client := fx.Client(orbit)
+	user := fx.User(orbit)
+	repo := repositories.NewConsentRepository(dbConn.Exec(), nop)
+
+	now := time.Now().UTC()
+	if _, err := repo.Create(ctx, &models.Consent{
+		OrbitID:   orbit.ID,
+		UserID:    user.ID,
+		ClientID:  client.ID,
+		Scopes:    json.RawMessage(`["openid"]`),
+		GrantedAt: now.Add(-2 * time.Hour),
+		ExpiresAt: ptr(now.Add(-time.Hour)),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	active, err := repo.ListActiveByUser(ctx, orbit.ID, user.ID, now)
+	if err != nil || len(active) != 0 {
+		t.Fatalf("ListActiveByUser = %d entries, %v, want expired consent to be left out", len(active), err)
+	}
+	if got, err := repo.Get(ctx, orbit.ID, user.ID, fx.Client(orbit).ID); err != nil || got != nil {
+		t.Fatalf("Get for another client = %+v, %v, want nil", got, err)
+	}
+}
@@ -20,6 +20,17 @@ type DB struct {
 	logger zerolog.Logger
 }
 
+// NewPool connects to Postgres with search_path set to schema, so the
+// repositories can use unqualified table names.
+func NewPool(ctx context.Context, connString, schema string) (*pgxpool.Pool, error) {
+	cfg, err := pgxpool.ParseConfig(connString)
+	if err != nil {
+		return nil, err
+	}
+	cfg.ConnConfig.RuntimeParams["search_path"] = pgx.Identifier{schema}.Sanitize()
+	return pgxpool.NewWithConfig(ctx, cfg)
+}
+
 func New(pool *pgxpool.Pool, logger zerolog.Logger) *DB {
 	return &DB{pool: pool, logger: logger}
 }
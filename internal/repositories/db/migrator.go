@@ -0,0 +1,108 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/postgres-migration/migrations"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/tern/v2/migrate"
+	"github.com/rs/zerolog"
+)
+
+// VersionTable lives in the migrated schema, so side-by-side schemas keep
+// their own versions. The tern CLI of the migration image uses the same
+// table, so either tool can pick up where the other stopped.
+const VersionTable = "schema_version"
+
+type MigrationStatus struct {
+	Current int32
+	Latest  int32
+	Pending []string
+}
+
+// Migrator applies the embedded schema migrations over a single connection.
+// tern holds an advisory lock while migrating, so concurrent runs wait for
+// each other.
+type Migrator struct {
+	migrator   *migrate.Migrator
+	migrations []migrations.Migration
+	logger     zerolog.Logger
+}
+
+// NewMigrator prepares the migrations of schema, creating the schema when it
+// does not exist yet.
+func NewMigrator(ctx context.Context, conn *pgx.Conn, schema string, logger zerolog.Logger) (*Migrator, error) {
+	list, err := migrations.All()
+	if err != nil {
+		return nil, err
+	}
+	quoted := pgx.Identifier{schema}.Sanitize()
+	if _, err := conn.Exec(ctx, "CREATE SCHEMA IF NOT EXISTS "+quoted); err != nil {
+		return nil, err
+	}
+	m, err := migrate.NewMigrator(ctx, conn, pgx.Identifier{schema, VersionTable}.Sanitize())
+	if err != nil {
+		return nil, err
+	}
+	for _, mig := range list {
+		up, err := migrations.Render(mig.Name, mig.Up, quoted)
+		if err != nil {
+			return nil, err
+		}
+		down, err := migrations.Render(mig.Name, mig.Down, quoted)
+		if err != nil {
+			return nil, err
+		}
+		m.AppendMigration(mig.Name, up, down)
+	}
+	m.OnStart = func(sequence int32, name, direction, _ string) {
+		logger.Info().Int32("version", sequence).Str("name", name).Str("direction", direction).Msg("migrating")
+	}
+	return &Migrator{migrator: m, migrations: list, logger: logger}, nil
+}
+
+// Up migrates to version, or to the latest one when version is 0.
+func (m *Migrator) Up(ctx context.Context, version int32) error {
+	latest := int32(len(m.migrations))
+	if version == 0 {
+		version = latest
+	}
+	if version < 0 || version > latest {
+		return fmt.Errorf("unknown migration version %d, latest is %d", version, latest)
+	}
+	current, err := m.migrator.GetCurrentVersion(ctx)
+	if err != nil {
+		return err
+	}
+	if version < current {
+		return fmt.Errorf("database is at version %d, use down to go back to %d", current, version)
+	}
+	return m.migrator.MigrateTo(ctx, version)
+}
+
+// Down rolls back the last steps migrations.
+func (m *Migrator) Down(ctx context.Context, steps int32) error {
+	if steps <= 0 {
+		return fmt.Errorf("steps must be positive, got %d", steps)
+	}
+	current, err := m.migrator.GetCurrentVersion(ctx)
+	if err != nil {
+		return err
+	}
+	return m.migrator.MigrateTo(ctx, max(current-steps, 0))
+}
+
+func (m *Migrator) Status(ctx context.Context) (MigrationStatus, error) {
+	current, err := m.migrator.GetCurrentVersion(ctx)
+	if err != nil {
+		return MigrationStatus{}, err
+	}
+	status := MigrationStatus{Current: current, Latest: int32(len(m.migrations))}
+	for _, mig := range m.migrations {
+		if mig.Version > current {
+			status.Pending = append(status.Pending, mig.Name)
+		}
+	}
+	return status, nil
+}
@@ -0,0 +1,97 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+var ErrSchemaDrift = errors.New("database schema does not match the code")
+
+// SchemaDriftError lists what the code expects but the database lacks. Extra
+// tables and columns are not drift, since a newer migration may add them
+// ahead of the code.
+type SchemaDriftError struct {
+	Schema         string
+	MissingTables  []string
+	MissingColumns []string
+}
+
+func (e *SchemaDriftError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s (schema %q):", ErrSchemaDrift, e.Schema)
+	for _, t := range e.MissingTables {
+		b.WriteString("\n- table " + t)
+	}
+	for _, c := range e.MissingColumns {
+		b.WriteString("\n- column " + c)
+	}
+	return b.String()
+}
+
+func (e *SchemaDriftError) Unwrap() error {
+	return ErrSchemaDrift
+}
+
+const selectSchemaColumnsSQL = `
+	SELECT table_name, column_name
+	FROM information_schema.columns
+	WHERE table_schema = current_schema()
+`
+
+// CheckSchema compares expected, the columns used per table, with the schema
+// the connections resolve unqualified names in.
+func (db *DB) CheckSchema(ctx context.Context, expected map[string][]string) error {
+	var schema *string
+	if err := db.pool.QueryRow(ctx, "SELECT current_schema()").Scan(&schema); err != nil {
+		return err
+	}
+
+	actual := make(map[string]map[string]bool)
+	if schema != nil {
+		rows, err := db.pool.Query(ctx, selectSchemaColumnsSQL)
+		if err != nil {
+			return err
+		}
+		defer rows.Close()
+		for rows.Next() {
+			var table, column string
+			if err := rows.Scan(&table, &column); err != nil {
+				return err
+			}
+			if actual[table] == nil {
+				actual[table] = make(map[string]bool)
+			}
+			actual[table][column] = true
+		}
+		if err := rows.Err(); err != nil {
+			return err
+		}
+	}
+
+	// current_schema() is NULL when no schema of search_path exists yet.
+	drift := &SchemaDriftError{Schema: strings.Trim(db.pool.Config().ConnConfig.RuntimeParams["search_path"], `"`)}
+	if schema != nil {
+		drift.Schema = *schema
+	}
+	for table, columns := range expected {
+		got, ok := actual[table]
+		if !ok {
+			drift.MissingTables = append(drift.MissingTables, table)
+			continue
+		}
+		for _, column := range columns {
+			if !got[column] {
+				drift.MissingColumns = append(drift.MissingColumns, table+"."+column)
+			}
+		}
+	}
+	if len(drift.MissingTables) == 0 && len(drift.MissingColumns) == 0 {
+		return nil
+	}
+	sort.Strings(drift.MissingTables)
+	sort.Strings(drift.MissingColumns)
+	return drift
+}
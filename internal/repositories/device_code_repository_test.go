@@ -0,0 +1,60 @@
+package repositories_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+)
+
+func TestDeviceCodeRepositoryRoundTrip(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	orbit := fx.Orbit()
+	client := fx.Client(orbit)
+	user := fx.User(orbit)
+	repo := repositories.NewDeviceCodeRepository(dbConn.Exec(), nop)
+
+	created, err := repo.Create(ctx, &models.DeviceCode{
+		OrbitID:         orbit.ID,
+		ClientID:        client.ID,
+		DeviceCodeHash:  "hash-1",
+		UserCode:        "ABCD-EFGH",
+		Scopes:          []string{"openid", "offline_access"},
+		ExpiresAt:       time.Now().UTC().Add(10 * time.Minute),
+		PollIntervalSec: 5,
+		Status:          models.DeviceCodePending,
+		Metadata:        map[string]any{"device": "tv"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := repo.GetByUserCode(ctx, orbit.ID, "ABCD-EFGH")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got == nil || got.ID != created.ID || got.Status != models.DeviceCodePending || got.UserID != nil {
+		t.Fatalf("GetByUserCode = %+v", got)
+	}
+	if len(got.Scopes) != 2 || got.Scopes[1] != "offline_access" || got.Metadata["device"] != "tv" {
+		t.Errorf("Scopes = %v, Metadata = %v", got.Scopes, got.Metadata)
+	}
+
+	if err := repo.UpdateStatus(ctx, created.ID, models.DeviceCodeApproved, &user.ID); err != nil {
+		t.Fatal(err)
+	}
+	got, err = repo.GetByUserCode(ctx, orbit.ID, "ABCD-EFGH")
+	if err != nil || got == nil || got.Status != models.DeviceCodeApproved || got.UserID == nil || *got.UserID != user.ID {
+		t.Fatalf("GetByUserCode after approval = %+v, %v", got, err)
+	}
+
+	// User codes are only unique within an orbit.
+	if got, err := repo.GetByUserCode(ctx, fx.Orbit().ID, "ABCD-EFGH"); err != nil || got != nil {
+		t.Fatalf("GetByUserCode in another orbit = %+v, %v, want nil", got, err)
+	}
+	if err := repo.UpdateStatus(ctx, 404, models.DeviceCodeDenied, nil); err != nil {
+		t.Fatalf("UpdateStatus of a missing code = %v", err)
+	}
+}
@@ -0,0 +1,61 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories/db"
+)
+
+type GroupMemberRepository struct {
+	exec   db.Executor
+	logger zerolog.Logger
+	tracer trace.Tracer
+}
+
+func NewGroupMemberRepository(exec db.Executor, logger zerolog.Logger) *GroupMemberRepository {
+	return &GroupMemberRepository{
+		exec:   exec,
+		logger: logger,
+		tracer: otel.Tracer("repository.group_member"),
+	}
+}
+
+const (
+	insertGroupMemberSQL = `
+		INSERT INTO group_members (group_id, user_id, created_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT DO NOTHING
+	`
+
+	deleteGroupMemberSQL = `
+		DELETE FROM group_members
+		WHERE group_id = $1 AND user_id = $2
+	`
+)
+
+func (r *GroupMemberRepository) Add(ctx context.Context, groupID, userID int64) error {
+	ctx, span := r.tracer.Start(ctx, "Add")
+	defer span.End()
+
+	_, err := r.exec.Exec(ctx, insertGroupMemberSQL, groupID, userID, time.Now().UTC())
+	if err != nil {
+		r.logger.Error().Err(err).Int64("group_id", groupID).Int64("user_id", userID).Msg("add group member failed")
+	}
+	return err
+}
+
+func (r *GroupMemberRepository) Remove(ctx context.Context, groupID, userID int64) error {
+	ctx, span := r.tracer.Start(ctx, "Remove")
+	defer span.End()
+
+	_, err := r.exec.Exec(ctx, deleteGroupMemberSQL, groupID, userID)
+	if err != nil {
+		r.logger.Error().Err(err).Int64("group_id", groupID).Int64("user_id", userID).Msg("remove group member failed")
+	}
+	return err
+}
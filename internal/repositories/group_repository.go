@@ -0,0 +1,108 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories/db"
+	"github.com/jackc/pgx/v5"
+)
+
+type GroupRepository struct {
+	exec   db.Executor
+	logger zerolog.Logger
+	tracer trace.Tracer
+}
+
+func NewGroupRepository(exec db.Executor, logger zerolog.Logger) *GroupRepository {
+	return &GroupRepository{
+		exec:   exec,
+		logger: logger,
+		tracer: otel.Tracer("repository.group"),
+	}
+}
+
+const (
+	groupColumns = `
+		id, orbit_id, name, metadata, created_at
+	`
+
+	insertGroupSQL = `
+		INSERT INTO groups (orbit_id, name, metadata, created_at)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id, created_at
+	`
+
+	selectGroupByIDSQL = `
+		SELECT id, orbit_id, name, metadata, created_at
+		FROM groups
+		WHERE id = $1
+	`
+
+	deleteGroupSQL = `
+		DELETE FROM groups
+		WHERE id = $1
+	`
+)
+
+func (r *GroupRepository) Create(ctx context.Context, group *models.Group) (*models.Group, error) {
+	ctx, span := r.tracer.Start(ctx, "Create")
+	defer span.End()
+
+	now := time.Now().UTC()
+	row := r.exec.QueryRow(ctx, insertGroupSQL,
+		group.OrbitID,
+		group.Name,
+		group.Metadata,
+		now,
+	)
+
+	if err := row.Scan(&group.ID, &group.CreatedAt); err != nil {
+		return nil, err
+	}
+	return group, nil
+}
+
+func (r *GroupRepository) GetByID(ctx context.Context, id int64) (*models.Group, error) {
+	ctx, span := r.tracer.Start(ctx, "GetByID")
+	defer span.End()
+
+	row := r.exec.QueryRow(ctx, selectGroupByIDSQL, id)
+	return scanGroup(row)
+}
+
+func (r *GroupRepository) ListByOrbit(ctx context.Context, orbitID int64, page Page) ([]*models.Group, string, error) {
+	ctx, span := r.tracer.Start(ctx, "ListByOrbit")
+	defer span.End()
+
+	q := newListQuery("groups", groupColumns, "groups").and("orbit_id = ?", orbitID)
+	return listPage(ctx, r.exec, q, page, scanGroup, func(g *models.Group) int64 { return g.ID })
+}
+
+func (r *GroupRepository) Delete(ctx context.Context, id int64) error {
+	ctx, span := r.tracer.Start(ctx, "Delete")
+	defer span.End()
+
+	_, err := r.exec.Exec(ctx, deleteGroupSQL, id)
+	return err
+}
+
+func scanGroup(scanner interface{ Scan(dest ...any) error }) (*models.Group, error) {
+	g := &models.Group{}
+	err := scanner.Scan(
+		&g.ID,
+		&g.OrbitID,
+		&g.Name,
+		&g.Metadata,
+		&g.CreatedAt,
+	)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	return g, err
+}
@@ -0,0 +1,93 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories/db"
+)
+
+type GroupRoleRepository struct {
+	exec   db.Executor
+	logger zerolog.Logger
+	tracer trace.Tracer
+}
+
+func NewGroupRoleRepository(exec db.Executor, logger zerolog.Logger) *GroupRoleRepository {
+	return &GroupRoleRepository{
+		exec:   exec,
+		logger: logger,
+		tracer: otel.Tracer("repository.group_role"),
+	}
+}
+
+const (
+	insertGroupRoleSQL = `
+		INSERT INTO group_roles (group_id, role_id, created_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT DO NOTHING
+	`
+
+	deleteGroupRoleSQL = `
+		DELETE FROM group_roles
+		WHERE group_id = $1 AND role_id = $2
+	`
+
+	listRolesByGroupSQL = `
+		SELECT r.id, r.orbit_id, r.name, r.metadata, r.created_at
+		FROM group_roles gr
+		JOIN roles r ON r.id = gr.role_id
+		WHERE gr.group_id = $1
+		ORDER BY r.id
+	`
+)
+
+func (r *GroupRoleRepository) Assign(ctx context.Context, groupID, roleID int64) error {
+	ctx, span := r.tracer.Start(ctx, "Assign")
+	defer span.End()
+
+	_, err := r.exec.Exec(ctx, insertGroupRoleSQL, groupID, roleID, time.Now().UTC())
+	if err != nil {
+		r.logger.Error().Err(err).Int64("group_id", groupID).Int64("role_id", roleID).Msg("assign role to group failed")
+	}
+	return err
+}
+
+func (r *GroupRoleRepository) Revoke(ctx context.Context, groupID, roleID int64) error {
+	ctx, span := r.tracer.Start(ctx, "Revoke")
+	defer span.End()
+
+	_, err := r.exec.Exec(ctx, deleteGroupRoleSQL, groupID, roleID)
+	if err != nil {
+		r.logger.Error().Err(err).Int64("group_id", groupID).Int64("role_id", roleID).Msg("revoke role from group failed")
+	}
+	return err
+}
+
+// ListRoles returns the roles assigned to a group.
+func (r *GroupRoleRepository) ListRoles(ctx context.Context, groupID int64) ([]*models.Role, error) {
+	ctx, span := r.tracer.Start(ctx, "ListRoles")
+	defer span.End()
+
+	rows, err := r.exec.Query(ctx, listRolesByGroupSQL, groupID)
+	if err != nil {
+		r.logger.Error().Err(err).Int64("group_id", groupID).Msg("list roles by group query failed")
+		return nil, err
+	}
+	defer rows.Close()
+
+	roles := []*models.Role{}
+	for rows.Next() {
+		role, err := scanRole(rows)
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, role)
+	}
+	return roles, rows.Err()
+}
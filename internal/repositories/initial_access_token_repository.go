@@ -0,0 +1,142 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type InitialAccessTokenRepository struct {
+	exec   db.Executor
+	logger zerolog.Logger
+	tracer trace.Tracer
+}
+
+func NewInitialAccessTokenRepository(exec db.Executor, logger zerolog.Logger) *InitialAccessTokenRepository {
+	return &InitialAccessTokenRepository{
+		exec:   exec,
+		logger: logger,
+		tracer: otel.Tracer("repository.initial_access_token"),
+	}
+}
+
+const (
+	initialAccessTokenColumns = `
+		id, orbit_id, token_hash, COALESCE(description, ''), max_uses, use_count,
+		expires_at, revoked, created_by, created_at, updated_at
+	`
+
+	insertInitialAccessTokenSQL = `
+		INSERT INTO initial_access_tokens (
+			orbit_id, token_hash, description, max_uses, use_count, expires_at, revoked, created_by, created_at, updated_at
+		)
+		VALUES ($1,$2,$3,$4,0,$5,false,$6,$7,$8)
+		RETURNING id, created_at, updated_at
+	`
+
+	consumeInitialAccessTokenSQL = `
+		UPDATE initial_access_tokens
+		SET use_count = use_count + 1, updated_at = $3
+		WHERE orbit_id = $1 AND token_hash = $2 AND revoked = false
+		  AND (expires_at IS NULL OR expires_at > $3)
+		  AND (max_uses = 0 OR use_count < max_uses)
+		RETURNING id
+	`
+
+	revokeInitialAccessTokenSQL = `
+		UPDATE initial_access_tokens
+		SET revoked = true, updated_at = $3
+		WHERE id = $1 AND orbit_id = $2
+	`
+)
+
+func (r *InitialAccessTokenRepository) Create(ctx context.Context, t *models.InitialAccessToken) (*models.InitialAccessToken, error) {
+	ctx, span := r.tracer.Start(ctx, "Create")
+	defer span.End()
+
+	now := time.Now().UTC()
+	row := r.exec.QueryRow(ctx, insertInitialAccessTokenSQL,
+		t.OrbitID,
+		t.TokenHash,
+		t.Description,
+		t.MaxUses,
+		t.ExpiresAt,
+		t.CreatedBy,
+		now,
+		now,
+	)
+	if err := row.Scan(&t.ID, &t.CreatedAt, &t.UpdatedAt); err != nil {
+		r.logger.Error().Err(err).Int64("orbit_id", t.OrbitID).Msg("initial access token create failed")
+		return nil, err
+	}
+	return t, nil
+}
+
+// Consume atomically counts one use of a token. It returns false when the token
+// is unknown, revoked, expired or has no uses left.
+func (r *InitialAccessTokenRepository) Consume(ctx context.Context, orbitID int64, tokenHash string) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "Consume")
+	defer span.End()
+
+	var id int64
+	err := r.exec.QueryRow(ctx, consumeInitialAccessTokenSQL, orbitID, tokenHash, time.Now().UTC()).Scan(&id)
+	if err == pgx.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		r.logger.Error().Err(err).Int64("orbit_id", orbitID).Msg("initial access token consume failed")
+		return false, err
+	}
+	return true, nil
+}
+
+func (r *InitialAccessTokenRepository) Revoke(ctx context.Context, orbitID, id int64) error {
+	ctx, span := r.tracer.Start(ctx, "Revoke")
+	defer span.End()
+
+	if _, err := r.exec.Exec(ctx, revokeInitialAccessTokenSQL, id, orbitID, time.Now().UTC()); err != nil {
+		r.logger.Error().Err(err).Int64("initial_access_token_id", id).Msg("initial access token revoke failed")
+		return err
+	}
+	return nil
+}
+
+func (r *InitialAccessTokenRepository) ListByOrbit(ctx context.Context, orbitID int64, page Page) ([]*models.InitialAccessToken, string, error) {
+	ctx, span := r.tracer.Start(ctx, "ListByOrbit")
+	defer span.End()
+
+	q := newListQuery("initial_access_tokens", initialAccessTokenColumns, "initial_access_tokens").
+		and("orbit_id = ?", orbitID)
+	tokens, next, err := listPage(ctx, r.exec, q, page, scanInitialAccessTokenRow, func(t *models.InitialAccessToken) int64 { return t.ID })
+	if err != nil && err != ErrInvalidCursor {
+		r.logger.Error().Err(err).Int64("orbit_id", orbitID).Msg("list initial access tokens query failed")
+	}
+	return tokens, next, err
+}
+
+func scanInitialAccessTokenRow(scanner interface{ Scan(dest ...any) error }) (*models.InitialAccessToken, error) {
+	t := &models.InitialAccessToken{}
+	err := scanner.Scan(
+		&t.ID,
+		&t.OrbitID,
+		&t.TokenHash,
+		&t.Description,
+		&t.MaxUses,
+		&t.UseCount,
+		&t.ExpiresAt,
+		&t.Revoked,
+		&t.CreatedBy,
+		&t.CreatedAt,
+		&t.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
@@ -0,0 +1,64 @@
+package repositories_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+)
+
+func TestInitialAccessTokenRepositoryConsume(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	orbit := fx.Orbit()
+	repo := repositories.NewInitialAccessTokenRepository(dbConn.Exec(), nop)
+
+	limited, err := repo.Create(ctx, &models.InitialAccessToken{OrbitID: orbit.ID, TokenHash: "limited", MaxUses: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = repo.Create(ctx, &models.InitialAccessToken{OrbitID: orbit.ID, TokenHash: "expired", ExpiresAt: ptr(time.Now().UTC().Add(-time.Minute))})
+	if err != nil {
+		t.Fatal(err)
+	}
+	unlimited, err := repo.Create(ctx, &models.InitialAccessToken{OrbitID: orbit.ID, TokenHash: "unlimited"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i, want := range []bool{true, true, false} {
+		ok, err := repo.Consume(ctx, orbit.ID, "limited")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok != want {
+			t.Errorf("use %d of a token with 2 uses: Consume = %v, want %v", i+1, ok, want)
+		}
+	}
+	if ok, _ := repo.Consume(ctx, orbit.ID, "expired"); ok {
+		t.Error("Consume accepted an expired token")
+	}
+	if ok, _ := repo.Consume(ctx, fx.Orbit().ID, "unlimited"); ok {
+		t.Error("Consume accepted a token of another orbit")
+	}
+	for range 3 {
+		if ok, _ := repo.Consume(ctx, orbit.ID, "unlimited"); !ok {
+			t.Fatal("Consume rejected a token without a use limit")
+		}
+	}
+	if err := repo.Revoke(ctx, orbit.ID, unlimited.ID); err != nil {
+		t.Fatal(err)
+	}
+	if ok, _ := repo.Consume(ctx, orbit.ID, "unlimited"); ok {
+		t.Error("Consume accepted a revoked token")
+	}
+
+	list, _, err := repo.ListByOrbit(ctx, orbit.ID, repositories.Page{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 3 || list[0].ID != limited.ID || list[0].UseCount != 2 || !list[2].Revoked {
+		t.Fatalf("ListByOrbit = %+v", list)
+	}
+}
@@ -27,6 +27,10 @@ func NewJWKRepository(exec db.Executor, logger zerolog.Logger) *JWKRepository {
 }
 
 const (
+	jwkColumns = `
+		id, orbit_id, kid, "use", alg, kty, public_key_jwk, private_key_cipher, is_active, not_before, expires_at, metadata, created_at, updated_at
+	`
+
 	insertJWKSQL = `
 		INSERT INTO jwks
 			(orbit_id, kid, "use", alg, kty, public_key_jwk, private_key_cipher, is_active, not_before, expires_at, metadata, created_at, updated_at)
@@ -49,6 +53,13 @@ const (
 		LIMIT 1
 	`
 
+	listActiveJWKsByOrbitSQL = `
+		SELECT id, orbit_id, kid, "use", alg, kty, public_key_jwk, private_key_cipher, is_active, not_before, expires_at, metadata, created_at, updated_at
+		FROM jwks
+		WHERE orbit_id = $1 AND is_active = TRUE AND (expires_at IS NULL OR expires_at > $2)
+		ORDER BY id DESC
+	`
+
 	updateJWKSQL = `
 		UPDATE jwks
 		SET public_key_jwk = $3, private_key_cipher = $4, is_active = $5, not_before = $6, expires_at = $7, metadata = $8, updated_at = $9
@@ -56,12 +67,11 @@ const (
 		RETURNING id, updated_at
 	`
 
-	listJWKsByOrbitSQL = `
-		SELECT id, orbit_id, kid, "use", alg, kty, public_key_jwk, private_key_cipher, is_active, not_before, expires_at, metadata, created_at, updated_at
+	lockJWKSQL = `
+		SELECT updated_at
 		FROM jwks
-		WHERE orbit_id = $1
-		ORDER BY id ASC
-		LIMIT $2 OFFSET $3
+		WHERE id = $1
+		FOR UPDATE
 	`
 
 	deleteJWKSQL = `
@@ -171,29 +181,61 @@ func (r *JWKRepository) Update(ctx context.Context, jwk *models.JWKey) (*models.
 	return jwk, nil
 }
 
-func (r *JWKRepository) ListByOrbit(ctx context.Context, orbitID int64, limit, offset int) ([]*models.JWKey, error) {
+// LockVersion locks the key until the transaction ends and returns when it
+// was last updated, or nil when there is no such key.
+func (r *JWKRepository) LockVersion(ctx context.Context, id int64) (*time.Time, error) {
+	ctx, span := r.tracer.Start(ctx, "LockVersion")
+	defer span.End()
+
+	var updatedAt time.Time
+	if err := r.exec.QueryRow(ctx, lockJWKSQL, id).Scan(&updatedAt); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		r.logger.Error().Err(err).Int64("jwk_id", id).Msg("jwk lock failed")
+		return nil, err
+	}
+	return &updatedAt, nil
+}
+
+func (r *JWKRepository) ListByOrbit(ctx context.Context, orbitID int64, page Page) ([]*models.JWKey, string, error) {
 	ctx, span := r.tracer.Start(ctx, "ListByOrbit")
 	defer span.End()
 
-	rows, err := r.exec.Query(ctx, listJWKsByOrbitSQL, orbitID, limit, offset)
-	if err != nil {
+	q := newListQuery("jwks", jwkColumns, "jwks").and("orbit_id = ?", orbitID)
+	keys, next, err := listPage(ctx, r.exec, q, page, scanJWKRow, func(j *models.JWKey) int64 { return j.ID })
+	if err != nil && err != ErrInvalidCursor {
 		r.logger.Error().Err(err).Int64("orbit_id", orbitID).Msg("jwk list failed")
+	}
+	return keys, next, err
+}
+
+// ListActiveByOrbit returns the keys of the orbit that are active and not
+// expired at now, newest first, including those whose not_before is still
+// ahead so that they are published before they sign anything.
+func (r *JWKRepository) ListActiveByOrbit(ctx context.Context, orbitID int64, now time.Time) ([]*models.JWKey, error) {
+	ctx, span := r.tracer.Start(ctx, "ListActiveByOrbit")
+	defer span.End()
+
+	rows, err := r.exec.Query(ctx, listActiveJWKsByOrbitSQL, orbitID, now)
+	if err != nil {
+		r.logger.Error().Err(err).Int64("orbit_id", orbitID).Msg("list active jwks query failed")
 		return nil, err
 	}
 	defer rows.Close()
 
-	var result []*models.JWKey
+	var keys []*models.JWKey
 	for rows.Next() {
-		jwk, err := scanJWKRow(rows)
+		j, err := scanJWKRow(rows)
 		if err != nil {
 			return nil, err
 		}
-		result = append(result, jwk)
+		keys = append(keys, j)
 	}
 	if err := rows.Err(); err != nil {
 		return nil, err
 	}
-	return result, nil
+	return keys, nil
 }
 
 func (r *JWKRepository) Delete(ctx context.Context, id int64, orbitID int64) error {
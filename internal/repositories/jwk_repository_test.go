@@ -0,0 +1,47 @@
+package repositories_test
+
+import (
+	"testing"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+)
+
+func TestJWKRepositoryRoundTrip(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	orbit := fx.Orbit()
+	key := fx.Key(orbit)
+	repo := repositories.NewJWKRepository(dbConn.Exec(), nop)
+
+	got, err := repo.GetByOrbitAndKid(ctx, orbit.ID, key.Kid)
+	if err != nil || got == nil || got.ID != key.ID || got.PrivateKeyCipher != key.PrivateKeyCipher {
+		t.Fatalf("GetByOrbitAndKid = %+v, %v", got, err)
+	}
+	if !jsonEqual(t, got.PublicKeyJWK, string(key.PublicKeyJWK)) {
+		t.Errorf("PublicKeyJWK = %s", got.PublicKeyJWK)
+	}
+
+	got.IsActive = false
+	if updated, err := repo.Update(ctx, got); err != nil || updated == nil {
+		t.Fatalf("Update = %+v, %v", updated, err)
+	}
+	got, _ = repo.GetByID(ctx, key.ID)
+	if got.IsActive {
+		t.Fatal("IsActive = true after update")
+	}
+
+	// Keys are scoped to their orbit, even when addressed by id.
+	other := fx.Orbit()
+	if err := repo.Delete(ctx, key.ID, other.ID); err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := repo.GetByID(ctx, key.ID); got == nil {
+		t.Fatal("Delete with the wrong orbit removed the key")
+	}
+	if err := repo.Delete(ctx, key.ID, orbit.ID); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := repo.GetByID(ctx, key.ID); err != nil || got != nil {
+		t.Fatalf("GetByID after delete = %+v, %v, want nil", got, err)
+	}
+}
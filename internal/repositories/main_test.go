@@ -0,0 +1,42 @@
+package repositories_test
+
+import (
+	"encoding/json"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories/db"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/testutil"
+	"github.com/rs/zerolog"
+)
+
+var nop = zerolog.Nop()
+
+func TestMain(m *testing.M) {
+	os.Exit(testutil.Main(m))
+}
+
+func setup(t *testing.T) (*db.DB, *testutil.Fixtures) {
+	t.Helper()
+	dbConn := testutil.Postgres(t)
+	return dbConn, testutil.NewFixtures(t, dbConn)
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
+
+// jsonEqual reports whether got holds the same JSON value as want; jsonb
+// does not keep the original formatting.
+func jsonEqual(t *testing.T, got []byte, want string) bool {
+	t.Helper()
+	var g, w any
+	if err := json.Unmarshal(got, &g); err != nil {
+		t.Fatalf("unmarshal %s: %v", got, err)
+	}
+	if err := json.Unmarshal([]byte(want), &w); err != nil {
+		t.Fatalf("unmarshal %s: %v", want, err)
+	}
+	return reflect.DeepEqual(g, w)
+}
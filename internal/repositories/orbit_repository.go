@@ -23,41 +23,55 @@ func NewOrbitRepository(exec db.Executor, logger zerolog.Logger) *OrbitRepositor
 }
 
 const (
+	orbitColumns = `
+		id, name, display_name, description, issuer, domain, config, default_scopes, created_at, updated_at, deleted_at
+	`
+
 	insertOrbitSQL = `
-		insert into orbits (name, description, metadata, created_at, updated_at)
-		values ($1, $2, $3, $4, $5)
+		insert into orbits (name, display_name, description, issuer, domain, config, default_scopes, created_at, updated_at)
+		values ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 		returning id, created_at, updated_at
 	`
 
 	selectOrbitByIDSQL = `
-		select id, name, description, metadata, created_at, updated_at, deleted_at
+		select id, name, display_name, description, issuer, domain, config, default_scopes, created_at, updated_at, deleted_at
 		from orbits
 		where id = $1 and deleted_at is null
 	`
 
+	selectOrbitByDomainSQL = `
+		select id, name, display_name, description, issuer, domain, config, default_scopes, created_at, updated_at, deleted_at
+		from orbits
+		where domain = $1 and deleted_at is null
+		limit 1
+	`
+
 	updateOrbitSQL = `
 		update orbits
 		set name = $2,
-		    description = $3,
-		    metadata = $4,
-		    updated_at = $5
+		    display_name = $3,
+		    description = $4,
+		    issuer = $5,
+		    domain = $6,
+		    config = $7,
+		    default_scopes = $8,
+		    updated_at = $9
 		where id = $1 and deleted_at is null
 		returning updated_at
 	`
 
+	lockOrbitSQL = `
+		select updated_at
+		from orbits
+		where id = $1 and deleted_at is null
+		for update
+	`
+
 	softDeleteOrbitSQL = `
 		update orbits
 		set deleted_at = $2
 		where id = $1 and deleted_at is null
 	`
-
-	listOrbitsSQL = `
-		select id, name, description, metadata, created_at, updated_at, deleted_at
-		from orbits
-		where deleted_at is null
-		order by id
-		limit $1 offset $2
-	`
 )
 
 func (r *OrbitRepository) Create(ctx context.Context, orbit *models.Orbit) (*models.Orbit, error) {
@@ -65,7 +79,17 @@ func (r *OrbitRepository) Create(ctx context.Context, orbit *models.Orbit) (*mod
 	defer span.End()
 
 	now := time.Now().UTC()
-	row := r.exec.QueryRow(ctx, insertOrbitSQL, orbit.Name, orbit.Description, now, now)
+	row := r.exec.QueryRow(ctx, insertOrbitSQL,
+		orbit.Name,
+		orbit.DisplayName,
+		orbit.Description,
+		orbit.Issuer,
+		orbit.Domain,
+		orbit.Config,
+		orbit.DefaultScopes,
+		now,
+		now,
+	)
 
 	if err := row.Scan(&orbit.ID, &orbit.CreatedAt, &orbit.UpdatedAt); err != nil {
 		r.logger.Error().Err(err).Msg("orbit create failed")
@@ -79,23 +103,28 @@ func (r *OrbitRepository) GetByID(ctx context.Context, id int64) (*models.Orbit,
 	ctx, span := r.tracer.Start(ctx, "GetByID")
 	defer span.End()
 
-	row := r.exec.QueryRow(ctx, selectOrbitByIDSQL, id)
+	orbit, err := scanOrbitRow(r.exec.QueryRow(ctx, selectOrbitByIDSQL, id))
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		r.logger.Error().Err(err).Int64("orbit_id", id).Msg("orbit get failed")
+		return nil, err
+	}
 
-	orbit := &models.Orbit{}
-	err := row.Scan(
-		&orbit.ID,
-		&orbit.Name,
-		&orbit.Description,
-		&orbit.CreatedAt,
-		&orbit.UpdatedAt,
-		&orbit.DeletedAt,
-	)
+	return orbit, nil
+}
+
+func (r *OrbitRepository) GetByDomain(ctx context.Context, domain string) (*models.Orbit, error) {
+	ctx, span := r.tracer.Start(ctx, "GetByDomain")
+	defer span.End()
 
+	orbit, err := scanOrbitRow(r.exec.QueryRow(ctx, selectOrbitByDomainSQL, domain))
 	if err == pgx.ErrNoRows {
 		return nil, nil
 	}
 	if err != nil {
-		r.logger.Error().Err(err).Int64("orbit_id", id).Msg("orbit get failed")
+		r.logger.Error().Err(err).Str("domain", domain).Msg("orbit get by domain failed")
 		return nil, err
 	}
 
@@ -107,7 +136,17 @@ func (r *OrbitRepository) Update(ctx context.Context, orbit *models.Orbit) (*mod
 	defer span.End()
 
 	now := time.Now().UTC()
-	row := r.exec.QueryRow(ctx, updateOrbitSQL, orbit.ID, orbit.Name, orbit.Description, now)
+	row := r.exec.QueryRow(ctx, updateOrbitSQL,
+		orbit.ID,
+		orbit.Name,
+		orbit.DisplayName,
+		orbit.Description,
+		orbit.Issuer,
+		orbit.Domain,
+		orbit.Config,
+		orbit.DefaultScopes,
+		now,
+	)
 
 	if err := row.Scan(&orbit.UpdatedAt); err == pgx.ErrNoRows {
 		return nil, nil
@@ -119,6 +158,22 @@ func (r *OrbitRepository) Update(ctx context.Context, orbit *models.Orbit) (*mod
 	return orbit, nil
 }
 
+// LockVersion locks the orbit until the transaction ends and returns when it
+// was last updated, or nil when there is no such orbit.
+func (r *OrbitRepository) LockVersion(ctx context.Context, id int64) (*time.Time, error) {
+	ctx, span := r.tracer.Start(ctx, "LockVersion")
+	defer span.End()
+
+	var updatedAt time.Time
+	if err := r.exec.QueryRow(ctx, lockOrbitSQL, id).Scan(&updatedAt); err == pgx.ErrNoRows {
+		return nil, nil
+	} else if err != nil {
+		r.logger.Error().Err(err).Int64("orbit_id", id).Msg("orbit lock failed")
+		return nil, err
+	}
+	return &updatedAt, nil
+}
+
 func (r *OrbitRepository) Delete(ctx context.Context, id int64) error {
 	ctx, span := r.tracer.Start(ctx, "Delete")
 	defer span.End()
@@ -130,31 +185,35 @@ func (r *OrbitRepository) Delete(ctx context.Context, id int64) error {
 	return err
 }
 
-func (r *OrbitRepository) List(ctx context.Context, limit, offset int) ([]*models.Orbit, error) {
+func (r *OrbitRepository) List(ctx context.Context, page Page) ([]*models.Orbit, string, error) {
 	ctx, span := r.tracer.Start(ctx, "List")
 	defer span.End()
 
-	rows, err := r.exec.Query(ctx, listOrbitsSQL, limit, offset)
-	if err != nil {
+	q := newListQuery("orbits", orbitColumns, "orbits").and("deleted_at IS NULL")
+	orbits, next, err := listPage(ctx, r.exec, q, page, scanOrbitRow, func(o *models.Orbit) int64 { return o.ID })
+	if err != nil && err != ErrInvalidCursor {
 		r.logger.Error().Err(err).Msg("orbit list failed")
-		return nil, err
 	}
-	defer rows.Close()
-
-	var result []*models.Orbit
-	for rows.Next() {
-		o := &models.Orbit{}
-		if err := rows.Scan(
-			&o.ID,
-			&o.Name,
-			&o.Description,
-			&o.CreatedAt,
-			&o.UpdatedAt,
-			&o.DeletedAt,
-		); err != nil {
-			return nil, err
-		}
-		result = append(result, o)
+	return orbits, next, err
+}
+
+func scanOrbitRow(scanner interface{ Scan(dest ...any) error }) (*models.Orbit, error) {
+	o := &models.Orbit{}
+	err := scanner.Scan(
+		&o.ID,
+		&o.Name,
+		&o.DisplayName,
+		&o.Description,
+		&o.Issuer,
+		&o.Domain,
+		&o.Config,
+		&o.DefaultScopes,
+		&o.CreatedAt,
+		&o.UpdatedAt,
+		&o.DeletedAt,
+	)
+	if err != nil {
+		return nil, err
 	}
-	return result, rows.Err()
+	return o, nil
 }
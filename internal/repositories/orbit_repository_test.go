@@ -0,0 +1,49 @@
+package repositories_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+)
+
+func TestOrbitRepositoryRoundTrip(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	orbit := fx.Orbit()
+	repo := repositories.NewOrbitRepository(dbConn.Exec(), nop)
+
+	got, err := repo.GetByDomain(ctx, orbit.Domain)
+	if err != nil || got == nil || got.ID != orbit.ID {
+		t.Fatalf("GetByDomain = %+v, %v", got, err)
+	}
+
+	got.Config = json.RawMessage(`{"access_token_ttl":"5m"}`)
+	updated, err := repo.Update(ctx, got)
+	if err != nil || updated == nil {
+		t.Fatalf("Update = %+v, %v", updated, err)
+	}
+	got, err = repo.GetByID(ctx, orbit.ID)
+	if err != nil || got == nil || !jsonEqual(t, got.Config, `{"access_token_ttl":"5m"}`) {
+		t.Fatalf("GetByID after update = %+v, %v", got, err)
+	}
+
+	if err := repo.Delete(ctx, orbit.ID); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := repo.GetByID(ctx, orbit.ID); err != nil || got != nil {
+		t.Fatalf("GetByID after delete = %+v, %v, want nil", got, err)
+	}
+	if got, err := repo.GetByDomain(ctx, orbit.Domain); err != nil || got != nil {
+		t.Fatalf("GetByDomain after delete = %+v, %v, want nil", got, err)
+	}
+	list, _, err := repo.List(ctx, repositories.Page{Limit: repositories.MaxPageLimit})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, o := range list {
+		if o.ID == orbit.ID {
+			t.Fatal("List returned a deleted orbit")
+		}
+	}
+}
@@ -6,7 +6,6 @@ import (
 
 	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
 	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories/db"
-	"github.com/jackc/pgx/v5"
 	"github.com/rs/zerolog"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
@@ -27,25 +26,22 @@ func NewPasswordHistoryRepository(exec db.Executor, logger zerolog.Logger) *Pass
 }
 
 const (
+	passwordHistoryColumns = `id, user_id, password_hash, password_algo, created_at`
+
 	insertPasswordHistorySQL = `
-		INSERT INTO password_history (user_id, password_hash, created_at)
-		VALUES ($1, $2, $3)
+		INSERT INTO password_history (user_id, password_hash, password_algo, created_at)
+		VALUES ($1, $2, $3, $4)
 		RETURNING id, created_at
 	`
 
-	selectPasswordHistoryByUserSQL = `
-		SELECT id, user_id, password_hash, created_at
-		FROM password_history
-		WHERE user_id = $1
-		ORDER BY created_at DESC
-		LIMIT $2 OFFSET $3
-	`
-
-	checkPasswordReuseSQL = `
-		SELECT 1
-		FROM password_history
-		WHERE user_id = $1 AND password_hash = $2
-		LIMIT 1
+	trimPasswordHistorySQL = `
+		DELETE FROM password_history
+		WHERE user_id = $1 AND id NOT IN (
+			SELECT id FROM password_history
+			WHERE user_id = $1
+			ORDER BY created_at DESC, id DESC
+			LIMIT $2
+		)
 	`
 )
 
@@ -54,7 +50,7 @@ func (r *PasswordHistoryRepository) Create(ctx context.Context, ph *models.Passw
 	defer span.End()
 
 	now := time.Now().UTC()
-	row := r.exec.QueryRow(ctx, insertPasswordHistorySQL, ph.UserID, ph.PasswordHash, now)
+	row := r.exec.QueryRow(ctx, insertPasswordHistorySQL, ph.UserID, ph.PasswordHash, ph.PasswordAlgo, now)
 	if err := row.Scan(&ph.ID, &ph.CreatedAt); err != nil {
 		r.logger.Error().Err(err).Int64("user_id", ph.UserID).Msg("password history insert failed")
 		return nil, err
@@ -62,44 +58,45 @@ func (r *PasswordHistoryRepository) Create(ctx context.Context, ph *models.Passw
 	return ph, nil
 }
 
-func (r *PasswordHistoryRepository) ListByUser(ctx context.Context, userID int64, limit, offset int) ([]*models.PasswordHistory, error) {
+// ListByUser lists the password hashes of a user, newest first.
+func (r *PasswordHistoryRepository) ListByUser(ctx context.Context, userID int64, page Page) ([]*models.PasswordHistory, string, error) {
 	ctx, span := r.tracer.Start(ctx, "ListByUser")
 	defer span.End()
 
-	rows, err := r.exec.Query(ctx, selectPasswordHistoryByUserSQL, userID, limit, offset)
-	if err != nil {
+	q := newListQuery("password_history", passwordHistoryColumns, "password_history").
+		newest().
+		and("user_id = ?", userID)
+	list, next, err := listPage(ctx, r.exec, q, page, scanPasswordHistoryRow, func(ph *models.PasswordHistory) int64 { return ph.ID })
+	if err != nil && err != ErrInvalidCursor {
 		r.logger.Error().Err(err).Int64("user_id", userID).Msg("password history list query failed")
-		return nil, err
 	}
-	defer rows.Close()
+	return list, next, err
+}
 
-	var result []*models.PasswordHistory
-	for rows.Next() {
-		ph := &models.PasswordHistory{}
-		if err := rows.Scan(&ph.ID, &ph.UserID, &ph.PasswordHash, &ph.CreatedAt); err != nil {
-			r.logger.Error().Err(err).Int64("user_id", userID).Msg("scan password history row failed")
-			return nil, err
-		}
-		result = append(result, ph)
-	}
-	if err := rows.Err(); err != nil {
+func scanPasswordHistoryRow(scanner interface{ Scan(dest ...any) error }) (*models.PasswordHistory, error) {
+	ph := &models.PasswordHistory{}
+	if err := scanner.Scan(&ph.ID, &ph.UserID, &ph.PasswordHash, &ph.PasswordAlgo, &ph.CreatedAt); err != nil {
 		return nil, err
 	}
-	return result, nil
+	return ph, nil
+}
+
+// ListRecent returns the last n password hashes of a user. Hashes are salted,
+// so reuse has to be detected by verifying the candidate against each of them
+// rather than by comparing hash values.
+func (r *PasswordHistoryRepository) ListRecent(ctx context.Context, userID int64, n int) ([]*models.PasswordHistory, error) {
+	list, _, err := r.ListByUser(ctx, userID, Page{Limit: n})
+	return list, err
 }
 
-func (r *PasswordHistoryRepository) ExistsWithHash(ctx context.Context, userID int64, hash string) (bool, error) {
-	ctx, span := r.tracer.Start(ctx, "ExistsWithHash")
+// Trim keeps only the newest keep entries of a user.
+func (r *PasswordHistoryRepository) Trim(ctx context.Context, userID int64, keep int) error {
+	ctx, span := r.tracer.Start(ctx, "Trim")
 	defer span.End()
 
-	row := r.exec.QueryRow(ctx, checkPasswordReuseSQL, userID, hash)
-	var one int
-	if err := row.Scan(&one); err != nil {
-		if err == pgx.ErrNoRows {
-			return false, nil
-		}
-		r.logger.Error().Err(err).Int64("user_id", userID).Msg("password reuse check failed")
-		return false, err
+	if _, err := r.exec.Exec(ctx, trimPasswordHistorySQL, userID, keep); err != nil {
+		r.logger.Error().Err(err).Int64("user_id", userID).Msg("password history trim failed")
+		return err
 	}
-	return true, nil
+	return nil
 }
@@ -0,0 +1,36 @@
+package repositories_test
+
+import (
+	"testing"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+)
+
+func TestPasswordHistoryRepositoryTrim(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	user := fx.User(fx.Orbit())
+	repo := repositories.NewPasswordHistoryRepository(dbConn.Exec(), nop)
+
+	for _, hash := range []string{"h1", "h2", "h3", "h4"} {
+		if _, err := repo.Create(ctx, &models.PasswordHistory{UserID: user.ID, PasswordHash: hash, PasswordAlgo: "test"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := repo.Trim(ctx, user.ID, 2); err != nil {
+		t.Fatal(err)
+	}
+
+	recent, err := repo.ListRecent(ctx, user.ID, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(recent) != 2 || recent[0].PasswordHash != "h4" || recent[1].PasswordHash != "h3" {
+		var got []string
+		for _, ph := range recent {
+			got = append(got, ph.PasswordHash)
+		}
+		t.Fatalf("ListRecent after Trim = %v, want [h4 h3]", got)
+	}
+}
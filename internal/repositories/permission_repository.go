@@ -28,6 +28,10 @@ func NewPermissionRepository(exec db.Executor, logger zerolog.Logger) *Permissio
 }
 
 const (
+	permissionColumns = `
+		id, orbit_id, name, metadata, created_at
+	`
+
 	insertPermissionSQL = `
 		INSERT INTO permissions (orbit_id, name, metadata, created_at)
 		VALUES ($1, $2, $3, $4)
@@ -40,14 +44,6 @@ const (
 		WHERE id = $1
 	`
 
-	listPermissionsByOrbitSQL = `
-		SELECT id, orbit_id, name, metadata, created_at
-		FROM permissions
-		WHERE orbit_id = $1
-		ORDER BY id
-		LIMIT $2 OFFSET $3
-	`
-
 	deletePermissionSQL = `
 		DELETE FROM permissions
 		WHERE id = $1
@@ -81,25 +77,12 @@ func (r *PermissionRepository) GetByID(ctx context.Context, id int64) (*models.P
 	return scanPermission(row)
 }
 
-func (r *PermissionRepository) ListByOrbit(ctx context.Context, orbitID int64, limit, offset int) ([]*models.Permission, error) {
+func (r *PermissionRepository) ListByOrbit(ctx context.Context, orbitID int64, page Page) ([]*models.Permission, string, error) {
 	ctx, span := r.tracer.Start(ctx, "ListByOrbit")
 	defer span.End()
 
-	rows, err := r.exec.Query(ctx, listPermissionsByOrbitSQL, orbitID, limit, offset)
-	if err != nil {
-		return nil, err
-	}
-	defer rows.Close()
-
-	var result []*models.Permission
-	for rows.Next() {
-		permission, err := scanPermission(rows)
-		if err != nil {
-			return nil, err
-		}
-		result = append(result, permission)
-	}
-	return result, rows.Err()
+	q := newListQuery("permissions", permissionColumns, "permissions").and("orbit_id = ?", orbitID)
+	return listPage(ctx, r.exec, q, page, scanPermission, func(p *models.Permission) int64 { return p.ID })
 }
 
 func (r *PermissionRepository) Delete(ctx context.Context, id int64) error {
@@ -0,0 +1,48 @@
+package repositories_test
+
+import (
+	"testing"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+)
+
+func TestPermissionRepositoryRoundTrip(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	orbit := fx.Orbit()
+	repo := repositories.NewPermissionRepository(dbConn.Exec(), nop)
+
+	var created []*models.Permission
+	for _, name := range []string{"invoices:read", "invoices:write", "invoices:approve"} {
+		p, err := repo.Create(ctx, &models.Permission{OrbitID: orbit.ID, Name: name, Metadata: map[string]any{"group": "billing"}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		created = append(created, p)
+	}
+	if _, err := repo.Create(ctx, &models.Permission{OrbitID: fx.Orbit().ID, Name: "invoices:read"}); err != nil {
+		t.Fatalf("Create of a name another orbit uses = %v", err)
+	}
+
+	got, err := repo.GetByID(ctx, created[0].ID)
+	if err != nil || got == nil || got.Name != "invoices:read" || got.Metadata["group"] != "billing" {
+		t.Fatalf("GetByID = %+v, %v", got, err)
+	}
+
+	first, next, err := repo.ListByOrbit(ctx, orbit.ID, repositories.Page{Limit: 2})
+	if err != nil || len(first) != 2 || next == "" {
+		t.Fatalf("first page = %d permissions, %q, %v", len(first), next, err)
+	}
+	rest, next, err := repo.ListByOrbit(ctx, orbit.ID, repositories.Page{Limit: 2, Cursor: next})
+	if err != nil || len(rest) != 1 || next != "" || rest[0].ID != created[2].ID {
+		t.Fatalf("second page = %+v, %q, %v", rest, next, err)
+	}
+
+	if err := repo.Delete(ctx, created[0].ID); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := repo.GetByID(ctx, created[0].ID); err != nil || got != nil {
+		t.Fatalf("GetByID after delete = %+v, %v, want nil", got, err)
+	}
+}
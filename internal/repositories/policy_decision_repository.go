@@ -0,0 +1,119 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories/db"
+)
+
+type PolicyDecisionRepository struct {
+	exec   db.Executor
+	logger zerolog.Logger
+	tracer trace.Tracer
+}
+
+func NewPolicyDecisionRepository(exec db.Executor, logger zerolog.Logger) *PolicyDecisionRepository {
+	return &PolicyDecisionRepository{
+		exec:   exec,
+		logger: logger,
+		tracer: otel.Tracer("repository.policy_decision"),
+	}
+}
+
+const (
+	policyDecisionColumns = `
+		id, orbit_id, client_id, user_id, action, decision, policies, request, errors, created_at
+	`
+
+	insertPolicyDecisionSQL = `
+		INSERT INTO policy_decisions (
+			orbit_id, client_id, user_id, action, decision, policies, request, errors, created_at
+		)
+		VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9)
+		RETURNING id, created_at
+	`
+)
+
+func (r *PolicyDecisionRepository) Create(ctx context.Context, d *models.PolicyDecision) (*models.PolicyDecision, error) {
+	ctx, span := r.tracer.Start(ctx, "Create")
+	defer span.End()
+
+	row := r.exec.QueryRow(ctx, insertPolicyDecisionSQL,
+		d.OrbitID,
+		d.ClientID,
+		d.UserID,
+		d.Action,
+		d.Decision,
+		d.Policies,
+		d.Request,
+		d.Errors,
+		time.Now().UTC(),
+	)
+	if err := row.Scan(&d.ID, &d.CreatedAt); err != nil {
+		r.logger.Error().Err(err).Msg("policy decision create failed")
+		return nil, err
+	}
+	return d, nil
+}
+
+// PolicyDecisionFilter narrows ListByOrbit. Zero fields do not filter.
+type PolicyDecisionFilter struct {
+	Action   string
+	Decision models.PolicyEffect
+	UserID   *int64
+	Since    *time.Time
+	Until    *time.Time
+}
+
+// ListByOrbit returns the newest decisions first.
+func (r *PolicyDecisionRepository) ListByOrbit(ctx context.Context, orbitID int64, filter PolicyDecisionFilter, page Page) ([]*models.PolicyDecision, string, error) {
+	ctx, span := r.tracer.Start(ctx, "ListByOrbit")
+	defer span.End()
+
+	q := newListQuery("policy_decisions", policyDecisionColumns, "policy_decisions").newest().
+		and("orbit_id = ?", orbitID)
+	if filter.Action != "" {
+		q.and("action = ?", filter.Action)
+	}
+	if filter.Decision != "" {
+		q.and("decision = ?", filter.Decision)
+	}
+	if filter.UserID != nil {
+		q.and("user_id = ?", *filter.UserID)
+	}
+	if filter.Since != nil {
+		q.and("created_at >= ?", *filter.Since)
+	}
+	if filter.Until != nil {
+		q.and("created_at < ?", *filter.Until)
+	}
+
+	decisions, next, err := listPage(ctx, r.exec, q, page, scanPolicyDecisionRow, func(d *models.PolicyDecision) int64 { return d.ID })
+	if err != nil && err != ErrInvalidCursor {
+		r.logger.Error().Err(err).Int64("orbit_id", orbitID).Msg("list policy decisions query failed")
+	}
+	return decisions, next, err
+}
+
+func scanPolicyDecisionRow(scanner interface{ Scan(dest ...any) error }) (*models.PolicyDecision, error) {
+	d := &models.PolicyDecision{}
+	err := scanner.Scan(
+		&d.ID,
+		&d.OrbitID,
+		&d.ClientID,
+		&d.UserID,
+		&d.Action,
+		&d.Decision,
+		&d.Policies,
+		&d.Request,
+		&d.Errors,
+		&d.CreatedAt,
+	)
+	return d, err
+}
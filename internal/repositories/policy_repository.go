@@ -0,0 +1,210 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories/db"
+	"github.com/jackc/pgx/v5"
+)
+
+type PolicyRepository struct {
+	exec   db.Executor
+	logger zerolog.Logger
+	tracer trace.Tracer
+}
+
+func NewPolicyRepository(exec db.Executor, logger zerolog.Logger) *PolicyRepository {
+	return &PolicyRepository{
+		exec:   exec,
+		logger: logger,
+		tracer: otel.Tracer("repository.policy"),
+	}
+}
+
+const (
+	policyColumns = `
+		id, orbit_id, name, description, effect, actions, condition, obligations, is_active, created_at, updated_at
+	`
+
+	insertPolicySQL = `
+		INSERT INTO policies (orbit_id, name, description, effect, actions, condition, obligations, is_active, created_at, updated_at)
+		VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10)
+		RETURNING id, created_at, updated_at
+	`
+
+	selectPolicyByIDSQL = `
+		SELECT id, orbit_id, name, description, effect, actions, condition, obligations, is_active, created_at, updated_at
+		FROM policies
+		WHERE id = $1
+	`
+
+	selectActivePoliciesByOrbitSQL = `
+		SELECT id, orbit_id, name, description, effect, actions, condition, obligations, is_active, created_at, updated_at
+		FROM policies
+		WHERE orbit_id = $1 AND is_active
+		ORDER BY id
+	`
+
+	updatePolicySQL = `
+		UPDATE policies
+		SET name = $2, description = $3, effect = $4, actions = $5, condition = $6, obligations = $7, is_active = $8, updated_at = $9
+		WHERE id = $1
+		RETURNING updated_at
+	`
+
+	lockPolicySQL = `
+		SELECT updated_at
+		FROM policies
+		WHERE id = $1
+		FOR UPDATE
+	`
+
+	deletePolicySQL = `
+		DELETE FROM policies
+		WHERE id = $1
+	`
+)
+
+func (r *PolicyRepository) Create(ctx context.Context, p *models.Policy) (*models.Policy, error) {
+	ctx, span := r.tracer.Start(ctx, "Create")
+	defer span.End()
+
+	now := time.Now().UTC()
+	row := r.exec.QueryRow(ctx, insertPolicySQL,
+		p.OrbitID,
+		p.Name,
+		p.Description,
+		p.Effect,
+		p.Actions,
+		p.Condition,
+		p.Obligations,
+		p.IsActive,
+		now,
+		now,
+	)
+	if err := row.Scan(&p.ID, &p.CreatedAt, &p.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+func (r *PolicyRepository) GetByID(ctx context.Context, id int64) (*models.Policy, error) {
+	ctx, span := r.tracer.Start(ctx, "GetByID")
+	defer span.End()
+
+	row := r.exec.QueryRow(ctx, selectPolicyByIDSQL, id)
+	return scanPolicy(row)
+}
+
+func (r *PolicyRepository) Update(ctx context.Context, p *models.Policy) (*models.Policy, error) {
+	ctx, span := r.tracer.Start(ctx, "Update")
+	defer span.End()
+
+	now := time.Now().UTC()
+	row := r.exec.QueryRow(ctx, updatePolicySQL,
+		p.ID,
+		p.Name,
+		p.Description,
+		p.Effect,
+		p.Actions,
+		p.Condition,
+		p.Obligations,
+		p.IsActive,
+		now,
+	)
+	if err := row.Scan(&p.UpdatedAt); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return p, nil
+}
+
+// LockVersion locks the policy until the transaction ends and returns when it
+// was last updated, or nil when there is no such policy.
+func (r *PolicyRepository) LockVersion(ctx context.Context, id int64) (*time.Time, error) {
+	ctx, span := r.tracer.Start(ctx, "LockVersion")
+	defer span.End()
+
+	var updatedAt time.Time
+	if err := r.exec.QueryRow(ctx, lockPolicySQL, id).Scan(&updatedAt); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		r.logger.Error().Err(err).Int64("policy_id", id).Msg("policy lock failed")
+		return nil, err
+	}
+	return &updatedAt, nil
+}
+
+func (r *PolicyRepository) Delete(ctx context.Context, id int64) error {
+	ctx, span := r.tracer.Start(ctx, "Delete")
+	defer span.End()
+
+	_, err := r.exec.Exec(ctx, deletePolicySQL, id)
+	return err
+}
+
+func (r *PolicyRepository) ListByOrbit(ctx context.Context, orbitID int64, page Page) ([]*models.Policy, string, error) {
+	ctx, span := r.tracer.Start(ctx, "ListByOrbit")
+	defer span.End()
+
+	q := newListQuery("policies", policyColumns, "policies").and("orbit_id = ?", orbitID)
+	return listPage(ctx, r.exec, q, page, scanPolicy, func(p *models.Policy) int64 { return p.ID })
+}
+
+// ListActiveByOrbit returns every active policy of an orbit, the set a
+// decision is made from.
+func (r *PolicyRepository) ListActiveByOrbit(ctx context.Context, orbitID int64) ([]*models.Policy, error) {
+	ctx, span := r.tracer.Start(ctx, "ListActiveByOrbit")
+	defer span.End()
+
+	rows, err := r.exec.Query(ctx, selectActivePoliciesByOrbitSQL, orbitID)
+	if err != nil {
+		r.logger.Error().Err(err).Int64("orbit_id", orbitID).Msg("list active policies query failed")
+		return nil, err
+	}
+	defer rows.Close()
+
+	policies := []*models.Policy{}
+	for rows.Next() {
+		p, err := scanPolicy(rows)
+		if err != nil {
+			return nil, err
+		}
+		policies = append(policies, p)
+	}
+	return policies, rows.Err()
+}
+
+func scanPolicy(scanner interface{ Scan(dest ...any) error }) (*models.Policy, error) {
+	p := &models.Policy{}
+	var description *string
+	err := scanner.Scan(
+		&p.ID,
+		&p.OrbitID,
+		&p.Name,
+		&description,
+		&p.Effect,
+		&p.Actions,
+		&p.Condition,
+		&p.Obligations,
+		&p.IsActive,
+		&p.CreatedAt,
+		&p.UpdatedAt,
+	)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	if description != nil {
+		p.Description = *description
+	}
+	return p, err
+}
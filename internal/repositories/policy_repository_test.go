@@ -0,0 +1,102 @@
+package repositories_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+)
+
+func TestPolicyRepositoryRoundTrip(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	orbit := fx.Orbit()
+	repo := repositories.NewPolicyRepository(dbConn.Exec(), nop)
+
+	created, err := repo.Create(ctx, &models.Policy{
+		OrbitID:     orbit.ID,
+		Name:        "approve-own-department",
+		Effect:      models.PolicyEffectAllow,
+		Actions:     []string{"invoice:approve"},
+		Condition:   `user.profile.department == resource.department`,
+		Obligations: []map[string]any{{"type": "notify", "to": "finance"}},
+		IsActive:    true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.Create(ctx, &models.Policy{OrbitID: orbit.ID, Name: "dormant", Effect: models.PolicyEffectDeny, Actions: []string{"*"}}); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := repo.GetByID(ctx, created.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Name != created.Name || got.Effect != models.PolicyEffectAllow || got.Condition != created.Condition ||
+		!reflect.DeepEqual(got.Actions, created.Actions) || !reflect.DeepEqual(got.Obligations, created.Obligations) {
+		t.Errorf("GetByID = %+v, want %+v", got, created)
+	}
+
+	active, err := repo.ListActiveByOrbit(ctx, orbit.ID)
+	if err != nil || len(active) != 1 || active[0].ID != created.ID {
+		t.Fatalf("ListActiveByOrbit = %+v, %v, want the active policy alone", active, err)
+	}
+
+	version, err := repo.LockVersion(ctx, created.ID)
+	if err != nil || version == nil || !version.Equal(got.UpdatedAt) {
+		t.Fatalf("LockVersion = %v, %v, want %v", version, err, got.UpdatedAt)
+	}
+	got.IsActive = false
+	if _, err := repo.Update(ctx, got); err != nil {
+		t.Fatal(err)
+	}
+	if active, err := repo.ListActiveByOrbit(ctx, orbit.ID); err != nil || len(active) != 0 {
+		t.Fatalf("ListActiveByOrbit after deactivating = %+v, %v", active, err)
+	}
+	if err := repo.Delete(ctx, created.ID); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := repo.GetByID(ctx, created.ID); err != nil || got != nil {
+		t.Fatalf("GetByID after Delete = %+v, %v", got, err)
+	}
+}
+
+func TestPolicyDecisionRepositoryFilters(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	orbit := fx.Orbit()
+	client := fx.Client(orbit)
+	user := fx.User(orbit)
+	repo := repositories.NewPolicyDecisionRepository(dbConn.Exec(), nop)
+
+	for _, d := range []*models.PolicyDecision{
+		{Action: "invoice:approve", Decision: models.PolicyEffectAllow, UserID: &user.ID, Policies: []string{"approvers"}},
+		{Action: "invoice:approve", Decision: models.PolicyEffectDeny, UserID: &user.ID, Errors: []string{"approvers: boom"}},
+		{Action: "invoice:read", Decision: models.PolicyEffectAllow},
+	} {
+		d.OrbitID = orbit.ID
+		d.ClientID = &client.ID
+		d.Request = map[string]any{"resource": map[string]any{"id": "inv-1"}}
+		if _, err := repo.Create(ctx, d); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	list, _, err := repo.ListByOrbit(ctx, orbit.ID, repositories.PolicyDecisionFilter{Action: "invoice:approve", UserID: &user.ID}, repositories.Page{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Newest first.
+	if len(list) != 2 || list[0].Decision != models.PolicyEffectDeny || list[1].Decision != models.PolicyEffectAllow {
+		t.Fatalf("ListByOrbit = %+v", list)
+	}
+	if !reflect.DeepEqual(list[0].Errors, []string{"approvers: boom"}) || list[0].ClientID == nil || *list[0].ClientID != client.ID {
+		t.Errorf("entry = %+v", list[0])
+	}
+	list, _, err = repo.ListByOrbit(ctx, orbit.ID, repositories.PolicyDecisionFilter{Decision: models.PolicyEffectAllow}, repositories.Page{})
+	if err != nil || len(list) != 2 {
+		t.Fatalf("ListByOrbit(allow) = %+v, %v, want 2 entries", list, err)
+	}
+}
@@ -0,0 +1,185 @@
+package repositories
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories/db"
+)
+
+const (
+	DefaultPageLimit = 50
+	MaxPageLimit     = 500
+)
+
+var ErrInvalidCursor = errors.New("invalid pagination cursor")
+
+// Page selects one page of a list. Cursor is empty for the first page and
+// otherwise the NextCursor returned with the previous page.
+type Page struct {
+	Limit  int
+	Cursor string
+}
+
+func (p Page) limit() int {
+	if p.Limit <= 0 {
+		return DefaultPageLimit
+	}
+	return min(p.Limit, MaxPageLimit)
+}
+
+// cursor is the position after the last row of a page. Lists are ordered by
+// id, so the id is all that is needed to continue; the list name keeps a
+// cursor from being replayed against another list.
+type cursor struct {
+	List string `json:"l"`
+	ID   int64  `json:"id"`
+}
+
+func encodeCursor(list string, id int64) string {
+	data, _ := json.Marshal(cursor{List: list, ID: id})
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+func decodeCursor(list, s string) (int64, error) {
+	data, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return 0, ErrInvalidCursor
+	}
+	var c cursor
+	if err := json.Unmarshal(data, &c); err != nil || c.List != list || c.ID <= 0 {
+		return 0, ErrInvalidCursor
+	}
+	return c.ID, nil
+}
+
+// listQuery builds the keyset-paginated SELECT behind the List methods.
+// Conditions are ANDed and rows are ordered by id, newest first when desc is
+// set, so every page is an index range scan however deep it is.
+type listQuery struct {
+	list    string
+	columns string
+	table   string
+	key     string
+	desc    bool
+	where   []string
+	args    []any
+}
+
+func newListQuery(list, columns, table string) *listQuery {
+	return &listQuery{list: list, columns: columns, table: table, key: "id"}
+}
+
+// orderBy keys the list on another unique integer column, for tables without
+// an id of their own or joins where id is ambiguous.
+func (q *listQuery) orderBy(key string) *listQuery {
+	q.key = key
+	return q
+}
+
+// newest orders the list by descending id, for logs read from the end.
+func (q *listQuery) newest() *listQuery {
+	q.desc = true
+	return q
+}
+
+// and adds a condition. Every ? in cond is bound to the next of args, so
+// conditions must not use the jsonb ? operator.
+func (q *listQuery) and(cond string, args ...any) *listQuery {
+	var b strings.Builder
+	for _, part := range strings.SplitAfter(cond, "?") {
+		if !strings.HasSuffix(part, "?") {
+			b.WriteString(part)
+			continue
+		}
+		q.args = append(q.args, args[0])
+		args = args[1:]
+		b.WriteString(strings.TrimSuffix(part, "?"))
+		b.WriteString("$" + strconv.Itoa(len(q.args)))
+	}
+	q.where = append(q.where, b.String())
+	return q
+}
+
+// build returns the SQL for page. One row more than the limit is fetched to
+// tell whether another page follows.
+func (q *listQuery) build(page Page) (string, []any, error) {
+	order, after := "ASC", q.key+" > ?"
+	if q.desc {
+		order, after = "DESC", q.key+" < ?"
+	}
+	if page.Cursor != "" {
+		id, err := decodeCursor(q.list, page.Cursor)
+		if err != nil {
+			return "", nil, err
+		}
+		q.and(after, id)
+	}
+
+	var b strings.Builder
+	b.WriteString("SELECT ")
+	b.WriteString(strings.TrimSpace(q.columns))
+	b.WriteString(" FROM ")
+	b.WriteString(q.table)
+	if len(q.where) > 0 {
+		b.WriteString(" WHERE ")
+		b.WriteString(strings.Join(q.where, " AND "))
+	}
+	b.WriteString(" ORDER BY ")
+	b.WriteString(q.key)
+	b.WriteString(" ")
+	b.WriteString(order)
+	q.args = append(q.args, page.limit()+1)
+	b.WriteString(" LIMIT $" + strconv.Itoa(len(q.args)))
+	return b.String(), q.args, nil
+}
+
+// listPage runs q and scans one page with scan. The returned cursor is empty
+// on the last page.
+func listPage[T any](
+	ctx context.Context,
+	exec db.Executor,
+	q *listQuery,
+	page Page,
+	scan func(scanner interface{ Scan(dest ...any) error }) (*T, error),
+	id func(*T) int64,
+) ([]*T, string, error) {
+	sql, args, err := q.build(page)
+	if err != nil {
+		return nil, "", err
+	}
+	rows, err := exec.Query(ctx, sql, args...)
+	if err != nil {
+		return nil, "", err
+	}
+	defer rows.Close()
+
+	var result []*T
+	for rows.Next() {
+		item, err := scan(rows)
+		if err != nil {
+			return nil, "", err
+		}
+		result = append(result, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, "", err
+	}
+
+	limit := page.limit()
+	if len(result) <= limit {
+		return result, "", nil
+	}
+	result = result[:limit]
+	return result, encodeCursor(q.list, id(result[limit-1])), nil
+}
+
+// likePrefix turns s into a LIKE pattern matching strings that start with s.
+func likePrefix(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`)
+	return r.Replace(s) + "%"
+}
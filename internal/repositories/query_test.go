@@ -0,0 +1,99 @@
+package repositories
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestListQueryBuild(t *testing.T) {
+	q := newListQuery("users", "id, email", "users").
+		and("orbit_id = ?", int64(7)).
+		and("deleted_at IS NULL").
+		and("created_at >= ? AND created_at < ?", "from", "to")
+
+	sql, args, err := q.build(Page{Limit: 20, Cursor: encodeCursor("users", 41)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantSQL := "SELECT id, email FROM users WHERE orbit_id = $1 AND deleted_at IS NULL AND created_at >= $2 AND created_at < $3 AND id > $4 ORDER BY id ASC LIMIT $5"
+	if sql != wantSQL {
+		t.Errorf("sql =\n%s\nwant\n%s", sql, wantSQL)
+	}
+	if want := []any{int64(7), "from", "to", int64(41), 21}; !reflect.DeepEqual(args, want) {
+		t.Errorf("args = %v, want %v", args, want)
+	}
+}
+
+func TestListQueryBuildNewestFirst(t *testing.T) {
+	sql, args, err := newListQuery("audit_logs", "id", "audit_logs").newest().build(Page{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "SELECT id FROM audit_logs ORDER BY id DESC LIMIT $1"; sql != want {
+		t.Errorf("sql = %s, want %s", sql, want)
+	}
+	if want := []any{DefaultPageLimit + 1}; !reflect.DeepEqual(args, want) {
+		t.Errorf("args = %v, want %v", args, want)
+	}
+
+	sql, _, err = newListQuery("audit_logs", "id", "audit_logs").newest().build(Page{Cursor: encodeCursor("audit_logs", 9)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "SELECT id FROM audit_logs WHERE id < $1 ORDER BY id DESC LIMIT $2"; sql != want {
+		t.Errorf("sql = %s, want %s", sql, want)
+	}
+}
+
+func TestListQueryBuildOrderBy(t *testing.T) {
+	q := newListQuery("role_permissions", "p.id, p.name", "role_permissions rp JOIN permissions p ON rp.permission_id = p.id").
+		orderBy("p.id").
+		and("rp.role_id = ?", int64(3))
+	sql, args, err := q.build(Page{Limit: 10, Cursor: encodeCursor("role_permissions", 8)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantSQL := "SELECT p.id, p.name FROM role_permissions rp JOIN permissions p ON rp.permission_id = p.id WHERE rp.role_id = $1 AND p.id > $2 ORDER BY p.id ASC LIMIT $3"
+	if sql != wantSQL {
+		t.Errorf("sql =\n%s\nwant\n%s", sql, wantSQL)
+	}
+	if want := []any{int64(3), int64(8), 11}; !reflect.DeepEqual(args, want) {
+		t.Errorf("args = %v, want %v", args, want)
+	}
+}
+
+func TestDecodeCursorRejectsForeignCursors(t *testing.T) {
+	for name, c := range map[string]string{
+		"other list":  encodeCursor("clients", 5),
+		"not base64":  "!!",
+		"not json":    "bm90IGpzb24",
+		"no position": encodeCursor("users", 0),
+	} {
+		if _, err := decodeCursor("users", c); !errors.Is(err, ErrInvalidCursor) {
+			t.Errorf("%s: decodeCursor = %v, want ErrInvalidCursor", name, err)
+		}
+	}
+	if id, err := decodeCursor("users", encodeCursor("users", 5)); err != nil || id != 5 {
+		t.Errorf("decodeCursor = %d, %v, want 5", id, err)
+	}
+}
+
+func TestPageLimit(t *testing.T) {
+	for limit, want := range map[int]int{
+		-1:               DefaultPageLimit,
+		0:                DefaultPageLimit,
+		10:               10,
+		MaxPageLimit + 1: MaxPageLimit,
+	} {
+		if got := (Page{Limit: limit}).limit(); got != want {
+			t.Errorf("Page{Limit: %d}.limit() = %d, want %d", limit, got, want)
+		}
+	}
+}
+
+func TestLikePrefix(t *testing.T) {
+	if got, want := likePrefix(`50%_off\`), `50\%\_off\\%`; got != want {
+		t.Errorf("likePrefix = %s, want %s", got, want)
+	}
+}
@@ -27,6 +27,8 @@ func NewRecoveryCodeRepository(exec db.Executor, logger zerolog.Logger) *Recover
 }
 
 const (
+	recoveryCodeColumns = `id, user_id, code_hash, used_at, created_at`
+
 	insertRecoveryCodeSQL = `
 		INSERT INTO recovery_codes (user_id, code_hash, used_at, created_at)
 		VALUES ($1, $2, $3, $4)
@@ -40,12 +42,9 @@ const (
 		LIMIT 1
 	`
 
-	listRecoveryCodesByUserSQL = `
-		SELECT id, user_id, code_hash, used_at, created_at
-		FROM recovery_codes
+	deleteRecoveryCodesByUserSQL = `
+		DELETE FROM recovery_codes
 		WHERE user_id = $1
-		ORDER BY created_at DESC
-		LIMIT $2 OFFSET $3
 	`
 
 	markRecoveryCodeUsedSQL = `
@@ -85,30 +84,38 @@ func (r *RecoveryCodeRepository) GetByID(ctx context.Context, id int64) (*models
 	return rc, nil
 }
 
-func (r *RecoveryCodeRepository) ListByUser(ctx context.Context, userID int64, limit, offset int) ([]*models.RecoveryCode, error) {
+// ListByUser lists the recovery codes of a user, newest first.
+func (r *RecoveryCodeRepository) ListByUser(ctx context.Context, userID int64, page Page) ([]*models.RecoveryCode, string, error) {
 	ctx, span := r.tracer.Start(ctx, "ListByUser")
 	defer span.End()
 
-	rows, err := r.exec.Query(ctx, listRecoveryCodesByUserSQL, userID, limit, offset)
-	if err != nil {
+	q := newListQuery("recovery_codes", recoveryCodeColumns, "recovery_codes").
+		newest().
+		and("user_id = ?", userID)
+	codes, next, err := listPage(ctx, r.exec, q, page, scanRecoveryCodeRow, func(rc *models.RecoveryCode) int64 { return rc.ID })
+	if err != nil && err != ErrInvalidCursor {
 		r.logger.Error().Err(err).Int64("user_id", userID).Msg("list recovery codes query failed")
-		return nil, err
 	}
-	defer rows.Close()
-
-	var result []*models.RecoveryCode
-	for rows.Next() {
-		rc := &models.RecoveryCode{}
-		if err := rows.Scan(&rc.ID, &rc.UserID, &rc.CodeHash, &rc.UsedAt, &rc.CreatedAt); err != nil {
-			r.logger.Error().Err(err).Int64("user_id", userID).Msg("scan recovery code row failed")
-			return nil, err
-		}
-		result = append(result, rc)
-	}
-	if err := rows.Err(); err != nil {
+	return codes, next, err
+}
+
+func scanRecoveryCodeRow(scanner interface{ Scan(dest ...any) error }) (*models.RecoveryCode, error) {
+	rc := &models.RecoveryCode{}
+	if err := scanner.Scan(&rc.ID, &rc.UserID, &rc.CodeHash, &rc.UsedAt, &rc.CreatedAt); err != nil {
 		return nil, err
 	}
-	return result, nil
+	return rc, nil
+}
+
+func (r *RecoveryCodeRepository) DeleteByUser(ctx context.Context, userID int64) error {
+	ctx, span := r.tracer.Start(ctx, "DeleteByUser")
+	defer span.End()
+
+	if _, err := r.exec.Exec(ctx, deleteRecoveryCodesByUserSQL, userID); err != nil {
+		r.logger.Error().Err(err).Int64("user_id", userID).Msg("delete recovery codes failed")
+		return err
+	}
+	return nil
 }
 
 func (r *RecoveryCodeRepository) Use(ctx context.Context, id int64) (bool, error) {
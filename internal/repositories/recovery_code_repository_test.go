@@ -0,0 +1,45 @@
+package repositories_test
+
+import (
+	"testing"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+)
+
+func TestRecoveryCodeRepositoryUseOnce(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	user := fx.User(fx.Orbit())
+	repo := repositories.NewRecoveryCodeRepository(dbConn.Exec(), nop)
+
+	var codes []*models.RecoveryCode
+	for _, hash := range []string{"hash-1", "hash-2"} {
+		rc, err := repo.Create(ctx, &models.RecoveryCode{UserID: user.ID, CodeHash: hash})
+		if err != nil {
+			t.Fatal(err)
+		}
+		codes = append(codes, rc)
+	}
+
+	ok, err := repo.Use(ctx, codes[0].ID)
+	if err != nil || !ok {
+		t.Fatalf("Use = %v, %v, want true", ok, err)
+	}
+	ok, err = repo.Use(ctx, codes[0].ID)
+	if err != nil || ok {
+		t.Fatalf("second Use = %v, %v, want false", ok, err)
+	}
+	got, err := repo.GetByID(ctx, codes[0].ID)
+	if err != nil || got == nil || got.UsedAt == nil {
+		t.Fatalf("GetByID = %+v, %v", got, err)
+	}
+
+	if err := repo.DeleteByUser(ctx, user.ID); err != nil {
+		t.Fatal(err)
+	}
+	list, _, err := repo.ListByUser(ctx, user.ID, repositories.Page{})
+	if err != nil || len(list) != 0 {
+		t.Fatalf("ListByUser after DeleteByUser = %d, %v", len(list), err)
+	}
+}
@@ -29,26 +29,34 @@ func NewRefreshTokenRepository(exec db.Executor, logger zerolog.Logger) *Refresh
 const (
 	insertRefreshTokenSQL = `
 		INSERT INTO refresh_tokens
-			(expires_at, token_string, jti, orbit_id, client_id, user_id, revoked, rotated_from_id, rotated_to_id, scopes, metadata, last_used_at, use_count, created_at)
+			(expires_at, token_string, jti, orbit_id, client_id, user_id, session_id, revoked, rotated_from_id, rotated_to_id, scopes, resources, metadata, last_used_at, use_count, created_at)
 		VALUES
-			($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14)
+			($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16)
 		RETURNING id, created_at, expires_at
 	`
 
 	selectRefreshTokenByIDSQL = `
-		SELECT id, expires_at, token_string, jti, orbit_id, client_id, user_id, revoked, rotated_from_id, rotated_to_id, scopes, metadata, last_used_at, use_count, created_at
+		SELECT id, expires_at, token_string, jti, orbit_id, client_id, user_id, session_id, revoked, rotated_from_id, rotated_to_id, scopes, resources, metadata, last_used_at, use_count, created_at
 		FROM refresh_tokens
 		WHERE id = $1
 		LIMIT 1
 	`
 
 	selectRefreshTokenByJTISQL = `
-		SELECT id, expires_at, token_string, jti, orbit_id, client_id, user_id, revoked, rotated_from_id, rotated_to_id, scopes, metadata, last_used_at, use_count, created_at
+		SELECT id, expires_at, token_string, jti, orbit_id, client_id, user_id, session_id, revoked, rotated_from_id, rotated_to_id, scopes, resources, metadata, last_used_at, use_count, created_at
 		FROM refresh_tokens
 		WHERE jti = $1
 		LIMIT 1
 	`
 
+	lockRefreshTokenByJTISQL = `
+		SELECT id, expires_at, token_string, jti, orbit_id, client_id, user_id, session_id, revoked, rotated_from_id, rotated_to_id, scopes, resources, metadata, last_used_at, use_count, created_at
+		FROM refresh_tokens
+		WHERE jti = $1
+		LIMIT 1
+		FOR UPDATE
+	`
+
 	updateRefreshTokenSQL = `
 		UPDATE refresh_tokens
 		SET
@@ -72,6 +80,24 @@ const (
 		RETURNING id
 	`
 
+	revokeRefreshTokensByUserClientSQL = `
+		UPDATE refresh_tokens
+		SET revoked = TRUE
+		WHERE user_id = $1 AND client_id = $2 AND (revoked IS NULL OR revoked = FALSE)
+	`
+
+	revokeRefreshTokensByUserSQL = `
+		UPDATE refresh_tokens
+		SET revoked = TRUE
+		WHERE user_id = $1 AND (revoked IS NULL OR revoked = FALSE)
+	`
+
+	revokeRefreshTokensBySessionsSQL = `
+		UPDATE refresh_tokens
+		SET revoked = TRUE
+		WHERE session_id = ANY($1) AND (revoked IS NULL OR revoked = FALSE)
+	`
+
 	rotateRefreshTokenSQL = `
 		UPDATE refresh_tokens
 		SET rotated_to_id = $2
@@ -95,10 +121,12 @@ func (r *RefreshTokenRepository) Create(ctx context.Context, token *models.Refre
 		token.OrbitID,
 		token.ClientID,
 		token.UserID,
+		token.SessionID,
 		token.Revoked,
 		token.RotatedFromID,
 		token.RotatedToID,
 		token.Scopes,
+		token.Resources,
 		token.Metadata,
 		token.LastUsedAt,
 		token.UseCount,
@@ -146,6 +174,24 @@ func (r *RefreshTokenRepository) GetByJTI(ctx context.Context, jti string) (*mod
 	return rt, nil
 }
 
+// LockByJTI loads a refresh token and locks its row until the transaction
+// ends, so that two requests cannot both rotate it.
+func (r *RefreshTokenRepository) LockByJTI(ctx context.Context, jti string) (*models.RefreshToken, error) {
+	ctx, span := r.tracer.Start(ctx, "LockByJTI")
+	defer span.End()
+
+	row := r.exec.QueryRow(ctx, lockRefreshTokenByJTISQL, jti)
+	rt, err := scanRefreshTokenRow(row)
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		r.logger.Error().Err(err).Str("jti", jti).Msg("lock refresh token failed")
+		return nil, err
+	}
+	return rt, nil
+}
+
 func (r *RefreshTokenRepository) Update(ctx context.Context, token *models.RefreshToken) (*models.RefreshToken, error) {
 	ctx, span := r.tracer.Start(ctx, "Update")
 	defer span.End()
@@ -196,6 +242,51 @@ func (r *RefreshTokenRepository) RevokeByJTI(ctx context.Context, jti string) (b
 	return true, nil
 }
 
+// RevokeByUserAndClient revokes every live refresh token the client holds for
+// the user and reports how many were affected.
+func (r *RefreshTokenRepository) RevokeByUserAndClient(ctx context.Context, userID, clientID int64) (int64, error) {
+	ctx, span := r.tracer.Start(ctx, "RevokeByUserAndClient")
+	defer span.End()
+
+	tag, err := r.exec.Exec(ctx, revokeRefreshTokensByUserClientSQL, userID, clientID)
+	if err != nil {
+		r.logger.Error().Err(err).Int64("user_id", userID).Int64("client_id", clientID).Msg("revoke refresh tokens failed")
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+// RevokeByUser revokes every live refresh token of the user, whichever client
+// holds it.
+func (r *RefreshTokenRepository) RevokeByUser(ctx context.Context, userID int64) (int64, error) {
+	ctx, span := r.tracer.Start(ctx, "RevokeByUser")
+	defer span.End()
+
+	tag, err := r.exec.Exec(ctx, revokeRefreshTokensByUserSQL, userID)
+	if err != nil {
+		r.logger.Error().Err(err).Int64("user_id", userID).Msg("revoke refresh tokens failed")
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+// RevokeBySessions revokes every live refresh token issued within one of the
+// sessions and reports how many were affected.
+func (r *RefreshTokenRepository) RevokeBySessions(ctx context.Context, sessionIDs []int64) (int64, error) {
+	ctx, span := r.tracer.Start(ctx, "RevokeBySessions")
+	defer span.End()
+
+	if len(sessionIDs) == 0 {
+		return 0, nil
+	}
+	tag, err := r.exec.Exec(ctx, revokeRefreshTokensBySessionsSQL, sessionIDs)
+	if err != nil {
+		r.logger.Error().Err(err).Ints64("session_ids", sessionIDs).Msg("revoke refresh tokens failed")
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
 func (r *RefreshTokenRepository) Rotate(ctx context.Context, id int64, rotatedToID int64) error {
 	ctx, span := r.tracer.Start(ctx, "Rotate")
 	defer span.End()
@@ -223,10 +314,12 @@ func scanRefreshTokenRow(scanner interface{ Scan(dest ...any) error }) (*models.
 		&rt.OrbitID,
 		&rt.ClientID,
 		&rt.UserID,
+		&rt.SessionID,
 		&rt.Revoked,
 		&rt.RotatedFromID,
 		&rt.RotatedToID,
 		&rt.Scopes,
+		&rt.Resources,
 		&rt.Metadata,
 		&rt.LastUsedAt,
 		&rt.UseCount,
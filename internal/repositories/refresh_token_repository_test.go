@@ -0,0 +1,103 @@
+package repositories_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+)
+
+func TestRefreshTokenRepositoryRotation(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	orbit := fx.Orbit()
+	client := fx.Client(orbit)
+	user := fx.User(orbit)
+	repo := repositories.NewRefreshTokenRepository(dbConn.Exec(), nop)
+
+	newToken := func(jti string, from *int64) *models.RefreshToken {
+		t.Helper()
+		rt, err := repo.Create(ctx, &models.RefreshToken{
+			ExpiresAt:     time.Now().UTC().Add(time.Hour),
+			TokenString:   "secret-" + jti,
+			JTI:           jti,
+			OrbitID:       orbit.ID,
+			ClientID:      client.ID,
+			UserID:        &user.ID,
+			RotatedFromID: from,
+			Scopes:        json.RawMessage(`["openid","offline_access"]`),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return rt
+	}
+
+	first := newToken("rt-1", nil)
+	second := newToken("rt-2", &first.ID)
+	if err := repo.Rotate(ctx, first.ID, second.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := repo.GetByJTI(ctx, "rt-1")
+	if err != nil || got == nil || got.RotatedToID == nil || *got.RotatedToID != second.ID {
+		t.Fatalf("GetByJTI(rt-1) = %+v, %v", got, err)
+	}
+	got, err = repo.GetByID(ctx, second.ID)
+	if err != nil || got == nil || got.RotatedFromID == nil || *got.RotatedFromID != first.ID {
+		t.Fatalf("GetByID(rt-2) = %+v, %v", got, err)
+	}
+	if !jsonEqual(t, got.Scopes, `["openid","offline_access"]`) {
+		t.Errorf("Scopes = %s", got.Scopes)
+	}
+
+	got.UseCount++
+	got.LastUsedAt = ptr(time.Now().UTC())
+	if updated, err := repo.Update(ctx, got); err != nil || updated == nil {
+		t.Fatalf("Update = %+v, %v", updated, err)
+	}
+	if got, _ := repo.GetByID(ctx, second.ID); got.UseCount != 1 || got.LastUsedAt == nil {
+		t.Fatalf("after Update: UseCount = %d, LastUsedAt = %v", got.UseCount, got.LastUsedAt)
+	}
+}
+
+func TestRefreshTokenRepositoryRevoke(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	orbit := fx.Orbit()
+	client := fx.Client(orbit)
+	otherClient := fx.Client(orbit)
+	user := fx.User(orbit)
+	repo := repositories.NewRefreshTokenRepository(dbConn.Exec(), nop)
+
+	for jti, clientID := range map[string]int64{"rt-a": client.ID, "rt-b": client.ID, "rt-c": otherClient.ID} {
+		_, err := repo.Create(ctx, &models.RefreshToken{
+			ExpiresAt: time.Now().UTC().Add(time.Hour),
+			JTI:       jti,
+			OrbitID:   orbit.ID,
+			ClientID:  clientID,
+			UserID:    &user.ID,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ok, err := repo.RevokeByJTI(ctx, "rt-a")
+	if err != nil || !ok {
+		t.Fatalf("RevokeByJTI = %v, %v, want true", ok, err)
+	}
+	n, err := repo.RevokeByUserAndClient(ctx, user.ID, client.ID)
+	if err != nil || n != 1 {
+		t.Fatalf("RevokeByUserAndClient = %d, %v, want 1", n, err)
+	}
+	n, err = repo.RevokeByUser(ctx, user.ID)
+	if err != nil || n != 1 {
+		t.Fatalf("RevokeByUser = %d, %v, want 1", n, err)
+	}
+	if ok, _ := repo.RevokeByJTI(ctx, "rt-c"); ok {
+		t.Fatal("RevokeByJTI of a revoked token = true")
+	}
+}
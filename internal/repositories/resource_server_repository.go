@@ -0,0 +1,203 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories/db"
+	"github.com/jackc/pgconn"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type ResourceServerRepository struct {
+	exec   db.Executor
+	logger zerolog.Logger
+	tracer trace.Tracer
+}
+
+func NewResourceServerRepository(exec db.Executor, logger zerolog.Logger) *ResourceServerRepository {
+	return &ResourceServerRepository{
+		exec:   exec,
+		logger: logger,
+		tracer: otel.Tracer("repository.resource_server"),
+	}
+}
+
+const (
+	resourceServerColumns = `
+		id, orbit_id, identifier, name, description, allowed_scopes,
+		token_format, access_token_ttl, is_active, metadata, created_at, updated_at, deleted_at
+	`
+
+	insertResourceServerSQL = `
+		INSERT INTO resource_servers (
+			orbit_id, identifier, name, description, allowed_scopes,
+			token_format, access_token_ttl, is_active, metadata, created_at, updated_at
+		)
+		VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11)
+		RETURNING id, created_at, updated_at
+	`
+
+	selectResourceServerByIDSQL = `
+		SELECT id, orbit_id, identifier, name, description, allowed_scopes,
+		       token_format, access_token_ttl, is_active, metadata, created_at, updated_at, deleted_at
+		FROM resource_servers
+		WHERE id = $1 AND deleted_at IS NULL
+	`
+
+	selectResourceServerByIdentifierSQL = `
+		SELECT id, orbit_id, identifier, name, description, allowed_scopes,
+		       token_format, access_token_ttl, is_active, metadata, created_at, updated_at, deleted_at
+		FROM resource_servers
+		WHERE orbit_id = $1 AND identifier = $2 AND deleted_at IS NULL
+		LIMIT 1
+	`
+
+	updateResourceServerSQL = `
+		UPDATE resource_servers
+		SET name = $3, description = $4, allowed_scopes = $5, token_format = $6,
+		    access_token_ttl = $7, is_active = $8, metadata = $9, updated_at = $10
+		WHERE id = $1 AND orbit_id = $2 AND deleted_at IS NULL
+		RETURNING updated_at
+	`
+
+	softDeleteResourceServerSQL = `
+		UPDATE resource_servers
+		SET deleted_at = $2, updated_at = $2
+		WHERE id = $1 AND deleted_at IS NULL
+		RETURNING id
+	`
+)
+
+func (r *ResourceServerRepository) Create(ctx context.Context, rs *models.ResourceServer) (*models.ResourceServer, error) {
+	ctx, span := r.tracer.Start(ctx, "Create")
+	defer span.End()
+
+	now := time.Now().UTC()
+	row := r.exec.QueryRow(ctx, insertResourceServerSQL,
+		rs.OrbitID,
+		rs.Identifier,
+		rs.Name,
+		rs.Description,
+		rs.AllowedScopes,
+		rs.TokenFormat,
+		rs.AccessTokenTTL,
+		rs.IsActive,
+		rs.Metadata,
+		now,
+		now,
+	)
+
+	if err := row.Scan(&rs.ID, &rs.CreatedAt, &rs.UpdatedAt); err != nil {
+		if pgErr, ok := err.(*pgconn.PgError); ok && pgErr.Code == "23505" {
+			r.logger.Warn().Err(err).Str("identifier", rs.Identifier).Msg("unique constraint violation on create resource server")
+			return nil, err
+		}
+		r.logger.Error().Err(err).Str("identifier", rs.Identifier).Int64("orbit_id", rs.OrbitID).Msg("resource server create failed")
+		return nil, err
+	}
+	r.logger.Info().Int64("resource_server_id", rs.ID).Str("identifier", rs.Identifier).Msg("resource server created")
+	return rs, nil
+}
+
+func (r *ResourceServerRepository) GetByID(ctx context.Context, id int64) (*models.ResourceServer, error) {
+	ctx, span := r.tracer.Start(ctx, "GetByID")
+	defer span.End()
+
+	row := r.exec.QueryRow(ctx, selectResourceServerByIDSQL, id)
+	return scanResourceServerRow(row)
+}
+
+func (r *ResourceServerRepository) GetByIdentifier(ctx context.Context, orbitID int64, identifier string) (*models.ResourceServer, error) {
+	ctx, span := r.tracer.Start(ctx, "GetByIdentifier")
+	defer span.End()
+
+	row := r.exec.QueryRow(ctx, selectResourceServerByIdentifierSQL, orbitID, identifier)
+	return scanResourceServerRow(row)
+}
+
+func (r *ResourceServerRepository) Update(ctx context.Context, rs *models.ResourceServer) (*models.ResourceServer, error) {
+	ctx, span := r.tracer.Start(ctx, "Update")
+	defer span.End()
+
+	now := time.Now().UTC()
+	row := r.exec.QueryRow(ctx, updateResourceServerSQL,
+		rs.ID,
+		rs.OrbitID,
+		rs.Name,
+		rs.Description,
+		rs.AllowedScopes,
+		rs.TokenFormat,
+		rs.AccessTokenTTL,
+		rs.IsActive,
+		rs.Metadata,
+		now,
+	)
+	if err := row.Scan(&rs.UpdatedAt); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		r.logger.Error().Err(err).Int64("resource_server_id", rs.ID).Msg("resource server update failed")
+		return nil, err
+	}
+	return rs, nil
+}
+
+func (r *ResourceServerRepository) Delete(ctx context.Context, id int64) error {
+	ctx, span := r.tracer.Start(ctx, "Delete")
+	defer span.End()
+
+	now := time.Now().UTC()
+	row := r.exec.QueryRow(ctx, softDeleteResourceServerSQL, id, now)
+	var returnedID int64
+	if err := row.Scan(&returnedID); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil
+		}
+		r.logger.Error().Err(err).Int64("resource_server_id", id).Msg("soft delete resource server failed")
+		return err
+	}
+	r.logger.Info().Int64("resource_server_id", id).Msg("resource server soft-deleted")
+	return nil
+}
+
+func (r *ResourceServerRepository) ListByOrbit(ctx context.Context, orbitID int64, page Page) ([]*models.ResourceServer, string, error) {
+	ctx, span := r.tracer.Start(ctx, "ListByOrbit")
+	defer span.End()
+
+	q := newListQuery("resource_servers", resourceServerColumns, "resource_servers").
+		and("orbit_id = ?", orbitID).
+		and("deleted_at IS NULL")
+	servers, next, err := listPage(ctx, r.exec, q, page, scanResourceServerRow, func(rs *models.ResourceServer) int64 { return rs.ID })
+	if err != nil && err != ErrInvalidCursor {
+		r.logger.Error().Err(err).Int64("orbit_id", orbitID).Msg("list resource servers query failed")
+	}
+	return servers, next, err
+}
+
+func scanResourceServerRow(scanner interface{ Scan(dest ...any) error }) (*models.ResourceServer, error) {
+	rs := &models.ResourceServer{}
+	err := scanner.Scan(
+		&rs.ID,
+		&rs.OrbitID,
+		&rs.Identifier,
+		&rs.Name,
+		&rs.Description,
+		&rs.AllowedScopes,
+		&rs.TokenFormat,
+		&rs.AccessTokenTTL,
+		&rs.IsActive,
+		&rs.Metadata,
+		&rs.CreatedAt,
+		&rs.UpdatedAt,
+		&rs.DeletedAt,
+	)
+	if err == pgx.ErrNoRows {
+		return nil, nil
+	}
+	return rs, err
+}
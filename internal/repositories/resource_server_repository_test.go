@@ -0,0 +1,76 @@
+package repositories_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+)
+
+func TestResourceServerRepositoryRoundTrip(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	orbit := fx.Orbit()
+	repo := repositories.NewResourceServerRepository(dbConn.Exec(), nop)
+
+	newServer := func(orbitID int64, identifier string) (*models.ResourceServer, error) {
+		return repo.Create(ctx, &models.ResourceServer{
+			OrbitID:        orbitID,
+			Identifier:     identifier,
+			Name:           "Invoices API",
+			Description:    "Invoices",
+			AllowedScopes:  json.RawMessage(`["invoices:read"]`),
+			TokenFormat:    models.TokenFormatJWT,
+			AccessTokenTTL: 300,
+			IsActive:       true,
+			Metadata:       json.RawMessage(`{}`),
+		})
+	}
+	created, err := newServer(orbit.ID, "https://invoices.example.test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := newServer(orbit.ID, "https://invoices.example.test"); err == nil {
+		t.Fatal("Create accepted a duplicate identifier")
+	}
+	if _, err := newServer(fx.Orbit().ID, "https://invoices.example.test"); err != nil {
+		t.Fatalf("Create of an identifier another orbit uses = %v", err)
+	}
+	other, err := newServer(orbit.ID, "https://reports.example.test")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := repo.GetByIdentifier(ctx, orbit.ID, "https://invoices.example.test")
+	if err != nil || got == nil || got.ID != created.ID || got.AccessTokenTTL != 300 || !jsonEqual(t, got.AllowedScopes, `["invoices:read"]`) {
+		t.Fatalf("GetByIdentifier = %+v, %v", got, err)
+	}
+
+	got.TokenFormat = models.TokenFormatOpaque
+	got.AllowedScopes = json.RawMessage(`["invoices:read","invoices:write"]`)
+	if updated, err := repo.Update(ctx, got); err != nil || updated == nil {
+		t.Fatalf("Update = %+v, %v", updated, err)
+	}
+	got, err = repo.GetByID(ctx, created.ID)
+	if err != nil || got == nil || got.TokenFormat != models.TokenFormatOpaque || !jsonEqual(t, got.AllowedScopes, `["invoices:read","invoices:write"]`) {
+		t.Fatalf("GetByID after update = %+v, %v", got, err)
+	}
+
+	if err := repo.Delete(ctx, created.ID); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := repo.GetByIdentifier(ctx, orbit.ID, "https://invoices.example.test"); err != nil || got != nil {
+		t.Fatalf("GetByIdentifier after delete = %+v, %v, want nil", got, err)
+	}
+	if got, err := repo.GetByID(ctx, created.ID); err != nil || got != nil {
+		t.Fatalf("GetByID after delete = %+v, %v, want nil", got, err)
+	}
+	if updated, err := repo.Update(ctx, created); err != nil || updated != nil {
+		t.Fatalf("Update after delete = %+v, %v, want nil", updated, err)
+	}
+	list, next, err := repo.ListByOrbit(ctx, orbit.ID, repositories.Page{})
+	if err != nil || next != "" || len(list) != 1 || list[0].ID != other.ID {
+		t.Fatalf("ListByOrbit after delete = %+v, %q, %v", list, next, err)
+	}
+}
@@ -0,0 +1,34 @@
+package repositories_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+)
+
+func TestRevokedTokenRepositoryRoundTrip(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	orbit := fx.Orbit()
+	repo := repositories.NewRevokedTokenRepository(dbConn.Exec(), nop)
+
+	expires := time.Now().UTC().Add(time.Hour).Truncate(time.Microsecond)
+	created, err := repo.Create(ctx, &models.RevokedToken{JTI: "jti-1", ExpiresAt: expires, OrbitID: orbit.ID, Reason: "logout"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := repo.GetByJTI(ctx, orbit.ID, "jti-1")
+	if err != nil || got == nil || got.ID != created.ID || got.Reason != "logout" || !got.ExpiresAt.Equal(expires) {
+		t.Fatalf("GetByJTI = %+v, %v", got, err)
+	}
+	// A jti is only revoked in the orbit that issued it.
+	if got, err := repo.GetByJTI(ctx, fx.Orbit().ID, "jti-1"); err != nil || got != nil {
+		t.Fatalf("GetByJTI in another orbit = %+v, %v, want nil", got, err)
+	}
+	if got, err := repo.GetByJTI(ctx, orbit.ID, "jti-2"); err != nil || got != nil {
+		t.Fatalf("GetByJTI of an unknown jti = %+v, %v, want nil", got, err)
+	}
+}
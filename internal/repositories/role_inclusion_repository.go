@@ -0,0 +1,136 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories/db"
+)
+
+// RoleInclusionRepository stores role composition: a role includes the
+// permissions of the roles it includes, and of the roles those include.
+type RoleInclusionRepository struct {
+	exec   db.Executor
+	logger zerolog.Logger
+	tracer trace.Tracer
+}
+
+func NewRoleInclusionRepository(exec db.Executor, logger zerolog.Logger) *RoleInclusionRepository {
+	return &RoleInclusionRepository{
+		exec:   exec,
+		logger: logger,
+		tracer: otel.Tracer("repository.role_inclusion"),
+	}
+}
+
+const (
+	insertRoleInclusionSQL = `
+		INSERT INTO role_inclusions (role_id, included_role_id, created_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT DO NOTHING
+	`
+
+	deleteRoleInclusionSQL = `
+		DELETE FROM role_inclusions
+		WHERE role_id = $1 AND included_role_id = $2
+	`
+
+	listIncludedRolesSQL = `
+		SELECT r.id, r.orbit_id, r.name, r.metadata, r.created_at
+		FROM role_inclusions ri
+		JOIN roles r ON r.id = ri.included_role_id
+		WHERE ri.role_id = $1
+		ORDER BY r.id
+	`
+
+	// UNION rather than UNION ALL stops at roles already reached, so the
+	// walk ends even if the table holds a cycle.
+	roleReachesSQL = `
+		WITH RECURSIVE reached (role_id) AS (
+			SELECT $1::BIGINT
+			UNION
+			SELECT ri.included_role_id
+			FROM role_inclusions ri
+			JOIN reached ON reached.role_id = ri.role_id
+		)
+		SELECT EXISTS (SELECT 1 FROM reached WHERE role_id = $2)
+	`
+
+	// Serializes changes to the role graph of an orbit, so that two
+	// inclusions checked at the same time cannot close a cycle together.
+	lockRoleGraphSQL = `
+		SELECT pg_advisory_xact_lock(hashtextextended('role_inclusions:' || $1::TEXT, 0))
+	`
+)
+
+// Include makes roleID include includedRoleID. Callers must rule out cycles
+// first with Reaches, under LockOrbit.
+func (r *RoleInclusionRepository) Include(ctx context.Context, roleID, includedRoleID int64) error {
+	ctx, span := r.tracer.Start(ctx, "Include")
+	defer span.End()
+
+	_, err := r.exec.Exec(ctx, insertRoleInclusionSQL, roleID, includedRoleID, time.Now().UTC())
+	if err != nil {
+		r.logger.Error().Err(err).Int64("role_id", roleID).Int64("included_role_id", includedRoleID).Msg("include role failed")
+	}
+	return err
+}
+
+func (r *RoleInclusionRepository) Exclude(ctx context.Context, roleID, includedRoleID int64) error {
+	ctx, span := r.tracer.Start(ctx, "Exclude")
+	defer span.End()
+
+	_, err := r.exec.Exec(ctx, deleteRoleInclusionSQL, roleID, includedRoleID)
+	if err != nil {
+		r.logger.Error().Err(err).Int64("role_id", roleID).Int64("included_role_id", includedRoleID).Msg("exclude role failed")
+	}
+	return err
+}
+
+// ListIncluded returns the roles a role includes directly.
+func (r *RoleInclusionRepository) ListIncluded(ctx context.Context, roleID int64) ([]*models.Role, error) {
+	ctx, span := r.tracer.Start(ctx, "ListIncluded")
+	defer span.End()
+
+	rows, err := r.exec.Query(ctx, listIncludedRolesSQL, roleID)
+	if err != nil {
+		r.logger.Error().Err(err).Int64("role_id", roleID).Msg("list included roles query failed")
+		return nil, err
+	}
+	defer rows.Close()
+
+	roles := []*models.Role{}
+	for rows.Next() {
+		role, err := scanRole(rows)
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, role)
+	}
+	return roles, rows.Err()
+}
+
+// Reaches reports whether fromRoleID is toRoleID or includes it, directly or
+// through other roles.
+func (r *RoleInclusionRepository) Reaches(ctx context.Context, fromRoleID, toRoleID int64) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "Reaches")
+	defer span.End()
+
+	var reaches bool
+	err := r.exec.QueryRow(ctx, roleReachesSQL, fromRoleID, toRoleID).Scan(&reaches)
+	return reaches, err
+}
+
+// LockOrbit holds the role graph of an orbit until the transaction ends.
+func (r *RoleInclusionRepository) LockOrbit(ctx context.Context, orbitID int64) error {
+	ctx, span := r.tracer.Start(ctx, "LockOrbit")
+	defer span.End()
+
+	_, err := r.exec.Exec(ctx, lockRoleGraphSQL, orbitID)
+	return err
+}
@@ -0,0 +1,78 @@
+package repositories_test
+
+import (
+	"testing"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+)
+
+func TestRoleInclusionRepositoryInheritance(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	orbit := fx.Orbit()
+	user := fx.User(orbit)
+	roles := repositories.NewRoleRepository(dbConn.Exec(), nop)
+	permissions := repositories.NewPermissionRepository(dbConn.Exec(), nop)
+	grants := repositories.NewRolePermissionRepository(dbConn.Exec(), nop)
+	inclusions := repositories.NewRoleInclusionRepository(dbConn.Exec(), nop)
+	groups := repositories.NewGroupRepository(dbConn.Exec(), nop)
+
+	role := func(name, permission string) *models.Role {
+		t.Helper()
+		r, err := roles.Create(ctx, &models.Role{OrbitID: orbit.ID, Name: name})
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := permissions.Create(ctx, &models.Permission{OrbitID: orbit.ID, Name: permission})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := grants.Assign(ctx, r.ID, p.ID); err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+	admin := role("admin", "c:write")
+	editor := role("editor", "b:write")
+	viewer := role("viewer", "a:read")
+
+	// admin includes editor, which includes viewer.
+	if err := inclusions.Include(ctx, admin.ID, editor.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := inclusions.Include(ctx, editor.ID, viewer.ID); err != nil {
+		t.Fatal(err)
+	}
+	if reaches, err := inclusions.Reaches(ctx, admin.ID, viewer.ID); err != nil || !reaches {
+		t.Fatalf("Reaches(admin, viewer) = %v, %v, want true", reaches, err)
+	}
+	if reaches, err := inclusions.Reaches(ctx, viewer.ID, admin.ID); err != nil || reaches {
+		t.Fatalf("Reaches(viewer, admin) = %v, %v, want false", reaches, err)
+	}
+
+	// The user gets editor through a group.
+	group, err := groups.Create(ctx, &models.Group{OrbitID: orbit.ID, Name: "editors"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := repositories.NewGroupMemberRepository(dbConn.Exec(), nop).Add(ctx, group.ID, user.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := repositories.NewGroupRoleRepository(dbConn.Exec(), nop).Assign(ctx, group.ID, editor.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	held, err := roles.ListHeldByUser(ctx, orbit.ID, user.ID)
+	if err != nil || len(held) != 2 || held[0].ID != editor.ID || held[1].ID != viewer.ID {
+		t.Fatalf("ListHeldByUser = %+v, %v, want editor and viewer", held, err)
+	}
+	list, err := repositories.NewUserRoleRepository(dbConn.Exec(), nop).ListPermissions(ctx, orbit.ID, user.ID)
+	if err != nil || len(list) != 2 || list[0].Name != "a:read" || list[1].Name != "b:write" {
+		t.Fatalf("ListPermissions = %+v, %v, want a:read and b:write", list, err)
+	}
+	members, _, err := repositories.NewUserRepository(dbConn.Exec(), nop).ListByGroup(ctx, group.ID, repositories.Page{})
+	if err != nil || len(members) != 1 || members[0].ID != user.ID {
+		t.Fatalf("ListByGroup = %+v, %v", members, err)
+	}
+}
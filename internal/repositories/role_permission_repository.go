@@ -27,6 +27,8 @@ func NewRolePermissionRepository(exec db.Executor, logger zerolog.Logger) *RoleP
 }
 
 const (
+	rolePermissionColumns = `p.id, p.orbit_id, p.name, p.metadata, p.created_at`
+
 	assignPermissionToRoleSQL = `
 		INSERT INTO role_permissions (role_id, permission_id, created_at)
 		VALUES ($1, $2, $3)
@@ -37,15 +39,6 @@ const (
 		DELETE FROM role_permissions
 		WHERE role_id = $1 AND permission_id = $2
 	`
-
-	listPermissionsByRoleSQL = `
-		SELECT p.id, p.orbit_id, p.name, p.metadata, p.created_at
-		FROM role_permissions rp
-		JOIN permissions p ON rp.permission_id = p.id
-		WHERE rp.role_id = $1
-		ORDER BY p.id
-		LIMIT $2 OFFSET $3
-	`
 )
 
 func (r *RolePermissionRepository) Assign(ctx context.Context, roleID, permissionID int64) error {
@@ -70,28 +63,16 @@ func (r *RolePermissionRepository) Revoke(ctx context.Context, roleID, permissio
 	return err
 }
 
-func (r *RolePermissionRepository) ListPermissionsByRole(ctx context.Context, roleID int64, limit, offset int) ([]*models.Permission, error) {
+func (r *RolePermissionRepository) ListPermissionsByRole(ctx context.Context, roleID int64, page Page) ([]*models.Permission, string, error) {
 	ctx, span := r.tracer.Start(ctx, "ListPermissionsByRole")
 	defer span.End()
 
-	rows, err := r.exec.Query(ctx, listPermissionsByRoleSQL, roleID, limit, offset)
-	if err != nil {
+	q := newListQuery("role_permissions", rolePermissionColumns, "role_permissions rp JOIN permissions p ON rp.permission_id = p.id").
+		orderBy("p.id").
+		and("rp.role_id = ?", roleID)
+	perms, next, err := listPage(ctx, r.exec, q, page, scanPermission, func(p *models.Permission) int64 { return p.ID })
+	if err != nil && err != ErrInvalidCursor {
 		r.logger.Error().Err(err).Int64("role_id", roleID).Msg("list permissions by role query failed")
-		return nil, err
-	}
-	defer rows.Close()
-
-	var result []*models.Permission
-	for rows.Next() {
-		p := &models.Permission{}
-		if err := rows.Scan(&p.ID, &p.OrbitID, &p.Name, &p.Metadata, &p.CreatedAt); err != nil {
-			r.logger.Error().Err(err).Int64("role_id", roleID).Msg("scan permission row failed")
-			return nil, err
-		}
-		result = append(result, p)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
 	}
-	return result, nil
+	return perms, next, err
 }
@@ -0,0 +1,73 @@
+package repositories_test
+
+import (
+	"testing"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+)
+
+func TestRolePermissionRepositoryAssign(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	orbit := fx.Orbit()
+	roles := repositories.NewRoleRepository(dbConn.Exec(), nop)
+	permissions := repositories.NewPermissionRepository(dbConn.Exec(), nop)
+	repo := repositories.NewRolePermissionRepository(dbConn.Exec(), nop)
+
+	role, err := roles.Create(ctx, &models.Role{OrbitID: orbit.ID, Name: "auditor", Metadata: map[string]any{"builtin": true}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var perms []*models.Permission
+	for _, name := range []string{"audit:read", "audit:export"} {
+		p, err := permissions.Create(ctx, &models.Permission{OrbitID: orbit.ID, Name: name})
+		if err != nil {
+			t.Fatal(err)
+		}
+		perms = append(perms, p)
+	}
+
+	for _, p := range perms {
+		if err := repo.Assign(ctx, role.ID, p.ID); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := repo.Assign(ctx, role.ID, perms[0].ID); err != nil {
+		t.Fatalf("repeated Assign = %v, want no error", err)
+	}
+	list, _, err := repo.ListPermissionsByRole(ctx, role.ID, repositories.Page{})
+	if err != nil || len(list) != 2 || list[0].Name != "audit:read" {
+		t.Fatalf("ListPermissionsByRole = %+v, %v", list, err)
+	}
+	first, next, err := repo.ListPermissionsByRole(ctx, role.ID, repositories.Page{Limit: 1})
+	if err != nil || len(first) != 1 || first[0].ID != perms[0].ID || next == "" {
+		t.Fatalf("first page = %+v, %q, %v", first, next, err)
+	}
+	rest, next, err := repo.ListPermissionsByRole(ctx, role.ID, repositories.Page{Limit: 1, Cursor: next})
+	if err != nil || len(rest) != 1 || rest[0].ID != perms[1].ID || next != "" {
+		t.Fatalf("second page = %+v, %q, %v", rest, next, err)
+	}
+
+	if err := repo.Revoke(ctx, role.ID, perms[0].ID); err != nil {
+		t.Fatal(err)
+	}
+	list, _, err = repo.ListPermissionsByRole(ctx, role.ID, repositories.Page{})
+	if err != nil || len(list) != 1 || list[0].ID != perms[1].ID {
+		t.Fatalf("ListPermissionsByRole after Revoke = %+v, %v", list, err)
+	}
+
+	// Deleting a permission removes its assignments.
+	if err := permissions.Delete(ctx, perms[1].ID); err != nil {
+		t.Fatal(err)
+	}
+	list, _, err = repo.ListPermissionsByRole(ctx, role.ID, repositories.Page{})
+	if err != nil || len(list) != 0 {
+		t.Fatalf("ListPermissionsByRole after Delete = %+v, %v", list, err)
+	}
+
+	got, err := roles.GetByID(ctx, role.ID)
+	if err != nil || got == nil || got.Metadata["builtin"] != true {
+		t.Fatalf("GetByID = %+v, %v", got, err)
+	}
+}
@@ -28,6 +28,10 @@ func NewRoleRepository(exec db.Executor, logger zerolog.Logger) *RoleRepository
 }
 
 const (
+	roleColumns = `
+		id, orbit_id, name, metadata, created_at
+	`
+
 	insertRoleSQL = `
 		INSERT INTO roles (orbit_id, name, metadata, created_at)
 		VALUES ($1, $2, $3, $4)
@@ -40,18 +44,47 @@ const (
 		WHERE id = $1
 	`
 
-	listRolesByOrbitSQL = `
-		SELECT id, orbit_id, name, metadata, created_at
-		FROM roles
-		WHERE orbit_id = $1
-		ORDER BY id
-		LIMIT $2 OFFSET $3
-	`
-
 	deleteRoleSQL = `
 		DELETE FROM roles
 		WHERE id = $1
 	`
+
+	selectRolesByUserSQL = `
+		SELECT r.id, r.orbit_id, r.name, r.metadata, r.created_at
+		FROM user_roles ur
+		JOIN roles r ON r.id = ur.role_id
+		WHERE ur.user_id = $1 AND r.orbit_id = $2
+		ORDER BY r.id
+	`
+
+	// heldRolesCTE names held the roles user $1 is assigned directly or
+	// through its groups, together with every role those include, however
+	// deep. UNION stops at roles already reached, so a cycle cannot loop.
+	// Queries using it filter on the orbit themselves.
+	heldRolesCTE = `
+		WITH RECURSIVE held (role_id) AS (
+			SELECT role_id
+			FROM user_roles
+			WHERE user_id = $1
+			UNION
+			SELECT gr.role_id
+			FROM group_members gm
+			JOIN group_roles gr ON gr.group_id = gm.group_id
+			WHERE gm.user_id = $1
+			UNION
+			SELECT ri.included_role_id
+			FROM role_inclusions ri
+			JOIN held ON held.role_id = ri.role_id
+		)
+	`
+
+	selectHeldRolesByUserSQL = heldRolesCTE + `
+		SELECT r.id, r.orbit_id, r.name, r.metadata, r.created_at
+		FROM held
+		JOIN roles r ON r.id = held.role_id
+		WHERE r.orbit_id = $2
+		ORDER BY r.name
+	`
 )
 
 func (r *RoleRepository) Create(ctx context.Context, role *models.Role) (*models.Role, error) {
@@ -80,25 +113,59 @@ func (r *RoleRepository) GetByID(ctx context.Context, id int64) (*models.Role, e
 	return scanRole(row)
 }
 
-func (r *RoleRepository) ListByOrbit(ctx context.Context, orbitID int64, limit, offset int) ([]*models.Role, error) {
+func (r *RoleRepository) ListByOrbit(ctx context.Context, orbitID int64, page Page) ([]*models.Role, string, error) {
 	ctx, span := r.tracer.Start(ctx, "ListByOrbit")
 	defer span.End()
 
-	rows, err := r.exec.Query(ctx, listRolesByOrbitSQL, orbitID, limit, offset)
+	q := newListQuery("roles", roleColumns, "roles").and("orbit_id = ?", orbitID)
+	return listPage(ctx, r.exec, q, page, scanRole, func(role *models.Role) int64 { return role.ID })
+}
+
+// ListByUser returns the roles assigned to a user in an orbit.
+func (r *RoleRepository) ListByUser(ctx context.Context, orbitID, userID int64) ([]*models.Role, error) {
+	ctx, span := r.tracer.Start(ctx, "ListByUser")
+	defer span.End()
+
+	rows, err := r.exec.Query(ctx, selectRolesByUserSQL, userID, orbitID)
+	if err != nil {
+		r.logger.Error().Err(err).Int64("user_id", userID).Msg("list roles by user query failed")
+		return nil, err
+	}
+	defer rows.Close()
+
+	var roles []*models.Role
+	for rows.Next() {
+		role, err := scanRole(rows)
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, role)
+	}
+	return roles, rows.Err()
+}
+
+// ListHeldByUser returns the roles a user holds in an orbit, directly,
+// through groups or by inclusion, sorted by name.
+func (r *RoleRepository) ListHeldByUser(ctx context.Context, orbitID, userID int64) ([]*models.Role, error) {
+	ctx, span := r.tracer.Start(ctx, "ListHeldByUser")
+	defer span.End()
+
+	rows, err := r.exec.Query(ctx, selectHeldRolesByUserSQL, userID, orbitID)
 	if err != nil {
+		r.logger.Error().Err(err).Int64("user_id", userID).Msg("list held roles by user query failed")
 		return nil, err
 	}
 	defer rows.Close()
 
-	var result []*models.Role
+	roles := []*models.Role{}
 	for rows.Next() {
 		role, err := scanRole(rows)
 		if err != nil {
 			return nil, err
 		}
-		result = append(result, role)
+		roles = append(roles, role)
 	}
-	return result, rows.Err()
+	return roles, rows.Err()
 }
 
 func (r *RoleRepository) Delete(ctx context.Context, id int64) error {
@@ -0,0 +1,61 @@
+package repositories_test
+
+import (
+	"testing"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+)
+
+func TestRoleRepositoryRoundTrip(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	orbit := fx.Orbit()
+	user := fx.User(orbit)
+	repo := repositories.NewRoleRepository(dbConn.Exec(), nop)
+	userRoles := repositories.NewUserRoleRepository(dbConn.Exec(), nop)
+
+	var created []*models.Role
+	for _, name := range []string{"admin", "auditor", "viewer"} {
+		role, err := repo.Create(ctx, &models.Role{OrbitID: orbit.ID, Name: name, Metadata: map[string]any{}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		created = append(created, role)
+	}
+	foreign, err := repo.Create(ctx, &models.Role{OrbitID: fx.Orbit().ID, Name: "admin"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := repo.GetByID(ctx, created[1].ID)
+	if err != nil || got == nil || got.Name != "auditor" || got.OrbitID != orbit.ID {
+		t.Fatalf("GetByID = %+v, %v", got, err)
+	}
+	list, next, err := repo.ListByOrbit(ctx, orbit.ID, repositories.Page{})
+	if err != nil || len(list) != 3 || next != "" {
+		t.Fatalf("ListByOrbit = %d roles, %q, %v, want 3", len(list), next, err)
+	}
+
+	// Roles of another orbit never show up, even when assigned.
+	for _, role := range []*models.Role{created[2], created[0], foreign} {
+		if err := userRoles.Assign(ctx, user.ID, role.ID); err != nil {
+			t.Fatal(err)
+		}
+	}
+	held, err := repo.ListByUser(ctx, orbit.ID, user.ID)
+	if err != nil || len(held) != 2 || held[0].ID != created[0].ID || held[1].ID != created[2].ID {
+		t.Fatalf("ListByUser = %+v, %v", held, err)
+	}
+
+	if err := repo.Delete(ctx, created[0].ID); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := repo.GetByID(ctx, created[0].ID); err != nil || got != nil {
+		t.Fatalf("GetByID after delete = %+v, %v, want nil", got, err)
+	}
+	held, err = repo.ListByUser(ctx, orbit.ID, user.ID)
+	if err != nil || len(held) != 1 || held[0].ID != created[2].ID {
+		t.Fatalf("ListByUser after delete = %+v, %v", held, err)
+	}
+}
@@ -28,6 +28,10 @@ func NewScopeRepository(exec db.Executor, logger zerolog.Logger) *ScopeRepositor
 }
 
 const (
+	scopeColumns = `
+		id, orbit_id, name, description, is_default, is_active, metadata, created_at, updated_at, deleted_at
+	`
+
 	insertScopeSQL = `
 		INSERT INTO scopes (orbit_id, name, description, is_default, is_active, metadata, created_at, updated_at)
 		VALUES ($1,$2,$3,$4,$5,$6,$7,$8)
@@ -60,12 +64,11 @@ const (
 		RETURNING id
 	`
 
-	listScopesByOrbitSQL = `
-		SELECT id, orbit_id, name, description, is_default, is_active, metadata, created_at, updated_at, deleted_at
+	lockScopeSQL = `
+		SELECT updated_at
 		FROM scopes
-		WHERE orbit_id = $1 AND deleted_at IS NULL
-		ORDER BY id
-		LIMIT $2 OFFSET $3
+		WHERE id = $1 AND deleted_at IS NULL
+		FOR UPDATE
 	`
 )
 
@@ -130,6 +133,23 @@ func (r *ScopeRepository) Update(ctx context.Context, s *models.Scope) (*models.
 	return s, nil
 }
 
+// LockVersion locks the scope until the transaction ends and returns when it
+// was last updated, or nil when there is no such scope.
+func (r *ScopeRepository) LockVersion(ctx context.Context, id int64) (*time.Time, error) {
+	ctx, span := r.tracer.Start(ctx, "LockVersion")
+	defer span.End()
+
+	var updatedAt time.Time
+	if err := r.exec.QueryRow(ctx, lockScopeSQL, id).Scan(&updatedAt); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		r.logger.Error().Err(err).Int64("scope_id", id).Msg("scope lock failed")
+		return nil, err
+	}
+	return &updatedAt, nil
+}
+
 func (r *ScopeRepository) SoftDelete(ctx context.Context, id int64) error {
 	ctx, span := r.tracer.Start(ctx, "SoftDelete")
 	defer span.End()
@@ -147,29 +167,18 @@ func (r *ScopeRepository) SoftDelete(ctx context.Context, id int64) error {
 	return nil
 }
 
-func (r *ScopeRepository) ListByOrbit(ctx context.Context, orbitID int64, limit, offset int) ([]*models.Scope, error) {
+func (r *ScopeRepository) ListByOrbit(ctx context.Context, orbitID int64, page Page) ([]*models.Scope, string, error) {
 	ctx, span := r.tracer.Start(ctx, "ListByOrbit")
 	defer span.End()
 
-	rows, err := r.exec.Query(ctx, listScopesByOrbitSQL, orbitID, limit, offset)
-	if err != nil {
+	q := newListQuery("scopes", scopeColumns, "scopes").
+		and("orbit_id = ?", orbitID).
+		and("deleted_at IS NULL")
+	scopes, next, err := listPage(ctx, r.exec, q, page, scanScopeRow, func(sc *models.Scope) int64 { return sc.ID })
+	if err != nil && err != ErrInvalidCursor {
 		r.logger.Error().Err(err).Int64("orbit_id", orbitID).Msg("list scopes query failed")
-		return nil, err
-	}
-	defer rows.Close()
-
-	var result []*models.Scope
-	for rows.Next() {
-		s, err := scanScopeRow(rows)
-		if err != nil {
-			return nil, err
-		}
-		result = append(result, s)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
 	}
-	return result, nil
+	return scopes, next, err
 }
 
 func scanScopeRow(scanner interface{ Scan(dest ...any) error }) (*models.Scope, error) {
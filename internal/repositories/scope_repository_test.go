@@ -0,0 +1,60 @@
+package repositories_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+)
+
+func TestScopeRepositorySoftDeleteFreesName(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	orbit := fx.Orbit()
+	repo := repositories.NewScopeRepository(dbConn.Exec(), nop)
+
+	newScope := func() (*models.Scope, error) {
+		return repo.Create(ctx, &models.Scope{
+			OrbitID:     orbit.ID,
+			Name:        "read:invoices",
+			Description: "Read invoices",
+			IsActive:    true,
+			Metadata:    json.RawMessage(`{}`),
+		})
+	}
+	first, err := newScope()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := newScope(); err == nil {
+		t.Fatal("Create accepted a duplicate name")
+	}
+
+	got, err := repo.GetByName(ctx, orbit.ID, "read:invoices")
+	if err != nil || got == nil || got.ID != first.ID {
+		t.Fatalf("GetByName = %+v, %v", got, err)
+	}
+	got.IsDefault = true
+	if updated, err := repo.Update(ctx, got); err != nil || updated == nil {
+		t.Fatalf("Update = %+v, %v", updated, err)
+	}
+
+	if err := repo.SoftDelete(ctx, first.ID); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := repo.GetByID(ctx, first.ID); err != nil || got != nil {
+		t.Fatalf("GetByID after delete = %+v, %v, want nil", got, err)
+	}
+	second, err := newScope()
+	if err != nil {
+		t.Fatalf("Create after delete = %v, want the name to be free again", err)
+	}
+	list, _, err := repo.ListByOrbit(ctx, orbit.ID, repositories.Page{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 1 || list[0].ID != second.ID || list[0].IsDefault {
+		t.Fatalf("ListByOrbit = %+v", list)
+	}
+}
@@ -27,6 +27,10 @@ func NewSecurityEventRepository(exec db.Executor, logger zerolog.Logger) *Securi
 }
 
 const (
+	securityEventColumns = `
+		id, orbit_id, user_id, event_type, severity, metadata, created_at
+	`
+
 	insertSecurityEventSQL = `
 		INSERT INTO security_events (
 			orbit_id, user_id, event_type, severity, metadata, created_at
@@ -34,14 +38,6 @@ const (
 		VALUES ($1,$2,$3,$4,$5,$6)
 		RETURNING id
 	`
-
-	listSecurityEventsByOrbitSQL = `
-		SELECT id, orbit_id, user_id, event_type, severity, metadata, created_at
-		FROM security_events
-		WHERE orbit_id = $1
-		ORDER BY id DESC
-		LIMIT $2 OFFSET $3
-	`
 )
 
 func (r *SecurityEventRepository) Create(ctx context.Context, e *models.SecurityEvent) (*models.SecurityEvent, error) {
@@ -64,31 +60,46 @@ func (r *SecurityEventRepository) Create(ctx context.Context, e *models.Security
 	return e, nil
 }
 
-func (r *SecurityEventRepository) ListByOrbit(ctx context.Context, orbitID int64, limit, offset int) ([]*models.SecurityEvent, error) {
+// SecurityEventFilter narrows ListByOrbit. Zero fields do not filter.
+type SecurityEventFilter struct {
+	EventType string
+	UserID    *int64
+	Since     *time.Time
+	Until     *time.Time
+}
+
+// ListByOrbit returns the newest events first.
+func (r *SecurityEventRepository) ListByOrbit(ctx context.Context, orbitID int64, filter SecurityEventFilter, page Page) ([]*models.SecurityEvent, string, error) {
 	ctx, span := r.tracer.Start(ctx, "ListByOrbit")
 	defer span.End()
 
-	rows, err := r.exec.Query(ctx, listSecurityEventsByOrbitSQL, orbitID, limit, offset)
-	if err != nil {
-		return nil, err
+	q := newListQuery("security_events", securityEventColumns, "security_events").newest().
+		and("orbit_id = ?", orbitID)
+	if filter.EventType != "" {
+		q.and("event_type = ?", filter.EventType)
 	}
-	defer rows.Close()
-
-	var result []*models.SecurityEvent
-	for rows.Next() {
-		e := &models.SecurityEvent{}
-		if err := rows.Scan(
-			&e.ID,
-			&e.OrbitID,
-			&e.UserID,
-			&e.EventType,
-			&e.Severity,
-			&e.Metadata,
-			&e.CreatedAt,
-		); err != nil {
-			return nil, err
-		}
-		result = append(result, e)
+	if filter.UserID != nil {
+		q.and("user_id = ?", *filter.UserID)
+	}
+	if filter.Since != nil {
+		q.and("created_at >= ?", *filter.Since)
+	}
+	if filter.Until != nil {
+		q.and("created_at < ?", *filter.Until)
 	}
-	return result, rows.Err()
+	return listPage(ctx, r.exec, q, page, scanSecurityEventRow, func(e *models.SecurityEvent) int64 { return e.ID })
+}
+
+func scanSecurityEventRow(scanner interface{ Scan(dest ...any) error }) (*models.SecurityEvent, error) {
+	e := &models.SecurityEvent{}
+	err := scanner.Scan(
+		&e.ID,
+		&e.OrbitID,
+		&e.UserID,
+		&e.EventType,
+		&e.Severity,
+		&e.Metadata,
+		&e.CreatedAt,
+	)
+	return e, err
 }
@@ -0,0 +1,57 @@
+package repositories_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+)
+
+func TestSecurityEventRepositoryFilters(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	orbit := fx.Orbit()
+	user := fx.User(orbit)
+	repo := repositories.NewSecurityEventRepository(dbConn.Exec(), nop)
+
+	start := time.Now().UTC()
+	var created []*models.SecurityEvent
+	for _, e := range []*models.SecurityEvent{
+		{OrbitID: orbit.ID, EventType: models.SecurityEventRecoveryCodesGenerated, Severity: models.SecuritySeverityInfo, UserID: &user.ID},
+		{OrbitID: orbit.ID, EventType: models.SecurityEventSoftwareStatementRejected, Severity: models.SecuritySeverityWarning, Metadata: map[string]any{"issuer": "https://directory.example.test"}},
+		{OrbitID: orbit.ID, EventType: models.SecurityEventRecoveryCodesGenerated, Severity: models.SecuritySeverityInfo, UserID: &user.ID},
+		{OrbitID: fx.Orbit().ID, EventType: models.SecurityEventRecoveryCodesGenerated, Severity: models.SecuritySeverityInfo},
+	} {
+		got, err := repo.Create(ctx, e)
+		if err != nil {
+			t.Fatal(err)
+		}
+		created = append(created, got)
+	}
+
+	// Newest first, one orbit only.
+	list, next, err := repo.ListByOrbit(ctx, orbit.ID, repositories.SecurityEventFilter{}, repositories.Page{Limit: 2})
+	if err != nil || len(list) != 2 || next == "" || list[0].ID != created[2].ID || list[1].ID != created[1].ID {
+		t.Fatalf("first page = %+v, %q, %v", list, next, err)
+	}
+	if list[1].Metadata["issuer"] != "https://directory.example.test" || list[1].UserID != nil {
+		t.Fatalf("event read back as %+v", list[1])
+	}
+	list, next, err = repo.ListByOrbit(ctx, orbit.ID, repositories.SecurityEventFilter{}, repositories.Page{Limit: 2, Cursor: next})
+	if err != nil || len(list) != 1 || next != "" || list[0].ID != created[0].ID {
+		t.Fatalf("second page = %+v, %q, %v", list, next, err)
+	}
+
+	list, _, err = repo.ListByOrbit(ctx, orbit.ID, repositories.SecurityEventFilter{
+		EventType: models.SecurityEventRecoveryCodesGenerated,
+		UserID:    &user.ID,
+		Since:     &start,
+	}, repositories.Page{})
+	if err != nil || len(list) != 2 {
+		t.Fatalf("filtered events = %d, %v, want 2", len(list), err)
+	}
+	if list, _, err := repo.ListByOrbit(ctx, orbit.ID, repositories.SecurityEventFilter{Until: &start}, repositories.Page{}); err != nil || len(list) != 0 {
+		t.Fatalf("events before the first = %d, %v, want 0", len(list), err)
+	}
+}
@@ -27,6 +27,8 @@ func NewSessionRepository(exec db.Executor, logger zerolog.Logger) *SessionRepos
 }
 
 const (
+	sessionColumns = `id, orbit_id, user_id, client_id, started_at, last_active_at, expires_at, revoked, device_info, ip, metadata, created_at, updated_at`
+
 	insertSessionSQL = `
 		INSERT INTO sessions
 			(orbit_id, user_id, client_id, started_at, last_active_at, expires_at, revoked, device_info, ip, metadata, created_at, updated_at)
@@ -49,6 +51,12 @@ const (
 		RETURNING id, updated_at
 	`
 
+	touchSessionSQL = `
+		UPDATE sessions
+		SET last_active_at = $2, updated_at = $2
+		WHERE id = $1 AND last_active_at < $2
+	`
+
 	revokeSessionSQL = `
 		UPDATE sessions
 		SET revoked = TRUE, updated_at = $2
@@ -56,12 +64,24 @@ const (
 		RETURNING id
 	`
 
-	listSessionsByUserSQL = `
+	revokeSessionsByUserSQL = `
+		UPDATE sessions
+		SET revoked = TRUE, updated_at = $2
+		WHERE user_id = $1 AND revoked = FALSE
+	`
+
+	revokeSessionsByUserExceptSQL = `
+		UPDATE sessions
+		SET revoked = TRUE, updated_at = $3
+		WHERE user_id = $1 AND id <> $2 AND revoked = FALSE
+		RETURNING id
+	`
+
+	listActiveSessionsByUserSQL = `
 		SELECT id, orbit_id, user_id, client_id, started_at, last_active_at, expires_at, revoked, device_info, ip, metadata, created_at, updated_at
 		FROM sessions
-		WHERE user_id = $1
-		ORDER BY id ASC
-		LIMIT $2 OFFSET $3
+		WHERE user_id = $1 AND revoked = FALSE AND (expires_at IS NULL OR expires_at > $2)
+		ORDER BY last_active_at DESC, id DESC
 	`
 )
 
@@ -155,6 +175,19 @@ func (r *SessionRepository) Update(ctx context.Context, s *models.Session) (*mod
 	return s, nil
 }
 
+// Touch moves the session's last activity forward to at. An older at, as
+// written by a request that lost a race, leaves the session alone.
+func (r *SessionRepository) Touch(ctx context.Context, id int64, at time.Time) error {
+	ctx, span := r.tracer.Start(ctx, "Touch")
+	defer span.End()
+
+	if _, err := r.exec.Exec(ctx, touchSessionSQL, id, at); err != nil {
+		r.logger.Error().Err(err).Int64("session_id", id).Msg("touch session failed")
+		return err
+	}
+	return nil
+}
+
 func (r *SessionRepository) Revoke(ctx context.Context, id int64) error {
 	ctx, span := r.tracer.Start(ctx, "Revoke")
 	defer span.End()
@@ -172,35 +205,81 @@ func (r *SessionRepository) Revoke(ctx context.Context, id int64) error {
 	return nil
 }
 
-func (r *SessionRepository) ListByUser(ctx context.Context, userID int64, limit, offset int) ([]*models.Session, error) {
-	ctx, span := r.tracer.Start(ctx, "ListByUser")
+// RevokeByUser signs the user out everywhere and reports how many sessions
+// were still live.
+func (r *SessionRepository) RevokeByUser(ctx context.Context, userID int64) (int64, error) {
+	ctx, span := r.tracer.Start(ctx, "RevokeByUser")
 	defer span.End()
 
-	rows, err := r.exec.Query(ctx, listSessionsByUserSQL, userID, limit, offset)
+	tag, err := r.exec.Exec(ctx, revokeSessionsByUserSQL, userID, time.Now().UTC())
 	if err != nil {
-		r.logger.Error().Err(err).Int64("user_id", userID).Msg("list sessions query failed")
+		r.logger.Error().Err(err).Int64("user_id", userID).Msg("revoke sessions failed")
+		return 0, err
+	}
+	return tag.RowsAffected(), nil
+}
+
+// RevokeByUserExcept signs the user out of every session but keepID and
+// returns the ids of the sessions it revoked. A keepID of zero keeps none.
+func (r *SessionRepository) RevokeByUserExcept(ctx context.Context, userID, keepID int64) ([]int64, error) {
+	ctx, span := r.tracer.Start(ctx, "RevokeByUserExcept")
+	defer span.End()
+
+	rows, err := r.exec.Query(ctx, revokeSessionsByUserExceptSQL, userID, keepID, time.Now().UTC())
+	if err != nil {
+		r.logger.Error().Err(err).Int64("user_id", userID).Msg("revoke sessions failed")
 		return nil, err
 	}
 	defer rows.Close()
 
+	var ids []int64
+	for rows.Next() {
+		var id int64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// ListActiveByUser returns the user's sessions that are neither revoked nor
+// expired at now, most recently active first.
+func (r *SessionRepository) ListActiveByUser(ctx context.Context, userID int64, now time.Time) ([]*models.Session, error) {
+	ctx, span := r.tracer.Start(ctx, "ListActiveByUser")
+	defer span.End()
+
+	rows, err := r.exec.Query(ctx, listActiveSessionsByUserSQL, userID, now)
+	if err != nil {
+		r.logger.Error().Err(err).Int64("user_id", userID).Msg("list active sessions query failed")
+		return nil, err
+	}
+	return scanSessions(rows)
+}
+
+func (r *SessionRepository) ListByUser(ctx context.Context, userID int64, page Page) ([]*models.Session, string, error) {
+	ctx, span := r.tracer.Start(ctx, "ListByUser")
+	defer span.End()
+
+	q := newListQuery("sessions", sessionColumns, "sessions").
+		and("user_id = ?", userID)
+	sessions, next, err := listPage(ctx, r.exec, q, page, scanSessionRow, func(s *models.Session) int64 { return s.ID })
+	if err != nil && err != ErrInvalidCursor {
+		r.logger.Error().Err(err).Int64("user_id", userID).Msg("list sessions query failed")
+	}
+	return sessions, next, err
+}
+
+func scanSessions(rows pgx.Rows) ([]*models.Session, error) {
+	defer rows.Close()
+
 	var sessions []*models.Session
 	for rows.Next() {
-		s := &models.Session{}
-		if err := rows.Scan(
-			&s.ID,
-			&s.OrbitID,
-			&s.UserID,
-			&s.ClientID,
-			&s.StartedAt,
-			&s.LastActiveAt,
-			&s.ExpiresAt,
-			&s.Revoked,
-			&s.DeviceInfo,
-			&s.IP,
-			&s.Metadata,
-			&s.CreatedAt,
-			&s.UpdatedAt,
-		); err != nil {
+		s, err := scanSessionRow(rows)
+		if err != nil {
 			return nil, err
 		}
 		sessions = append(sessions, s)
@@ -210,3 +289,26 @@ func (r *SessionRepository) ListByUser(ctx context.Context, userID int64, limit,
 	}
 	return sessions, nil
 }
+
+func scanSessionRow(scanner interface{ Scan(dest ...any) error }) (*models.Session, error) {
+	s := &models.Session{}
+	err := scanner.Scan(
+		&s.ID,
+		&s.OrbitID,
+		&s.UserID,
+		&s.ClientID,
+		&s.StartedAt,
+		&s.LastActiveAt,
+		&s.ExpiresAt,
+		&s.Revoked,
+		&s.DeviceInfo,
+		&s.IP,
+		&s.Metadata,
+		&s.CreatedAt,
+		&s.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
@@ -0,0 +1,130 @@
+package repositories_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+)
+
+func TestSessionRepositoryRevokeByUser(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	orbit := fx.Orbit()
+	client := fx.Client(orbit)
+	user := fx.User(orbit)
+	repo := repositories.NewSessionRepository(dbConn.Exec(), nop)
+
+	now := time.Now().UTC()
+	var sessions []*models.Session
+	for range 3 {
+		s, err := repo.Create(ctx, &models.Session{
+			OrbitID:      orbit.ID,
+			UserID:       user.ID,
+			ClientID:     &client.ID,
+			StartedAt:    now,
+			LastActiveAt: now,
+			ExpiresAt:    ptr(now.Add(time.Hour)),
+			DeviceInfo:   "test",
+			IP:           "192.0.2.1",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		sessions = append(sessions, s)
+	}
+
+	got, err := repo.GetByID(ctx, sessions[0].ID)
+	if err != nil || got == nil || got.ClientID == nil || *got.ClientID != client.ID || got.IP != "192.0.2.1" {
+		t.Fatalf("GetByID = %+v, %v", got, err)
+	}
+	got.LastActiveAt = now.Add(time.Minute)
+	if updated, err := repo.Update(ctx, got); err != nil || updated == nil {
+		t.Fatalf("Update = %+v, %v", updated, err)
+	}
+
+	if err := repo.Revoke(ctx, sessions[0].ID); err != nil {
+		t.Fatal(err)
+	}
+	n, err := repo.RevokeByUser(ctx, user.ID)
+	if err != nil || n != 2 {
+		t.Fatalf("RevokeByUser = %d, %v, want 2", n, err)
+	}
+
+	list, _, err := repo.ListByUser(ctx, user.ID, repositories.Page{})
+	if err != nil || len(list) != 3 {
+		t.Fatalf("ListByUser = %d sessions, %v", len(list), err)
+	}
+	for _, s := range list {
+		if !s.Revoked {
+			t.Errorf("session %d not revoked", s.ID)
+		}
+	}
+	if !list[0].LastActiveAt.Equal(now.Add(time.Minute).Truncate(time.Microsecond)) {
+		t.Errorf("LastActiveAt = %v, want the updated value", list[0].LastActiveAt)
+	}
+}
+
+func TestSessionRepositoryRevokeWithRefreshTokens(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	orbit := fx.Orbit()
+	client := fx.Client(orbit)
+	user := fx.User(orbit)
+	repo := repositories.NewSessionRepository(dbConn.Exec(), nop)
+	tokens := repositories.NewRefreshTokenRepository(dbConn.Exec(), nop)
+
+	now := time.Now().UTC()
+	var sessions []*models.Session
+	for i := range 3 {
+		s, err := repo.Create(ctx, &models.Session{
+			OrbitID:      orbit.ID,
+			UserID:       user.ID,
+			StartedAt:    now,
+			LastActiveAt: now.Add(time.Duration(i) * time.Minute),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tokens.Create(ctx, &models.RefreshToken{
+			ExpiresAt: now.Add(time.Hour),
+			JTI:       "rt-session-" + strconv.Itoa(i),
+			OrbitID:   orbit.ID,
+			ClientID:  client.ID,
+			UserID:    &user.ID,
+			SessionID: &s.ID,
+		}); err != nil {
+			t.Fatal(err)
+		}
+		sessions = append(sessions, s)
+	}
+
+	if err := repo.Touch(ctx, sessions[0].ID, now.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	// An older timestamp must not move the activity back.
+	if err := repo.Touch(ctx, sessions[0].ID, now); err != nil {
+		t.Fatal(err)
+	}
+	active, err := repo.ListActiveByUser(ctx, user.ID, now)
+	if err != nil || len(active) != 3 || active[0].ID != sessions[0].ID || active[1].ID != sessions[2].ID {
+		t.Fatalf("ListActiveByUser = %d sessions, %v, want the touched one first", len(active), err)
+	}
+
+	ids, err := repo.RevokeByUserExcept(ctx, user.ID, sessions[0].ID)
+	if err != nil || len(ids) != 2 {
+		t.Fatalf("RevokeByUserExcept = %v, %v, want two sessions", ids, err)
+	}
+	if n, err := tokens.RevokeBySessions(ctx, ids); err != nil || n != 2 {
+		t.Fatalf("RevokeBySessions = %d, %v, want 2", n, err)
+	}
+	got, err := tokens.GetByJTI(ctx, "rt-session-0")
+	if err != nil || got == nil || got.Revoked || got.SessionID == nil || *got.SessionID != sessions[0].ID {
+		t.Fatalf("GetByJTI(rt-session-0) = %+v, %v, want a live token of the kept session", got, err)
+	}
+	if active, _ := repo.ListActiveByUser(ctx, user.ID, now); len(active) != 1 {
+		t.Fatalf("ListActiveByUser after revoke = %d sessions, want 1", len(active))
+	}
+}
@@ -0,0 +1,46 @@
+package repositories_test
+
+import (
+	"testing"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+)
+
+func TestTokenRevocationRepositoryCreate(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	orbit := fx.Orbit()
+	admin := fx.User(orbit)
+	repo := repositories.NewTokenRevocationRepository(dbConn.Exec(), nop)
+
+	first, err := repo.Create(ctx, &models.TokenRevocation{
+		OrbitID:   orbit.ID,
+		TokenJTI:  "jti-1",
+		TokenType: "refresh_token",
+		Reason:    "admin",
+		RevokedBy: &admin.ID,
+		Metadata:  map[string]any{"client_id": "client-1"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if first.ID == 0 || first.RevokedAt.IsZero() {
+		t.Fatalf("Create = %+v, want an id and the revocation time", first)
+	}
+
+	// The log keeps every revocation, including repeated ones of a token.
+	second, err := repo.Create(ctx, &models.TokenRevocation{OrbitID: orbit.ID, TokenJTI: "jti-1", TokenType: "refresh_token"})
+	if err != nil || second.ID == first.ID {
+		t.Fatalf("repeated Create = %+v, %v", second, err)
+	}
+
+	// Nothing reads the log back through the repository yet.
+	var count int
+	if err := dbConn.Exec().QueryRow(ctx, `SELECT count(*) FROM token_revocations WHERE orbit_id = $1 AND token_jti = $2`, orbit.ID, "jti-1").Scan(&count); err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Fatalf("stored revocations = %d, want 2", count)
+	}
+}
@@ -27,6 +27,8 @@ func NewTOTPRepository(exec db.Executor, logger zerolog.Logger) *TOTPRepository
 }
 
 const (
+	totpColumns = `id, user_id, orbit_id, secret_cipher, algorithm, digits, period, issuer, label, last_used_step, is_confirmed, name, created_at, updated_at`
+
 	insertTOTPSQL = `
 		INSERT INTO totps
 			(created_at, updated_at, user_id, orbit_id, secret_cipher, algorithm, digits, period, issuer, label, last_used_step, is_confirmed, name)
@@ -49,19 +51,18 @@ const (
 		RETURNING id, updated_at
 	`
 
+	useTOTPStepSQL = `
+		UPDATE totps
+		SET last_used_step = $2, is_confirmed = TRUE, updated_at = $3
+		WHERE id = $1 AND last_used_step < $2
+		RETURNING id
+	`
+
 	deleteTOTPSQL = `
 		DELETE FROM totps
 		WHERE id = $1
 		RETURNING id
 	`
-
-	listTOTPsByUserSQL = `
-		SELECT id, user_id, orbit_id, secret_cipher, algorithm, digits, period, issuer, label, last_used_step, is_confirmed, name, created_at, updated_at
-		FROM totps
-		WHERE user_id = $1
-		ORDER BY id ASC
-		LIMIT $2 OFFSET $3
-	`
 )
 
 func (r *TOTPRepository) Create(ctx context.Context, t *models.TOTP) (*models.TOTP, error) {
@@ -150,6 +151,25 @@ func (r *TOTPRepository) Update(ctx context.Context, t *models.TOTP) (*models.TO
 	return t, nil
 }
 
+// UseStep records that a code for step was accepted, confirming the
+// authenticator if needed. It reports false when the step is not newer than
+// the last accepted one, which makes concurrent replays of a code fail.
+func (r *TOTPRepository) UseStep(ctx context.Context, id int64, step int64) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "UseStep")
+	defer span.End()
+
+	row := r.exec.QueryRow(ctx, useTOTPStepSQL, id, step, time.Now().UTC())
+	var returnedID int64
+	if err := row.Scan(&returnedID); err != nil {
+		if err == pgx.ErrNoRows {
+			return false, nil
+		}
+		r.logger.Error().Err(err).Int64("totp_id", id).Msg("totp use step failed")
+		return false, err
+	}
+	return true, nil
+}
+
 func (r *TOTPRepository) Delete(ctx context.Context, id int64) error {
 	ctx, span := r.tracer.Start(ctx, "Delete")
 	defer span.End()
@@ -167,29 +187,17 @@ func (r *TOTPRepository) Delete(ctx context.Context, id int64) error {
 	return nil
 }
 
-func (r *TOTPRepository) ListByUser(ctx context.Context, userID int64, limit, offset int) ([]*models.TOTP, error) {
+func (r *TOTPRepository) ListByUser(ctx context.Context, userID int64, page Page) ([]*models.TOTP, string, error) {
 	ctx, span := r.tracer.Start(ctx, "ListByUser")
 	defer span.End()
 
-	rows, err := r.exec.Query(ctx, listTOTPsByUserSQL, userID, limit, offset)
-	if err != nil {
+	q := newListQuery("totps", totpColumns, "totps").
+		and("user_id = ?", userID)
+	list, next, err := listPage(ctx, r.exec, q, page, scanTOTPRow, func(t *models.TOTP) int64 { return t.ID })
+	if err != nil && err != ErrInvalidCursor {
 		r.logger.Error().Err(err).Int64("user_id", userID).Msg("totp list failed")
-		return nil, err
-	}
-	defer rows.Close()
-
-	var result []*models.TOTP
-	for rows.Next() {
-		t, err := scanTOTPRow(rows)
-		if err != nil {
-			return nil, err
-		}
-		result = append(result, t)
-	}
-	if err := rows.Err(); err != nil {
-		return nil, err
 	}
-	return result, nil
+	return list, next, err
 }
 
 func scanTOTPRow(scanner interface{ Scan(dest ...any) error }) (*models.TOTP, error) {
@@ -0,0 +1,62 @@
+package repositories_test
+
+import (
+	"testing"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+)
+
+func TestTOTPRepositoryUseStepRejectsReplays(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	orbit := fx.Orbit()
+	user := fx.User(orbit)
+	repo := repositories.NewTOTPRepository(dbConn.Exec(), nop)
+
+	totp, err := repo.Create(ctx, &models.TOTP{
+		UserID:       user.ID,
+		OrbitID:      orbit.ID,
+		SecretCipher: "sealed",
+		Algorithm:    "SHA1",
+		Digits:       6,
+		Period:       30,
+		Issuer:       orbit.Name,
+		Label:        user.Email,
+		Name:         "phone",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, step := range []struct {
+		step int64
+		ok   bool
+	}{
+		{100, true},
+		{100, false},
+		{99, false},
+		{101, true},
+	} {
+		ok, err := repo.UseStep(ctx, totp.ID, step.step)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok != step.ok {
+			t.Errorf("UseStep(%d) = %v, want %v", step.step, ok, step.ok)
+		}
+	}
+
+	got, err := repo.GetByID(ctx, totp.ID)
+	if err != nil || got == nil || got.LastUsedStep != 101 || !got.IsConfirmed {
+		t.Fatalf("GetByID = %+v, %v", got, err)
+	}
+
+	if err := repo.Delete(ctx, totp.ID); err != nil {
+		t.Fatal(err)
+	}
+	list, _, err := repo.ListByUser(ctx, user.ID, repositories.Page{})
+	if err != nil || len(list) != 0 {
+		t.Fatalf("ListByUser after delete = %d, %v", len(list), err)
+	}
+}
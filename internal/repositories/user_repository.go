@@ -2,6 +2,7 @@ package repositories
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
@@ -28,6 +29,13 @@ func NewUserRepository(exec db.Executor, logger zerolog.Logger) *UserRepository
 }
 
 const (
+	userColumns = `
+		id, orbit_id, username, email, email_verified,
+		password_hash, password_algo, last_password_change,
+		display_name, profile, is_active, is_locked, mfa_enabled,
+		metadata, created_at, updated_at, deleted_at
+	`
+
 	insertUserSQL = `
 		INSERT INTO users (
 			orbit_id, username, email, email_verified,
@@ -89,6 +97,28 @@ const (
 		RETURNING updated_at
 	`
 
+	updateUserPasswordHashSQL = `
+		UPDATE users
+		SET password_hash = $2, password_algo = $3, updated_at = $4
+		WHERE id = $1 AND password_hash = $5 AND deleted_at IS NULL
+	`
+
+	// Only a change of state is reported so callers record each lock once.
+	setUserLockedSQL = `
+		UPDATE users
+		SET is_locked = $2, updated_at = $3
+		WHERE id = $1 AND is_locked <> $2 AND deleted_at IS NULL
+		RETURNING id
+	`
+
+	// The address must still be the one the verification was sent to.
+	markUserEmailVerifiedSQL = `
+		UPDATE users
+		SET email_verified = TRUE, updated_at = $3
+		WHERE id = $1 AND email = $2 AND deleted_at IS NULL
+		RETURNING id
+	`
+
 	softDeleteUserSQL = `
 		UPDATE users
 		SET deleted_at = $2
@@ -96,15 +126,11 @@ const (
 		RETURNING id
 	`
 
-	listUsersByOrbitSQL = `
-		SELECT id, orbit_id, username, email, email_verified,
-		       password_hash, password_algo, last_password_change,
-		       display_name, profile, is_active, is_locked, mfa_enabled,
-		       metadata, created_at, updated_at, deleted_at
+	lockUserSQL = `
+		SELECT updated_at
 		FROM users
-		WHERE orbit_id = $1 AND deleted_at IS NULL
-		ORDER BY id
-		LIMIT $2 OFFSET $3
+		WHERE id = $1 AND deleted_at IS NULL
+		FOR UPDATE
 	`
 )
 
@@ -208,6 +234,70 @@ func (r *UserRepository) Update(ctx context.Context, user *models.User) (*models
 	return user, nil
 }
 
+// UpdatePasswordHash swaps the stored hash without touching
+// last_password_change. It only applies while the hash is still previousHash,
+// so a rehash can never overwrite a concurrent password change.
+func (r *UserRepository) UpdatePasswordHash(ctx context.Context, userID int64, hash, algo, previousHash string) error {
+	ctx, span := r.tracer.Start(ctx, "UpdatePasswordHash")
+	defer span.End()
+
+	if _, err := r.exec.Exec(ctx, updateUserPasswordHashSQL, userID, hash, algo, time.Now().UTC(), previousHash); err != nil {
+		r.logger.Error().Err(err).Int64("user_id", userID).Msg("update user password hash failed")
+		return err
+	}
+	return nil
+}
+
+// SetLocked sets is_locked and reports whether the stored value changed.
+func (r *UserRepository) SetLocked(ctx context.Context, userID int64, locked bool) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "SetLocked")
+	defer span.End()
+
+	var returnedID int64
+	if err := r.exec.QueryRow(ctx, setUserLockedSQL, userID, locked, time.Now().UTC()).Scan(&returnedID); err != nil {
+		if err == pgx.ErrNoRows {
+			return false, nil
+		}
+		r.logger.Error().Err(err).Int64("user_id", userID).Bool("locked", locked).Msg("set user locked failed")
+		return false, err
+	}
+	return true, nil
+}
+
+// MarkEmailVerified flags email as verified and reports false when the user
+// no longer has that address.
+func (r *UserRepository) MarkEmailVerified(ctx context.Context, userID int64, email string) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "MarkEmailVerified")
+	defer span.End()
+
+	var returnedID int64
+	if err := r.exec.QueryRow(ctx, markUserEmailVerifiedSQL, userID, email, time.Now().UTC()).Scan(&returnedID); err != nil {
+		if err == pgx.ErrNoRows {
+			return false, nil
+		}
+		r.logger.Error().Err(err).Int64("user_id", userID).Msg("mark user email verified failed")
+		return false, err
+	}
+	return true, nil
+}
+
+// LockVersion locks the user until the transaction ends and returns when it
+// was last updated, or nil when there is no such user.
+func (r *UserRepository) LockVersion(ctx context.Context, id int64) (*time.Time, error) {
+	ctx, span := r.tracer.Start(ctx, "LockVersion")
+	defer span.End()
+
+	var updatedAt time.Time
+	if err := r.exec.QueryRow(ctx, lockUserSQL, id).Scan(&updatedAt); err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		r.logger.Error().Err(err).Int64("user_id", id).Msg("user lock failed")
+		return nil, err
+	}
+	return &updatedAt, nil
+}
+
 func (r *UserRepository) Delete(ctx context.Context, id int64) error {
 	ctx, span := r.tracer.Start(ctx, "Delete")
 	defer span.End()
@@ -226,29 +316,40 @@ func (r *UserRepository) Delete(ctx context.Context, id int64) error {
 	return nil
 }
 
-func (r *UserRepository) ListByOrbit(ctx context.Context, orbitID int64, limit, offset int) ([]*models.User, error) {
+// UserFilter narrows ListByOrbit. Zero fields do not filter.
+type UserFilter struct {
+	// EmailPrefix matches the start of the address, ignoring case.
+	EmailPrefix   string
+	IsActive      *bool
+	CreatedAfter  *time.Time
+	CreatedBefore *time.Time
+}
+
+func (r *UserRepository) ListByOrbit(ctx context.Context, orbitID int64, filter UserFilter, page Page) ([]*models.User, string, error) {
 	ctx, span := r.tracer.Start(ctx, "ListByOrbit")
 	defer span.End()
 
-	rows, err := r.exec.Query(ctx, listUsersByOrbitSQL, orbitID, limit, offset)
-	if err != nil {
-		r.logger.Error().Err(err).Int64("orbit_id", orbitID).Msg("list users query failed")
-		return nil, err
+	q := newListQuery("users", userColumns, "users").
+		and("orbit_id = ?", orbitID).
+		and("deleted_at IS NULL")
+	if filter.EmailPrefix != "" {
+		q.and(`lower(email) LIKE ? ESCAPE '\'`, likePrefix(strings.ToLower(filter.EmailPrefix)))
 	}
-	defer rows.Close()
-
-	var users []*models.User
-	for rows.Next() {
-		u, err := scanUserRow(rows)
-		if err != nil {
-			return nil, err
-		}
-		users = append(users, u)
+	if filter.IsActive != nil {
+		q.and("is_active = ?", *filter.IsActive)
 	}
-	if err := rows.Err(); err != nil {
-		return nil, err
+	if filter.CreatedAfter != nil {
+		q.and("created_at >= ?", *filter.CreatedAfter)
+	}
+	if filter.CreatedBefore != nil {
+		q.and("created_at < ?", *filter.CreatedBefore)
 	}
-	return users, nil
+
+	users, next, err := listPage(ctx, r.exec, q, page, scanUserRow, func(u *models.User) int64 { return u.ID })
+	if err != nil && err != ErrInvalidCursor {
+		r.logger.Error().Err(err).Int64("orbit_id", orbitID).Msg("list users query failed")
+	}
+	return users, next, err
 }
 
 func scanUserRow(scanner interface{ Scan(dest ...any) error }) (*models.User, error) {
@@ -277,3 +378,18 @@ func scanUserRow(scanner interface{ Scan(dest ...any) error }) (*models.User, er
 	}
 	return u, err
 }
+
+// ListByGroup pages through the members of a group.
+func (r *UserRepository) ListByGroup(ctx context.Context, groupID int64, page Page) ([]*models.User, string, error) {
+	ctx, span := r.tracer.Start(ctx, "ListByGroup")
+	defer span.End()
+
+	q := newListQuery("group_members", userColumns, "users").
+		and("id IN (SELECT user_id FROM group_members WHERE group_id = ?)", groupID).
+		and("deleted_at IS NULL")
+	users, next, err := listPage(ctx, r.exec, q, page, scanUserRow, func(u *models.User) int64 { return u.ID })
+	if err != nil && err != ErrInvalidCursor {
+		r.logger.Error().Err(err).Int64("group_id", groupID).Msg("list group members query failed")
+	}
+	return users, next, err
+}
@@ -0,0 +1,195 @@
+package repositories_test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+)
+
+func TestUserRepositoryLookups(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	orbit := fx.Orbit()
+	user := fx.User(orbit)
+	repo := repositories.NewUserRepository(dbConn.Exec(), nop)
+
+	byName, err := repo.GetByUsername(ctx, orbit.ID, user.Username)
+	if err != nil || byName == nil || byName.ID != user.ID {
+		t.Fatalf("GetByUsername = %+v, %v", byName, err)
+	}
+	byEmail, err := repo.GetByEmail(ctx, orbit.ID, user.Email)
+	if err != nil || byEmail == nil || byEmail.ID != user.ID {
+		t.Fatalf("GetByEmail = %+v, %v", byEmail, err)
+	}
+	if got, err := repo.GetByUsername(ctx, fx.Orbit().ID, user.Username); err != nil || got != nil {
+		t.Fatalf("GetByUsername in another orbit = %+v, %v, want nil", got, err)
+	}
+
+	byName.DisplayName = "Renamed"
+	updated, err := repo.Update(ctx, byName)
+	if err != nil || updated == nil {
+		t.Fatalf("Update = %+v, %v", updated, err)
+	}
+	got, err := repo.GetByID(ctx, user.ID)
+	if err != nil || got == nil || got.DisplayName != "Renamed" {
+		t.Fatalf("GetByID after update = %+v, %v", got, err)
+	}
+}
+
+func TestUserRepositoryUpdatePasswordHashComparesPreviousHash(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	user := fx.User(fx.Orbit())
+	repo := repositories.NewUserRepository(dbConn.Exec(), nop)
+
+	if err := repo.UpdatePasswordHash(ctx, user.ID, "stale", "test", "not-the-current-hash"); err != nil {
+		t.Fatal(err)
+	}
+	got, _ := repo.GetByID(ctx, user.ID)
+	if got.PasswordHash != user.PasswordHash {
+		t.Fatalf("hash changed although the previous hash did not match")
+	}
+
+	if err := repo.UpdatePasswordHash(ctx, user.ID, "rehashed", "test", user.PasswordHash); err != nil {
+		t.Fatal(err)
+	}
+	got, _ = repo.GetByID(ctx, user.ID)
+	if got.PasswordHash != "rehashed" || got.PasswordAlgo != "test" {
+		t.Fatalf("PasswordHash = %q (%s), want rehashed", got.PasswordHash, got.PasswordAlgo)
+	}
+}
+
+func TestUserRepositorySetLockedReportsChanges(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	user := fx.User(fx.Orbit())
+	repo := repositories.NewUserRepository(dbConn.Exec(), nop)
+
+	for i, step := range []struct {
+		locked, changed bool
+	}{
+		{true, true},
+		{true, false},
+		{false, true},
+		{false, false},
+	} {
+		changed, err := repo.SetLocked(ctx, user.ID, step.locked)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if changed != step.changed {
+			t.Errorf("step %d: SetLocked(%v) = %v, want %v", i, step.locked, changed, step.changed)
+		}
+	}
+}
+
+func TestUserRepositoryMarkEmailVerified(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	user := fx.User(fx.Orbit())
+	repo := repositories.NewUserRepository(dbConn.Exec(), nop)
+
+	ok, err := repo.MarkEmailVerified(ctx, user.ID, "old@example.test")
+	if err != nil || ok {
+		t.Fatalf("MarkEmailVerified with another address = %v, %v, want false", ok, err)
+	}
+	ok, err = repo.MarkEmailVerified(ctx, user.ID, user.Email)
+	if err != nil || !ok {
+		t.Fatalf("MarkEmailVerified = %v, %v, want true", ok, err)
+	}
+	got, _ := repo.GetByID(ctx, user.ID)
+	if !got.EmailVerified {
+		t.Fatal("EmailVerified = false after MarkEmailVerified")
+	}
+}
+
+func TestUserRepositoryDeleteHidesUser(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	orbit := fx.Orbit()
+	kept := fx.User(orbit)
+	deleted := fx.User(orbit)
+	repo := repositories.NewUserRepository(dbConn.Exec(), nop)
+
+	if err := repo.Delete(ctx, deleted.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := repo.Delete(ctx, deleted.ID); err != nil {
+		t.Fatalf("second Delete = %v", err)
+	}
+
+	if got, err := repo.GetByID(ctx, deleted.ID); err != nil || got != nil {
+		t.Fatalf("GetByID = %+v, %v, want nil", got, err)
+	}
+	if got, err := repo.GetByEmail(ctx, orbit.ID, deleted.Email); err != nil || got != nil {
+		t.Fatalf("GetByEmail = %+v, %v, want nil", got, err)
+	}
+	if updated, err := repo.Update(ctx, deleted); err != nil || updated != nil {
+		t.Fatalf("Update = %+v, %v, want nil", updated, err)
+	}
+
+	list, _, err := repo.ListByOrbit(ctx, orbit.ID, repositories.UserFilter{}, repositories.Page{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 1 || list[0].ID != kept.ID {
+		t.Fatalf("ListByOrbit = %d users, want only %d", len(list), kept.ID)
+	}
+}
+
+func TestUserRepositoryListPages(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	orbit := fx.Orbit()
+	repo := repositories.NewUserRepository(dbConn.Exec(), nop)
+
+	var want []int64
+	for i := range 5 {
+		u := fx.User(orbit, func(u *models.User) {
+			u.Email = fmt.Sprintf("Page%d@example.test", i)
+			u.IsActive = i != 2
+		})
+		want = append(want, u.ID)
+	}
+	fx.User(orbit, func(u *models.User) { u.Email = "other@example.test" })
+	fx.User(fx.Orbit(), func(u *models.User) { u.Email = "page9@example.test" })
+
+	var got []int64
+	page := repositories.Page{Limit: 2}
+	pages := 0
+	for {
+		users, next, err := repo.ListByOrbit(ctx, orbit.ID, repositories.UserFilter{EmailPrefix: "page"}, page)
+		if err != nil {
+			t.Fatal(err)
+		}
+		pages++
+		for _, u := range users {
+			got = append(got, u.ID)
+		}
+		if next == "" {
+			break
+		}
+		page.Cursor = next
+	}
+	if !reflect.DeepEqual(got, want) || pages != 3 {
+		t.Fatalf("%d pages of %v, want 3 pages of %v", pages, got, want)
+	}
+
+	inactive, next, err := repo.ListByOrbit(ctx, orbit.ID, repositories.UserFilter{IsActive: ptr(false)}, repositories.Page{})
+	if err != nil || next != "" || len(inactive) != 1 || inactive[0].ID != want[2] {
+		t.Fatalf("inactive users = %+v, %q, %v", inactive, next, err)
+	}
+
+	// Wildcards in the prefix are literal.
+	if users, _, err := repo.ListByOrbit(ctx, orbit.ID, repositories.UserFilter{EmailPrefix: "p_ge"}, repositories.Page{}); err != nil || len(users) != 0 {
+		t.Fatalf("ListByOrbit with a wildcard prefix = %d users, %v", len(users), err)
+	}
+
+	if _, _, err := repo.ListByOrbit(ctx, orbit.ID, repositories.UserFilter{}, repositories.Page{Cursor: "bogus"}); !errors.Is(err, repositories.ErrInvalidCursor) {
+		t.Fatalf("ListByOrbit with a bad cursor = %v, want ErrInvalidCursor", err)
+	}
+}
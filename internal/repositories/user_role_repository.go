@@ -30,6 +30,7 @@ const (
 	insertUserRoleSQL = `
 		INSERT INTO user_roles (user_id, role_id, created_at)
 		VALUES ($1, $2, $3)
+		ON CONFLICT DO NOTHING
 	`
 
 	deleteUserRoleSQL = `
@@ -37,12 +38,17 @@ const (
 		WHERE user_id = $1 AND role_id = $2
 	`
 
-	listRolesByUserSQL = `
-		SELECT role_id, created_at
-		FROM user_roles
-		WHERE user_id = $1
-		ORDER BY role_id
-		LIMIT $2 OFFSET $3
+	listPermissionsByUserSQL = heldRolesCTE + `
+		SELECT p.id, p.orbit_id, p.name, p.metadata, p.created_at
+		FROM permissions p
+		WHERE p.orbit_id = $2 AND EXISTS (
+			SELECT 1
+			FROM held
+			JOIN roles r ON r.id = held.role_id
+			JOIN role_permissions rp ON rp.role_id = held.role_id
+			WHERE r.orbit_id = $2 AND rp.permission_id = p.id
+		)
+		ORDER BY p.name
 	`
 )
 
@@ -62,23 +68,44 @@ func (r *UserRoleRepository) Revoke(ctx context.Context, userID, roleID int64) e
 	return err
 }
 
-func (r *UserRoleRepository) ListByUser(ctx context.Context, userID int64, limit, offset int) ([]*models.UserRole, error) {
+// ListByUser lists the roles assigned to a user directly, by role id.
+func (r *UserRoleRepository) ListByUser(ctx context.Context, userID int64, page Page) ([]*models.UserRole, string, error) {
 	ctx, span := r.tracer.Start(ctx, "ListByUser")
 	defer span.End()
 
-	rows, err := r.exec.Query(ctx, listRolesByUserSQL, userID, limit, offset)
+	q := newListQuery("user_roles", "role_id, created_at", "user_roles").
+		orderBy("role_id").
+		and("user_id = ?", userID)
+	scan := func(scanner interface{ Scan(dest ...any) error }) (*models.UserRole, error) {
+		ur := &models.UserRole{UserID: userID}
+		if err := scanner.Scan(&ur.RoleID, &ur.CreatedAt); err != nil {
+			return nil, err
+		}
+		return ur, nil
+	}
+	return listPage(ctx, r.exec, q, page, scan, func(ur *models.UserRole) int64 { return ur.RoleID })
+}
+
+// ListPermissions returns the permissions a user holds in an orbit through
+// the roles it holds directly, through groups or by inclusion, sorted by name.
+func (r *UserRoleRepository) ListPermissions(ctx context.Context, orbitID, userID int64) ([]*models.Permission, error) {
+	ctx, span := r.tracer.Start(ctx, "ListPermissions")
+	defer span.End()
+
+	rows, err := r.exec.Query(ctx, listPermissionsByUserSQL, userID, orbitID)
 	if err != nil {
+		r.logger.Error().Err(err).Int64("user_id", userID).Msg("list permissions by user query failed")
 		return nil, err
 	}
 	defer rows.Close()
 
-	var result []*models.UserRole
+	result := []*models.Permission{}
 	for rows.Next() {
-		ur := &models.UserRole{UserID: userID}
-		if err := rows.Scan(&ur.RoleID, &ur.CreatedAt); err != nil {
+		p, err := scanPermission(rows)
+		if err != nil {
 			return nil, err
 		}
-		result = append(result, ur)
+		result = append(result, p)
 	}
 	return result, rows.Err()
 }
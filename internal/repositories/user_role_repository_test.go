@@ -0,0 +1,109 @@
+package repositories_test
+
+import (
+	"testing"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+)
+
+func TestUserRoleRepositoryAssign(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	orbit := fx.Orbit()
+	user := fx.User(orbit)
+	roles := repositories.NewRoleRepository(dbConn.Exec(), nop)
+	repo := repositories.NewUserRoleRepository(dbConn.Exec(), nop)
+
+	admin, err := roles.Create(ctx, &models.Role{OrbitID: orbit.ID, Name: "admin"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	viewer, err := roles.Create(ctx, &models.Role{OrbitID: orbit.ID, Name: "viewer"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, role := range []*models.Role{viewer, admin} {
+		if err := repo.Assign(ctx, user.ID, role.ID); err != nil {
+			t.Fatal(err)
+		}
+	}
+	list, _, err := repo.ListByUser(ctx, user.ID, repositories.Page{})
+	if err != nil || len(list) != 2 || list[0].RoleID != admin.ID || list[0].UserID != user.ID {
+		t.Fatalf("ListByUser = %+v, %v", list, err)
+	}
+	// Assignments are paged by role id, whatever order they were made in.
+	first, next, err := repo.ListByUser(ctx, user.ID, repositories.Page{Limit: 1})
+	if err != nil || len(first) != 1 || first[0].RoleID != admin.ID || next == "" {
+		t.Fatalf("first page = %+v, %q, %v", first, next, err)
+	}
+	rest, next, err := repo.ListByUser(ctx, user.ID, repositories.Page{Limit: 1, Cursor: next})
+	if err != nil || len(rest) != 1 || rest[0].RoleID != viewer.ID || next != "" {
+		t.Fatalf("second page = %+v, %q, %v", rest, next, err)
+	}
+
+	if err := repo.Revoke(ctx, user.ID, admin.ID); err != nil {
+		t.Fatal(err)
+	}
+	// Deleting a role removes its assignments.
+	if err := roles.Delete(ctx, viewer.ID); err != nil {
+		t.Fatal(err)
+	}
+	list, _, err = repo.ListByUser(ctx, user.ID, repositories.Page{})
+	if err != nil || len(list) != 0 {
+		t.Fatalf("ListByUser after Revoke and Delete = %+v, %v", list, err)
+	}
+}
+
+func TestUserRoleRepositoryListPermissions(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	orbit := fx.Orbit()
+	user := fx.User(orbit)
+	roles := repositories.NewRoleRepository(dbConn.Exec(), nop)
+	permissions := repositories.NewPermissionRepository(dbConn.Exec(), nop)
+	grants := repositories.NewRolePermissionRepository(dbConn.Exec(), nop)
+	repo := repositories.NewUserRoleRepository(dbConn.Exec(), nop)
+
+	admin, err := roles.Create(ctx, &models.Role{OrbitID: orbit.ID, Name: "admin"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	viewer, err := roles.Create(ctx, &models.Role{OrbitID: orbit.ID, Name: "viewer"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	read, err := permissions.Create(ctx, &models.Permission{OrbitID: orbit.ID, Name: "orbit:clients:read"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	write, err := permissions.Create(ctx, &models.Permission{OrbitID: orbit.ID, Name: "orbit:clients:write"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, grant := range [][2]int64{{admin.ID, read.ID}, {admin.ID, write.ID}, {viewer.ID, read.ID}} {
+		if err := grants.Assign(ctx, grant[0], grant[1]); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	list, err := repo.ListPermissions(ctx, orbit.ID, user.ID)
+	if err != nil || list == nil || len(list) != 0 {
+		t.Fatalf("ListPermissions without roles = %+v, %v, want empty", list, err)
+	}
+	// Assigning twice is harmless.
+	for _, role := range []*models.Role{viewer, admin, admin} {
+		if err := repo.Assign(ctx, user.ID, role.ID); err != nil {
+			t.Fatal(err)
+		}
+	}
+	// Permissions granted by several roles are listed once.
+	list, err = repo.ListPermissions(ctx, orbit.ID, user.ID)
+	if err != nil || len(list) != 2 || list[0].ID != read.ID || list[1].ID != write.ID {
+		t.Fatalf("ListPermissions = %+v, %v", list, err)
+	}
+	if list, err := repo.ListPermissions(ctx, fx.Orbit().ID, user.ID); err != nil || len(list) != 0 {
+		t.Fatalf("ListPermissions(other orbit) = %+v, %v, want empty", list, err)
+	}
+}
@@ -0,0 +1,155 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type UserTokenRepository struct {
+	exec   db.Executor
+	logger zerolog.Logger
+	tracer trace.Tracer
+}
+
+func NewUserTokenRepository(exec db.Executor, logger zerolog.Logger) *UserTokenRepository {
+	return &UserTokenRepository{
+		exec:   exec,
+		logger: logger,
+		tracer: otel.Tracer("repository.user_token"),
+	}
+}
+
+const (
+	userTokenColumns = `
+		id, orbit_id, user_id, purpose, token_hash, email, expires_at, consumed_at, created_at
+	`
+
+	insertUserTokenSQL = `
+		INSERT INTO user_tokens (orbit_id, user_id, purpose, token_hash, email, expires_at, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id, created_at
+	`
+
+	selectUserTokenByHashSQL = `
+		SELECT` + userTokenColumns + `
+		FROM user_tokens
+		WHERE token_hash = $1
+		LIMIT 1
+	`
+
+	// Consuming is a single statement so a token cannot be spent twice by
+	// concurrent requests.
+	consumeUserTokenSQL = `
+		UPDATE user_tokens
+		SET consumed_at = $3
+		WHERE token_hash = $1 AND purpose = $2 AND consumed_at IS NULL AND expires_at > $3
+		RETURNING` + userTokenColumns
+
+	consumeUserTokensByUserSQL = `
+		UPDATE user_tokens
+		SET consumed_at = $3
+		WHERE user_id = $1 AND purpose = $2 AND consumed_at IS NULL
+	`
+
+	countUserTokensSinceSQL = `
+		SELECT COUNT(*)
+		FROM user_tokens
+		WHERE user_id = $1 AND purpose = $2 AND created_at > $3
+	`
+)
+
+func (r *UserTokenRepository) Create(ctx context.Context, t *models.UserToken) (*models.UserToken, error) {
+	ctx, span := r.tracer.Start(ctx, "Create")
+	defer span.End()
+
+	now := time.Now().UTC()
+	row := r.exec.QueryRow(ctx, insertUserTokenSQL, t.OrbitID, t.UserID, t.Purpose, t.TokenHash, t.Email, t.ExpiresAt, now)
+	if err := row.Scan(&t.ID, &t.CreatedAt); err != nil {
+		r.logger.Error().Err(err).Int64("user_id", t.UserID).Str("purpose", t.Purpose).Msg("user token insert failed")
+		return nil, err
+	}
+	return t, nil
+}
+
+func (r *UserTokenRepository) GetByHash(ctx context.Context, tokenHash string) (*models.UserToken, error) {
+	ctx, span := r.tracer.Start(ctx, "GetByHash")
+	defer span.End()
+
+	t, err := scanUserTokenRow(r.exec.QueryRow(ctx, selectUserTokenByHashSQL, tokenHash))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		r.logger.Error().Err(err).Msg("get user token failed")
+		return nil, err
+	}
+	return t, nil
+}
+
+// Consume marks an unexpired token as used and returns it. Unknown, expired
+// and already used tokens all yield nil.
+func (r *UserTokenRepository) Consume(ctx context.Context, tokenHash, purpose string, now time.Time) (*models.UserToken, error) {
+	ctx, span := r.tracer.Start(ctx, "Consume")
+	defer span.End()
+
+	t, err := scanUserTokenRow(r.exec.QueryRow(ctx, consumeUserTokenSQL, tokenHash, purpose, now))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		r.logger.Error().Err(err).Str("purpose", purpose).Msg("consume user token failed")
+		return nil, err
+	}
+	return t, nil
+}
+
+// ConsumeAllByUser retires every outstanding token of the purpose, so only
+// the most recently mailed one stays usable.
+func (r *UserTokenRepository) ConsumeAllByUser(ctx context.Context, userID int64, purpose string) error {
+	ctx, span := r.tracer.Start(ctx, "ConsumeAllByUser")
+	defer span.End()
+
+	if _, err := r.exec.Exec(ctx, consumeUserTokensByUserSQL, userID, purpose, time.Now().UTC()); err != nil {
+		r.logger.Error().Err(err).Int64("user_id", userID).Str("purpose", purpose).Msg("consume user tokens failed")
+		return err
+	}
+	return nil
+}
+
+func (r *UserTokenRepository) CountSince(ctx context.Context, userID int64, purpose string, since time.Time) (int, error) {
+	ctx, span := r.tracer.Start(ctx, "CountSince")
+	defer span.End()
+
+	var n int
+	if err := r.exec.QueryRow(ctx, countUserTokensSinceSQL, userID, purpose, since).Scan(&n); err != nil {
+		r.logger.Error().Err(err).Int64("user_id", userID).Str("purpose", purpose).Msg("count user tokens failed")
+		return 0, err
+	}
+	return n, nil
+}
+
+func scanUserTokenRow(scanner interface{ Scan(dest ...any) error }) (*models.UserToken, error) {
+	t := &models.UserToken{}
+	err := scanner.Scan(
+		&t.ID,
+		&t.OrbitID,
+		&t.UserID,
+		&t.Purpose,
+		&t.TokenHash,
+		&t.Email,
+		&t.ExpiresAt,
+		&t.ConsumedAt,
+		&t.CreatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return t, nil
+}
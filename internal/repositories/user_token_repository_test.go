@@ -0,0 +1,63 @@
+package repositories_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+)
+
+func TestUserTokenRepositoryConsume(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	orbit := fx.Orbit()
+	user := fx.User(orbit)
+	repo := repositories.NewUserTokenRepository(dbConn.Exec(), nop)
+
+	now := time.Now().UTC()
+	create := func(hash string, expiresAt time.Time) {
+		t.Helper()
+		_, err := repo.Create(ctx, &models.UserToken{
+			OrbitID:   orbit.ID,
+			UserID:    user.ID,
+			Purpose:   models.UserTokenPurposePasswordReset,
+			TokenHash: hash,
+			Email:     user.Email,
+			ExpiresAt: expiresAt,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	create("valid", now.Add(time.Hour))
+	create("expired", now.Add(-time.Minute))
+	create("outstanding", now.Add(time.Hour))
+
+	if got, err := repo.Consume(ctx, "valid", models.UserTokenPurposeEmailVerification, now); err != nil || got != nil {
+		t.Fatalf("Consume with another purpose = %+v, %v, want nil", got, err)
+	}
+	got, err := repo.Consume(ctx, "valid", models.UserTokenPurposePasswordReset, now)
+	if err != nil || got == nil || got.UserID != user.ID || got.ConsumedAt == nil {
+		t.Fatalf("Consume = %+v, %v", got, err)
+	}
+	if got, err := repo.Consume(ctx, "valid", models.UserTokenPurposePasswordReset, now); err != nil || got != nil {
+		t.Fatalf("second Consume = %+v, %v, want nil", got, err)
+	}
+	if got, err := repo.Consume(ctx, "expired", models.UserTokenPurposePasswordReset, now); err != nil || got != nil {
+		t.Fatalf("Consume of an expired token = %+v, %v, want nil", got, err)
+	}
+
+	if err := repo.ConsumeAllByUser(ctx, user.ID, models.UserTokenPurposePasswordReset); err != nil {
+		t.Fatal(err)
+	}
+	stored, err := repo.GetByHash(ctx, "outstanding")
+	if err != nil || stored == nil || stored.ConsumedAt == nil {
+		t.Fatalf("GetByHash after ConsumeAllByUser = %+v, %v", stored, err)
+	}
+
+	n, err := repo.CountSince(ctx, user.ID, models.UserTokenPurposePasswordReset, now.Add(-time.Hour))
+	if err != nil || n != 3 {
+		t.Fatalf("CountSince = %d, %v, want 3", n, err)
+	}
+}
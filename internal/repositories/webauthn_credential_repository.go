@@ -0,0 +1,225 @@
+package repositories
+
+import (
+	"context"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type WebAuthnCredentialRepository struct {
+	exec   db.Executor
+	logger zerolog.Logger
+	tracer trace.Tracer
+}
+
+func NewWebAuthnCredentialRepository(exec db.Executor, logger zerolog.Logger) *WebAuthnCredentialRepository {
+	return &WebAuthnCredentialRepository{
+		exec:   exec,
+		logger: logger,
+		tracer: otel.Tracer("repository.webauthn_credential"),
+	}
+}
+
+const (
+	webAuthnCredentialColumns = `
+		id, orbit_id, user_id, user_handle, credential_id, public_key, attestation_format,
+		aaguid, sign_count, transports, user_verified, backup_eligible, backup_state, discoverable, name,
+		last_used_at, created_at, updated_at
+	`
+
+	insertWebAuthnCredentialSQL = `
+		INSERT INTO webauthn_credentials
+			(orbit_id, user_id, user_handle, credential_id, public_key, attestation_format,
+			 aaguid, sign_count, transports, user_verified, backup_eligible, backup_state, discoverable, name,
+			 created_at, updated_at)
+		VALUES ($1,$2,$3,$4,$5,$6,$7,$8,$9,$10,$11,$12,$13,$14,$15,$16)
+		RETURNING id, created_at, updated_at
+	`
+
+	selectWebAuthnCredentialByIDSQL = `
+		SELECT` + webAuthnCredentialColumns + `
+		FROM webauthn_credentials
+		WHERE id = $1
+		LIMIT 1
+	`
+
+	selectWebAuthnCredentialByCredentialIDSQL = `
+		SELECT` + webAuthnCredentialColumns + `
+		FROM webauthn_credentials
+		WHERE orbit_id = $1 AND credential_id = $2
+		LIMIT 1
+	`
+
+	// The counter only moves forward so two racing assertions with the same
+	// counter cannot both succeed.
+	recordWebAuthnAssertionSQL = `
+		UPDATE webauthn_credentials
+		SET sign_count = $2, user_verified = $3, backup_state = $4, last_used_at = $5, updated_at = $5
+		WHERE id = $1 AND (sign_count < $2 OR (sign_count = 0 AND $2 = 0))
+		RETURNING id
+	`
+
+	deleteWebAuthnCredentialSQL = `
+		DELETE FROM webauthn_credentials
+		WHERE id = $1
+		RETURNING id
+	`
+)
+
+func (r *WebAuthnCredentialRepository) Create(ctx context.Context, c *models.WebAuthnCredential) (*models.WebAuthnCredential, error) {
+	ctx, span := r.tracer.Start(ctx, "Create")
+	defer span.End()
+
+	now := time.Now().UTC()
+	row := r.exec.QueryRow(ctx, insertWebAuthnCredentialSQL,
+		c.OrbitID,
+		c.UserID,
+		c.UserHandle,
+		c.CredentialID,
+		c.PublicKey,
+		c.AttestationFormat,
+		c.AAGUID,
+		c.SignCount,
+		c.Transports,
+		c.UserVerified,
+		c.BackupEligible,
+		c.BackupState,
+		c.Discoverable,
+		c.Name,
+		now,
+		now,
+	)
+	if err := row.Scan(&c.ID, &c.CreatedAt, &c.UpdatedAt); err != nil {
+		r.logger.Error().Err(err).Int64("user_id", c.UserID).Msg("webauthn credential insert failed")
+		return nil, err
+	}
+	return c, nil
+}
+
+func (r *WebAuthnCredentialRepository) GetByID(ctx context.Context, id int64) (*models.WebAuthnCredential, error) {
+	ctx, span := r.tracer.Start(ctx, "GetByID")
+	defer span.End()
+
+	c, err := scanWebAuthnCredentialRow(r.exec.QueryRow(ctx, selectWebAuthnCredentialByIDSQL, id))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		r.logger.Error().Err(err).Int64("webauthn_credential_id", id).Msg("get webauthn credential failed")
+		return nil, err
+	}
+	return c, nil
+}
+
+func (r *WebAuthnCredentialRepository) GetByCredentialID(ctx context.Context, orbitID int64, credentialID []byte) (*models.WebAuthnCredential, error) {
+	ctx, span := r.tracer.Start(ctx, "GetByCredentialID")
+	defer span.End()
+
+	c, err := scanWebAuthnCredentialRow(r.exec.QueryRow(ctx, selectWebAuthnCredentialByCredentialIDSQL, orbitID, credentialID))
+	if err != nil {
+		if err == pgx.ErrNoRows {
+			return nil, nil
+		}
+		r.logger.Error().Err(err).Int64("orbit_id", orbitID).Msg("get webauthn credential by credential id failed")
+		return nil, err
+	}
+	return c, nil
+}
+
+func (r *WebAuthnCredentialRepository) ListByUser(ctx context.Context, userID int64, page Page) ([]*models.WebAuthnCredential, string, error) {
+	ctx, span := r.tracer.Start(ctx, "ListByUser")
+	defer span.End()
+
+	q := newListQuery("webauthn_credentials", webAuthnCredentialColumns, "webauthn_credentials").
+		and("user_id = ?", userID)
+	list, next, err := listPage(ctx, r.exec, q, page, scanWebAuthnCredentialRow, func(c *models.WebAuthnCredential) int64 { return c.ID })
+	if err != nil && err != ErrInvalidCursor {
+		r.logger.Error().Err(err).Int64("user_id", userID).Msg("list webauthn credentials query failed")
+	}
+	return list, next, err
+}
+
+func (r *WebAuthnCredentialRepository) ListByUserHandle(ctx context.Context, orbitID int64, userHandle []byte, page Page) ([]*models.WebAuthnCredential, string, error) {
+	ctx, span := r.tracer.Start(ctx, "ListByUserHandle")
+	defer span.End()
+
+	q := newListQuery("webauthn_credentials", webAuthnCredentialColumns, "webauthn_credentials").
+		and("orbit_id = ?", orbitID).
+		and("user_handle = ?", userHandle)
+	list, next, err := listPage(ctx, r.exec, q, page, scanWebAuthnCredentialRow, func(c *models.WebAuthnCredential) int64 { return c.ID })
+	if err != nil && err != ErrInvalidCursor {
+		r.logger.Error().Err(err).Int64("orbit_id", orbitID).Msg("list webauthn credentials by user handle query failed")
+	}
+	return list, next, err
+}
+
+// RecordAssertion stores the state reported by a successful assertion. It
+// returns false when the stored sign counter is already at or past the new
+// value, which points to a cloned authenticator or a replayed response.
+func (r *WebAuthnCredentialRepository) RecordAssertion(ctx context.Context, c *models.WebAuthnCredential) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "RecordAssertion")
+	defer span.End()
+
+	now := time.Now().UTC()
+	var id int64
+	err := r.exec.QueryRow(ctx, recordWebAuthnAssertionSQL, c.ID, c.SignCount, c.UserVerified, c.BackupState, now).Scan(&id)
+	if err == pgx.ErrNoRows {
+		return false, nil
+	}
+	if err != nil {
+		r.logger.Error().Err(err).Int64("webauthn_credential_id", c.ID).Msg("record webauthn assertion failed")
+		return false, err
+	}
+	c.LastUsedAt = &now
+	c.UpdatedAt = now
+	return true, nil
+}
+
+func (r *WebAuthnCredentialRepository) Delete(ctx context.Context, id int64) (bool, error) {
+	ctx, span := r.tracer.Start(ctx, "Delete")
+	defer span.End()
+
+	var returnedID int64
+	if err := r.exec.QueryRow(ctx, deleteWebAuthnCredentialSQL, id).Scan(&returnedID); err != nil {
+		if err == pgx.ErrNoRows {
+			return false, nil
+		}
+		r.logger.Error().Err(err).Int64("webauthn_credential_id", id).Msg("delete webauthn credential failed")
+		return false, err
+	}
+	return true, nil
+}
+
+func scanWebAuthnCredentialRow(scanner interface{ Scan(dest ...any) error }) (*models.WebAuthnCredential, error) {
+	c := &models.WebAuthnCredential{}
+	err := scanner.Scan(
+		&c.ID,
+		&c.OrbitID,
+		&c.UserID,
+		&c.UserHandle,
+		&c.CredentialID,
+		&c.PublicKey,
+		&c.AttestationFormat,
+		&c.AAGUID,
+		&c.SignCount,
+		&c.Transports,
+		&c.UserVerified,
+		&c.BackupEligible,
+		&c.BackupState,
+		&c.Discoverable,
+		&c.Name,
+		&c.LastUsedAt,
+		&c.CreatedAt,
+		&c.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return c, nil
+}
@@ -0,0 +1,97 @@
+package repositories_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+)
+
+func TestWebAuthnCredentialRepositorySignCount(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	orbit := fx.Orbit()
+	user := fx.User(orbit)
+	repo := repositories.NewWebAuthnCredentialRepository(dbConn.Exec(), nop)
+
+	handle := []byte("handle-1")
+	cred, err := repo.Create(ctx, &models.WebAuthnCredential{
+		OrbitID:           orbit.ID,
+		UserID:            user.ID,
+		UserHandle:        handle,
+		CredentialID:      []byte("credential-1"),
+		PublicKey:         []byte("cose-key"),
+		AttestationFormat: "none",
+		AAGUID:            make([]byte, 16),
+		SignCount:         5,
+		Transports:        []string{"usb", "nfc"},
+		Name:              "security key",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := repo.GetByCredentialID(ctx, orbit.ID, []byte("credential-1"))
+	if err != nil || got == nil || got.ID != cred.ID || !bytes.Equal(got.UserHandle, handle) {
+		t.Fatalf("GetByCredentialID = %+v, %v", got, err)
+	}
+	if len(got.Transports) != 2 || got.Transports[1] != "nfc" {
+		t.Errorf("Transports = %v", got.Transports)
+	}
+
+	for _, step := range []struct {
+		count int64
+		ok    bool
+	}{
+		{5, false},
+		{4, false},
+		{6, true},
+	} {
+		got.SignCount = step.count
+		ok, err := repo.RecordAssertion(ctx, got)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ok != step.ok {
+			t.Errorf("RecordAssertion with counter %d = %v, want %v", step.count, ok, step.ok)
+		}
+	}
+
+	list, _, err := repo.ListByUserHandle(ctx, orbit.ID, handle, repositories.Page{})
+	if err != nil || len(list) != 1 || list[0].SignCount != 6 || list[0].LastUsedAt == nil {
+		t.Fatalf("ListByUserHandle = %+v, %v", list, err)
+	}
+
+	if ok, err := repo.Delete(ctx, cred.ID); err != nil || !ok {
+		t.Fatalf("Delete = %v, %v, want true", ok, err)
+	}
+	if ok, err := repo.Delete(ctx, cred.ID); err != nil || ok {
+		t.Fatalf("second Delete = %v, %v, want false", ok, err)
+	}
+}
+
+func TestWebAuthnCredentialRepositoryZeroCounter(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	orbit := fx.Orbit()
+	user := fx.User(orbit)
+	repo := repositories.NewWebAuthnCredentialRepository(dbConn.Exec(), nop)
+
+	// Authenticators without a counter always report zero.
+	cred, err := repo.Create(ctx, &models.WebAuthnCredential{
+		OrbitID:      orbit.ID,
+		UserID:       user.ID,
+		UserHandle:   []byte("handle-2"),
+		CredentialID: []byte("credential-2"),
+		PublicKey:    []byte("cose-key"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for range 2 {
+		if ok, err := repo.RecordAssertion(ctx, cred); err != nil || !ok {
+			t.Fatalf("RecordAssertion = %v, %v, want true", ok, err)
+		}
+	}
+}
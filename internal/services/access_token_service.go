@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
@@ -14,6 +15,8 @@ import (
 	"go.opentelemetry.io/otel/trace"
 )
 
+var ErrAudienceMismatch = errors.New("access token was not issued for this resource")
+
 type AccessTokenService struct {
 	db               *db.DB
 	cacheMan         cache.Manager
@@ -129,3 +132,18 @@ func (s *AccessTokenService) Introspect(ctx context.Context, jti string) (*model
 	_ = s.introspection.Set(ctx, jti, token, s.introspectionTTL)
 	return token, !token.Revoked, nil
 }
+
+func (s *AccessTokenService) IntrospectForResource(ctx context.Context, jti string, resource string) (*models.AccessToken, bool, error) {
+	ctx, span := s.tracer.Start(ctx, "IntrospectForResource")
+	defer span.End()
+
+	token, active, err := s.Introspect(ctx, jti)
+	if err != nil || token == nil || !active {
+		return token, active, err
+	}
+	if !containsString(decodeStringList(token.Audience), resource) {
+		s.logger.Warn().Str("jti", jti).Str("resource", resource).Msg("access token presented to a resource outside its audience")
+		return token, false, ErrAudienceMismatch
+	}
+	return token, true, nil
+}
@@ -0,0 +1,64 @@
+package services_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/testutil"
+)
+
+func TestAccessTokenServiceRevoke(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	cacheManager, _ := testutil.Redis(t)
+	tokens := services.NewAccessTokenService(dbConn, cacheManager, nop)
+	orbit := fx.Orbit()
+	client := fx.Client(orbit)
+	user := fx.User(orbit)
+
+	now := time.Now().UTC()
+	if _, err := tokens.Issue(ctx, &models.AccessToken{
+		JTI:       "at-1",
+		OrbitID:   orbit.ID,
+		ClientID:  client.ID,
+		UserID:    &user.ID,
+		Scope:     json.RawMessage(`["openid"]`),
+		Audience:  json.RawMessage(`["https://api.example.test"]`),
+		IssuedAt:  now,
+		TokenType: "Bearer",
+		ExpiresAt: now.Add(time.Hour),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, active, err := tokens.IntrospectForResource(ctx, "at-1", "https://api.example.test"); err != nil || !active || got.UserID == nil || *got.UserID != user.ID {
+		t.Fatalf("IntrospectForResource = %+v, %v, %v", got, active, err)
+	}
+	if _, active, err := tokens.IntrospectForResource(ctx, "at-1", "https://other.example.test"); !errors.Is(err, services.ErrAudienceMismatch) || active {
+		t.Fatalf("IntrospectForResource of another resource = %v, %v, want ErrAudienceMismatch", active, err)
+	}
+
+	// The cached introspection result must not outlive the revocation.
+	if err := tokens.Revoke(ctx, orbit.ID, "at-1", "logout"); err != nil {
+		t.Fatal(err)
+	}
+	if got, active, err := tokens.Introspect(ctx, "at-1"); err != nil || active || got == nil || !got.Revoked {
+		t.Fatalf("Introspect after revoke = %+v, %v, %v", got, active, err)
+	}
+	revoked, err := repositories.NewRevokedTokenRepository(dbConn.Exec(), nop).GetByJTI(ctx, orbit.ID, "at-1")
+	if err != nil || revoked == nil || revoked.Reason != "logout" {
+		t.Fatalf("revoked token entry = %+v, %v", revoked, err)
+	}
+	if err := tokens.Revoke(ctx, orbit.ID, "at-1", "again"); err != nil {
+		t.Fatalf("second Revoke = %v", err)
+	}
+
+	if got, active, err := tokens.Introspect(ctx, "unknown"); err != nil || active || got != nil {
+		t.Fatalf("Introspect of an unknown token = %+v, %v, %v", got, active, err)
+	}
+}
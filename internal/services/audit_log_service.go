@@ -0,0 +1,47 @@
+package services
+
+import (
+	"context"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories/db"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// AuditLogService records administrative changes. Unlike security events,
+// which describe what happened to an account, audit entries describe who
+// changed the configuration of an orbit.
+type AuditLogService struct {
+	db     *db.DB
+	logger zerolog.Logger
+	tracer trace.Tracer
+}
+
+func NewAuditLogService(dbConn *db.DB, logger zerolog.Logger) *AuditLogService {
+	return &AuditLogService{
+		db:     dbConn,
+		logger: logger,
+		tracer: otel.Tracer("service.audit_log"),
+	}
+}
+
+// Record stores an entry once the change it describes has been made.
+// Failures are only logged, since the change cannot be taken back.
+func (s *AuditLogService) Record(ctx context.Context, entry *models.AuditLog) {
+	ctx, span := s.tracer.Start(ctx, "Record")
+	defer span.End()
+
+	if _, err := repositories.NewAuditLogRepository(s.db.Exec(), s.logger).Create(ctx, entry); err != nil {
+		s.logger.Error().Err(err).Int64("orbit_id", entry.OrbitID).Str("action", entry.Action).Str("target", entry.Target).Msg("audit log record failed")
+	}
+}
+
+func (s *AuditLogService) ListByOrbit(ctx context.Context, orbitID int64, filter repositories.AuditLogFilter, page repositories.Page) ([]*models.AuditLog, string, error) {
+	ctx, span := s.tracer.Start(ctx, "ListByOrbit")
+	defer span.End()
+
+	return repositories.NewAuditLogRepository(s.db.Exec(), s.logger).ListByOrbit(ctx, orbitID, filter, page)
+}
@@ -0,0 +1,65 @@
+package services_test
+
+import (
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/testutil"
+)
+
+func TestAuthCodeServiceConsumeOnce(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	cacheManager, _ := testutil.Redis(t)
+	codes := services.NewAuthCodeService(dbConn, cacheManager, nop)
+	orbit := fx.Orbit()
+	client := fx.Client(orbit)
+
+	if _, err := codes.Create(ctx, &models.AuthCode{
+		Code:        "code-1",
+		OrbitID:     orbit.ID,
+		ClientID:    client.ID,
+		RedirectURI: "https://app.example.test/callback",
+		ExpiresAt:   time.Now().UTC().Add(time.Minute),
+	}); err != nil {
+		t.Fatal(err)
+	}
+	// Warm the cache, which must not let a used code through.
+	if got, err := codes.GetByCode(ctx, "code-1"); err != nil || got == nil || got.Used {
+		t.Fatalf("GetByCode = %+v, %v", got, err)
+	}
+
+	var wg sync.WaitGroup
+	errs := make([]error, 4)
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, errs[i] = codes.Consume(ctx, "code-1")
+		}()
+	}
+	wg.Wait()
+	consumed := 0
+	for _, err := range errs {
+		switch {
+		case err == nil:
+			consumed++
+		case !errors.Is(err, services.ErrAuthCodeAlreadyUsed):
+			t.Errorf("Consume error = %v, want ErrAuthCodeAlreadyUsed", err)
+		}
+	}
+	if consumed != 1 {
+		t.Fatalf("code consumed %d times, want once", consumed)
+	}
+
+	if got, err := codes.GetByCode(ctx, "code-1"); err != nil || got == nil || !got.Used {
+		t.Fatalf("GetByCode after Consume = %+v, %v, want it used", got, err)
+	}
+	if _, err := codes.Consume(ctx, "unknown"); !errors.Is(err, services.ErrAuthCodeNotFound) {
+		t.Fatalf("Consume of an unknown code error = %v, want ErrAuthCodeNotFound", err)
+	}
+}
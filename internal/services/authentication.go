@@ -0,0 +1,217 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+)
+
+var ErrMFANotConfigured = errors.New("multi-factor authentication is required but the user has not set it up")
+
+// Authentication method references as registered by RFC 8176.
+const (
+	AMRPassword    = "pwd"
+	AMROTP         = "otp"
+	AMRHardwareKey = "hwk"
+	AMRUser        = "user"
+	AMRMultiFactor = "mfa"
+)
+
+// AMRRecoveryCode marks a second factor given with a one-time recovery code.
+// RFC 8176 registers no value for it, so relying parties can tell it from a
+// code of an authenticator.
+const AMRRecoveryCode = "recovery"
+
+// Authentication context classes offered by the orbit, weakest first.
+const (
+	ACRSingleFactor = "urn:orbitum:acr:sfa"
+	ACRMultiFactor  = "urn:orbitum:acr:mfa"
+)
+
+var acrLevels = map[string]int{
+	ACRSingleFactor: 1,
+	ACRMultiFactor:  2,
+}
+
+// SupportedACRValues lists the ACR values for the discovery document.
+func SupportedACRValues() []string {
+	return []string{ACRSingleFactor, ACRMultiFactor}
+}
+
+// amrFactors are the methods that count as an independent factor. A passkey
+// verified with a biometric or PIN contributes both hwk and user.
+var amrFactors = map[string]bool{
+	AMRPassword:     true,
+	AMROTP:          true,
+	AMRRecoveryCode: true,
+	AMRHardwareKey:  true,
+	AMRUser:         true,
+}
+
+// Authentication is what a session has proven about the user. The field names
+// match the ID token claims they end up in.
+type Authentication struct {
+	ACR      string   `json:"acr,omitempty"`
+	AMR      []string `json:"amr,omitempty"`
+	AuthTime int64    `json:"auth_time"`
+}
+
+// Level ranks the achieved ACR; zero means nothing is known.
+func (a Authentication) Level() int {
+	return acrLevels[a.ACR]
+}
+
+// newAuthentication derives the ACR from the methods used. Two independent
+// factors make a multi-factor authentication.
+func newAuthentication(amr []string, at time.Time) Authentication {
+	factors := 0
+	out := Authentication{AuthTime: at.Unix()}
+	for _, m := range amr {
+		if m == AMRMultiFactor || containsString(out.AMR, m) {
+			continue
+		}
+		out.AMR = append(out.AMR, m)
+		if amrFactors[m] {
+			factors++
+		}
+	}
+	switch {
+	case factors >= 2:
+		out.AMR = append(out.AMR, AMRMultiFactor)
+		out.ACR = ACRMultiFactor
+	case factors == 1:
+		out.ACR = ACRSingleFactor
+	}
+	return out
+}
+
+// SessionAuthentication reads the authentication recorded on a session.
+// Sessions created before methods were tracked count as a password login at
+// their start time.
+func SessionAuthentication(s *models.Session) Authentication {
+	var a Authentication
+	if len(s.Metadata) > 0 {
+		_ = json.Unmarshal(s.Metadata, &a)
+	}
+	if a.AuthTime == 0 {
+		a.AuthTime = s.StartedAt.Unix()
+	}
+	if a.ACR == "" {
+		a = newAuthentication([]string{AMRPassword}, time.Unix(a.AuthTime, 0))
+	}
+	return a
+}
+
+// SessionComplete reports whether a session has proven what the user's own
+// settings demand. A password-only session of a user with MFA is still waiting
+// for its second factor and may only be used to finish that sign-in.
+func SessionComplete(s *models.Session, u *models.User) bool {
+	return !u.MFAEnabled || SessionAuthentication(s).Level() >= acrLevels[ACRMultiFactor]
+}
+
+// setSessionAuthentication stores a on the session, keeping any other
+// metadata keys.
+func setSessionAuthentication(s *models.Session, a Authentication) error {
+	md := map[string]any{}
+	if len(s.Metadata) > 0 {
+		if err := json.Unmarshal(s.Metadata, &md); err != nil {
+			return err
+		}
+	}
+	md["acr"] = a.ACR
+	md["amr"] = a.AMR
+	md["auth_time"] = a.AuthTime
+	raw, err := json.Marshal(md)
+	if err != nil {
+		return err
+	}
+	s.Metadata = raw
+	return nil
+}
+
+// CodeAuthentication returns the authentication an authorization code was
+// issued for, which the token endpoint copies into the ID token.
+func CodeAuthentication(code *models.AuthCode) (Authentication, error) {
+	var a Authentication
+	if len(code.Metadata) == 0 {
+		return a, nil
+	}
+	err := json.Unmarshal(code.Metadata, &a)
+	return a, err
+}
+
+// RequestedACR picks the first supported value of acr_values. Unknown values
+// are ignored since acr_values is a voluntary claim request.
+func RequestedACR(values []string) string {
+	for _, v := range values {
+		if _, ok := acrLevels[v]; ok {
+			return v
+		}
+	}
+	return ""
+}
+
+// ClientMinimumACR reads the ACR administrators require for a client from
+// Client.Metadata. An unknown value fails closed to the strongest class.
+func ClientMinimumACR(c *models.Client) string {
+	var md struct {
+		MinACR string `json:"min_acr"`
+	}
+	if len(c.Metadata) == 0 || json.Unmarshal(c.Metadata, &md) != nil || md.MinACR == "" {
+		return ""
+	}
+	if _, ok := acrLevels[md.MinACR]; !ok {
+		return ACRMultiFactor
+	}
+	return md.MinACR
+}
+
+// WebAuthnAMR describes a passkey assertion. User verification adds the
+// inherence factor that makes a passkey multi-factor on its own.
+func WebAuthnAMR(c *models.WebAuthnCredential) []string {
+	if c.UserVerified {
+		return []string{AMRHardwareKey, AMRUser}
+	}
+	return []string{AMRHardwareKey}
+}
+
+// CodeSessionID returns the session an authorization code was issued in, which
+// the token endpoint records on the refresh tokens it issues for the code so
+// that signing the session out revokes them. It is nil for codes that carry
+// no session.
+func CodeSessionID(code *models.AuthCode) *int64 {
+	var md struct {
+		SessionID int64 `json:"session_id"`
+	}
+	if len(code.Metadata) == 0 || json.Unmarshal(code.Metadata, &md) != nil || md.SessionID == 0 {
+		return nil
+	}
+	return &md.SessionID
+}
+
+// CodeRedirectURISent reports whether the authorization request a code was
+// issued for named redirect_uri. Codes that do not record it are treated as if
+// it was sent.
+func CodeRedirectURISent(code *models.AuthCode) bool {
+	var md struct {
+		RedirectURISent *bool `json:"redirect_uri_sent"`
+	}
+	if len(code.Metadata) == 0 || json.Unmarshal(code.Metadata, &md) != nil || md.RedirectURISent == nil {
+		return true
+	}
+	return *md.RedirectURISent
+}
+
+// CodeNonce returns the nonce of the authorization request a code was issued
+// for, which the ID token must repeat.
+func CodeNonce(code *models.AuthCode) string {
+	var md struct {
+		Nonce string `json:"nonce"`
+	}
+	if len(code.Metadata) == 0 || json.Unmarshal(code.Metadata, &md) != nil {
+		return ""
+	}
+	return md.Nonce
+}
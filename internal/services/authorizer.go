@@ -0,0 +1,48 @@
+package services
+
+import (
+	"context"
+	"slices"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Authorizer decides what users may manage. Users hold the permissions their
+// roles grant in their own orbit; users of the master orbit hold theirs in
+// every orbit, and only they can manage the set of orbits itself.
+type Authorizer struct {
+	roles         *RoleService
+	masterOrbitID int64
+	tracer        trace.Tracer
+}
+
+// NewAuthorizer takes the id of the master orbit, or 0 when there is none.
+func NewAuthorizer(roles *RoleService, masterOrbitID int64) *Authorizer {
+	return &Authorizer{
+		roles:         roles,
+		masterOrbitID: masterOrbitID,
+		tracer:        otel.Tracer("service.authorizer"),
+	}
+}
+
+// Allowed reports whether user holds permission in orbitID. An orbitID of 0
+// stands for the set of orbits.
+func (a *Authorizer) Allowed(ctx context.Context, user *models.User, orbitID int64, permission string) (bool, error) {
+	ctx, span := a.tracer.Start(ctx, "Allowed")
+	defer span.End()
+
+	if user == nil || !user.IsActive || user.IsLocked || user.DeletedAt != nil {
+		return false, nil
+	}
+	master := a.masterOrbitID != 0 && user.OrbitID == a.masterOrbitID
+	if !master && (orbitID == 0 || orbitID != user.OrbitID) {
+		return false, nil
+	}
+	perms, err := a.roles.EffectivePermissions(ctx, user.OrbitID, user.ID)
+	if err != nil {
+		return false, err
+	}
+	return slices.Contains(perms, permission), nil
+}
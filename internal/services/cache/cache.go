@@ -15,6 +15,10 @@ type Cache interface {
 	Get(ctx context.Context, key string, dest any) error
 	Set(ctx context.Context, key string, value any, ttl time.Duration) error
 	Delete(ctx context.Context, key string) error
+	// Increment atomically adds one to the counter stored at key and returns
+	// the new value. The ttl starts when the counter is created and is not
+	// extended by later increments, so counters describe a fixed window.
+	Increment(ctx context.Context, key string, ttl time.Duration) (int64, error)
 }
 
 type Manager interface {
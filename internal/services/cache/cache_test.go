@@ -0,0 +1,166 @@
+package cache_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services/cache"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/testutil"
+)
+
+type backend struct {
+	name string
+	// open returns a manager and a function that lets d pass for its
+	// expirations.
+	open func(t *testing.T) (cache.Manager, func(d time.Duration))
+}
+
+var backends = []backend{
+	{"local", func(t *testing.T) (cache.Manager, func(time.Duration)) {
+		return testutil.LocalCache(t), time.Sleep
+	}},
+	{"redis", func(t *testing.T) (cache.Manager, func(time.Duration)) {
+		manager, srv := testutil.Redis(t)
+		return manager, srv.FastForward
+	}},
+}
+
+type entry struct {
+	Name  string
+	Count int
+}
+
+func TestCacheSetGetDelete(t *testing.T) {
+	for _, b := range backends {
+		t.Run(b.name, func(t *testing.T) {
+			manager, _ := b.open(t)
+			ctx := t.Context()
+			c := manager.Cache("entries")
+
+			var got entry
+			if err := c.Get(ctx, "k", &got); !errors.Is(err, cache.ErrCacheMiss) {
+				t.Fatalf("Get of a missing key = %v, want ErrCacheMiss", err)
+			}
+			if err := c.Set(ctx, "k", entry{Name: "a", Count: 2}, time.Minute); err != nil {
+				t.Fatal(err)
+			}
+			if err := c.Get(ctx, "k", &got); err != nil || got != (entry{Name: "a", Count: 2}) {
+				t.Fatalf("Get = %+v, %v", got, err)
+			}
+
+			// Caches of different names do not share keys.
+			if err := manager.Cache("other").Get(ctx, "k", &got); !errors.Is(err, cache.ErrCacheMiss) {
+				t.Fatalf("Get from another cache = %v, want ErrCacheMiss", err)
+			}
+
+			if err := c.Delete(ctx, "k"); err != nil {
+				t.Fatal(err)
+			}
+			if err := c.Delete(ctx, "k"); err != nil {
+				t.Fatalf("Delete of a missing key = %v", err)
+			}
+			if err := c.Get(ctx, "k", &got); !errors.Is(err, cache.ErrCacheMiss) {
+				t.Fatalf("Get after Delete = %v, want ErrCacheMiss", err)
+			}
+		})
+	}
+}
+
+func TestCacheExpiry(t *testing.T) {
+	for _, b := range backends {
+		t.Run(b.name, func(t *testing.T) {
+			manager, wait := b.open(t)
+			ctx := t.Context()
+			c := manager.Cache("entries")
+
+			if err := c.Set(ctx, "k", entry{Name: "a"}, 50*time.Millisecond); err != nil {
+				t.Fatal(err)
+			}
+			wait(100 * time.Millisecond)
+			var got entry
+			if err := c.Get(ctx, "k", &got); !errors.Is(err, cache.ErrCacheMiss) {
+				t.Fatalf("Get after expiry = %+v, %v, want ErrCacheMiss", got, err)
+			}
+		})
+	}
+}
+
+func TestCacheIncrementUsesFixedWindow(t *testing.T) {
+	for _, b := range backends {
+		t.Run(b.name, func(t *testing.T) {
+			manager, wait := b.open(t)
+			ctx := t.Context()
+			c := manager.Cache("counters")
+			ttl := 200 * time.Millisecond
+
+			for want := int64(1); want <= 3; want++ {
+				n, err := c.Increment(ctx, "k", ttl)
+				if err != nil {
+					t.Fatal(err)
+				}
+				if n != want {
+					t.Fatalf("Increment = %d, want %d", n, want)
+				}
+				// Later increments must not push the expiry out.
+				wait(80 * time.Millisecond)
+			}
+			wait(80 * time.Millisecond)
+			n, err := c.Increment(ctx, "k", ttl)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if n != 1 {
+				t.Fatalf("Increment after the window = %d, want a new counter", n)
+			}
+		})
+	}
+}
+
+func TestCacheIncrementConcurrent(t *testing.T) {
+	for _, b := range backends {
+		t.Run(b.name, func(t *testing.T) {
+			manager, _ := b.open(t)
+			ctx := t.Context()
+			c := manager.Cache("counters")
+
+			const workers = 20
+			var wg sync.WaitGroup
+			for range workers {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					if _, err := c.Increment(ctx, "k", time.Minute); err != nil {
+						t.Error(err)
+					}
+				}()
+			}
+			wg.Wait()
+
+			n, err := c.Increment(ctx, "k", time.Minute)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if n != workers+1 {
+				t.Fatalf("Increment = %d, want %d", n, workers+1)
+			}
+		})
+	}
+}
+
+func TestCacheAfterShutdown(t *testing.T) {
+	for _, b := range backends {
+		t.Run(b.name, func(t *testing.T) {
+			manager, _ := b.open(t)
+			if err := manager.Shutdown(context.Background()); err != nil {
+				t.Fatal(err)
+			}
+			err := manager.Cache("entries").Set(t.Context(), "k", entry{}, time.Minute)
+			if !errors.Is(err, cache.ErrClosed) {
+				t.Fatalf("Set after Shutdown = %v, want ErrClosed", err)
+			}
+		})
+	}
+}
@@ -75,6 +75,35 @@ func (c *Cache) Delete(_ context.Context, key string) error {
 	return nil
 }
 
+func (c *Cache) Increment(_ context.Context, key string, ttl time.Duration) (int64, error) {
+	if ttl <= 0 {
+		ttl = c.defaultTTL
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	current, exists := c.items[key]
+	var n int64
+	if exists && now.Before(current.expiresAt) {
+		if err := json.Unmarshal(current.value, &n); err != nil {
+			return 0, err
+		}
+	} else {
+		current = item{expiresAt: now.Add(ttl)}
+	}
+
+	n++
+	data, err := json.Marshal(n)
+	if err != nil {
+		return 0, err
+	}
+	current.value = data
+	c.items[key] = current
+	return n, nil
+}
+
 func (c *Cache) EvictExpired() int {
 	now := time.Now()
 	evicted := 0
@@ -96,3 +96,7 @@ func (e *errorCache) Set(context.Context, string, any, time.Duration) error {
 func (e *errorCache) Delete(context.Context, string) error {
 	return e.err
 }
+
+func (e *errorCache) Increment(context.Context, string, time.Duration) (int64, error) {
+	return 0, e.err
+}
@@ -10,13 +10,24 @@ import (
 	"github.com/redis/go-redis/v9"
 )
 
+// incrementScript sets the expiry together with the first increment so a
+// counter can never be left without one. It touches a single key and is
+// therefore safe to run against a cluster.
+var incrementScript = redis.NewScript(`
+local n = redis.call('INCR', KEYS[1])
+if n == 1 then
+	redis.call('PEXPIRE', KEYS[1], ARGV[1])
+end
+return n
+`)
+
 type Cache struct {
-	client     *redis.Client
+	client     redis.UniversalClient
 	namespace  string
 	defaultTTL time.Duration
 }
 
-func newCache(client *redis.Client, namespace string, defaultTTL time.Duration) *Cache {
+func newCache(client redis.UniversalClient, namespace string, defaultTTL time.Duration) *Cache {
 	return &Cache{
 		client:     client,
 		namespace:  namespace,
@@ -60,3 +71,11 @@ func (c *Cache) Delete(ctx context.Context, key string) error {
 	}
 	return err
 }
+
+func (c *Cache) Increment(ctx context.Context, key string, ttl time.Duration) (int64, error) {
+	if ttl <= 0 {
+		ttl = c.defaultTTL
+	}
+
+	return incrementScript.Run(ctx, c.client, []string{c.buildKey(key)}, ttl.Milliseconds()).Int64()
+}
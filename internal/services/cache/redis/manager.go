@@ -11,7 +11,7 @@ import (
 )
 
 type Manager struct {
-	client     *redis.Client
+	client     redis.UniversalClient
 	defaultTTL time.Duration
 
 	mu     sync.RWMutex
@@ -27,7 +27,7 @@ func WithDefaultTTL(ttl time.Duration) Option {
 	}
 }
 
-func NewManager(client *redis.Client, opts ...Option) *Manager {
+func NewManager(client redis.UniversalClient, opts ...Option) *Manager {
 	m := &Manager{
 		client:     client,
 		defaultTTL: 5 * time.Minute,
@@ -89,3 +89,7 @@ func (e *errorCache) Set(context.Context, string, any, time.Duration) error {
 func (e *errorCache) Delete(context.Context, string) error {
 	return e.err
 }
+
+func (e *errorCache) Increment(context.Context, string, time.Duration) (int64, error) {
+	return 0, e.err
+}
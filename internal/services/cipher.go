@@ -0,0 +1,64 @@
+package services
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var ErrMalformedCiphertext = errors.New("malformed ciphertext")
+
+// SecretCipher encrypts secrets the server has to read back later, such as
+// TOTP seeds. The associated data ties a ciphertext to the row it belongs to
+// so it cannot be copied onto another user.
+type SecretCipher interface {
+	Seal(plaintext, associatedData []byte) (string, error)
+	Open(ciphertext string, associatedData []byte) ([]byte, error)
+}
+
+const aesGCMPrefix = "v1:"
+
+type AESGCMCipher struct {
+	aead cipher.AEAD
+}
+
+func NewAESGCMCipher(key []byte) (*AESGCMCipher, error) {
+	if len(key) != 32 {
+		return nil, fmt.Errorf("encryption key must be 32 bytes, got %d", len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, err
+	}
+	return &AESGCMCipher{aead: aead}, nil
+}
+
+func (c *AESGCMCipher) Seal(plaintext, associatedData []byte) (string, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	sealed := c.aead.Seal(nonce, nonce, plaintext, associatedData)
+	return aesGCMPrefix + base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+func (c *AESGCMCipher) Open(ciphertext string, associatedData []byte) ([]byte, error) {
+	encoded, ok := strings.CutPrefix(ciphertext, aesGCMPrefix)
+	if !ok {
+		return nil, ErrMalformedCiphertext
+	}
+	sealed, err := base64.RawStdEncoding.DecodeString(encoded)
+	if err != nil || len(sealed) < c.aead.NonceSize() {
+		return nil, ErrMalformedCiphertext
+	}
+	nonce, data := sealed[:c.aead.NonceSize()], sealed[c.aead.NonceSize():]
+	return c.aead.Open(nil, nonce, data, associatedData)
+}
@@ -0,0 +1,169 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+)
+
+const (
+	ClaimSourceRoles       = "roles"
+	ClaimSourcePermissions = "permissions"
+
+	TokenTypeAccess = "access_token"
+	TokenTypeID     = "id_token"
+)
+
+// ClaimMapper puts what a user holds through roles into a claim of the tokens
+// issued to a client, such as the authorities a Spring resource server reads.
+// Mappers are kept in the client metadata under claim_mappers.
+type ClaimMapper struct {
+	Source string   `json:"source"`
+	Claim  string   `json:"claim"`
+	Tokens []string `json:"tokens,omitempty"`
+	Prefix string   `json:"prefix,omitempty"`
+}
+
+// appliesTo reports whether the mapper adds its claim to tokens of the type.
+func (m ClaimMapper) appliesTo(tokenType string) bool {
+	return len(m.Tokens) == 0 || slices.Contains(m.Tokens, tokenType)
+}
+
+// Claims the server sets itself, which mappers must not overwrite.
+var reservedClaims = map[string]bool{
+	"iss": true, "sub": true, "aud": true, "exp": true, "nbf": true, "iat": true, "jti": true,
+	"azp": true, "nonce": true, "auth_time": true, "acr": true, "amr": true, "sid": true,
+	"at_hash": true, "c_hash": true, "cnf": true, "scope": true, "client_id": true,
+}
+
+const maxClaimMappers = 10
+
+func normalizeClaimMappers(mappers []ClaimMapper) ([]ClaimMapper, error) {
+	if len(mappers) > maxClaimMappers {
+		return nil, fmt.Errorf("%w: at most %d claim mappers", ErrInvalidClientMetadata, maxClaimMappers)
+	}
+	claimed := make(map[string]bool)
+	out := make([]ClaimMapper, 0, len(mappers))
+	for _, m := range mappers {
+		m.Claim = strings.TrimSpace(m.Claim)
+		if m.Source != ClaimSourceRoles && m.Source != ClaimSourcePermissions {
+			return nil, fmt.Errorf("%w: unsupported claim mapper source %q", ErrInvalidClientMetadata, m.Source)
+		}
+		if m.Claim == "" || reservedClaims[m.Claim] {
+			return nil, fmt.Errorf("%w: claim %q cannot be mapped", ErrInvalidClientMetadata, m.Claim)
+		}
+		var tokens []string
+		for _, t := range m.Tokens {
+			if t != TokenTypeAccess && t != TokenTypeID {
+				return nil, fmt.Errorf("%w: unsupported token type %q", ErrInvalidClientMetadata, t)
+			}
+			if !slices.Contains(tokens, t) {
+				tokens = append(tokens, t)
+			}
+		}
+		m.Tokens = tokens
+		for _, t := range []string{TokenTypeAccess, TokenTypeID} {
+			if !m.appliesTo(t) {
+				continue
+			}
+			if claimed[t+" "+m.Claim] {
+				return nil, fmt.Errorf("%w: claim %q is mapped twice", ErrInvalidClientMetadata, m.Claim)
+			}
+			claimed[t+" "+m.Claim] = true
+		}
+		out = append(out, m)
+	}
+	return out, nil
+}
+
+// ClaimMappersOf returns the claim mappers configured for a client.
+func ClaimMappersOf(c *models.Client) []ClaimMapper {
+	var meta struct {
+		ClaimMappers []ClaimMapper `json:"claim_mappers"`
+	}
+	if len(c.Metadata) > 0 {
+		_ = json.Unmarshal(c.Metadata, &meta)
+	}
+	return meta.ClaimMappers
+}
+
+func setClaimMappers(c *models.Client, mappers []ClaimMapper) {
+	meta := map[string]any{}
+	if len(c.Metadata) > 0 {
+		_ = json.Unmarshal(c.Metadata, &meta)
+	}
+	if len(mappers) == 0 {
+		delete(meta, "claim_mappers")
+	} else {
+		meta["claim_mappers"] = mappers
+	}
+	c.Metadata, _ = json.Marshal(meta)
+}
+
+// TokenClaims returns the claims the mappers of client add to a token of the
+// type issued to a user for audience. The audience of an ID token is the
+// client id. A permission is only put into tokens for the audiences its
+// metadata lists under "audiences", so every resource server sees the
+// authorities meant for it alone.
+func (s *RoleService) TokenClaims(ctx context.Context, client *models.Client, userID int64, tokenType string, audience []string) (map[string]any, error) {
+	ctx, span := s.tracer.Start(ctx, "TokenClaims")
+	defer span.End()
+
+	var claims map[string]any
+	var roles []string
+	var perms []*models.Permission
+	for _, m := range ClaimMappersOf(client) {
+		if !m.appliesTo(tokenType) {
+			continue
+		}
+		var values []string
+		switch m.Source {
+		case ClaimSourceRoles:
+			if roles == nil {
+				var err error
+				if roles, err = s.RoleNames(ctx, client.OrbitID, userID); err != nil {
+					return nil, err
+				}
+			}
+			values = roles
+		case ClaimSourcePermissions:
+			if perms == nil {
+				var err error
+				if perms, err = s.UserPermissions(ctx, client.OrbitID, userID); err != nil {
+					return nil, err
+				}
+			}
+			for _, p := range perms {
+				if permissionIntendedFor(p, audience) {
+					values = append(values, p.Name)
+				}
+			}
+		default:
+			continue
+		}
+
+		mapped := make([]string, 0, len(values))
+		for _, v := range values {
+			mapped = append(mapped, m.Prefix+v)
+		}
+		if claims == nil {
+			claims = make(map[string]any)
+		}
+		claims[m.Claim] = mapped
+	}
+	return claims, nil
+}
+
+func permissionIntendedFor(p *models.Permission, audience []string) bool {
+	list, _ := p.Metadata["audiences"].([]any)
+	for _, v := range list {
+		if aud, ok := v.(string); ok && slices.Contains(audience, aud) {
+			return true
+		}
+	}
+	return false
+}
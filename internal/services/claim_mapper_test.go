@@ -0,0 +1,76 @@
+package services_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/testutil"
+)
+
+func TestRoleServiceTokenClaims(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	cacheManager, _ := testutil.Redis(t)
+	clients := services.NewClientService(dbConn, cacheManager, nop)
+	roles := services.NewRoleService(dbConn, cacheManager, nop)
+	permissions := services.NewPermissionService(dbConn, cacheManager, nop)
+	orbit := fx.Orbit()
+	user := fx.User(orbit)
+
+	settings := services.ClientSettings{
+		Metadata: services.ClientMetadata{RedirectURIs: []string{"https://app.example.test/callback"}},
+		IsActive: true,
+		ClaimMappers: []services.ClaimMapper{
+			{Source: services.ClaimSourceRoles, Claim: "roles", Tokens: []string{services.TokenTypeID}},
+			{Source: services.ClaimSourcePermissions, Claim: "authorities", Prefix: "SCOPE_", Tokens: []string{services.TokenTypeAccess}},
+		},
+	}
+	client, _, err := clients.CreateManaged(ctx, orbit, settings)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	role, err := roles.Create(ctx, &models.Role{OrbitID: orbit.ID, Name: "clerk"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, audiences := range map[string][]any{
+		"orders:read":      {"https://orders.example.test"},
+		"billing:read":     {"https://billing.example.test"},
+		"orbit:users:read": nil,
+	} {
+		perm, err := permissions.Create(ctx, &models.Permission{OrbitID: orbit.ID, Name: name, Metadata: map[string]any{"audiences": audiences}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := roles.GrantPermission(ctx, orbit.ID, role.ID, perm.ID); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := roles.AssignToUser(ctx, orbit.ID, role.ID, user.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	claims, err := roles.TokenClaims(ctx, client, user.ID, services.TokenTypeAccess, []string{"https://orders.example.test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := claims["authorities"].([]string); !slices.Equal(got, []string{"SCOPE_orders:read"}) || len(claims) != 1 {
+		t.Fatalf("access token claims = %v, want the orders authorities alone", claims)
+	}
+	claims, err = roles.TokenClaims(ctx, client, user.ID, services.TokenTypeID, []string{client.ClientID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := claims["roles"].([]string); !slices.Equal(got, []string{"clerk"}) || len(claims) != 1 {
+		t.Fatalf("ID token claims = %v, want the roles alone", claims)
+	}
+
+	settings.ClaimMappers = []services.ClaimMapper{{Source: services.ClaimSourceRoles, Claim: "sub"}}
+	if _, err := clients.UpdateManaged(ctx, client, settings); !errors.Is(err, services.ErrInvalidClientMetadata) {
+		t.Fatalf("UpdateManaged(mapping sub) error = %v, want ErrInvalidClientMetadata", err)
+	}
+}
@@ -0,0 +1,549 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	ErrRegistrationDisabled      = errors.New("dynamic client registration is disabled")
+	ErrInvalidInitialAccessToken = errors.New("initial access token is invalid")
+	ErrInvalidRegistrationToken  = errors.New("registration access token is invalid")
+	ErrInvalidClientMetadata     = errors.New("invalid client metadata")
+)
+
+const (
+	AuthMethodClientSecretBasic = "client_secret_basic"
+	AuthMethodClientSecretPost  = "client_secret_post"
+	AuthMethodPrivateKeyJWT     = "private_key_jwt"
+	AuthMethodNone              = "none"
+
+	GrantTypeAuthorizationCode = "authorization_code"
+	GrantTypeRefreshToken      = "refresh_token"
+	GrantTypeClientCredentials = "client_credentials"
+	GrantTypeDeviceCode        = "urn:ietf:params:oauth:grant-type:device_code"
+
+	AppTypeWeb    = "web"
+	AppTypeNative = "native"
+)
+
+var supportedAuthMethods = map[string]bool{
+	AuthMethodClientSecretBasic: true,
+	AuthMethodClientSecretPost:  true,
+	AuthMethodPrivateKeyJWT:     true,
+	AuthMethodNone:              true,
+}
+
+var supportedGrantTypes = map[string]bool{
+	GrantTypeAuthorizationCode: true,
+	GrantTypeRefreshToken:      true,
+	GrantTypeClientCredentials: true,
+	GrantTypeDeviceCode:        true,
+}
+
+// ClientMetadata is the RFC 7591 view of a client, independent of the wire
+// format used by the HTTP layer.
+type ClientMetadata struct {
+	ClientID                string
+	RedirectURIs            []string
+	PostLogoutRedirectURIs  []string
+	TokenEndpointAuthMethod string
+	GrantTypes              []string
+	ResponseTypes           []string
+	ApplicationType         string
+	ClientName              string
+	ClientURI               string
+	LogoURI                 string
+	Scope                   string
+	Contacts                []string
+	JWKS                    json.RawMessage
+	JWKSURI                 string
+	SoftwareStatement       string
+	SoftwareID              string
+	SoftwareVersion         string
+	SoftwareStatementIssuer string
+}
+
+type RegisteredClient struct {
+	Client                  *models.Client
+	Metadata                ClientMetadata
+	ClientSecret            string
+	ClientSecretExpiresAt   *time.Time
+	RegistrationAccessToken string
+	RegistrationClientURI   string
+}
+
+type ClientRegistrationService struct {
+	db      *db.DB
+	clients *ClientService
+	events  *SecurityEventService
+	logger  zerolog.Logger
+	tracer  trace.Tracer
+}
+
+func NewClientRegistrationService(dbConn *db.DB, clients *ClientService, events *SecurityEventService, logger zerolog.Logger) *ClientRegistrationService {
+	return &ClientRegistrationService{
+		db:      dbConn,
+		clients: clients,
+		events:  events,
+		logger:  logger,
+		tracer:  otel.Tracer("service.client_registration"),
+	}
+}
+
+// IssueInitialAccessToken creates a token that gates /register for an orbit.
+// maxUses of zero means unlimited, a zero ttl means the token never expires.
+// The plain token is only returned here.
+func (s *ClientRegistrationService) IssueInitialAccessToken(ctx context.Context, orbitID int64, description string, maxUses int, ttl time.Duration, createdBy *int64) (string, *models.InitialAccessToken, error) {
+	ctx, span := s.tracer.Start(ctx, "IssueInitialAccessToken")
+	defer span.End()
+
+	plain, err := newOpaqueToken(32)
+	if err != nil {
+		return "", nil, err
+	}
+	t := &models.InitialAccessToken{
+		OrbitID:     orbitID,
+		TokenHash:   hashToken(plain),
+		Description: description,
+		MaxUses:     maxUses,
+		CreatedBy:   createdBy,
+	}
+	if ttl > 0 {
+		exp := time.Now().UTC().Add(ttl)
+		t.ExpiresAt = &exp
+	}
+
+	var created *models.InitialAccessToken
+	err = s.db.WithTx(ctx, func(tx pgx.Tx) error {
+		repo := repositories.NewInitialAccessTokenRepository(tx, s.logger)
+		var err error
+		created, err = repo.Create(ctx, t)
+		return err
+	})
+	if err != nil {
+		s.logger.Error().Err(err).Int64("orbit_id", orbitID).Msg("initial access token issue failed")
+		return "", nil, err
+	}
+	return plain, created, nil
+}
+
+func (s *ClientRegistrationService) RevokeInitialAccessToken(ctx context.Context, orbitID, id int64) error {
+	ctx, span := s.tracer.Start(ctx, "RevokeInitialAccessToken")
+	defer span.End()
+
+	return s.db.WithTx(ctx, func(tx pgx.Tx) error {
+		return repositories.NewInitialAccessTokenRepository(tx, s.logger).Revoke(ctx, orbitID, id)
+	})
+}
+
+func (s *ClientRegistrationService) Register(ctx context.Context, orbit *models.Orbit, initialAccessToken string, md ClientMetadata) (*RegisteredClient, error) {
+	ctx, span := s.tracer.Start(ctx, "Register")
+	defer span.End()
+
+	cfg, err := ParseOrbitConfig(orbit)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("orbit_id", orbit.ID).Msg("orbit config is invalid")
+		return nil, err
+	}
+	if !cfg.Registration.Enabled {
+		return nil, ErrRegistrationDisabled
+	}
+	if cfg.Registration.RequireInitialAccessToken && initialAccessToken == "" {
+		return nil, ErrInvalidInitialAccessToken
+	}
+
+	md, err = s.resolveSoftwareStatement(ctx, orbit, cfg, md, nil)
+	if err != nil {
+		return nil, err
+	}
+	md, err = normalizeClientMetadata(md)
+	if err != nil {
+		return nil, err
+	}
+	md, err = defaultClientScope(md, orbit)
+	if err != nil {
+		return nil, err
+	}
+
+	clientID, err := newOpaqueToken(24)
+	if err != nil {
+		return nil, err
+	}
+	registrationToken, err := newOpaqueToken(32)
+	if err != nil {
+		return nil, err
+	}
+
+	client := &models.Client{
+		OrbitID:               orbit.ID,
+		ClientID:              clientID,
+		IsActive:              true,
+		AllowedCORSOrigins:    encodeStringList(nil),
+		RegistrationTokenHash: hashToken(registrationToken),
+	}
+	applyClientMetadata(client, md)
+
+	var secret *IssuedSecret
+	if usesClientSecret(client) {
+		var hash string
+		if secret, hash, err = newClientSecret(cfg.ClientSecrets); err != nil {
+			return nil, err
+		}
+		client.ClientSecretHash = hash
+		client.ClientSecretExpiresAt = secret.ExpiresAt
+	}
+
+	var created *models.Client
+	err = s.db.WithTx(ctx, func(tx pgx.Tx) error {
+		if initialAccessToken != "" {
+			ok, err := repositories.NewInitialAccessTokenRepository(tx, s.logger).Consume(ctx, orbit.ID, hashToken(initialAccessToken))
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return ErrInvalidInitialAccessToken
+			}
+		}
+		var err error
+		created, err = repositories.NewClientRepository(tx, s.logger).Create(ctx, client)
+		return err
+	})
+	if err != nil {
+		if !errors.Is(err, ErrInvalidInitialAccessToken) {
+			s.logger.Error().Err(err).Int64("orbit_id", orbit.ID).Msg("client registration failed")
+		}
+		return nil, err
+	}
+
+	s.logger.Info().Int64("orbit_id", orbit.ID).Str("client_id", created.ClientID).Msg("client registered")
+	registered := &RegisteredClient{
+		Client:                  created,
+		Metadata:                ClientMetadataOf(created),
+		RegistrationAccessToken: registrationToken,
+		RegistrationClientURI:   registrationClientURI(orbit, created.ClientID),
+	}
+	if secret != nil {
+		registered.ClientSecret = secret.Secret
+		registered.ClientSecretExpiresAt = secret.ExpiresAt
+	}
+	return registered, nil
+}
+
+func (s *ClientRegistrationService) Read(ctx context.Context, orbit *models.Orbit, clientID, registrationToken string) (*RegisteredClient, error) {
+	ctx, span := s.tracer.Start(ctx, "Read")
+	defer span.End()
+
+	client, err := s.authenticate(ctx, orbit.ID, clientID, registrationToken)
+	if err != nil {
+		return nil, err
+	}
+	return &RegisteredClient{
+		Client:                client,
+		Metadata:              ClientMetadataOf(client),
+		RegistrationClientURI: registrationClientURI(orbit, client.ClientID),
+	}, nil
+}
+
+// Update replaces the client metadata as described by RFC 7592. The
+// registration access token is rotated on every successful update.
+func (s *ClientRegistrationService) Update(ctx context.Context, orbit *models.Orbit, clientID, registrationToken string, md ClientMetadata) (*RegisteredClient, error) {
+	ctx, span := s.tracer.Start(ctx, "Update")
+	defer span.End()
+
+	client, err := s.authenticate(ctx, orbit.ID, clientID, registrationToken)
+	if err != nil {
+		return nil, err
+	}
+	if md.ClientID != "" && md.ClientID != client.ClientID {
+		return nil, fmt.Errorf("%w: client_id does not match", ErrInvalidClientMetadata)
+	}
+
+	cfg, err := ParseOrbitConfig(orbit)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("orbit_id", orbit.ID).Msg("orbit config is invalid")
+		return nil, err
+	}
+	md, err = s.resolveSoftwareStatement(ctx, orbit, cfg, md, client)
+	if err != nil {
+		return nil, err
+	}
+	md, err = normalizeClientMetadata(md)
+	if err != nil {
+		return nil, err
+	}
+	md, err = defaultClientScope(md, orbit)
+	if err != nil {
+		return nil, err
+	}
+	if md.TokenEndpointAuthMethod != AuthMethodNone && client.IsPublic {
+		return nil, fmt.Errorf("%w: a public client cannot become confidential", ErrInvalidClientMetadata)
+	}
+	if md.TokenEndpointAuthMethod == AuthMethodNone && !client.IsPublic {
+		return nil, fmt.Errorf("%w: a confidential client cannot become public", ErrInvalidClientMetadata)
+	}
+
+	rotated, err := newOpaqueToken(32)
+	if err != nil {
+		return nil, err
+	}
+	applyClientMetadata(client, md)
+	client.RegistrationTokenHash = hashToken(rotated)
+
+	updated, err := s.clients.Update(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	if updated == nil {
+		return nil, ErrInvalidRegistrationToken
+	}
+	return &RegisteredClient{
+		Client:                  updated,
+		Metadata:                ClientMetadataOf(updated),
+		RegistrationAccessToken: rotated,
+		RegistrationClientURI:   registrationClientURI(orbit, updated.ClientID),
+	}, nil
+}
+
+func (s *ClientRegistrationService) Delete(ctx context.Context, orbit *models.Orbit, clientID, registrationToken string) error {
+	ctx, span := s.tracer.Start(ctx, "Delete")
+	defer span.End()
+
+	client, err := s.authenticate(ctx, orbit.ID, clientID, registrationToken)
+	if err != nil {
+		return err
+	}
+	if err := s.clients.Delete(ctx, client.ID); err != nil {
+		return err
+	}
+	s.logger.Info().Int64("orbit_id", orbit.ID).Str("client_id", clientID).Msg("client deregistered")
+	return nil
+}
+
+// resolveSoftwareStatement verifies a presented software statement and lets its
+// claims take precedence over the plain metadata sent alongside it. A client
+// registered with a statement, passed as registered on update, stays bound to
+// it: updates must present a statement of the same issuer for the same
+// software, or the metadata it asserted could be replaced at will.
+func (s *ClientRegistrationService) resolveSoftwareStatement(ctx context.Context, orbit *models.Orbit, cfg OrbitConfig, md ClientMetadata, registered *models.Client) (ClientMetadata, error) {
+	var bound ClientMetadata
+	if registered != nil {
+		bound = ClientMetadataOf(registered)
+	}
+	md.SoftwareID, md.SoftwareVersion, md.SoftwareStatementIssuer = "", "", ""
+	if md.SoftwareStatement == "" {
+		if cfg.Registration.SoftwareStatement.Required || bound.SoftwareStatementIssuer != "" {
+			err := fmt.Errorf("%w: software_statement is required", ErrUnapprovedSoftwareStatement)
+			s.rejectSoftwareStatement(ctx, orbit.ID, err)
+			return md, err
+		}
+		return md, nil
+	}
+
+	claims, err := verifySoftwareStatement(md.SoftwareStatement, cfg.Registration.SoftwareStatement.TrustedDirectories, time.Now())
+	if err != nil {
+		if errors.Is(err, ErrInvalidSoftwareStatement) || errors.Is(err, ErrUnapprovedSoftwareStatement) {
+			s.rejectSoftwareStatement(ctx, orbit.ID, err)
+		} else {
+			s.logger.Error().Err(err).Int64("orbit_id", orbit.ID).Msg("software statement verification failed")
+		}
+		return md, err
+	}
+	if bound.SoftwareStatementIssuer != "" && (claims.Issuer != bound.SoftwareStatementIssuer || claims.SoftwareID != bound.SoftwareID) {
+		err := fmt.Errorf("%w: the statement is for other software than the client was registered with", ErrUnapprovedSoftwareStatement)
+		s.rejectSoftwareStatement(ctx, orbit.ID, err)
+		return md, err
+	}
+	return claims.applyTo(md), nil
+}
+
+func (s *ClientRegistrationService) rejectSoftwareStatement(ctx context.Context, orbitID int64, reason error) {
+	s.logger.Warn().Err(reason).Int64("orbit_id", orbitID).Msg("software statement rejected")
+	s.events.Record(ctx, orbitID, nil, models.SecurityEventSoftwareStatementRejected, models.SecuritySeverityWarning, map[string]any{
+		"reason": reason.Error(),
+	})
+}
+
+// authenticate deliberately reports unknown clients and bad tokens the same way
+// so the configuration endpoint cannot be used to probe for client ids.
+func (s *ClientRegistrationService) authenticate(ctx context.Context, orbitID int64, clientID, registrationToken string) (*models.Client, error) {
+	if registrationToken == "" {
+		return nil, ErrInvalidRegistrationToken
+	}
+	client, err := s.clients.GetByClientID(ctx, orbitID, clientID)
+	if err != nil {
+		return nil, err
+	}
+	if client == nil || client.RegistrationTokenHash == "" || !tokenHashEqual(registrationToken, client.RegistrationTokenHash) {
+		s.logger.Warn().Int64("orbit_id", orbitID).Str("client_id", clientID).Msg("invalid registration access token")
+		return nil, ErrInvalidRegistrationToken
+	}
+	return client, nil
+}
+
+func normalizeClientMetadata(md ClientMetadata) (ClientMetadata, error) {
+	if md.TokenEndpointAuthMethod == "" {
+		md.TokenEndpointAuthMethod = AuthMethodClientSecretBasic
+	}
+	if !supportedAuthMethods[md.TokenEndpointAuthMethod] {
+		return md, fmt.Errorf("%w: unsupported token_endpoint_auth_method %q", ErrInvalidClientMetadata, md.TokenEndpointAuthMethod)
+	}
+	if len(md.GrantTypes) == 0 {
+		md.GrantTypes = []string{GrantTypeAuthorizationCode}
+	}
+	for _, gt := range md.GrantTypes {
+		if !supportedGrantTypes[gt] {
+			return md, fmt.Errorf("%w: unsupported grant_type %q", ErrInvalidClientMetadata, gt)
+		}
+	}
+	if len(md.ResponseTypes) == 0 && containsString(md.GrantTypes, GrantTypeAuthorizationCode) {
+		md.ResponseTypes = []string{"code"}
+	}
+	for _, rt := range md.ResponseTypes {
+		if rt != "code" {
+			return md, fmt.Errorf("%w: unsupported response_type %q", ErrInvalidClientMetadata, rt)
+		}
+	}
+	if len(md.ResponseTypes) > 0 && !containsString(md.GrantTypes, GrantTypeAuthorizationCode) {
+		return md, fmt.Errorf("%w: response_type code requires the authorization_code grant", ErrInvalidClientMetadata)
+	}
+	if md.ApplicationType == "" {
+		md.ApplicationType = AppTypeWeb
+	}
+	if md.ApplicationType != AppTypeWeb && md.ApplicationType != AppTypeNative {
+		return md, fmt.Errorf("%w: unsupported application_type %q", ErrInvalidClientMetadata, md.ApplicationType)
+	}
+
+	if md.TokenEndpointAuthMethod == AuthMethodNone && containsString(md.GrantTypes, GrantTypeClientCredentials) {
+		return md, fmt.Errorf("%w: public clients cannot use client_credentials", ErrInvalidClientMetadata)
+	}
+	hasJWKS := len(md.JWKS) > 0 && string(md.JWKS) != "null"
+	if hasJWKS && md.JWKSURI != "" {
+		return md, fmt.Errorf("%w: jwks and jwks_uri are mutually exclusive", ErrInvalidClientMetadata)
+	}
+	if md.TokenEndpointAuthMethod == AuthMethodPrivateKeyJWT && !hasJWKS && md.JWKSURI == "" {
+		return md, fmt.Errorf("%w: private_key_jwt requires jwks or jwks_uri", ErrInvalidClientMetadata)
+	}
+	if !hasJWKS {
+		md.JWKS = nil
+	}
+
+	for _, u := range []string{md.ClientURI, md.LogoURI, md.JWKSURI} {
+		if u != "" && !isHTTPURL(u) {
+			return md, fmt.Errorf("%w: %q is not an http(s) url", ErrInvalidClientMetadata, u)
+		}
+	}
+
+	if containsString(md.GrantTypes, GrantTypeAuthorizationCode) && len(md.RedirectURIs) == 0 {
+		return md, fmt.Errorf("%w: redirect_uris is required for the authorization_code grant", ErrInvalidRedirectURI)
+	}
+	for _, uri := range append(append([]string{}, md.RedirectURIs...), md.PostLogoutRedirectURIs...) {
+		if err := ValidateRedirectURI(uri, md.ApplicationType); err != nil {
+			return md, err
+		}
+	}
+	return md, nil
+}
+
+// defaultClientScope gives a client registered without scope the default
+// scopes of the orbit. A client with no scope list may request any scope, so
+// registration never leaves it empty.
+func defaultClientScope(md ClientMetadata, orbit *models.Orbit) (ClientMetadata, error) {
+	if len(strings.Fields(md.Scope)) > 0 {
+		return md, nil
+	}
+	defaults := decodeStringList(orbit.DefaultScopes)
+	if len(defaults) == 0 {
+		return md, fmt.Errorf("%w: scope is required", ErrInvalidClientMetadata)
+	}
+	md.Scope = strings.Join(defaults, " ")
+	return md, nil
+}
+
+func isHTTPURL(raw string) bool {
+	u, err := url.Parse(raw)
+	return err == nil && (u.Scheme == "https" || u.Scheme == "http") && u.Host != ""
+}
+
+// isOrigin accepts a serialized web origin: scheme, host and port only.
+func isOrigin(raw string) bool {
+	u, err := url.Parse(raw)
+	return err == nil && isHTTPURL(raw) && u.User == nil && u.Path == "" && u.RawQuery == "" && !strings.Contains(raw, "#")
+}
+
+func applyClientMetadata(c *models.Client, md ClientMetadata) {
+	c.Name = md.ClientName
+	c.RedirectURIs = encodeStringList(md.RedirectURIs)
+	c.PostLogoutRedirectURIs = encodeStringList(md.PostLogoutRedirectURIs)
+	c.GrantTypes = encodeStringList(md.GrantTypes)
+	c.ResponseTypes = encodeStringList(md.ResponseTypes)
+	c.TokenEndpointAuthMethod = md.TokenEndpointAuthMethod
+	c.Contacts = encodeStringList(md.Contacts)
+	c.LogoURI = md.LogoURI
+	c.AppType = md.ApplicationType
+	c.IsPublic = md.TokenEndpointAuthMethod == AuthMethodNone
+	c.AllowedScopes = encodeStringList(strings.Fields(md.Scope))
+	c.JWKS = md.JWKS
+	c.JWKSURI = md.JWKSURI
+
+	meta := map[string]any{}
+	if len(c.Metadata) > 0 {
+		_ = json.Unmarshal(c.Metadata, &meta)
+	}
+	setMetadataString(meta, "client_uri", md.ClientURI)
+	setMetadataString(meta, "software_id", md.SoftwareID)
+	setMetadataString(meta, "software_version", md.SoftwareVersion)
+	setMetadataString(meta, "software_statement_issuer", md.SoftwareStatementIssuer)
+	c.Metadata, _ = json.Marshal(meta)
+}
+
+func ClientMetadataOf(c *models.Client) ClientMetadata {
+	md := ClientMetadata{
+		ClientID:                c.ClientID,
+		RedirectURIs:            decodeStringList(c.RedirectURIs),
+		PostLogoutRedirectURIs:  decodeStringList(c.PostLogoutRedirectURIs),
+		TokenEndpointAuthMethod: c.TokenEndpointAuthMethod,
+		GrantTypes:              decodeStringList(c.GrantTypes),
+		ResponseTypes:           decodeStringList(c.ResponseTypes),
+		ApplicationType:         c.AppType,
+		ClientName:              c.Name,
+		LogoURI:                 c.LogoURI,
+		Scope:                   strings.Join(decodeStringList(c.AllowedScopes), " "),
+		Contacts:                decodeStringList(c.Contacts),
+		JWKS:                    c.JWKS,
+		JWKSURI:                 c.JWKSURI,
+	}
+	var meta map[string]any
+	if len(c.Metadata) > 0 && json.Unmarshal(c.Metadata, &meta) == nil {
+		md.ClientURI, _ = meta["client_uri"].(string)
+		md.SoftwareID, _ = meta["software_id"].(string)
+		md.SoftwareVersion, _ = meta["software_version"].(string)
+		md.SoftwareStatementIssuer, _ = meta["software_statement_issuer"].(string)
+	}
+	return md
+}
+
+func setMetadataString(meta map[string]any, key, value string) {
+	if value == "" {
+		delete(meta, key)
+		return
+	}
+	meta[key] = value
+}
+
+func registrationClientURI(orbit *models.Orbit, clientID string) string {
+	return strings.TrimRight(orbit.Issuer, "/") + "/register/" + url.PathEscape(clientID)
+}
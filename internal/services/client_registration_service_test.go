@@ -0,0 +1,158 @@
+package services_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/testutil"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+)
+
+// softwareStatement signs the claims of a software statement as the directory
+// https://directory.example.test.
+func softwareStatement(t *testing.T, key *ecdsa.PrivateKey, claims map[string]any) string {
+	t.Helper()
+	signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.ES256, Key: jose.JSONWebKey{Key: key, KeyID: "directory"}}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	claims["iss"] = "https://directory.example.test"
+	claims["iat"] = time.Now().Unix()
+	raw, err := jwt.Signed(signer).Claims(claims).Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return raw
+}
+
+func TestClientRegistrationServiceKeepsStatementBinding(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	cacheManager, _ := testutil.Redis(t)
+	registration := services.NewClientRegistrationService(dbConn, services.NewClientService(dbConn, cacheManager, nop), services.NewSecurityEventService(dbConn, nop), nop)
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwks, err := json.Marshal(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{{Key: &key.PublicKey, KeyID: "directory", Algorithm: "ES256", Use: "sig"}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Statements are optional in the orbit, so only the binding of the
+	// registered client can demand one on update.
+	cfg, err := json.Marshal(map[string]any{"registration": map[string]any{
+		"enabled": true,
+		"software_statement": map[string]any{
+			"trusted_directories": []map[string]any{{"issuer": "https://directory.example.test", "jwks": json.RawMessage(jwks)}},
+		},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	orbit := fx.Orbit(func(o *models.Orbit) { o.Config = cfg })
+
+	statement := softwareStatement(t, key, map[string]any{
+		"software_id":   "payments-app",
+		"redirect_uris": []string{"https://payments.example.test/callback"},
+		"grant_types":   []string{"authorization_code"},
+		"scope":         "openid",
+	})
+	registered, err := registration.Register(ctx, orbit, "", services.ClientMetadata{
+		SoftwareStatement: statement,
+		RedirectURIs:      []string{"https://evil.example.test/callback"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(registered.Metadata.RedirectURIs, []string{"https://payments.example.test/callback"}) || registered.Metadata.SoftwareID != "payments-app" {
+		t.Fatalf("registered metadata = %+v, want the statement to win", registered.Metadata)
+	}
+	clientID, token := registered.Client.ClientID, registered.RegistrationAccessToken
+
+	// Dropping the statement would otherwise let the client replace what the
+	// directory asserted.
+	_, err = registration.Update(ctx, orbit, clientID, token, services.ClientMetadata{
+		RedirectURIs: []string{"https://evil.example.test/callback"},
+		GrantTypes:   []string{"authorization_code", "refresh_token"},
+		Scope:        "openid admin",
+	})
+	if !errors.Is(err, services.ErrUnapprovedSoftwareStatement) {
+		t.Fatalf("Update without the statement error = %v, want ErrUnapprovedSoftwareStatement", err)
+	}
+
+	other := softwareStatement(t, key, map[string]any{
+		"software_id":   "other-app",
+		"redirect_uris": []string{"https://evil.example.test/callback"},
+	})
+	if _, err := registration.Update(ctx, orbit, clientID, token, services.ClientMetadata{SoftwareStatement: other}); !errors.Is(err, services.ErrUnapprovedSoftwareStatement) {
+		t.Fatalf("Update with another software's statement error = %v, want ErrUnapprovedSoftwareStatement", err)
+	}
+
+	read, err := registration.Read(ctx, orbit, clientID, token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(read.Metadata.RedirectURIs, []string{"https://payments.example.test/callback"}) || read.Metadata.SoftwareID != "payments-app" {
+		t.Fatalf("metadata after rejected updates = %+v, want it unchanged", read.Metadata)
+	}
+
+	updated, err := registration.Update(ctx, orbit, clientID, token, services.ClientMetadata{
+		SoftwareStatement: statement,
+		ClientName:        "Payments",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Metadata.ClientName != "Payments" || updated.Metadata.SoftwareID != "payments-app" {
+		t.Fatalf("metadata after update with the statement = %+v", updated.Metadata)
+	}
+}
+
+// A client registered without scope must not end up with an empty scope
+// list, which would let it request any scope.
+func TestClientRegistrationServiceDefaultsScope(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	cacheManager, _ := testutil.Redis(t)
+	registration := services.NewClientRegistrationService(dbConn, services.NewClientService(dbConn, cacheManager, nop), services.NewSecurityEventService(dbConn, nop), nop)
+	enabled := json.RawMessage(`{"registration":{"enabled":true}}`)
+	md := services.ClientMetadata{RedirectURIs: []string{"https://app.example.test/callback"}}
+
+	orbit := fx.Orbit(func(o *models.Orbit) { o.Config = enabled })
+	registered, err := registration.Register(ctx, orbit, "", md)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if registered.Metadata.Scope != "openid" {
+		t.Fatalf("scope = %q, want the orbit default openid", registered.Metadata.Scope)
+	}
+	if err := services.CheckScopes(registered.Client, []string{"admin"}); !errors.Is(err, services.ErrInvalidScope) {
+		t.Fatalf("CheckScopes(admin) = %v, want ErrInvalidScope", err)
+	}
+
+	updated, err := registration.Update(ctx, orbit, registered.Client.ClientID, registered.RegistrationAccessToken, md)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if updated.Metadata.Scope != "openid" {
+		t.Fatalf("scope after update = %q, want the orbit default openid", updated.Metadata.Scope)
+	}
+
+	bare := fx.Orbit(func(o *models.Orbit) {
+		o.Config = enabled
+		o.DefaultScopes = json.RawMessage(`[]`)
+	})
+	if _, err := registration.Register(ctx, bare, "", md); !errors.Is(err, services.ErrInvalidClientMetadata) {
+		t.Fatalf("Register without scope in an orbit without defaults error = %v, want ErrInvalidClientMetadata", err)
+	}
+}
@@ -2,6 +2,8 @@ package services
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"strconv"
 	"time"
 
@@ -9,12 +11,27 @@ import (
 	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
 	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories/db"
 	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services/cache"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services/hashing"
 	"github.com/jackc/pgx/v5"
 	"github.com/rs/zerolog"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
 )
 
+var (
+	ErrClientNotFound        = errors.New("client not found")
+	ErrClientNotConfidential = errors.New("client does not authenticate with a shared secret")
+)
+
+var clientSecretHasher = hashing.NewArgon2id(hashing.DefaultArgon2idParams)
+
+// IssuedSecret carries a freshly generated client secret. The plain value is
+// never stored and cannot be retrieved again once this is returned.
+type IssuedSecret struct {
+	Secret    string
+	ExpiresAt *time.Time
+}
+
 type ClientService struct {
 	db         *db.DB
 	cache      cache.Manager
@@ -53,7 +70,9 @@ func (s *ClientService) Create(ctx context.Context, c *models.Client) (*models.C
 		return err
 	})
 	if err != nil {
-		s.logger.Error().Err(err).Str("client_id", c.ClientID).Msg("client create failed")
+		if err = conflictError(err); !isConflict(err) {
+			s.logger.Error().Err(err).Str("client_id", c.ClientID).Msg("client create failed")
+		}
 		return nil, err
 	}
 	_ = s.cache.Cache(s.prefix).Set(ctx, s.keyByClientID(created.OrbitID, created.ClientID), created, s.ttl)
@@ -109,15 +128,39 @@ func (s *ClientService) Update(ctx context.Context, c *models.Client) (*models.C
 	ctx, span := s.tracer.Start(ctx, "Update")
 	defer span.End()
 
+	return s.update(ctx, c, false)
+}
+
+// UpdateIfUnmodified updates c only if the stored client was last updated at
+// c.UpdatedAt. Otherwise it fails with ErrVersionMismatch.
+func (s *ClientService) UpdateIfUnmodified(ctx context.Context, c *models.Client) (*models.Client, error) {
+	ctx, span := s.tracer.Start(ctx, "UpdateIfUnmodified")
+	defer span.End()
+
+	return s.update(ctx, c, true)
+}
+
+func (s *ClientService) update(ctx context.Context, c *models.Client, ifUnmodified bool) (*models.Client, error) {
 	var updated *models.Client
 	err := s.db.WithTx(ctx, func(tx pgx.Tx) error {
 		repo := repositories.NewClientRepository(tx, s.logger)
+		if ifUnmodified {
+			version, err := repo.LockVersion(ctx, c.ID)
+			if err != nil {
+				return err
+			}
+			if err := checkVersion(version, c.UpdatedAt); err != nil {
+				return err
+			}
+		}
 		var err error
 		updated, err = repo.Update(ctx, c)
 		return err
 	})
 	if err != nil {
-		s.logger.Error().Err(err).Int64("client_db_id", c.ID).Msg("client update failed")
+		if err = conflictError(err); !isConflict(err) {
+			s.logger.Error().Err(err).Int64("client_db_id", c.ID).Msg("client update failed")
+		}
 		return nil, err
 	}
 	_ = s.cache.Cache(s.prefix).Delete(ctx, s.keyByClientID(c.OrbitID, c.ClientID))
@@ -155,20 +198,243 @@ func (s *ClientService) Delete(ctx context.Context, id int64) error {
 	return nil
 }
 
-func (s *ClientService) ListByOrbit(ctx context.Context, orbitID int64, limit, offset int) ([]*models.Client, error) {
+func (s *ClientService) ListByOrbit(ctx context.Context, orbitID int64, filter repositories.ClientFilter, page repositories.Page) ([]*models.Client, string, error) {
 	ctx, span := s.tracer.Start(ctx, "ListByOrbit")
 	defer span.End()
 
-	var list []*models.Client
-	err := s.db.WithTx(ctx, func(tx pgx.Tx) error {
+	list, next, err := repositories.NewClientRepository(s.db.Exec(), s.logger).ListByOrbit(ctx, orbitID, filter, page)
+	if err != nil {
+		if !errors.Is(err, repositories.ErrInvalidCursor) {
+			s.logger.Error().Err(err).Int64("orbit_id", orbitID).Msg("client list failed")
+		}
+		return nil, "", err
+	}
+	return list, next, nil
+}
+
+// CreateWithSecret creates a confidential client and generates its secret.
+func (s *ClientService) CreateWithSecret(ctx context.Context, orbit *models.Orbit, c *models.Client) (*models.Client, *IssuedSecret, error) {
+	ctx, span := s.tracer.Start(ctx, "CreateWithSecret")
+	defer span.End()
+
+	if !usesClientSecret(c) {
+		return nil, nil, ErrClientNotConfidential
+	}
+	cfg, err := ParseOrbitConfig(orbit)
+	if err != nil {
+		return nil, nil, err
+	}
+	issued, hash, err := newClientSecret(cfg.ClientSecrets)
+	if err != nil {
+		return nil, nil, err
+	}
+	c.ClientSecretHash = hash
+	c.ClientSecretExpiresAt = issued.ExpiresAt
+
+	created, err := s.Create(ctx, c)
+	if err != nil {
+		return nil, nil, err
+	}
+	return created, issued, nil
+}
+
+// RotateSecret issues a new secret and keeps the current one valid for the
+// orbit's rotation grace period, but never beyond its own expiry.
+func (s *ClientService) RotateSecret(ctx context.Context, orbit *models.Orbit, clientID string) (*IssuedSecret, error) {
+	ctx, span := s.tracer.Start(ctx, "RotateSecret")
+	defer span.End()
+
+	cfg, err := ParseOrbitConfig(orbit)
+	if err != nil {
+		return nil, err
+	}
+	issued, hash, err := newClientSecret(cfg.ClientSecrets)
+	if err != nil {
+		return nil, err
+	}
+
+	err = s.db.WithTx(ctx, func(tx pgx.Tx) error {
 		repo := repositories.NewClientRepository(tx, s.logger)
-		var err error
-		list, err = repo.ListByOrbit(ctx, orbitID, limit, offset)
-		return err
+		c, err := repo.GetByClientID(ctx, orbit.ID, clientID)
+		if err != nil {
+			return err
+		}
+		if c == nil {
+			return ErrClientNotFound
+		}
+		if !usesClientSecret(c) {
+			return ErrClientNotConfidential
+		}
+
+		now := time.Now().UTC()
+		c.PreviousSecretHash = ""
+		c.PreviousSecretExpiresAt = nil
+		if grace := cfg.ClientSecrets.RotationGrace(); grace > 0 && c.ClientSecretHash != "" && !secretExpired(c.ClientSecretExpiresAt, now) {
+			until := now.Add(grace)
+			if c.ClientSecretExpiresAt != nil && c.ClientSecretExpiresAt.Before(until) {
+				until = *c.ClientSecretExpiresAt
+			}
+			c.PreviousSecretHash = c.ClientSecretHash
+			c.PreviousSecretExpiresAt = &until
+		}
+		c.ClientSecretHash = hash
+		c.ClientSecretExpiresAt = issued.ExpiresAt
+
+		updated, err := repo.UpdateSecret(ctx, c)
+		if err != nil {
+			return err
+		}
+		if updated == nil {
+			return ErrClientNotFound
+		}
+		return nil
 	})
 	if err != nil {
-		s.logger.Error().Err(err).Int64("orbit_id", orbitID).Msg("client list failed")
+		if !errors.Is(err, ErrClientNotFound) && !errors.Is(err, ErrClientNotConfidential) {
+			s.logger.Error().Err(err).Str("client_id", clientID).Msg("client secret rotation failed")
+		}
 		return nil, err
 	}
-	return list, nil
+	_ = s.cache.Cache(s.prefix).Delete(ctx, s.keyByClientID(orbit.ID, clientID))
+	s.logger.Info().Int64("orbit_id", orbit.ID).Str("client_id", clientID).Msg("client secret rotated")
+	return issued, nil
+}
+
+// ClientSettings describe a client managed by an administrator: its RFC 7591
+// metadata plus the settings dynamic registration cannot change.
+type ClientSettings struct {
+	Metadata           ClientMetadata
+	Description        string
+	IsActive           bool
+	AllowedCORSOrigins []string
+	ClaimMappers       []ClaimMapper
+}
+
+func (cs ClientSettings) normalize() (ClientSettings, error) {
+	// Administrators state metadata directly; there is no directory to vouch
+	// for it.
+	cs.Metadata.SoftwareStatement = ""
+	cs.Metadata.SoftwareStatementIssuer = ""
+	md, err := normalizeClientMetadata(cs.Metadata)
+	if err != nil {
+		return cs, err
+	}
+	cs.Metadata = md
+	if cs.ClaimMappers, err = normalizeClaimMappers(cs.ClaimMappers); err != nil {
+		return cs, err
+	}
+	for _, origin := range cs.AllowedCORSOrigins {
+		if !isOrigin(origin) {
+			return cs, fmt.Errorf("%w: %q is not an origin", ErrInvalidClientMetadata, origin)
+		}
+	}
+	return cs, nil
+}
+
+func (cs ClientSettings) apply(c *models.Client) {
+	applyClientMetadata(c, cs.Metadata)
+	c.Description = cs.Description
+	c.IsActive = cs.IsActive
+	c.AllowedCORSOrigins = encodeStringList(cs.AllowedCORSOrigins)
+	setClaimMappers(c, cs.ClaimMappers)
+}
+
+// CreateManaged creates a client of orbit from settings validated like a
+// registration. A client id is generated unless one is given, and clients
+// authenticating with a shared secret get one issued.
+func (s *ClientService) CreateManaged(ctx context.Context, orbit *models.Orbit, settings ClientSettings) (*models.Client, *IssuedSecret, error) {
+	ctx, span := s.tracer.Start(ctx, "CreateManaged")
+	defer span.End()
+
+	settings, err := settings.normalize()
+	if err != nil {
+		return nil, nil, err
+	}
+	clientID := settings.Metadata.ClientID
+	if clientID == "" {
+		if clientID, err = newOpaqueToken(24); err != nil {
+			return nil, nil, err
+		}
+	}
+	c := &models.Client{OrbitID: orbit.ID, ClientID: clientID}
+	settings.apply(c)
+
+	if !usesClientSecret(c) {
+		created, err := s.Create(ctx, c)
+		return created, nil, err
+	}
+	return s.CreateWithSecret(ctx, orbit, c)
+}
+
+// UpdateManaged replaces the settings of c if it is unmodified since it was
+// read. Whether the client is public is fixed at creation, as with
+// registration.
+func (s *ClientService) UpdateManaged(ctx context.Context, c *models.Client, settings ClientSettings) (*models.Client, error) {
+	ctx, span := s.tracer.Start(ctx, "UpdateManaged")
+	defer span.End()
+
+	if settings.Metadata.ClientID != "" && settings.Metadata.ClientID != c.ClientID {
+		return nil, fmt.Errorf("%w: client_id cannot be changed", ErrInvalidClientMetadata)
+	}
+	settings, err := settings.normalize()
+	if err != nil {
+		return nil, err
+	}
+	if (settings.Metadata.TokenEndpointAuthMethod == AuthMethodNone) != c.IsPublic {
+		return nil, fmt.Errorf("%w: a client cannot change between public and confidential", ErrInvalidClientMetadata)
+	}
+	settings.apply(c)
+	return s.UpdateIfUnmodified(ctx, c)
+}
+
+// VerifySecret accepts the current secret until it expires and the previous
+// one until its grace period ends.
+func (s *ClientService) VerifySecret(c *models.Client, secret string) bool {
+	if c == nil || secret == "" {
+		return false
+	}
+	now := time.Now().UTC()
+	if c.ClientSecretHash != "" && !secretExpired(c.ClientSecretExpiresAt, now) {
+		if ok, err := clientSecretHasher.Verify(secret, c.ClientSecretHash); err == nil && ok {
+			return true
+		}
+	}
+	if c.PreviousSecretHash != "" && c.PreviousSecretExpiresAt != nil && now.Before(*c.PreviousSecretExpiresAt) {
+		if ok, err := clientSecretHasher.Verify(secret, c.PreviousSecretHash); err == nil && ok {
+			return true
+		}
+	}
+	return false
+}
+
+func newClientSecret(cfg ClientSecretConfig) (*IssuedSecret, string, error) {
+	plain, err := newOpaqueToken(32)
+	if err != nil {
+		return nil, "", err
+	}
+	hash, err := clientSecretHasher.Hash(plain)
+	if err != nil {
+		return nil, "", err
+	}
+	issued := &IssuedSecret{Secret: plain}
+	if ttl := cfg.TTL(); ttl > 0 {
+		exp := time.Now().UTC().Add(ttl)
+		issued.ExpiresAt = &exp
+	}
+	return issued, hash, nil
+}
+
+func usesClientSecret(c *models.Client) bool {
+	if c.IsPublic {
+		return false
+	}
+	switch c.TokenEndpointAuthMethod {
+	case AuthMethodClientSecretBasic, AuthMethodClientSecretPost, "":
+		return true
+	}
+	return false
+}
+
+func secretExpired(expiresAt *time.Time, now time.Time) bool {
+	return expiresAt != nil && !now.Before(*expiresAt)
 }
@@ -0,0 +1,66 @@
+package services_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/testutil"
+)
+
+func TestClientServiceRotateSecret(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	svc := services.NewClientService(dbConn, testutil.LocalCache(t), nop)
+	orbit := fx.Orbit()
+	client := fx.Client(orbit)
+
+	// Warm the cache so rotation has to invalidate it.
+	if _, err := svc.GetByClientID(ctx, orbit.ID, client.ClientID); err != nil {
+		t.Fatal(err)
+	}
+
+	first, err := svc.RotateSecret(ctx, orbit, client.ClientID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := svc.RotateSecret(ctx, orbit, client.ClientID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := svc.GetByClientID(ctx, orbit.ID, client.ClientID)
+	if err != nil || got == nil {
+		t.Fatalf("GetByClientID = %+v, %v", got, err)
+	}
+	if !svc.VerifySecret(got, second.Secret) {
+		t.Error("current secret rejected")
+	}
+	if !svc.VerifySecret(got, first.Secret) {
+		t.Error("previous secret rejected within the grace period")
+	}
+	if svc.VerifySecret(got, "not-a-secret") {
+		t.Error("unknown secret accepted")
+	}
+}
+
+func TestClientServiceRotateSecretOfPublicClient(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	svc := services.NewClientService(dbConn, testutil.LocalCache(t), nop)
+	orbit := fx.Orbit()
+	client := fx.Client(orbit, func(c *models.Client) {
+		c.TokenEndpointAuthMethod = "none"
+		c.AppType = "native"
+		c.GrantTypes = json.RawMessage(`["authorization_code"]`)
+	})
+
+	if _, err := svc.RotateSecret(ctx, orbit, client.ClientID); !errors.Is(err, services.ErrClientNotConfidential) {
+		t.Fatalf("RotateSecret = %v, want ErrClientNotConfidential", err)
+	}
+	if _, err := svc.RotateSecret(ctx, orbit, "unknown"); !errors.Is(err, services.ErrClientNotFound) {
+		t.Fatalf("RotateSecret of an unknown client = %v, want ErrClientNotFound", err)
+	}
+}
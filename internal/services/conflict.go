@@ -0,0 +1,43 @@
+package services
+
+import (
+	"errors"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+var (
+	// ErrVersionMismatch rejects an update made from a stale read: the
+	// resource changed after the caller read it.
+	ErrVersionMismatch = errors.New("resource was modified since it was read")
+	ErrAlreadyExists   = errors.New("a resource with the same unique name already exists")
+)
+
+// checkVersion compares the updated_at locked in the caller's transaction
+// with the one the update was based on, at the microsecond precision Postgres
+// keeps. A missing row is left for the update itself to report.
+func checkVersion(locked *time.Time, read time.Time) error {
+	if locked == nil || locked.UnixMicro() == read.UnixMicro() {
+		return nil
+	}
+	return ErrVersionMismatch
+}
+
+func isUniqueViolation(err error) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23505"
+}
+
+// conflictError turns a unique constraint violation into ErrAlreadyExists and
+// passes any other error through.
+func conflictError(err error) error {
+	if isUniqueViolation(err) {
+		return ErrAlreadyExists
+	}
+	return err
+}
+
+func isConflict(err error) bool {
+	return errors.Is(err, ErrVersionMismatch) || errors.Is(err, ErrAlreadyExists)
+}
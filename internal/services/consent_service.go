@@ -2,6 +2,8 @@ package services
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"strconv"
 	"time"
 
@@ -15,6 +17,8 @@ import (
 	"go.opentelemetry.io/otel/trace"
 )
 
+var ErrConsentNotFound = errors.New("consent not found")
+
 type ConsentService struct {
 	db     *db.DB
 	cache  cache.Manager
@@ -85,21 +89,160 @@ func (s *ConsentService) Get(ctx context.Context, orbitID, userID, clientID int6
 	return c, nil
 }
 
-func (s *ConsentService) Revoke(ctx context.Context, consentID int64) error {
+// ConsentActive reports whether a stored consent still covers new requests.
+func ConsentActive(c *models.Consent, now time.Time) bool {
+	return c != nil && !c.Revoked && (c.ExpiresAt == nil || now.Before(*c.ExpiresAt))
+}
+
+func ConsentScopes(c *models.Consent) []string {
+	scopes := decodeStringList(c.Scopes)
+	if scopes == nil {
+		return []string{}
+	}
+	return scopes
+}
+
+// IsFirstParty reports whether the client is operated by the orbit itself and
+// may skip the consent screen. The flag is set by administrators in
+// Client.Metadata and cannot be supplied through dynamic registration.
+func IsFirstParty(c *models.Client) bool {
+	var md struct {
+		FirstParty bool `json:"first_party"`
+	}
+	if len(c.Metadata) == 0 || json.Unmarshal(c.Metadata, &md) != nil {
+		return false
+	}
+	return md.FirstParty
+}
+
+// MissingScopes returns the requested scopes the user has not yet consented
+// to for the client. Revoked or expired consents cover nothing.
+func (s *ConsentService) MissingScopes(ctx context.Context, orbitID, userID, clientID int64, requested []string) ([]string, error) {
+	ctx, span := s.tracer.Start(ctx, "MissingScopes")
+	defer span.End()
+
+	consent, err := s.Get(ctx, orbitID, userID, clientID)
+	if err != nil {
+		return nil, err
+	}
+	if !ConsentActive(consent, time.Now()) {
+		return requested, nil
+	}
+	granted := decodeStringList(consent.Scopes)
+	var missing []string
+	for _, scope := range requested {
+		if !containsString(granted, scope) {
+			missing = append(missing, scope)
+		}
+	}
+	return missing, nil
+}
+
+// Required decides whether the user has to see the consent screen before req
+// can be answered and which scopes it should list. First-party clients skip
+// the screen unless prompt=consent asks for it explicitly.
+func (s *ConsentService) Required(ctx context.Context, req *AuthorizationRequest, userID int64) ([]string, bool, error) {
+	if req.HasPrompt(PromptConsent) {
+		return req.Scopes, true, nil
+	}
+	if req.FirstParty {
+		return nil, false, nil
+	}
+	missing, err := s.MissingScopes(ctx, req.OrbitID, userID, req.ClientID, req.Scopes)
+	if err != nil {
+		return nil, false, err
+	}
+	return missing, len(missing) > 0, nil
+}
+
+// Grant records the user's approval of scopes. Scopes of a still active
+// consent are kept, so clients can ask for more access incrementally.
+func (s *ConsentService) Grant(ctx context.Context, orbit *models.Orbit, userID, clientID int64, scopes []string) (*models.Consent, error) {
+	ctx, span := s.tracer.Start(ctx, "Grant")
+	defer span.End()
+
+	cfg, err := ParseOrbitConfig(orbit)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+
+	var granted *models.Consent
+	err = s.db.WithTx(ctx, func(tx pgx.Tx) error {
+		repo := repositories.NewConsentRepository(tx, s.logger)
+		existing, err := repo.Get(ctx, orbit.ID, userID, clientID)
+		if err != nil {
+			return err
+		}
+		merged := append([]string(nil), scopes...)
+		if ConsentActive(existing, now) {
+			for _, scope := range decodeStringList(existing.Scopes) {
+				if !containsString(merged, scope) {
+					merged = append(merged, scope)
+				}
+			}
+		}
+
+		consent := &models.Consent{
+			OrbitID:   orbit.ID,
+			UserID:    userID,
+			ClientID:  clientID,
+			Scopes:    encodeStringList(merged),
+			GrantedAt: now,
+		}
+		if lifetime := cfg.Consents.Lifetime(); lifetime > 0 {
+			expiresAt := now.Add(lifetime)
+			consent.ExpiresAt = &expiresAt
+		}
+		granted, err = repo.Upsert(ctx, consent)
+		return err
+	})
+	if err != nil {
+		s.logger.Error().Err(err).Int64("user_id", userID).Int64("client_id", clientID).Msg("consent grant failed")
+		return nil, err
+	}
+	_ = s.cache.Cache(s.prefix).Set(ctx, s.key(granted.OrbitID, granted.UserID, granted.ClientID), granted, s.ttl)
+	return granted, nil
+}
+
+func (s *ConsentService) ListByUser(ctx context.Context, orbitID, userID int64) ([]*models.Consent, error) {
+	ctx, span := s.tracer.Start(ctx, "ListByUser")
+	defer span.End()
+
+	return repositories.NewConsentRepository(s.db.Exec(), s.logger).ListActiveByUser(ctx, orbitID, userID, time.Now().UTC())
+}
+
+// Revoke withdraws one of the user's consents. The client loses its refresh
+// tokens for the user along with it, so it cannot keep acting on the grant.
+func (s *ConsentService) Revoke(ctx context.Context, orbitID, userID, consentID int64) error {
 	ctx, span := s.tracer.Start(ctx, "Revoke")
 	defer span.End()
 
+	var revoked *models.Consent
 	err := s.db.WithTx(ctx, func(tx pgx.Tx) error {
 		repo := repositories.NewConsentRepository(tx, s.logger)
-		var err error
-		_, err = repo.Get(ctx, 0, 0, 0)
-		_ = err
-		return repo.Revoke(ctx, consentID)
+		c, err := repo.GetByID(ctx, consentID)
+		if err != nil {
+			return err
+		}
+		if c == nil || c.OrbitID != orbitID || c.UserID != userID {
+			return ErrConsentNotFound
+		}
+		if err := repo.Revoke(ctx, consentID); err != nil {
+			return err
+		}
+		if _, err := repositories.NewRefreshTokenRepository(tx, s.logger).RevokeByUserAndClient(ctx, userID, c.ClientID); err != nil {
+			return err
+		}
+		revoked = c
+		return nil
 	})
 	if err != nil {
-		s.logger.Error().Err(err).Int64("consent_id", consentID).Msg("consent revoke failed")
+		if !errors.Is(err, ErrConsentNotFound) {
+			s.logger.Error().Err(err).Int64("consent_id", consentID).Msg("consent revoke failed")
+		}
 		return err
 	}
-	_ = s.cache.Cache(s.prefix).Delete(ctx, "")
+	_ = s.cache.Cache(s.prefix).Delete(ctx, s.key(revoked.OrbitID, revoked.UserID, revoked.ClientID))
 	return nil
 }
@@ -0,0 +1,84 @@
+package services_test
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/testutil"
+)
+
+func TestConsentServiceGrantAndRevoke(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	cacheManager, _ := testutil.Redis(t)
+	consents := services.NewConsentService(dbConn, cacheManager, nop)
+	orbit := fx.Orbit()
+	client := fx.Client(orbit)
+	user := fx.User(orbit)
+	req := &services.AuthorizationRequest{OrbitID: orbit.ID, ClientID: client.ID, Scopes: []string{"openid", "email"}}
+
+	if scopes, required, err := consents.Required(ctx, req, user.ID); err != nil || !required || !slices.Equal(scopes, req.Scopes) {
+		t.Fatalf("Required before consent = %v, %v, %v", scopes, required, err)
+	}
+
+	// Consent is incremental: a later grant keeps the scopes of an earlier one.
+	if _, err := consents.Grant(ctx, orbit, user.ID, client.ID, []string{"openid"}); err != nil {
+		t.Fatal(err)
+	}
+	granted, err := consents.Grant(ctx, orbit, user.ID, client.ID, []string{"email"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if scopes := services.ConsentScopes(granted); !slices.Contains(scopes, "openid") || !slices.Contains(scopes, "email") {
+		t.Fatalf("granted scopes = %v, want openid and email", scopes)
+	}
+	if scopes, required, err := consents.Required(ctx, req, user.ID); err != nil || required || len(scopes) != 0 {
+		t.Fatalf("Required after consent = %v, %v, %v", scopes, required, err)
+	}
+	req.Prompt = []string{services.PromptConsent}
+	if _, required, err := consents.Required(ctx, req, user.ID); err != nil || !required {
+		t.Fatalf("Required with prompt=consent = %v, %v, want true", required, err)
+	}
+
+	refreshTokens := repositories.NewRefreshTokenRepository(dbConn.Exec(), nop)
+	if _, err := refreshTokens.Create(ctx, &models.RefreshToken{
+		ExpiresAt:   time.Now().UTC().Add(time.Hour),
+		TokenString: "secret",
+		JTI:         "rt-1",
+		OrbitID:     orbit.ID,
+		ClientID:    client.ID,
+		UserID:      &user.ID,
+		Scopes:      json.RawMessage(`["openid","email"]`),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	list, err := consents.ListByUser(ctx, orbit.ID, user.ID)
+	if err != nil || len(list) != 1 {
+		t.Fatalf("ListByUser = %d consents, %v, want 1", len(list), err)
+	}
+	stranger := fx.User(orbit)
+	if err := consents.Revoke(ctx, orbit.ID, stranger.ID, list[0].ID); !errors.Is(err, services.ErrConsentNotFound) {
+		t.Fatalf("Revoke of another user's consent error = %v, want ErrConsentNotFound", err)
+	}
+	if err := consents.Revoke(ctx, orbit.ID, user.ID, list[0].ID); err != nil {
+		t.Fatal(err)
+	}
+
+	// The cached consent goes with it, and so do the client's refresh tokens.
+	if missing, err := consents.MissingScopes(ctx, orbit.ID, user.ID, client.ID, []string{"openid"}); err != nil || !slices.Equal(missing, []string{"openid"}) {
+		t.Fatalf("MissingScopes after revoke = %v, %v", missing, err)
+	}
+	if rt, err := refreshTokens.GetByJTI(ctx, "rt-1"); err != nil || rt == nil || !rt.Revoked {
+		t.Fatalf("refresh token after revoke = %+v, %v, want it revoked", rt, err)
+	}
+	if list, err := consents.ListByUser(ctx, orbit.ID, user.ID); err != nil || len(list) != 0 {
+		t.Fatalf("ListByUser after revoke = %d consents, %v, want 0", len(list), err)
+	}
+}
@@ -0,0 +1,112 @@
+package services
+
+import (
+	"bytes"
+	htmltemplate "html/template"
+	"text/template"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services/mail"
+)
+
+// emailData is what email templates are executed with.
+type emailData struct {
+	Orbit     string
+	Name      string
+	Email     string
+	Link      string
+	ExpiresAt time.Time
+	ValidFor  string
+}
+
+var defaultEmailTemplates = map[string]EmailTemplate{
+	models.UserTokenPurposeEmailVerification: {
+		Subject: "Confirm your email address for {{.Orbit}}",
+		Text: `Hello {{.Name}},
+
+please confirm that {{.Email}} is your email address by opening the link below:
+
+{{.Link}}
+
+The link is valid for {{.ValidFor}}. If you did not sign up for {{.Orbit}}, you can ignore this message.
+`,
+		HTML: `<p>Hello {{.Name}},</p>
+<p>please confirm that {{.Email}} is your email address.</p>
+<p><a href="{{.Link}}">Confirm email address</a></p>
+<p>The link is valid for {{.ValidFor}}. If you did not sign up for {{.Orbit}}, you can ignore this message.</p>
+`,
+	},
+	models.UserTokenPurposePasswordReset: {
+		Subject: "Reset your {{.Orbit}} password",
+		Text: `Hello {{.Name}},
+
+somebody asked to reset the password of your {{.Orbit}} account. To choose a new password, open the link below:
+
+{{.Link}}
+
+The link is valid for {{.ValidFor}} and can be used once. Resetting your password signs you out on every device.
+If you did not ask for this, you can ignore this message; your password stays unchanged.
+`,
+		HTML: `<p>Hello {{.Name}},</p>
+<p>somebody asked to reset the password of your {{.Orbit}} account.</p>
+<p><a href="{{.Link}}">Choose a new password</a></p>
+<p>The link is valid for {{.ValidFor}} and can be used once. Resetting your password signs you out on every device.</p>
+<p>If you did not ask for this, you can ignore this message; your password stays unchanged.</p>
+`,
+	},
+}
+
+// emailTemplate merges the orbit's template for purpose over the built-in
+// one, part by part.
+func emailTemplate(cfg EmailConfig, purpose string) EmailTemplate {
+	t := defaultEmailTemplates[purpose]
+	custom := cfg.Templates[purpose]
+	if custom.Subject != "" {
+		t.Subject = custom.Subject
+	}
+	if custom.Text != "" {
+		t.Text = custom.Text
+	}
+	if custom.HTML != "" {
+		t.HTML = custom.HTML
+	}
+	return t
+}
+
+func renderEmail(t EmailTemplate, data emailData) (mail.Message, error) {
+	var msg mail.Message
+	var buf bytes.Buffer
+
+	subject, err := template.New("subject").Parse(t.Subject)
+	if err != nil {
+		return msg, err
+	}
+	if err := subject.Execute(&buf, data); err != nil {
+		return msg, err
+	}
+	msg.Subject = buf.String()
+
+	buf.Reset()
+	text, err := template.New("text").Parse(t.Text)
+	if err != nil {
+		return msg, err
+	}
+	if err := text.Execute(&buf, data); err != nil {
+		return msg, err
+	}
+	msg.Text = buf.String()
+
+	if t.HTML != "" {
+		buf.Reset()
+		html, err := htmltemplate.New("html").Parse(t.HTML)
+		if err != nil {
+			return msg, err
+		}
+		if err := html.Execute(&buf, data); err != nil {
+			return msg, err
+		}
+		msg.HTML = buf.String()
+	}
+	return msg, nil
+}
@@ -0,0 +1,13 @@
+package services
+
+import "time"
+
+// SetClock fixes the time TOTPService checks codes against.
+func (s *TOTPService) SetClock(now func() time.Time) {
+	s.now = now
+}
+
+// TOTPAssociatedData is bound to sealed TOTP secrets of a user.
+var TOTPAssociatedData = totpAssociatedData
+
+var NormalizeRecoveryCode = normalizeRecoveryCode
@@ -0,0 +1,256 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories/db"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services/cache"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var ErrGroupNotFound = errors.New("group not found")
+
+// GroupService manages groups of users. Members of a group hold the roles
+// assigned to it, which RoleService takes into account.
+type GroupService struct {
+	db     *db.DB
+	cache  cache.Manager
+	roles  *RoleService
+	logger zerolog.Logger
+	tracer trace.Tracer
+	ttl    time.Duration
+	prefix string
+}
+
+func NewGroupService(dbConn *db.DB, cacheManager cache.Manager, roles *RoleService, logger zerolog.Logger) *GroupService {
+	return &GroupService{
+		db:     dbConn,
+		cache:  cacheManager,
+		roles:  roles,
+		logger: logger,
+		tracer: otel.Tracer("service.group"),
+		ttl:    30 * time.Minute,
+		prefix: "groups",
+	}
+}
+
+func (s *GroupService) key(orbitID, groupID int64) string {
+	return fmt.Sprintf("orbit:%d:group:%d", orbitID, groupID)
+}
+
+func (s *GroupService) Create(ctx context.Context, g *models.Group) (*models.Group, error) {
+	ctx, span := s.tracer.Start(ctx, "Create")
+	defer span.End()
+
+	var created *models.Group
+	err := s.db.WithTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		created, err = repositories.NewGroupRepository(tx, s.logger).Create(ctx, g)
+		return err
+	})
+	if err != nil {
+		if err = conflictError(err); !isConflict(err) {
+			s.logger.Error().Err(err).Msg("group create failed")
+		}
+		return nil, err
+	}
+	_ = s.cache.Cache(s.prefix).Set(ctx, s.key(created.OrbitID, created.ID), created, s.ttl)
+	return created, nil
+}
+
+// GetByID returns nil when the group does not exist or belongs to another
+// orbit.
+func (s *GroupService) GetByID(ctx context.Context, orbitID, groupID int64) (*models.Group, error) {
+	ctx, span := s.tracer.Start(ctx, "GetByID")
+	defer span.End()
+
+	key := s.key(orbitID, groupID)
+	var cached models.Group
+	if err := s.cache.Cache(s.prefix).Get(ctx, key, &cached); err == nil {
+		return &cached, nil
+	}
+
+	g, err := repositories.NewGroupRepository(s.db.Exec(), s.logger).GetByID(ctx, groupID)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("group_id", groupID).Msg("group get failed")
+		return nil, err
+	}
+	if g == nil || g.OrbitID != orbitID {
+		return nil, nil
+	}
+	_ = s.cache.Cache(s.prefix).Set(ctx, key, g, s.ttl)
+	return g, nil
+}
+
+func (s *GroupService) Delete(ctx context.Context, orbitID, groupID int64) error {
+	ctx, span := s.tracer.Start(ctx, "Delete")
+	defer span.End()
+
+	err := s.db.WithTx(ctx, func(tx pgx.Tx) error {
+		repo := repositories.NewGroupRepository(tx, s.logger)
+		g, err := repo.GetByID(ctx, groupID)
+		if err != nil || g == nil || g.OrbitID != orbitID {
+			return err
+		}
+		return repo.Delete(ctx, groupID)
+	})
+	if err != nil {
+		s.logger.Error().Err(err).Int64("group_id", groupID).Msg("group delete failed")
+		return err
+	}
+	_ = s.cache.Cache(s.prefix).Delete(ctx, s.key(orbitID, groupID))
+	retireEffectivePermissions(ctx, s.cache, orbitID)
+	return nil
+}
+
+func (s *GroupService) ListByOrbit(ctx context.Context, orbitID int64, page repositories.Page) ([]*models.Group, string, error) {
+	ctx, span := s.tracer.Start(ctx, "ListByOrbit")
+	defer span.End()
+
+	list, next, err := repositories.NewGroupRepository(s.db.Exec(), s.logger).ListByOrbit(ctx, orbitID, page)
+	if err != nil {
+		if !errors.Is(err, repositories.ErrInvalidCursor) {
+			s.logger.Error().Err(err).Int64("orbit_id", orbitID).Msg("group list failed")
+		}
+		return nil, "", err
+	}
+	return list, next, nil
+}
+
+// AddMember puts a user of the orbit in a group of the same orbit. Adding a
+// member twice is not an error.
+func (s *GroupService) AddMember(ctx context.Context, orbitID, groupID, userID int64) error {
+	ctx, span := s.tracer.Start(ctx, "AddMember")
+	defer span.End()
+
+	return s.changeMember(ctx, orbitID, groupID, userID, func(repo *repositories.GroupMemberRepository) error {
+		return repo.Add(ctx, groupID, userID)
+	})
+}
+
+func (s *GroupService) RemoveMember(ctx context.Context, orbitID, groupID, userID int64) error {
+	ctx, span := s.tracer.Start(ctx, "RemoveMember")
+	defer span.End()
+
+	return s.changeMember(ctx, orbitID, groupID, userID, func(repo *repositories.GroupMemberRepository) error {
+		return repo.Remove(ctx, groupID, userID)
+	})
+}
+
+func (s *GroupService) changeMember(ctx context.Context, orbitID, groupID, userID int64, change func(*repositories.GroupMemberRepository) error) error {
+	err := s.db.WithTx(ctx, func(tx pgx.Tx) error {
+		if err := s.checkGroup(ctx, tx, orbitID, groupID); err != nil {
+			return err
+		}
+		user, err := repositories.NewUserRepository(tx, s.logger).GetByID(ctx, userID)
+		if err != nil {
+			return err
+		}
+		if user == nil || user.OrbitID != orbitID || user.DeletedAt != nil {
+			return ErrUserNotFound
+		}
+		return change(repositories.NewGroupMemberRepository(tx, s.logger))
+	})
+	if err != nil {
+		if !errors.Is(err, ErrGroupNotFound) && !errors.Is(err, ErrUserNotFound) {
+			s.logger.Error().Err(err).Int64("group_id", groupID).Int64("user_id", userID).Msg("group membership change failed")
+		}
+		return err
+	}
+	s.roles.forgetUser(ctx, orbitID, userID)
+	return nil
+}
+
+// ListMembers pages through the users in a group.
+func (s *GroupService) ListMembers(ctx context.Context, orbitID, groupID int64, page repositories.Page) ([]*models.User, string, error) {
+	ctx, span := s.tracer.Start(ctx, "ListMembers")
+	defer span.End()
+
+	g, err := s.GetByID(ctx, orbitID, groupID)
+	if err != nil {
+		return nil, "", err
+	}
+	if g == nil {
+		return nil, "", ErrGroupNotFound
+	}
+	return repositories.NewUserRepository(s.db.Exec(), s.logger).ListByGroup(ctx, groupID, page)
+}
+
+// AssignRole gives every member of a group a role of the same orbit.
+func (s *GroupService) AssignRole(ctx context.Context, orbitID, groupID, roleID int64) error {
+	ctx, span := s.tracer.Start(ctx, "AssignRole")
+	defer span.End()
+
+	return s.changeRole(ctx, orbitID, groupID, roleID, func(repo *repositories.GroupRoleRepository) error {
+		return repo.Assign(ctx, groupID, roleID)
+	})
+}
+
+func (s *GroupService) RevokeRole(ctx context.Context, orbitID, groupID, roleID int64) error {
+	ctx, span := s.tracer.Start(ctx, "RevokeRole")
+	defer span.End()
+
+	return s.changeRole(ctx, orbitID, groupID, roleID, func(repo *repositories.GroupRoleRepository) error {
+		return repo.Revoke(ctx, groupID, roleID)
+	})
+}
+
+func (s *GroupService) changeRole(ctx context.Context, orbitID, groupID, roleID int64, change func(*repositories.GroupRoleRepository) error) error {
+	err := s.db.WithTx(ctx, func(tx pgx.Tx) error {
+		if err := s.checkGroup(ctx, tx, orbitID, groupID); err != nil {
+			return err
+		}
+		role, err := repositories.NewRoleRepository(tx, s.logger).GetByID(ctx, roleID)
+		if err != nil {
+			return err
+		}
+		if role == nil || role.OrbitID != orbitID {
+			return ErrRoleNotFound
+		}
+		return change(repositories.NewGroupRoleRepository(tx, s.logger))
+	})
+	if err != nil {
+		if !errors.Is(err, ErrGroupNotFound) && !errors.Is(err, ErrRoleNotFound) {
+			s.logger.Error().Err(err).Int64("group_id", groupID).Int64("role_id", roleID).Msg("group role change failed")
+		}
+		return err
+	}
+	// Every member is affected, so the whole orbit is recomputed rather than
+	// each member forgotten.
+	retireEffectivePermissions(ctx, s.cache, orbitID)
+	return nil
+}
+
+// ListRoles returns the roles assigned to a group.
+func (s *GroupService) ListRoles(ctx context.Context, orbitID, groupID int64) ([]*models.Role, error) {
+	ctx, span := s.tracer.Start(ctx, "ListRoles")
+	defer span.End()
+
+	g, err := s.GetByID(ctx, orbitID, groupID)
+	if err != nil {
+		return nil, err
+	}
+	if g == nil {
+		return nil, ErrGroupNotFound
+	}
+	return repositories.NewGroupRoleRepository(s.db.Exec(), s.logger).ListRoles(ctx, groupID)
+}
+
+func (s *GroupService) checkGroup(ctx context.Context, tx pgx.Tx, orbitID, groupID int64) error {
+	g, err := repositories.NewGroupRepository(tx, s.logger).GetByID(ctx, groupID)
+	if err != nil {
+		return err
+	}
+	if g == nil || g.OrbitID != orbitID {
+		return ErrGroupNotFound
+	}
+	return nil
+}
@@ -0,0 +1,80 @@
+package services_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/testutil"
+)
+
+func TestGroupServiceInheritedPermissions(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	cacheManager, _ := testutil.Redis(t)
+	roles := services.NewRoleService(dbConn, cacheManager, nop)
+	groups := services.NewGroupService(dbConn, cacheManager, roles, nop)
+	permissions := services.NewPermissionService(dbConn, cacheManager, nop)
+	orbit := fx.Orbit()
+	user := fx.User(orbit)
+
+	role := func(name string) *models.Role {
+		t.Helper()
+		r, err := roles.Create(ctx, &models.Role{OrbitID: orbit.ID, Name: name})
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, err := permissions.Create(ctx, &models.Permission{OrbitID: orbit.ID, Name: name + ":use"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := roles.GrantPermission(ctx, orbit.ID, r.ID, p.ID); err != nil {
+			t.Fatal(err)
+		}
+		return r
+	}
+	lead := role("lead")
+	member := role("member")
+	group, err := groups.Create(ctx, &models.Group{OrbitID: orbit.ID, Name: "team"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := func(step string, names ...string) {
+		t.Helper()
+		got, err := roles.EffectivePermissions(ctx, orbit.ID, user.ID)
+		if err != nil || !slices.Equal(got, names) {
+			t.Fatalf("EffectivePermissions %s = %v, %v, want %v", step, got, err, names)
+		}
+	}
+
+	// Each step follows a cached answer to the previous one.
+	want("initially")
+	if err := groups.AssignRole(ctx, orbit.ID, group.ID, lead.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := groups.AddMember(ctx, orbit.ID, group.ID, user.ID); err != nil {
+		t.Fatal(err)
+	}
+	want("after joining the group", "lead:use")
+	if err := roles.IncludeRole(ctx, orbit.ID, lead.ID, member.ID); err != nil {
+		t.Fatal(err)
+	}
+	want("after lead includes member", "lead:use", "member:use")
+	if err := roles.IncludeRole(ctx, orbit.ID, member.ID, lead.ID); !errors.Is(err, services.ErrRoleCycle) {
+		t.Fatalf("IncludeRole(member, lead) error = %v, want ErrRoleCycle", err)
+	}
+	if err := roles.IncludeRole(ctx, orbit.ID, lead.ID, lead.ID); !errors.Is(err, services.ErrRoleCycle) {
+		t.Fatalf("IncludeRole(lead, lead) error = %v, want ErrRoleCycle", err)
+	}
+	if err := groups.RemoveMember(ctx, orbit.ID, group.ID, user.ID); err != nil {
+		t.Fatal(err)
+	}
+	want("after leaving the group")
+
+	other := fx.Orbit()
+	if err := groups.AddMember(ctx, other.ID, group.ID, user.ID); !errors.Is(err, services.ErrGroupNotFound) {
+		t.Fatalf("AddMember(other orbit) error = %v, want ErrGroupNotFound", err)
+	}
+}
@@ -0,0 +1,51 @@
+package hashing
+
+import (
+	"errors"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+type BcryptParams struct {
+	Cost int `json:"cost"`
+}
+
+var DefaultBcryptParams = BcryptParams{Cost: 12}
+
+type Bcrypt struct {
+	params BcryptParams
+}
+
+func NewBcrypt(params BcryptParams) *Bcrypt {
+	return &Bcrypt{params: params}
+}
+
+func (h *Bcrypt) Algorithm() string {
+	return AlgoBcrypt
+}
+
+// Hash fails for passwords longer than 72 bytes instead of silently
+// truncating them.
+func (h *Bcrypt) Hash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), h.params.Cost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+func (h *Bcrypt) Verify(password, encoded string) (bool, error) {
+	err := bcrypt.CompareHashAndPassword([]byte(encoded), []byte(password))
+	if err == nil {
+		return true, nil
+	}
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, nil
+	}
+	return false, ErrMalformedHash
+}
+
+func (h *Bcrypt) NeedsRehash(encoded string) bool {
+	cost, err := bcrypt.Cost([]byte(encoded))
+	return err != nil || cost < h.params.Cost
+}
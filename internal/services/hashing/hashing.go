@@ -0,0 +1,45 @@
+package hashing
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+)
+
+const (
+	AlgoArgon2id     = "argon2id"
+	AlgoBcrypt       = "bcrypt"
+	AlgoScrypt       = "scrypt"
+	AlgoPBKDF2SHA256 = "pbkdf2-sha256"
+	AlgoPBKDF2SHA512 = "pbkdf2-sha512"
+)
+
+var (
+	ErrMalformedHash        = errors.New("malformed password hash")
+	ErrUnsupportedAlgorithm = errors.New("unsupported password hash algorithm")
+)
+
+type Hasher interface {
+	Algorithm() string
+	Hash(password string) (string, error)
+	Verify(password, encoded string) (bool, error)
+	// NeedsRehash reports whether encoded was produced with weaker parameters
+	// than the hasher is currently configured with.
+	NeedsRehash(encoded string) bool
+}
+
+func randomSalt(n uint32) ([]byte, error) {
+	salt := make([]byte, n)
+	if _, err := rand.Read(salt); err != nil {
+		return nil, err
+	}
+	return salt, nil
+}
+
+func b64(data []byte) string {
+	return base64.RawStdEncoding.EncodeToString(data)
+}
+
+func unb64(s string) ([]byte, error) {
+	return base64.RawStdEncoding.DecodeString(s)
+}
@@ -0,0 +1,162 @@
+package hashing_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services/hashing"
+)
+
+// Cheap parameters keep the round trips fast; the known answers carry their
+// own.
+var (
+	testArgon2id = hashing.Argon2idParams{Memory: 64, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32}
+	testBcrypt   = hashing.BcryptParams{Cost: 4}
+	testScrypt   = hashing.ScryptParams{LogN: 4, R: 8, P: 1, SaltLength: 16, KeyLength: 32}
+	testPBKDF2   = hashing.PBKDF2Params{Iterations: 1000, SaltLength: 16, KeyLength: 32}
+)
+
+func testConfig(algo string) hashing.Config {
+	return hashing.Config{
+		Algorithm: algo,
+		Argon2id:  testArgon2id,
+		Bcrypt:    testBcrypt,
+		Scrypt:    testScrypt,
+		PBKDF2:    testPBKDF2,
+	}
+}
+
+func hashers() []hashing.Hasher {
+	return []hashing.Hasher{
+		hashing.NewArgon2id(testArgon2id),
+		hashing.NewBcrypt(testBcrypt),
+		hashing.NewScrypt(testScrypt),
+		hashing.NewPBKDF2SHA256(testPBKDF2),
+		hashing.NewPBKDF2SHA512(testPBKDF2),
+	}
+}
+
+// Published vectors: the Argon2 reference implementation's test suite, the
+// OpenWall crypt_blowfish suite, RFC 7914 section 12 for scrypt and the
+// PBKDF2-HMAC-SHA2 values matching RFC 6070's inputs, in PHC form.
+var knownAnswers = []struct {
+	hasher   hashing.Hasher
+	password string
+	encoded  string
+	// rehash tells whether the vector's parameters are below the defaults.
+	rehash bool
+}{
+	{hashing.NewArgon2id(hashing.DefaultArgon2idParams), "password",
+		"$argon2id$v=19$m=65536,t=2,p=1$c29tZXNhbHQ$CTFhFdXPJO1aFaMaO6Mm5c8y7cJHAph8ArZWb2GRPPc", false},
+	{hashing.NewBcrypt(hashing.DefaultBcryptParams), "U*U",
+		"$2a$05$CCCCCCCCCCCCCCCCCCCCC.E5YPO9kmyuRGyh0XouQYb4YMJKvyOeW", true},
+	{hashing.NewScrypt(hashing.DefaultScryptParams), "password",
+		"$scrypt$ln=10,r=8,p=16$TmFDbA$/bq+HJ00cgB4VucZDQHp/nxq18vII3gw53N2Y0s3MWIurzDZLiKjiG/xCSedmDDaxyevuUqD7m2DYMvfoswGQA", true},
+	{hashing.NewPBKDF2SHA256(hashing.DefaultPBKDF2Params), "password",
+		"$pbkdf2-sha256$i=4096$c2FsdA$xeR41ZKIyEGqUw22hFxMjZYok6ABzk4RpJY4c6qYE0o", true},
+	{hashing.NewPBKDF2SHA512(hashing.DefaultPBKDF2Params), "password",
+		"$pbkdf2-sha512$i=4096$c2FsdA$0Zexsz2wFD4BixLz0dFHnmzevcyXxcD4f2kC4HL0V7UUPzBgJkGz1VzTNZiMs2uEN2Bg7NUy4Dm3QqI5Q0ry1Q", true},
+}
+
+func TestKnownAnswers(t *testing.T) {
+	for _, ka := range knownAnswers {
+		t.Run(ka.hasher.Algorithm(), func(t *testing.T) {
+			if ok, err := ka.hasher.Verify(ka.password, ka.encoded); err != nil || !ok {
+				t.Fatalf("Verify of the published hash = %v, %v, want true", ok, err)
+			}
+			if ok, err := ka.hasher.Verify(ka.password+"!", ka.encoded); err != nil || ok {
+				t.Fatalf("Verify with another password = %v, %v, want false", ok, err)
+			}
+			if got := ka.hasher.NeedsRehash(ka.encoded); got != ka.rehash {
+				t.Errorf("NeedsRehash = %v, want %v", got, ka.rehash)
+			}
+		})
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	for _, h := range hashers() {
+		t.Run(h.Algorithm(), func(t *testing.T) {
+			const password = "correct horse battery staple ✓"
+			encoded, err := h.Hash(password)
+			if err != nil {
+				t.Fatalf("Hash: %v", err)
+			}
+			again, err := h.Hash(password)
+			if err != nil || again == encoded {
+				t.Fatalf("second Hash = %q, %v, want a different salt", again, err)
+			}
+			if ok, err := h.Verify(password, encoded); err != nil || !ok {
+				t.Fatalf("Verify = %v, %v, want true", ok, err)
+			}
+			if ok, err := h.Verify("correct horse battery staple", encoded); err != nil || ok {
+				t.Fatalf("Verify with another password = %v, %v, want false", ok, err)
+			}
+			if h.NeedsRehash(encoded) {
+				t.Error("NeedsRehash = true for a hash just produced")
+			}
+		})
+	}
+}
+
+func TestMalformedHashes(t *testing.T) {
+	for _, h := range hashers() {
+		t.Run(h.Algorithm(), func(t *testing.T) {
+			encoded, err := h.Hash("password")
+			if err != nil {
+				t.Fatal(err)
+			}
+			for _, bad := range []string{
+				"",
+				"plaintext",
+				encoded[:strings.LastIndex(encoded, "$")],
+				encoded[:strings.LastIndex(encoded, "$")+1] + "!!!",
+			} {
+				if ok, err := h.Verify("password", bad); ok || !errors.Is(err, hashing.ErrMalformedHash) {
+					t.Errorf("Verify(%q) = %v, %v, want ErrMalformedHash", bad, ok, err)
+				}
+				if !h.NeedsRehash(bad) {
+					t.Errorf("NeedsRehash(%q) = false, want true", bad)
+				}
+			}
+		})
+	}
+}
+
+func TestArgon2idParameterBounds(t *testing.T) {
+	const hash = "$c29tZXNhbHQ$CTFhFdXPJO1aFaMaO6Mm5c8y7cJHAph8ArZWb2GRPPc"
+	h := hashing.NewArgon2id(testArgon2id)
+	for _, tc := range []struct {
+		params string
+		ok     bool
+	}{
+		{"m=64,t=1,p=1", true},
+		{"m=1048576,t=64,p=8", true},
+		{"m=64,t=0,p=1", false},
+		{"m=64,t=65,p=1", false},
+		{"m=64,t=1,p=0", false},
+		{"m=64,t=1,p=256", false},
+		{"m=7,t=1,p=1", false},
+		{"m=64,t=1,p=9", false},
+		{"m=1048577,t=1,p=1", false},
+		{"m=4294967295,t=1,p=1", false},
+	} {
+		encoded := "$argon2id$v=19$" + tc.params + hash
+		if tc.ok {
+			if h.NeedsRehash(encoded) {
+				t.Errorf("NeedsRehash(%s) = true, want the parameters accepted", tc.params)
+			}
+			continue
+		}
+		if ok, err := h.Verify("password", encoded); ok || !errors.Is(err, hashing.ErrMalformedHash) {
+			t.Errorf("Verify with %s = %v, %v, want ErrMalformedHash", tc.params, ok, err)
+		}
+	}
+}
+
+func TestBcryptRejectsLongPasswords(t *testing.T) {
+	if _, err := hashing.NewBcrypt(testBcrypt).Hash(strings.Repeat("a", 73)); err == nil {
+		t.Fatal("Hash of a 73-byte password succeeded, want an error instead of truncation")
+	}
+}
@@ -0,0 +1,94 @@
+package hashing
+
+import (
+	"crypto/pbkdf2"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"fmt"
+	"hash"
+	"strings"
+)
+
+type PBKDF2Params struct {
+	Iterations int    `json:"iterations"`
+	SaltLength uint32 `json:"salt_length"`
+	KeyLength  int    `json:"key_length"`
+}
+
+var DefaultPBKDF2Params = PBKDF2Params{
+	Iterations: 600000,
+	SaltLength: 16,
+	KeyLength:  32,
+}
+
+// PBKDF2 exists for users imported from systems that stored PBKDF2 hashes in
+// the $pbkdf2-<digest>$i=<iterations>$<salt>$<key> format. It is never the
+// preferred algorithm of an orbit.
+type PBKDF2 struct {
+	algo   string
+	digest func() hash.Hash
+	params PBKDF2Params
+}
+
+func NewPBKDF2SHA256(params PBKDF2Params) *PBKDF2 {
+	return &PBKDF2{algo: AlgoPBKDF2SHA256, digest: sha256.New, params: params}
+}
+
+func NewPBKDF2SHA512(params PBKDF2Params) *PBKDF2 {
+	return &PBKDF2{algo: AlgoPBKDF2SHA512, digest: sha512.New, params: params}
+}
+
+func (h *PBKDF2) Algorithm() string {
+	return h.algo
+}
+
+func (h *PBKDF2) Hash(password string) (string, error) {
+	p := h.params
+	salt, err := randomSalt(p.SaltLength)
+	if err != nil {
+		return "", err
+	}
+	key, err := pbkdf2.Key(h.digest, password, salt, p.Iterations, p.KeyLength)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("$%s$i=%d$%s$%s", h.algo, p.Iterations, b64(salt), b64(key)), nil
+}
+
+func (h *PBKDF2) Verify(password, encoded string) (bool, error) {
+	iterations, salt, key, err := h.decode(encoded)
+	if err != nil {
+		return false, err
+	}
+	other, err := pbkdf2.Key(h.digest, password, salt, iterations, len(key))
+	if err != nil {
+		return false, ErrMalformedHash
+	}
+	return subtle.ConstantTimeCompare(key, other) == 1, nil
+}
+
+func (h *PBKDF2) NeedsRehash(encoded string) bool {
+	iterations, _, _, err := h.decode(encoded)
+	return err != nil || iterations < h.params.Iterations
+}
+
+func (h *PBKDF2) decode(encoded string) (int, []byte, []byte, error) {
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 5 || parts[1] != h.algo {
+		return 0, nil, nil, ErrMalformedHash
+	}
+	var iterations int
+	if _, err := fmt.Sscanf(parts[2], "i=%d", &iterations); err != nil || iterations <= 0 {
+		return 0, nil, nil, ErrMalformedHash
+	}
+	salt, err := unb64(parts[3])
+	if err != nil {
+		return 0, nil, nil, ErrMalformedHash
+	}
+	key, err := unb64(parts[4])
+	if err != nil || len(key) == 0 {
+		return 0, nil, nil, ErrMalformedHash
+	}
+	return iterations, salt, key, nil
+}
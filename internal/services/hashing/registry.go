@@ -0,0 +1,79 @@
+package hashing
+
+import "fmt"
+
+// Config selects the algorithm new hashes are produced with and the
+// parameters of every supported algorithm. Hashes of any supported algorithm
+// can be verified regardless of which one is preferred.
+type Config struct {
+	Algorithm string         `json:"algorithm"`
+	Argon2id  Argon2idParams `json:"argon2id"`
+	Bcrypt    BcryptParams   `json:"bcrypt"`
+	Scrypt    ScryptParams   `json:"scrypt"`
+	PBKDF2    PBKDF2Params   `json:"pbkdf2"`
+}
+
+func DefaultConfig() Config {
+	return Config{
+		Algorithm: AlgoArgon2id,
+		Argon2id:  DefaultArgon2idParams,
+		Bcrypt:    DefaultBcryptParams,
+		Scrypt:    DefaultScryptParams,
+		PBKDF2:    DefaultPBKDF2Params,
+	}
+}
+
+type Registry struct {
+	preferred Hasher
+	hashers   map[string]Hasher
+}
+
+func NewRegistry(cfg Config) (*Registry, error) {
+	r := &Registry{hashers: make(map[string]Hasher)}
+	for _, h := range []Hasher{
+		NewArgon2id(cfg.Argon2id),
+		NewBcrypt(cfg.Bcrypt),
+		NewScrypt(cfg.Scrypt),
+		NewPBKDF2SHA256(cfg.PBKDF2),
+		NewPBKDF2SHA512(cfg.PBKDF2),
+	} {
+		r.hashers[h.Algorithm()] = h
+	}
+
+	switch cfg.Algorithm {
+	case AlgoArgon2id, AlgoBcrypt, AlgoScrypt:
+		r.preferred = r.hashers[cfg.Algorithm]
+	default:
+		return nil, fmt.Errorf("%w: %q cannot be used for new hashes", ErrUnsupportedAlgorithm, cfg.Algorithm)
+	}
+	return r, nil
+}
+
+func (r *Registry) Preferred() Hasher {
+	return r.preferred
+}
+
+// Hash returns the encoded hash and the algorithm name to store alongside it.
+func (r *Registry) Hash(password string) (string, string, error) {
+	encoded, err := r.preferred.Hash(password)
+	if err != nil {
+		return "", "", err
+	}
+	return encoded, r.preferred.Algorithm(), nil
+}
+
+// Verify checks password against a hash produced by algo. On success rehash
+// tells the caller the hash should be replaced because it uses a legacy
+// algorithm or outdated parameters.
+func (r *Registry) Verify(algo, encoded, password string) (ok bool, rehash bool, err error) {
+	h, found := r.hashers[algo]
+	if !found {
+		return false, false, fmt.Errorf("%w: %q", ErrUnsupportedAlgorithm, algo)
+	}
+	ok, err = h.Verify(password, encoded)
+	if err != nil || !ok {
+		return false, false, err
+	}
+	rehash = algo != r.preferred.Algorithm() || r.preferred.NeedsRehash(encoded)
+	return true, rehash, nil
+}
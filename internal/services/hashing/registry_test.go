@@ -0,0 +1,89 @@
+package hashing_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services/hashing"
+)
+
+func TestNewRegistryPreferred(t *testing.T) {
+	for _, algo := range []string{hashing.AlgoArgon2id, hashing.AlgoBcrypt, hashing.AlgoScrypt} {
+		r, err := hashing.NewRegistry(testConfig(algo))
+		if err != nil {
+			t.Fatalf("NewRegistry(%s): %v", algo, err)
+		}
+		if got := r.Preferred().Algorithm(); got != algo {
+			t.Errorf("Preferred = %s, want %s", got, algo)
+		}
+	}
+	// PBKDF2 is only there to verify imported hashes.
+	for _, algo := range []string{hashing.AlgoPBKDF2SHA256, hashing.AlgoPBKDF2SHA512, "md5", ""} {
+		if _, err := hashing.NewRegistry(testConfig(algo)); !errors.Is(err, hashing.ErrUnsupportedAlgorithm) {
+			t.Errorf("NewRegistry(%q) error = %v, want ErrUnsupportedAlgorithm", algo, err)
+		}
+	}
+}
+
+func TestRegistryHashAndVerify(t *testing.T) {
+	r, err := hashing.NewRegistry(testConfig(hashing.AlgoArgon2id))
+	if err != nil {
+		t.Fatal(err)
+	}
+	encoded, algo, err := r.Hash("password")
+	if err != nil || algo != hashing.AlgoArgon2id {
+		t.Fatalf("Hash = %s, %v, want argon2id", algo, err)
+	}
+	if ok, rehash, err := r.Verify(algo, encoded, "password"); err != nil || !ok || rehash {
+		t.Fatalf("Verify of a current hash = %v, %v, %v, want ok without rehash", ok, rehash, err)
+	}
+	if ok, rehash, err := r.Verify(algo, encoded, "wrong"); err != nil || ok || rehash {
+		t.Fatalf("Verify with a wrong password = %v, %v, %v", ok, rehash, err)
+	}
+	if _, _, err := r.Verify("md5", encoded, "password"); !errors.Is(err, hashing.ErrUnsupportedAlgorithm) {
+		t.Fatalf("Verify of an unknown algorithm error = %v, want ErrUnsupportedAlgorithm", err)
+	}
+	// The stored algorithm decides which hasher reads the hash.
+	if ok, _, err := r.Verify(hashing.AlgoBcrypt, encoded, "password"); ok || !errors.Is(err, hashing.ErrMalformedHash) {
+		t.Fatalf("Verify under the wrong algorithm = %v, %v, want ErrMalformedHash", ok, err)
+	}
+}
+
+// A hash of any algorithm other than the preferred one is verified and then
+// flagged for replacement, and so is one with outdated parameters.
+func TestRegistryRehash(t *testing.T) {
+	r, err := hashing.NewRegistry(testConfig(hashing.AlgoArgon2id))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, h := range hashers() {
+		encoded, err := h.Hash("password")
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := h.Algorithm() != hashing.AlgoArgon2id
+		if ok, rehash, err := r.Verify(h.Algorithm(), encoded, "password"); err != nil || !ok || rehash != want {
+			t.Errorf("Verify of a %s hash = %v, rehash %v, %v, want rehash %v", h.Algorithm(), ok, rehash, err, want)
+		}
+		// A wrong password never asks for a rehash.
+		if _, rehash, _ := r.Verify(h.Algorithm(), encoded, "wrong"); rehash {
+			t.Errorf("Verify of a %s hash with a wrong password asked for a rehash", h.Algorithm())
+		}
+	}
+
+	weaker := testArgon2id
+	weaker.Iterations = 1
+	stronger := testConfig(hashing.AlgoArgon2id)
+	stronger.Argon2id.Iterations = 2
+	r, err = hashing.NewRegistry(stronger)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encoded, err := hashing.NewArgon2id(weaker).Hash("password")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, rehash, err := r.Verify(hashing.AlgoArgon2id, encoded, "password"); err != nil || !ok || !rehash {
+		t.Fatalf("Verify of a hash with fewer iterations = %v, rehash %v, %v, want a rehash", ok, rehash, err)
+	}
+}
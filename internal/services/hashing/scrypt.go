@@ -0,0 +1,91 @@
+package hashing
+
+import (
+	"crypto/subtle"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+// ScryptParams uses the log2 form of N, as in the PHC scrypt format.
+type ScryptParams struct {
+	LogN       uint8  `json:"log_n"`
+	R          int    `json:"r"`
+	P          int    `json:"p"`
+	SaltLength uint32 `json:"salt_length"`
+	KeyLength  int    `json:"key_length"`
+}
+
+var DefaultScryptParams = ScryptParams{
+	LogN:       17,
+	R:          8,
+	P:          1,
+	SaltLength: 16,
+	KeyLength:  32,
+}
+
+type Scrypt struct {
+	params ScryptParams
+}
+
+func NewScrypt(params ScryptParams) *Scrypt {
+	return &Scrypt{params: params}
+}
+
+func (h *Scrypt) Algorithm() string {
+	return AlgoScrypt
+}
+
+func (h *Scrypt) Hash(password string) (string, error) {
+	p := h.params
+	salt, err := randomSalt(p.SaltLength)
+	if err != nil {
+		return "", err
+	}
+	key, err := scrypt.Key([]byte(password), salt, 1<<p.LogN, p.R, p.P, p.KeyLength)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("$scrypt$ln=%d,r=%d,p=%d$%s$%s", p.LogN, p.R, p.P, b64(salt), b64(key)), nil
+}
+
+func (h *Scrypt) Verify(password, encoded string) (bool, error) {
+	p, salt, key, err := decodeScrypt(encoded)
+	if err != nil {
+		return false, err
+	}
+	other, err := scrypt.Key([]byte(password), salt, 1<<p.LogN, p.R, p.P, len(key))
+	if err != nil {
+		return false, ErrMalformedHash
+	}
+	return subtle.ConstantTimeCompare(key, other) == 1, nil
+}
+
+func (h *Scrypt) NeedsRehash(encoded string) bool {
+	p, _, _, err := decodeScrypt(encoded)
+	if err != nil {
+		return true
+	}
+	return p.LogN < h.params.LogN || p.R < h.params.R || p.P < h.params.P
+}
+
+func decodeScrypt(encoded string) (ScryptParams, []byte, []byte, error) {
+	var p ScryptParams
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 5 || parts[1] != AlgoScrypt {
+		return p, nil, nil, ErrMalformedHash
+	}
+	if _, err := fmt.Sscanf(parts[2], "ln=%d,r=%d,p=%d", &p.LogN, &p.R, &p.P); err != nil || p.LogN > 30 {
+		return p, nil, nil, ErrMalformedHash
+	}
+	salt, err := unb64(parts[3])
+	if err != nil {
+		return p, nil, nil, ErrMalformedHash
+	}
+	key, err := unb64(parts[4])
+	if err != nil || len(key) == 0 {
+		return p, nil, nil, ErrMalformedHash
+	}
+	return p, salt, key, nil
+}
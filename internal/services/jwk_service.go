@@ -2,6 +2,17 @@ package services
 
 import (
 	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
 	"strconv"
 	"time"
 
@@ -9,15 +20,30 @@ import (
 	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
 	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories/db"
 	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services/cache"
+	"github.com/go-jose/go-jose/v4"
 	"github.com/jackc/pgx/v5"
 	"github.com/rs/zerolog"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
 )
 
+var (
+	ErrUnsupportedKeyAlgorithm = errors.New("unsupported signing key algorithm")
+	ErrNoSigningKey            = errors.New("orbit has no usable signing key")
+)
+
+// Signing algorithms Generate can create keys for.
+const (
+	KeyAlgES256 = "ES256"
+	KeyAlgRS256 = "RS256"
+)
+
+const rsaKeyBits = 3072
+
 type JWKService struct {
 	db       *db.DB
 	cacheMan cache.Manager
+	cipher   SecretCipher
 	logger   zerolog.Logger
 	tracer   trace.Tracer
 	cache    cache.Cache
@@ -25,10 +51,11 @@ type JWKService struct {
 	name     string
 }
 
-func NewJWKService(dbConn *db.DB, cacheManager cache.Manager, logger zerolog.Logger) *JWKService {
+func NewJWKService(dbConn *db.DB, cacheManager cache.Manager, secretCipher SecretCipher, logger zerolog.Logger) *JWKService {
 	return &JWKService{
 		db:       dbConn,
 		cacheMan: cacheManager,
+		cipher:   secretCipher,
 		logger:   logger,
 		tracer:   otel.Tracer("service.jwk"),
 		cache:    cacheManager.Cache("jwks"),
@@ -60,6 +87,77 @@ func (s *JWKService) Create(ctx context.Context, jwk *models.JWKey) (*models.JWK
 	return created, nil
 }
 
+// Generate creates a signing key pair for an orbit. The private key is stored
+// as PKCS #8 sealed with the data encryption key and bound to its kid.
+func (s *JWKService) Generate(ctx context.Context, orbitID int64, alg string, notBefore, expiresAt *time.Time) (*models.JWKey, error) {
+	ctx, span := s.tracer.Start(ctx, "Generate")
+	defer span.End()
+
+	kid, err := newOpaqueToken(16)
+	if err != nil {
+		return nil, err
+	}
+	var priv crypto.Signer
+	var pub map[string]string
+	switch alg {
+	case KeyAlgES256:
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		priv = key
+		pub = map[string]string{
+			"kty": "EC",
+			"crv": "P-256",
+			"x":   base64.RawURLEncoding.EncodeToString(key.X.FillBytes(make([]byte, 32))),
+			"y":   base64.RawURLEncoding.EncodeToString(key.Y.FillBytes(make([]byte, 32))),
+		}
+	case KeyAlgRS256:
+		key, err := rsa.GenerateKey(rand.Reader, rsaKeyBits)
+		if err != nil {
+			return nil, err
+		}
+		priv = key
+		pub = map[string]string{
+			"kty": "RSA",
+			"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+			"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+		}
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedKeyAlgorithm, alg)
+	}
+	pub["kid"] = kid
+	pub["use"] = "sig"
+	pub["alg"] = alg
+
+	der, err := x509.MarshalPKCS8PrivateKey(priv)
+	if err != nil {
+		return nil, err
+	}
+	sealed, err := s.cipher.Seal(der, []byte(kid))
+	if err != nil {
+		return nil, err
+	}
+	publicJWK, err := json.Marshal(pub)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Create(ctx, &models.JWKey{
+		OrbitID:          orbitID,
+		Kid:              kid,
+		Use:              "sig",
+		Alg:              alg,
+		Kty:              pub["kty"],
+		PublicKeyJWK:     publicJWK,
+		PrivateKeyCipher: sealed,
+		IsActive:         true,
+		NotBefore:        notBefore,
+		ExpiresAt:        expiresAt,
+		Metadata:         json.RawMessage(`{}`),
+	})
+}
+
 func (s *JWKService) GetByID(ctx context.Context, id int64) (*models.JWKey, error) {
 	ctx, span := s.tracer.Start(ctx, "GetByID")
 	defer span.End()
@@ -109,15 +207,39 @@ func (s *JWKService) Update(ctx context.Context, jwk *models.JWKey) (*models.JWK
 	ctx, span := s.tracer.Start(ctx, "Update")
 	defer span.End()
 
+	return s.update(ctx, jwk, false)
+}
+
+// UpdateIfUnmodified updates jwk only if the stored key was last updated at
+// jwk.UpdatedAt. Otherwise it fails with ErrVersionMismatch.
+func (s *JWKService) UpdateIfUnmodified(ctx context.Context, jwk *models.JWKey) (*models.JWKey, error) {
+	ctx, span := s.tracer.Start(ctx, "UpdateIfUnmodified")
+	defer span.End()
+
+	return s.update(ctx, jwk, true)
+}
+
+func (s *JWKService) update(ctx context.Context, jwk *models.JWKey, ifUnmodified bool) (*models.JWKey, error) {
 	var updated *models.JWKey
 	err := s.db.WithTx(ctx, func(tx pgx.Tx) error {
 		repo := repositories.NewJWKRepository(tx, s.logger)
+		if ifUnmodified {
+			version, err := repo.LockVersion(ctx, jwk.ID)
+			if err != nil {
+				return err
+			}
+			if err := checkVersion(version, jwk.UpdatedAt); err != nil {
+				return err
+			}
+		}
 		var err error
 		updated, err = repo.Update(ctx, jwk)
 		return err
 	})
 	if err != nil {
-		s.logger.Error().Err(err).Int64("jwk_id", jwk.ID).Msg("jwk update failed")
+		if !isConflict(err) {
+			s.logger.Error().Err(err).Int64("jwk_id", jwk.ID).Msg("jwk update failed")
+		}
 		return nil, err
 	}
 	if updated != nil {
@@ -127,40 +249,100 @@ func (s *JWKService) Update(ctx context.Context, jwk *models.JWKey) (*models.JWK
 	return updated, nil
 }
 
-func (s *JWKService) ListByOrbit(ctx context.Context, orbitID int64, limit, offset int) ([]*models.JWKey, error) {
+func (s *JWKService) ListByOrbit(ctx context.Context, orbitID int64, page repositories.Page) ([]*models.JWKey, string, error) {
 	ctx, span := s.tracer.Start(ctx, "ListByOrbit")
 	defer span.End()
 
-	var items []*models.JWKey
-	err := s.db.WithTx(ctx, func(tx pgx.Tx) error {
-		repo := repositories.NewJWKRepository(tx, s.logger)
-		var err error
-		items, err = repo.ListByOrbit(ctx, orbitID, limit, offset)
-		return err
-	})
+	items, next, err := repositories.NewJWKRepository(s.db.Exec(), s.logger).ListByOrbit(ctx, orbitID, page)
 	if err != nil {
-		s.logger.Error().Err(err).Int64("orbit_id", orbitID).Msg("jwk list failed")
-		return nil, err
+		if !errors.Is(err, repositories.ErrInvalidCursor) {
+			s.logger.Error().Err(err).Int64("orbit_id", orbitID).Msg("jwk list failed")
+		}
+		return nil, "", err
 	}
-	return items, nil
+	return items, next, nil
 }
 
 func (s *JWKService) Delete(ctx context.Context, id int64, orbitID int64) error {
 	ctx, span := s.tracer.Start(ctx, "Delete")
 	defer span.End()
 
+	var kid string
 	err := s.db.WithTx(ctx, func(tx pgx.Tx) error {
 		repo := repositories.NewJWKRepository(tx, s.logger)
+		// The cache is keyed by kid, which the caller only knows by id.
+		jwk, err := repo.GetByID(ctx, id)
+		if err != nil || jwk == nil || jwk.OrbitID != orbitID {
+			return err
+		}
+		kid = jwk.Kid
 		return repo.Delete(ctx, id, orbitID)
 	})
 	if err != nil {
 		s.logger.Error().Err(err).Int64("jwk_id", id).Int64("orbit_id", orbitID).Msg("jwk delete failed")
 		return err
 	}
-	_ = s.cache.Delete(ctx, s.cacheKey(orbitID, ""))
+	if kid != "" {
+		_ = s.cache.Delete(ctx, s.cacheKey(orbitID, kid))
+	}
 	return nil
 }
 
+// Signer returns a signer for the newest active key of the orbit that is
+// already valid. The private key is opened for every call so that it does not
+// linger in memory or in the cache.
+func (s *JWKService) Signer(ctx context.Context, orbitID int64) (jose.Signer, error) {
+	ctx, span := s.tracer.Start(ctx, "Signer")
+	defer span.End()
+
+	now := time.Now().UTC()
+	keys, err := repositories.NewJWKRepository(s.db.Exec(), s.logger).ListActiveByOrbit(ctx, orbitID, now)
+	if err != nil {
+		return nil, err
+	}
+	for _, key := range keys {
+		if key.Use != "sig" || key.NotBefore != nil && now.Before(*key.NotBefore) {
+			continue
+		}
+		der, err := s.cipher.Open(key.PrivateKeyCipher, []byte(key.Kid))
+		if err != nil {
+			s.logger.Error().Err(err).Int64("orbit_id", orbitID).Str("kid", key.Kid).Msg("signing key cannot be opened")
+			return nil, err
+		}
+		priv, err := x509.ParsePKCS8PrivateKey(der)
+		if err != nil {
+			return nil, err
+		}
+		return jose.NewSigner(
+			jose.SigningKey{Algorithm: jose.SignatureAlgorithm(key.Alg), Key: jose.JSONWebKey{Key: priv, KeyID: key.Kid}},
+			(&jose.SignerOptions{}).WithType("JWT"),
+		)
+	}
+	return nil, ErrNoSigningKey
+}
+
+// PublicKeys returns the key set published at the JWKS endpoint: the public
+// halves of every active key of the orbit that has not expired.
+func (s *JWKService) PublicKeys(ctx context.Context, orbitID int64) (*jose.JSONWebKeySet, error) {
+	ctx, span := s.tracer.Start(ctx, "PublicKeys")
+	defer span.End()
+
+	keys, err := repositories.NewJWKRepository(s.db.Exec(), s.logger).ListActiveByOrbit(ctx, orbitID, time.Now().UTC())
+	if err != nil {
+		return nil, err
+	}
+	set := &jose.JSONWebKeySet{Keys: make([]jose.JSONWebKey, 0, len(keys))}
+	for _, key := range keys {
+		var jwk jose.JSONWebKey
+		if err := jwk.UnmarshalJSON(key.PublicKeyJWK); err != nil {
+			s.logger.Error().Err(err).Int64("orbit_id", orbitID).Str("kid", key.Kid).Msg("malformed public key skipped")
+			continue
+		}
+		set.Keys = append(set.Keys, jwk)
+	}
+	return set, nil
+}
+
 func fmtID(id int64) string {
 	return strconv.FormatInt(id, 10)
 }
@@ -0,0 +1,95 @@
+package services_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/testutil"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+)
+
+func TestJWKServiceRotation(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	cacheManager, _ := testutil.Redis(t)
+	secretCipher, err := services.NewAESGCMCipher(testutil.EncryptionKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keys := services.NewJWKService(dbConn, cacheManager, secretCipher, nop)
+	orbit := fx.Orbit()
+
+	if _, err := keys.Signer(ctx, orbit.ID); !errors.Is(err, services.ErrNoSigningKey) {
+		t.Fatalf("Signer without keys error = %v, want ErrNoSigningKey", err)
+	}
+	if _, err := keys.Generate(ctx, orbit.ID, "HS256", nil, nil); !errors.Is(err, services.ErrUnsupportedKeyAlgorithm) {
+		t.Fatalf("Generate(HS256) error = %v, want ErrUnsupportedKeyAlgorithm", err)
+	}
+
+	current, err := keys.Generate(ctx, orbit.ID, services.KeyAlgES256, nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A key announced ahead of its rotation is published but not used yet.
+	notBefore := time.Now().UTC().Add(time.Hour)
+	next, err := keys.Generate(ctx, orbit.ID, services.KeyAlgES256, &notBefore, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	signer, err := keys.Signer(ctx, orbit.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	raw, err := jwt.Signed(signer).Claims(jwt.Claims{Subject: "42"}).Serialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+	set, err := keys.PublicKeys(ctx, orbit.ID)
+	if err != nil || len(set.Keys) != 2 || len(set.Key(next.Kid)) != 1 {
+		t.Fatalf("PublicKeys = %+v, %v, want both keys", set, err)
+	}
+	parsed, err := jwt.ParseSigned(raw, []jose.SignatureAlgorithm{jose.ES256})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if kid := parsed.Headers[0].KeyID; kid != current.Kid {
+		t.Fatalf("signed with %s, want the current key %s", kid, current.Kid)
+	}
+	var claims jwt.Claims
+	if err := parsed.Claims(set, &claims); err != nil || claims.Subject != "42" {
+		t.Fatalf("verify with the published keys = %+v, %v", claims, err)
+	}
+
+	// Deactivating a key replaces its cached copy.
+	if got, err := keys.GetByOrbitAndKid(ctx, orbit.ID, current.Kid); err != nil || got == nil || !got.IsActive {
+		t.Fatalf("GetByOrbitAndKid = %+v, %v", got, err)
+	}
+	current.IsActive = false
+	if _, err := keys.Update(ctx, current); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := keys.GetByOrbitAndKid(ctx, orbit.ID, current.Kid); err != nil || got == nil || got.IsActive {
+		t.Fatalf("GetByOrbitAndKid after deactivation = %+v, %v", got, err)
+	}
+	if _, err := keys.Signer(ctx, orbit.ID); !errors.Is(err, services.ErrNoSigningKey) {
+		t.Fatalf("Signer with only a future key error = %v, want ErrNoSigningKey", err)
+	}
+
+	// Keys of another orbit cannot be deleted through it.
+	if err := keys.Delete(ctx, next.ID, fx.Orbit().ID); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := keys.GetByOrbitAndKid(ctx, orbit.ID, next.Kid); err != nil || got == nil {
+		t.Fatalf("GetByOrbitAndKid after a foreign delete = %+v, %v", got, err)
+	}
+	if err := keys.Delete(ctx, next.ID, orbit.ID); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := keys.GetByOrbitAndKid(ctx, orbit.ID, next.Kid); err != nil || got != nil {
+		t.Fatalf("GetByOrbitAndKid after delete = %+v, %v, want nil", got, err)
+	}
+}
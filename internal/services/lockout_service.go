@@ -0,0 +1,304 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services/cache"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var ErrLoginThrottled = errors.New("too many failed login attempts")
+
+// LoginThrottledError tells the caller how long to wait before the next
+// attempt is accepted.
+type LoginThrottledError struct {
+	RetryAfter time.Duration
+}
+
+func (e *LoginThrottledError) Error() string {
+	return fmt.Sprintf("%s, retry after %s", ErrLoginThrottled, e.RetryAfter)
+}
+
+func (e *LoginThrottledError) Unwrap() error {
+	return ErrLoginThrottled
+}
+
+// Factors counted separately, so that proving one does not reset the failures
+// recorded against another.
+const (
+	LoginFactorPassword     = "pwd"
+	LoginFactorSecondFactor = "mfa"
+)
+
+// LoginAttempt is a credential check guarded by the lockout service. The
+// subject is the account when it is known and the submitted identity
+// otherwise, so unknown identities are throttled like real ones.
+type LoginAttempt struct {
+	orbit   *models.Orbit
+	cfg     LockoutConfig
+	user    *models.User
+	subject string
+	factor  string
+	ip      string
+}
+
+type LockoutService struct {
+	cacheMan cache.Manager
+	users    *UserService
+	events   *SecurityEventService
+	logger   zerolog.Logger
+	tracer   trace.Tracer
+}
+
+func NewLockoutService(cacheManager cache.Manager, users *UserService, events *SecurityEventService, logger zerolog.Logger) *LockoutService {
+	return &LockoutService{
+		cacheMan: cacheManager,
+		users:    users,
+		events:   events,
+		logger:   logger,
+		tracer:   otel.Tracer("service.lockout"),
+	}
+}
+
+// Counters live in the shared cache so every instance sees the same totals.
+func (s *LockoutService) cache() cache.Cache {
+	return s.cacheMan.Cache("lockout")
+}
+
+func userSubject(userID int64) string {
+	return "user:" + strconv.FormatInt(userID, 10)
+}
+
+func failuresKey(orbitID int64, factor, subject string) string {
+	return fmt.Sprintf("failures:%d:%s:%s", orbitID, factor, subject)
+}
+
+func ipFailuresKey(orbitID int64, ip string) string {
+	return fmt.Sprintf("ip_failures:%d:%s", orbitID, ip)
+}
+
+func waitKey(orbitID int64, factor, subject string) string {
+	return fmt.Sprintf("wait:%d:%s:%s", orbitID, factor, subject)
+}
+
+func lockedKey(orbitID int64, subject string) string {
+	return fmt.Sprintf("locked:%d:%s", orbitID, subject)
+}
+
+func lockoutsKey(orbitID int64, subject string) string {
+	return fmt.Sprintf("lockouts:%d:%s", orbitID, subject)
+}
+
+func blockedIPKey(orbitID int64, ip string) string {
+	return fmt.Sprintf("blocked_ip:%d:%s", orbitID, ip)
+}
+
+// BeginPassword checks a password login for identity before the password is
+// verified.
+func (s *LockoutService) BeginPassword(ctx context.Context, orbit *models.Orbit, identity, ip string) (*LoginAttempt, error) {
+	ctx, span := s.tracer.Start(ctx, "BeginPassword")
+	defer span.End()
+
+	user, err := s.users.GetByIdentity(ctx, orbit.ID, identity)
+	if err != nil {
+		return nil, err
+	}
+	subject := "identity:" + strings.ToLower(identity)
+	if user != nil {
+		subject = userSubject(user.ID)
+	}
+	return s.begin(ctx, orbit, user, subject, LoginFactorPassword, ip)
+}
+
+// BeginSecondFactor checks a one-time or recovery code submitted for a user
+// who has already passed the first factor.
+func (s *LockoutService) BeginSecondFactor(ctx context.Context, orbit *models.Orbit, userID int64, ip string) (*LoginAttempt, error) {
+	ctx, span := s.tracer.Start(ctx, "BeginSecondFactor")
+	defer span.End()
+
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+	return s.begin(ctx, orbit, user, userSubject(userID), LoginFactorSecondFactor, ip)
+}
+
+func (s *LockoutService) begin(ctx context.Context, orbit *models.Orbit, user *models.User, subject, factor, ip string) (*LoginAttempt, error) {
+	cfg, err := ParseOrbitConfig(orbit)
+	if err != nil {
+		return nil, err
+	}
+	attempt := &LoginAttempt{orbit: orbit, cfg: cfg.Lockout, user: user, subject: subject, factor: factor, ip: ip}
+	if !attempt.cfg.Enabled {
+		return attempt, nil
+	}
+
+	// A cache outage must not stop logins, so lookups that fail count as
+	// "not blocked".
+	c := s.cache()
+	for _, key := range []string{
+		blockedIPKey(orbit.ID, ip),
+		lockedKey(orbit.ID, subject),
+		waitKey(orbit.ID, factor, subject),
+	} {
+		var until time.Time
+		if err := c.Get(ctx, key, &until); err != nil {
+			if !errors.Is(err, cache.ErrCacheMiss) {
+				s.logger.Error().Err(err).Str("key", key).Msg("lockout lookup failed")
+			}
+			continue
+		}
+		if wait := time.Until(until); wait > 0 {
+			return nil, &LoginThrottledError{RetryAfter: (wait + time.Second - 1).Truncate(time.Second)}
+		}
+	}
+	return attempt, nil
+}
+
+// Failed records a rejected credential. It imposes the progressive delay and
+// escalates to a temporary, then a permanent lockout.
+func (s *LockoutService) Failed(ctx context.Context, a *LoginAttempt) {
+	ctx, span := s.tracer.Start(ctx, "Failed")
+	defer span.End()
+
+	if !a.cfg.Enabled {
+		return
+	}
+	c := s.cache()
+	now := time.Now().UTC()
+	orbitID := a.orbit.ID
+
+	if a.cfg.MaxFailuresPerIP > 0 && a.ip != "" {
+		n, err := c.Increment(ctx, ipFailuresKey(orbitID, a.ip), a.cfg.Window())
+		if err != nil {
+			s.logger.Error().Err(err).Msg("count login failure by ip failed")
+		} else if n == int64(a.cfg.MaxFailuresPerIP) {
+			until := now.Add(a.cfg.LockoutDuration())
+			_ = c.Set(ctx, blockedIPKey(orbitID, a.ip), until, a.cfg.LockoutDuration())
+			s.events.Record(ctx, orbitID, nil, models.SecurityEventLoginIPBlocked, models.SecuritySeverityWarning, map[string]any{
+				"ip":       a.ip,
+				"failures": n,
+				"until":    until,
+			})
+			s.logger.Warn().Int64("orbit_id", orbitID).Str("ip", a.ip).Msg("login ip blocked")
+		}
+	}
+
+	n, err := c.Increment(ctx, failuresKey(orbitID, a.factor, a.subject), a.cfg.Window())
+	if err != nil {
+		s.logger.Error().Err(err).Msg("count login failure failed")
+		return
+	}
+	if a.cfg.MaxFailures > 0 && n >= int64(a.cfg.MaxFailures) {
+		// Concurrent failures can push the counter past the limit; only the
+		// one that hits it exactly locks, so each lockout is recorded once.
+		if n == int64(a.cfg.MaxFailures) {
+			s.lock(ctx, a, n, now)
+		}
+		return
+	}
+	if delay := a.cfg.Delay(n); delay > 0 {
+		_ = c.Set(ctx, waitKey(orbitID, a.factor, a.subject), now.Add(delay), delay)
+	}
+}
+
+func (s *LockoutService) lock(ctx context.Context, a *LoginAttempt, failures int64, now time.Time) {
+	c := s.cache()
+	orbitID := a.orbit.ID
+	_ = c.Delete(ctx, failuresKey(orbitID, a.factor, a.subject))
+	_ = c.Delete(ctx, waitKey(orbitID, a.factor, a.subject))
+
+	until := now.Add(a.cfg.LockoutDuration())
+	_ = c.Set(ctx, lockedKey(orbitID, a.subject), until, a.cfg.LockoutDuration())
+
+	var userID *int64
+	if a.user != nil {
+		userID = &a.user.ID
+	}
+	metadata := map[string]any{
+		"subject":  a.subject,
+		"factor":   a.factor,
+		"failures": failures,
+		"ip":       a.ip,
+	}
+
+	lockouts, err := c.Increment(ctx, lockoutsKey(orbitID, a.subject), a.cfg.History())
+	if err != nil {
+		s.logger.Error().Err(err).Msg("count lockout failed")
+	}
+	if a.user != nil && a.cfg.PermanentAfter > 0 && lockouts >= int64(a.cfg.PermanentAfter) {
+		changed, err := s.users.SetLocked(ctx, a.user, true)
+		if err != nil {
+			s.logger.Error().Err(err).Int64("user_id", a.user.ID).Msg("permanent lockout failed")
+		}
+		if changed {
+			metadata["lockouts"] = lockouts
+			s.events.Record(ctx, orbitID, userID, models.SecurityEventAccountLocked, models.SecuritySeverityCritical, metadata)
+			s.logger.Warn().Int64("user_id", a.user.ID).Msg("account locked")
+			return
+		}
+	}
+
+	metadata["until"] = until
+	s.events.Record(ctx, orbitID, userID, models.SecurityEventAccountLockedTemporarily, models.SecuritySeverityWarning, metadata)
+	s.logger.Warn().Int64("orbit_id", orbitID).Str("subject", a.subject).Time("until", until).Msg("account locked temporarily")
+}
+
+// Succeeded clears the failures recorded for the factor. Failures by IP are
+// kept, since one valid account must not reset a spraying attack.
+func (s *LockoutService) Succeeded(ctx context.Context, a *LoginAttempt) {
+	if !a.cfg.Enabled {
+		return
+	}
+	c := s.cache()
+	_ = c.Delete(ctx, failuresKey(a.orbit.ID, a.factor, a.subject))
+	_ = c.Delete(ctx, waitKey(a.orbit.ID, a.factor, a.subject))
+}
+
+// Unlock lifts both temporary and permanent lockouts of a user and forgets
+// the failures that led to them.
+func (s *LockoutService) Unlock(ctx context.Context, orbit *models.Orbit, userID int64) error {
+	ctx, span := s.tracer.Start(ctx, "Unlock")
+	defer span.End()
+
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil || user.OrbitID != orbit.ID || user.DeletedAt != nil {
+		return ErrUserNotFound
+	}
+
+	if _, err := s.users.SetLocked(ctx, user, false); err != nil {
+		return err
+	}
+	subject := userSubject(userID)
+	c := s.cache()
+	for _, key := range []string{
+		lockedKey(orbit.ID, subject),
+		lockoutsKey(orbit.ID, subject),
+		failuresKey(orbit.ID, LoginFactorPassword, subject),
+		failuresKey(orbit.ID, LoginFactorSecondFactor, subject),
+		waitKey(orbit.ID, LoginFactorPassword, subject),
+		waitKey(orbit.ID, LoginFactorSecondFactor, subject),
+	} {
+		if err := c.Delete(ctx, key); err != nil {
+			return err
+		}
+	}
+
+	s.events.Record(ctx, orbit.ID, &userID, models.SecurityEventAccountUnlocked, models.SecuritySeverityInfo, nil)
+	s.logger.Info().Int64("user_id", userID).Msg("account unlocked")
+	return nil
+}
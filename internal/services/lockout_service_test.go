@@ -0,0 +1,84 @@
+package services_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/testutil"
+)
+
+func TestLockoutServiceLocksAndUnlocks(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	cacheManager, srv := testutil.Redis(t)
+	users := newUserService(dbConn, cacheManager)
+	events := services.NewSecurityEventService(dbConn, nop)
+	svc := services.NewLockoutService(cacheManager, users, events, nop)
+
+	// No delays, so only the lockout stops attempts.
+	orbit := fx.Orbit(func(o *models.Orbit) {
+		o.Config = json.RawMessage(`{"lockout":{"enabled":true,"max_failures":3,"window_seconds":600,"free_attempts":10,"lockout_seconds":600,"permanent_after":2,"history_seconds":3600}}`)
+	})
+	user := fx.User(orbit)
+
+	fail := func(n int) {
+		t.Helper()
+		for range n {
+			attempt, err := svc.BeginPassword(ctx, orbit, user.Username, "192.0.2.1")
+			if err != nil {
+				t.Fatalf("BeginPassword = %v", err)
+			}
+			svc.Failed(ctx, attempt)
+		}
+	}
+
+	fail(3)
+	var throttled *services.LoginThrottledError
+	if _, err := svc.BeginPassword(ctx, orbit, user.Email, "192.0.2.2"); !errors.As(err, &throttled) || throttled.RetryAfter <= 0 {
+		t.Fatalf("BeginPassword after 3 failures = %v, want LoginThrottledError", err)
+	}
+
+	// A second lockout within the history window locks the account for good.
+	srv.FastForward(601 * time.Second)
+	fail(3)
+	locked, err := users.GetByID(ctx, user.ID)
+	if err != nil || locked == nil || !locked.IsLocked {
+		t.Fatalf("user after repeated lockouts = %+v, %v, want locked", locked, err)
+	}
+
+	list, _, err := events.ListByOrbit(ctx, orbit.ID, repositories.SecurityEventFilter{}, repositories.Page{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var types []string
+	for _, e := range list {
+		types = append(types, e.EventType)
+	}
+	for _, want := range []string{
+		models.SecurityEventAccountLockedTemporarily,
+		models.SecurityEventAccountLocked,
+	} {
+		found := false
+		for _, typ := range types {
+			found = found || typ == want
+		}
+		if !found {
+			t.Errorf("security events %v lack %s", types, want)
+		}
+	}
+
+	if _, err := users.Authenticate(ctx, orbit, user.Username, testutil.Password); !errors.Is(err, services.ErrUserLocked) {
+		t.Fatalf("Authenticate of a locked user = %v, want ErrUserLocked", err)
+	}
+	if err := svc.Unlock(ctx, orbit, user.ID); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := users.Authenticate(ctx, orbit, user.Username, testutil.Password); err != nil {
+		t.Fatalf("Authenticate after unlock = %v", err)
+	}
+}
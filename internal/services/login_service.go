@@ -0,0 +1,336 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories/db"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services/cache"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	ErrAuthorizationRequestNotFound = errors.New("authorization request not found or expired")
+	ErrInvalidAuthorizationRequest  = errors.New("invalid authorization request")
+	ErrInvalidScope                 = errors.New("requested scope is not allowed for this client")
+	ErrPasswordExpired              = errors.New("password has expired")
+)
+
+const (
+	PromptNone          = "none"
+	PromptLogin         = "login"
+	PromptConsent       = "consent"
+	PromptSelectAccount = "select_account"
+)
+
+// AuthorizationRequest is an /authorize request that has been validated
+// against its client. It is parked in the cache while the user logs in.
+type AuthorizationRequest struct {
+	OrbitID             int64
+	ClientID            int64
+	ClientName          string
+	FirstParty          bool
+	RedirectURI         string
+	Scopes              []string
+	Resources           []string
+	State               string
+	Nonce               string
+	CodeChallenge       string
+	CodeChallengeMethod string
+	Prompt              []string
+	MaxAge              *int
+	LoginHint           string
+	ACRValues           []string
+	// MinACR is the class the client requires regardless of acr_values.
+	MinACR string
+	// SessionID binds a request waiting for consent to the session that
+	// authenticated it.
+	SessionID int64
+	// BrowserHash binds a request waiting for sign-in to the browser that
+	// started it. Only the hash is kept so the cache never holds the cookie.
+	BrowserHash string
+	// RedirectURISent records whether the request named redirect_uri or left
+	// the registered one to be used, which decides whether the token request
+	// must repeat it.
+	RedirectURISent bool
+}
+
+func (r *AuthorizationRequest) HasPrompt(value string) bool {
+	return containsString(r.Prompt, value)
+}
+
+// NewBrowserBinding returns a value for the pre-session cookie that ties
+// pending requests to one browser.
+func NewBrowserBinding() (string, error) {
+	return newOpaqueToken(32)
+}
+
+func (r *AuthorizationRequest) BindBrowser(binding string) {
+	r.BrowserHash = hashToken(binding)
+}
+
+// BoundTo reports whether the request was started by the browser holding
+// binding.
+func (r *AuthorizationRequest) BoundTo(binding string) bool {
+	return r.BrowserHash != "" && binding != "" && tokenHashEqual(binding, r.BrowserHash)
+}
+
+// ParsePrompt splits the prompt parameter. "none" cannot be combined with any
+// other value (OpenID Connect Core section 3.1.2.1).
+func ParsePrompt(raw string) ([]string, error) {
+	values := strings.Fields(raw)
+	for _, v := range values {
+		switch v {
+		case PromptNone, PromptLogin, PromptConsent, PromptSelectAccount:
+		default:
+			return nil, fmt.Errorf("%w: unsupported prompt %q", ErrInvalidAuthorizationRequest, v)
+		}
+	}
+	if containsString(values, PromptNone) && len(values) > 1 {
+		return nil, fmt.Errorf("%w: prompt=none cannot be combined with other values", ErrInvalidAuthorizationRequest)
+	}
+	return values, nil
+}
+
+// CheckScopes rejects scopes the client was not registered for. Clients
+// without a scope list may request anything.
+func CheckScopes(c *models.Client, scopes []string) error {
+	allowed := decodeStringList(c.AllowedScopes)
+	if len(allowed) == 0 {
+		return nil
+	}
+	for _, scope := range scopes {
+		if !containsString(allowed, scope) {
+			return fmt.Errorf("%w: %q", ErrInvalidScope, scope)
+		}
+	}
+	return nil
+}
+
+type LoginService struct {
+	db         *db.DB
+	cacheMan   cache.Manager
+	users      *UserService
+	sessions   *SessionService
+	lockout    *LockoutService
+	authCodes  *AuthCodeService
+	logger     zerolog.Logger
+	tracer     trace.Tracer
+	requestTTL time.Duration
+	codeTTL    time.Duration
+	cacheKey   string
+}
+
+func NewLoginService(dbConn *db.DB, cacheManager cache.Manager, users *UserService, sessions *SessionService, lockout *LockoutService, authCodes *AuthCodeService, logger zerolog.Logger) *LoginService {
+	return &LoginService{
+		db:        dbConn,
+		cacheMan:  cacheManager,
+		users:     users,
+		sessions:  sessions,
+		lockout:   lockout,
+		authCodes: authCodes,
+		logger:    logger,
+		tracer:    otel.Tracer("service.login"),
+		// Long enough to type a password, short enough that abandoned
+		// requests do not pile up.
+		requestTTL: 10 * time.Minute,
+		codeTTL:    time.Minute,
+		cacheKey:   "authorization_request",
+	}
+}
+
+func (s *LoginService) cacheKeyFor(id string) string {
+	return s.cacheKey + ":" + id
+}
+
+// SavePending parks an authorization request and returns the opaque id the
+// login page refers to it by.
+func (s *LoginService) SavePending(ctx context.Context, req *AuthorizationRequest) (string, error) {
+	ctx, span := s.tracer.Start(ctx, "SavePending")
+	defer span.End()
+
+	id, err := newOpaqueToken(32)
+	if err != nil {
+		return "", err
+	}
+	if err := s.cacheMan.Cache("volatile").Set(ctx, s.cacheKeyFor(id), req, s.requestTTL); err != nil {
+		s.logger.Error().Err(err).Msg("save authorization request failed")
+		return "", err
+	}
+	return id, nil
+}
+
+func (s *LoginService) Pending(ctx context.Context, orbitID int64, id string) (*AuthorizationRequest, error) {
+	ctx, span := s.tracer.Start(ctx, "Pending")
+	defer span.End()
+
+	var req AuthorizationRequest
+	if err := s.cacheMan.Cache("volatile").Get(ctx, s.cacheKeyFor(id), &req); err != nil {
+		if errors.Is(err, cache.ErrCacheMiss) {
+			return nil, ErrAuthorizationRequestNotFound
+		}
+		return nil, err
+	}
+	if req.OrbitID != orbitID {
+		return nil, ErrAuthorizationRequestNotFound
+	}
+	return &req, nil
+}
+
+func (s *LoginService) DropPending(ctx context.Context, id string) {
+	_ = s.cacheMan.Cache("volatile").Delete(ctx, s.cacheKeyFor(id))
+}
+
+// Login verifies the submitted credentials and starts a new session for the
+// client that initiated the authorization request. Repeated failures are
+// throttled and eventually lock the account, see LockoutService.
+func (s *LoginService) Login(ctx context.Context, orbit *models.Orbit, req *AuthorizationRequest, identity, password, deviceInfo, ip string) (*models.Session, error) {
+	ctx, span := s.tracer.Start(ctx, "Login")
+	defer span.End()
+
+	identity = strings.TrimSpace(identity)
+	attempt, err := s.lockout.BeginPassword(ctx, orbit, identity, ip)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.users.Authenticate(ctx, orbit, identity, password)
+	if errors.Is(err, ErrInvalidCredentials) {
+		s.lockout.Failed(ctx, attempt)
+	}
+	if err != nil {
+		return nil, err
+	}
+	s.lockout.Succeeded(ctx, attempt)
+	if s.users.PasswordExpired(orbit, user) {
+		return nil, ErrPasswordExpired
+	}
+	return s.StartSession(ctx, orbit, req, user, []string{AMRPassword}, deviceInfo, ip)
+}
+
+// StartSession opens a session for a user who has already been authenticated
+// with the given methods, for instance with a passkey.
+func (s *LoginService) StartSession(ctx context.Context, orbit *models.Orbit, req *AuthorizationRequest, user *models.User, amr []string, deviceInfo, ip string) (*models.Session, error) {
+	ctx, span := s.tracer.Start(ctx, "StartSession")
+	defer span.End()
+
+	now := time.Now().UTC()
+	session := &models.Session{
+		OrbitID:      orbit.ID,
+		UserID:       user.ID,
+		ClientID:     &req.ClientID,
+		StartedAt:    now,
+		LastActiveAt: now,
+		DeviceInfo:   deviceInfo,
+		IP:           ip,
+	}
+	if err := setSessionAuthentication(session, newAuthentication(amr, now)); err != nil {
+		return nil, err
+	}
+
+	created, err := s.sessions.Start(ctx, orbit, session)
+	if err != nil {
+		return nil, err
+	}
+	s.logger.Info().Int64("user_id", user.ID).Int64("session_id", created.ID).Msg("user logged in")
+	return created, nil
+}
+
+// NeedsLogin decides whether the request can be answered from the existing
+// session or the user has to authenticate (again).
+func (s *LoginService) NeedsLogin(req *AuthorizationRequest, session *models.Session, user *models.User, now time.Time) bool {
+	if session == nil || user == nil {
+		return true
+	}
+	if req.HasPrompt(PromptLogin) || req.HasPrompt(PromptSelectAccount) {
+		return true
+	}
+	authTime := time.Unix(SessionAuthentication(session).AuthTime, 0)
+	if req.MaxAge != nil && now.Sub(authTime) > time.Duration(*req.MaxAge)*time.Second {
+		return true
+	}
+	if req.LoginHint != "" && !strings.EqualFold(req.LoginHint, user.Username) && !strings.EqualFold(req.LoginHint, user.Email) {
+		return true
+	}
+	return false
+}
+
+// StepUpRequired reports whether the session has to add a second factor
+// before the request can be answered. The client minimum and the user's own
+// MFA setting are mandatory; acr_values is only honoured when the user is able
+// to satisfy it. ErrMFANotConfigured means a mandatory class is out of reach.
+func (s *LoginService) StepUpRequired(ctx context.Context, req *AuthorizationRequest, session *models.Session) (bool, error) {
+	ctx, span := s.tracer.Start(ctx, "StepUpRequired")
+	defer span.End()
+
+	user, err := s.users.GetByID(ctx, session.UserID)
+	if err != nil {
+		return false, err
+	}
+	if user == nil {
+		return false, ErrUserNotFound
+	}
+
+	mandatory := acrLevels[req.MinACR]
+	if user.MFAEnabled {
+		mandatory = max(mandatory, acrLevels[ACRMultiFactor])
+	}
+	wanted := max(mandatory, acrLevels[RequestedACR(req.ACRValues)])
+
+	achieved := SessionAuthentication(session).Level()
+	if achieved >= wanted {
+		return false, nil
+	}
+	if user.MFAEnabled {
+		return true, nil
+	}
+	if achieved < mandatory {
+		return false, ErrMFANotConfigured
+	}
+	return false, nil
+}
+
+// IssueCode finishes an authorization request for an authenticated session.
+func (s *LoginService) IssueCode(ctx context.Context, req *AuthorizationRequest, session *models.Session) (*models.AuthCode, error) {
+	ctx, span := s.tracer.Start(ctx, "IssueCode")
+	defer span.End()
+
+	code, err := newOpaqueToken(32)
+	if err != nil {
+		return nil, err
+	}
+	auth := SessionAuthentication(session)
+	metadata, err := json.Marshal(map[string]any{
+		"session_id":        session.ID,
+		"acr":               auth.ACR,
+		"amr":               auth.AMR,
+		"auth_time":         auth.AuthTime,
+		"nonce":             req.Nonce,
+		"redirect_uri_sent": req.RedirectURISent,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC()
+	return s.authCodes.Create(ctx, &models.AuthCode{
+		Code:                code,
+		OrbitID:             req.OrbitID,
+		ClientID:            req.ClientID,
+		UserID:              &session.UserID,
+		RedirectURI:         req.RedirectURI,
+		Scope:               encodeStringList(req.Scopes),
+		Resources:           encodeStringList(req.Resources),
+		CodeChallenge:       req.CodeChallenge,
+		CodeChallengeMethod: req.CodeChallengeMethod,
+		Metadata:            metadata,
+		CreatedAt:           now,
+		ExpiresAt:           now.Add(s.codeTTL),
+	})
+}
@@ -0,0 +1,110 @@
+package services_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/testutil"
+)
+
+func TestLoginServiceIssuesCodeForSession(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	cacheManager, _ := testutil.Redis(t)
+	users := newUserService(dbConn, cacheManager)
+	events := services.NewSecurityEventService(dbConn, nop)
+	authCodes := services.NewAuthCodeService(dbConn, cacheManager, nop)
+	login := services.NewLoginService(dbConn, cacheManager, users,
+		services.NewSessionService(dbConn, users, nop),
+		services.NewLockoutService(cacheManager, users, events, nop),
+		authCodes, nop)
+	orbit := fx.Orbit()
+	client := fx.Client(orbit)
+	user := fx.User(orbit)
+
+	req := &services.AuthorizationRequest{
+		OrbitID:             orbit.ID,
+		ClientID:            client.ID,
+		RedirectURI:         "https://app.example.test/callback",
+		Scopes:              []string{"openid"},
+		Nonce:               "nonce-1",
+		CodeChallenge:       "challenge",
+		CodeChallengeMethod: "S256",
+	}
+	id, err := login.SavePending(ctx, req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// A pending request is only found through the orbit it was made in.
+	if _, err := login.Pending(ctx, fx.Orbit().ID, id); !errors.Is(err, services.ErrAuthorizationRequestNotFound) {
+		t.Fatalf("Pending from another orbit error = %v, want ErrAuthorizationRequestNotFound", err)
+	}
+	pending, err := login.Pending(ctx, orbit.ID, id)
+	if err != nil || pending.Nonce != "nonce-1" {
+		t.Fatalf("Pending = %+v, %v", pending, err)
+	}
+
+	if _, err := login.Login(ctx, orbit, pending, user.Username, "wrong", "test", "192.0.2.1"); !errors.Is(err, services.ErrInvalidCredentials) {
+		t.Fatalf("Login with a wrong password error = %v, want ErrInvalidCredentials", err)
+	}
+	session, err := login.Login(ctx, orbit, pending, " "+user.Email+" ", testutil.Password, "test", "192.0.2.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if session.UserID != user.ID || session.ClientID == nil || *session.ClientID != client.ID {
+		t.Fatalf("session = %+v", session)
+	}
+	if login.NeedsLogin(pending, session, user, session.StartedAt) {
+		t.Fatal("NeedsLogin right after login = true")
+	}
+
+	code, err := login.IssueCode(ctx, pending, session)
+	if err != nil {
+		t.Fatal(err)
+	}
+	consumed, err := authCodes.Consume(ctx, code.Code)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var metadata struct {
+		SessionID int64    `json:"session_id"`
+		AMR       []string `json:"amr"`
+		Nonce     string   `json:"nonce"`
+	}
+	if err := json.Unmarshal(consumed.Metadata, &metadata); err != nil {
+		t.Fatal(err)
+	}
+	if consumed.UserID == nil || *consumed.UserID != user.ID || consumed.CodeChallenge != "challenge" ||
+		metadata.SessionID != session.ID || metadata.Nonce != "nonce-1" || len(metadata.AMR) != 1 || metadata.AMR[0] != services.AMRPassword {
+		t.Fatalf("code = %+v with metadata %+v", consumed, metadata)
+	}
+
+	login.DropPending(ctx, id)
+	if _, err := login.Pending(ctx, orbit.ID, id); !errors.Is(err, services.ErrAuthorizationRequestNotFound) {
+		t.Fatalf("Pending after DropPending error = %v, want ErrAuthorizationRequestNotFound", err)
+	}
+}
+
+func TestAuthorizationRequestBoundTo(t *testing.T) {
+	binding, err := services.NewBrowserBinding()
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := services.NewBrowserBinding()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var req services.AuthorizationRequest
+	if req.BoundTo(binding) || req.BoundTo("") {
+		t.Fatal("a request without a binding matched a browser")
+	}
+	req.BindBrowser(binding)
+	if !req.BoundTo(binding) {
+		t.Fatal("request does not match the browser it was bound to")
+	}
+	if req.BoundTo(other) || req.BoundTo("") || req.BoundTo(req.BrowserHash) {
+		t.Fatal("request matched another browser")
+	}
+}
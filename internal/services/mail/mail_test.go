@@ -0,0 +1,125 @@
+package mail_test
+
+import (
+	"errors"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	netmail "net/mail"
+	"strings"
+	"testing"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services/mail"
+)
+
+// parseMessage reads a rendered message and returns it with its decoded
+// single-part body.
+func parseMessage(t *testing.T, data string) (*netmail.Message, []byte) {
+	t.Helper()
+	msg, err := netmail.ReadMessage(strings.NewReader(data))
+	if err != nil {
+		t.Fatalf("ReadMessage: %v\n%s", err, data)
+	}
+	if msg.Header.Get("Content-Transfer-Encoding") != "quoted-printable" {
+		body, _ := io.ReadAll(msg.Body)
+		return msg, body
+	}
+	body, err := io.ReadAll(quotedprintable.NewReader(msg.Body))
+	if err != nil {
+		t.Fatalf("decode body: %v", err)
+	}
+	return msg, body
+}
+
+func TestMessageBytesHeaders(t *testing.T) {
+	data, err := testMessage().Bytes()
+	if err != nil {
+		t.Fatalf("Bytes: %v", err)
+	}
+	msg, _ := parseMessage(t, string(data))
+
+	subject, err := new(mime.WordDecoder).DecodeHeader(msg.Header.Get("Subject"))
+	if err != nil || subject != "Réinitialiser votre mot de passe" {
+		t.Errorf("decoded Subject = %q, %v", subject, err)
+	}
+	if raw := msg.Header.Get("Subject"); !strings.HasPrefix(raw, "=?utf-8?q?") {
+		t.Errorf("raw Subject = %q, want Q-encoding", raw)
+	}
+	for _, h := range []string{"From", "To", "Date", "Message-ID"} {
+		if msg.Header.Get(h) == "" {
+			t.Errorf("missing %s header", h)
+		}
+	}
+	if id := msg.Header.Get("Message-ID"); !strings.HasSuffix(id, "@example.com>") {
+		t.Errorf("Message-ID = %q, want the sender's domain", id)
+	}
+	if msg.Header.Get("MIME-Version") != "1.0" {
+		t.Errorf("MIME-Version = %q", msg.Header.Get("MIME-Version"))
+	}
+}
+
+func TestMessageBytesAlternative(t *testing.T) {
+	in := testMessage()
+	in.Text += strings.Repeat("Une ligne bien plus longue que soixante-seize caractères. ", 3) + "=\n"
+	data, err := in.Bytes()
+	if err != nil {
+		t.Fatalf("Bytes: %v", err)
+	}
+	for _, line := range strings.Split(string(data), "\r\n") {
+		if len(line) > 998 {
+			t.Fatalf("line of %d bytes exceeds RFC 5322", len(line))
+		}
+	}
+
+	msg, err := netmail.ReadMessage(strings.NewReader(string(data)))
+	if err != nil {
+		t.Fatalf("ReadMessage: %v", err)
+	}
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil || mediaType != "multipart/alternative" {
+		t.Fatalf("Content-Type = %q, %v", msg.Header.Get("Content-Type"), err)
+	}
+	parts := multipart.NewReader(msg.Body, params["boundary"])
+	want := []struct{ contentType, body string }{
+		{"text/plain; charset=utf-8", in.Text},
+		{"text/html; charset=utf-8", in.HTML},
+	}
+	for _, w := range want {
+		p, err := parts.NextPart()
+		if err != nil {
+			t.Fatalf("NextPart: %v", err)
+		}
+		if got := p.Header.Get("Content-Type"); got != w.contentType {
+			t.Errorf("part Content-Type = %q, want %q", got, w.contentType)
+		}
+		// NextPart decodes quoted-printable and drops the header.
+		body, err := io.ReadAll(p)
+		if err != nil {
+			t.Fatalf("read part: %v", err)
+		}
+		if got := strings.ReplaceAll(string(body), "\r\n", "\n"); got != w.body {
+			t.Errorf("%s part = %q, want %q", w.contentType, got, w.body)
+		}
+	}
+	if _, err := parts.NextPart(); err != io.EOF {
+		t.Errorf("extra part after the HTML alternative: %v", err)
+	}
+}
+
+func TestMessageBytesRejectsInvalid(t *testing.T) {
+	for name, mutate := range map[string]func(*mail.Message){
+		"header injection": func(m *mail.Message) { m.Subject = "Hello\r\nBcc: eve@example.net" },
+		"bare newline":     func(m *mail.Message) { m.Subject = "Hello\nBcc: eve@example.net" },
+		"bad from":         func(m *mail.Message) { m.From = "not an address" },
+		"bad to":           func(m *mail.Message) { m.To = "ada@" },
+	} {
+		t.Run(name, func(t *testing.T) {
+			m := testMessage()
+			mutate(&m)
+			if _, err := m.Bytes(); !errors.Is(err, mail.ErrInvalidMessage) {
+				t.Fatalf("Bytes error = %v, want ErrInvalidMessage", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,324 @@
+package mail_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"errors"
+	"io"
+	"math/big"
+	"net"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services/mail"
+)
+
+// delivery is what the test server saw of one SMTP session.
+type delivery struct {
+	TLS  bool
+	Auth string // decoded AUTH PLAIN response
+	From string
+	To   []string
+	Data string
+}
+
+// smtpServer is an in-process relay speaking just enough ESMTP for
+// SMTPMailer: EHLO, STARTTLS, AUTH PLAIN, MAIL, RCPT, DATA and QUIT.
+type smtpServer struct {
+	ln  net.Listener
+	tls *tls.Config // nil disables STARTTLS
+	// requireTLS refuses MAIL before STARTTLS, like a submission port.
+	requireTLS bool
+
+	mu         sync.Mutex
+	deliveries []delivery
+	wg         sync.WaitGroup
+}
+
+func newSMTPServer(t *testing.T, tlsConfig *tls.Config, requireTLS bool) *smtpServer {
+	t.Helper()
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("listen: %v", err)
+	}
+	srv := &smtpServer{ln: ln, tls: tlsConfig, requireTLS: requireTLS}
+	srv.wg.Add(1)
+	go func() {
+		defer srv.wg.Done()
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			srv.wg.Add(1)
+			go func() {
+				defer srv.wg.Done()
+				srv.serve(conn)
+			}()
+		}
+	}()
+	t.Cleanup(func() {
+		_ = ln.Close()
+		srv.wg.Wait()
+	})
+	return srv
+}
+
+func (s *smtpServer) config(mode string) mail.SMTPConfig {
+	host, port, _ := net.SplitHostPort(s.ln.Addr().String())
+	p, _ := strconv.Atoi(port)
+	return mail.SMTPConfig{Host: host, Port: p, TLS: mode}
+}
+
+func (s *smtpServer) received() []delivery {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]delivery(nil), s.deliveries...)
+}
+
+func (s *smtpServer) serve(conn net.Conn) {
+	defer func() { _ = conn.Close() }()
+	_ = conn.SetDeadline(time.Now().Add(10 * time.Second))
+	text := textproto.NewConn(conn)
+	reply := func(format string, args ...any) bool {
+		return text.PrintfLine(format, args...) == nil
+	}
+
+	var d delivery
+	if !reply("220 localhost ESMTP test") {
+		return
+	}
+	for {
+		line, err := text.ReadLine()
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+		switch strings.ToUpper(verb) {
+		case "EHLO", "HELO":
+			ext := []string{"localhost"}
+			if s.tls != nil && !d.TLS {
+				ext = append(ext, "STARTTLS")
+			}
+			ext = append(ext, "AUTH PLAIN")
+			for i, e := range ext {
+				sep := "-"
+				if i == len(ext)-1 {
+					sep = " "
+				}
+				reply("250%s%s", sep, e)
+			}
+		case "STARTTLS":
+			if s.tls == nil || d.TLS {
+				reply("502 not available")
+				continue
+			}
+			reply("220 ready")
+			tlsConn := tls.Server(conn, s.tls)
+			if err := tlsConn.Handshake(); err != nil {
+				return
+			}
+			conn = tlsConn
+			text = textproto.NewConn(conn)
+			d = delivery{TLS: true}
+		case "AUTH":
+			mech, resp, _ := strings.Cut(arg, " ")
+			if mech != "PLAIN" {
+				reply("504 unsupported")
+				continue
+			}
+			raw, err := base64.StdEncoding.DecodeString(resp)
+			if err != nil {
+				reply("501 malformed")
+				continue
+			}
+			d.Auth = string(raw)
+			reply("235 ok")
+		case "MAIL":
+			if s.requireTLS && !d.TLS {
+				reply("530 must issue STARTTLS first")
+				continue
+			}
+			d.From = addrArg(arg)
+			reply("250 ok")
+		case "RCPT":
+			d.To = append(d.To, addrArg(arg))
+			reply("250 ok")
+		case "DATA":
+			reply("354 go ahead")
+			data, err := io.ReadAll(text.DotReader())
+			if err != nil {
+				return
+			}
+			d.Data = string(data)
+			s.mu.Lock()
+			s.deliveries = append(s.deliveries, d)
+			s.mu.Unlock()
+			reply("250 queued")
+		case "RSET", "NOOP":
+			reply("250 ok")
+		case "QUIT":
+			reply("221 bye")
+			return
+		default:
+			reply("502 unknown command")
+		}
+	}
+}
+
+// addrArg takes the address out of "FROM:<a@b>" or "TO:<a@b>".
+func addrArg(arg string) string {
+	_, addr, _ := strings.Cut(arg, ":")
+	addr, _, _ = strings.Cut(strings.TrimSpace(addr), " ")
+	return strings.Trim(addr, "<>")
+}
+
+// selfSigned returns a server config for 127.0.0.1 and a pool that trusts it.
+func selfSigned(t *testing.T) (*tls.Config, *x509.CertPool) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "orbitum test relay"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1)},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pool := x509.NewCertPool()
+	pool.AddCert(cert)
+	return &tls.Config{
+		Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}},
+		MinVersion:   tls.VersionTLS12,
+	}, pool
+}
+
+func testMessage() mail.Message {
+	return mail.Message{
+		From:    "Orbitum <no-reply@example.com>",
+		To:      "Ada <ada@example.org>",
+		Subject: "Réinitialiser votre mot de passe",
+		Text:    "Bonjour Ada,\n\nhttps://id.example.com/password/reset?token=abc.def\n",
+		HTML:    `<p>Bonjour Ada,</p><p><a href="https://id.example.com/password/reset?token=abc.def">Réinitialiser</a></p>`,
+	}
+}
+
+func TestSMTPMailerPlainConnection(t *testing.T) {
+	srv := newSMTPServer(t, nil, false)
+	cfg := srv.config(mail.TLSNone)
+	cfg.Username = "relay"
+	cfg.Password = "s3cret"
+
+	if err := mail.NewSMTPMailer(cfg).Send(t.Context(), testMessage()); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	got := srv.received()
+	if len(got) != 1 {
+		t.Fatalf("relay got %d messages, want 1", len(got))
+	}
+	d := got[0]
+	if d.TLS {
+		t.Error("session upgraded to TLS in mode none")
+	}
+	if d.Auth != "\x00relay\x00s3cret" {
+		t.Errorf("AUTH PLAIN = %q", d.Auth)
+	}
+	if d.From != "no-reply@example.com" || len(d.To) != 1 || d.To[0] != "ada@example.org" {
+		t.Errorf("envelope = %q -> %q, want bare addresses", d.From, d.To)
+	}
+	if !strings.Contains(d.Data, "Subject: =?utf-8?q?") {
+		t.Errorf("delivered data has no encoded subject:\n%s", d.Data)
+	}
+}
+
+func TestSMTPMailerStartTLS(t *testing.T) {
+	serverTLS, roots := selfSigned(t)
+	srv := newSMTPServer(t, serverTLS, true)
+	cfg := srv.config(mail.TLSStartTLS)
+	cfg.RootCAs = roots
+	cfg.Username = "relay"
+	cfg.Password = "s3cret"
+
+	if err := mail.NewSMTPMailer(cfg).Send(t.Context(), testMessage()); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	got := srv.received()
+	if len(got) != 1 {
+		t.Fatalf("relay got %d messages, want 1", len(got))
+	}
+	if !got[0].TLS {
+		t.Error("message was delivered before STARTTLS")
+	}
+	if got[0].Auth != "\x00relay\x00s3cret" {
+		t.Errorf("AUTH PLAIN after STARTTLS = %q", got[0].Auth)
+	}
+}
+
+func TestSMTPMailerStartTLSVerifiesCertificate(t *testing.T) {
+	serverTLS, _ := selfSigned(t)
+	srv := newSMTPServer(t, serverTLS, true)
+
+	err := mail.NewSMTPMailer(srv.config(mail.TLSStartTLS)).Send(t.Context(), testMessage())
+	if err == nil || !strings.Contains(err.Error(), "starttls") {
+		t.Fatalf("Send to an untrusted relay error = %v, want a starttls failure", err)
+	}
+	if got := srv.received(); len(got) != 0 {
+		t.Fatalf("relay got %d messages from an unverified session", len(got))
+	}
+}
+
+func TestSMTPMailerRefusedWithoutTLS(t *testing.T) {
+	serverTLS, _ := selfSigned(t)
+	srv := newSMTPServer(t, serverTLS, true)
+
+	err := mail.NewSMTPMailer(srv.config(mail.TLSNone)).Send(t.Context(), testMessage())
+	var tpErr *textproto.Error
+	if !errors.As(err, &tpErr) || tpErr.Code != 530 {
+		t.Fatalf("Send in mode none to a TLS-only relay error = %v, want 530", err)
+	}
+}
+
+// The relay must see the same bytes Message.Bytes renders, dot-stuffing
+// aside.
+func TestSMTPMailerSendsRenderedMessage(t *testing.T) {
+	srv := newSMTPServer(t, nil, false)
+	msg := testMessage()
+	msg.HTML = ""
+	msg.Text = ".leading dot\n" + strings.Repeat("long line ", 20) + "\n"
+
+	if err := mail.NewSMTPMailer(srv.config(mail.TLSNone)).Send(t.Context(), msg); err != nil {
+		t.Fatalf("Send: %v", err)
+	}
+	got := srv.received()
+	if len(got) != 1 {
+		t.Fatalf("relay got %d messages, want 1", len(got))
+	}
+	parsed, body := parseMessage(t, got[0].Data)
+	if parsed.Header.Get("Content-Type") != "text/plain; charset=utf-8" {
+		t.Errorf("Content-Type = %q", parsed.Header.Get("Content-Type"))
+	}
+	if string(body) != msg.Text {
+		t.Errorf("decoded body = %q, want %q", body, msg.Text)
+	}
+}
@@ -0,0 +1,29 @@
+package services_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories/db"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services/cache"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/testutil"
+	"github.com/rs/zerolog"
+)
+
+var nop = zerolog.Nop()
+
+func TestMain(m *testing.M) {
+	os.Exit(testutil.Main(m))
+}
+
+func setup(t *testing.T) (*db.DB, *testutil.Fixtures) {
+	t.Helper()
+	dbConn := testutil.Postgres(t)
+	return dbConn, testutil.NewFixtures(t, dbConn)
+}
+
+func newUserService(dbConn *db.DB, cacheManager cache.Manager) *services.UserService {
+	return services.NewUserService(dbConn, repositories.NewUserRepository(dbConn.Exec(), nop), cacheManager, nil, nop)
+}
@@ -0,0 +1,296 @@
+package services
+
+import (
+	"encoding/json"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services/hashing"
+)
+
+type RegistrationConfig struct {
+	Enabled                   bool                    `json:"enabled"`
+	RequireInitialAccessToken bool                    `json:"require_initial_access_token"`
+	SoftwareStatement         SoftwareStatementConfig `json:"software_statement"`
+}
+
+type SoftwareStatementConfig struct {
+	Required           bool               `json:"required"`
+	TrustedDirectories []TrustedDirectory `json:"trusted_directories"`
+}
+
+// TrustedDirectory is a software statement issuer together with the public
+// keys it signs statements with.
+type TrustedDirectory struct {
+	Issuer string          `json:"issuer"`
+	JWKS   json.RawMessage `json:"jwks"`
+}
+
+// ClientSecretConfig controls generated client secrets. A zero TTL means
+// secrets never expire; the grace period keeps the previous secret valid after
+// a rotation so deployments can roll over without downtime.
+type ClientSecretConfig struct {
+	TTLSeconds           int `json:"ttl_seconds"`
+	RotationGraceSeconds int `json:"rotation_grace_seconds"`
+}
+
+func (c ClientSecretConfig) TTL() time.Duration {
+	return time.Duration(c.TTLSeconds) * time.Second
+}
+
+func (c ClientSecretConfig) RotationGrace() time.Duration {
+	return time.Duration(c.RotationGraceSeconds) * time.Second
+}
+
+// TokenConfig sets the lifetimes of the tokens the token endpoint issues. A
+// resource server with a shorter access_token_ttl shortens the access tokens
+// minted for it. Refresh tokens keep the expiry of the first one in their
+// rotation chain.
+type TokenConfig struct {
+	AccessTokenTTLSeconds  int `json:"access_token_ttl_seconds"`
+	IDTokenTTLSeconds      int `json:"id_token_ttl_seconds"`
+	RefreshTokenTTLSeconds int `json:"refresh_token_ttl_seconds"`
+}
+
+func (c TokenConfig) AccessTokenTTL() time.Duration {
+	return time.Duration(c.AccessTokenTTLSeconds) * time.Second
+}
+
+func (c TokenConfig) IDTokenTTL() time.Duration {
+	return time.Duration(c.IDTokenTTLSeconds) * time.Second
+}
+
+func (c TokenConfig) RefreshTokenTTL() time.Duration {
+	return time.Duration(c.RefreshTokenTTLSeconds) * time.Second
+}
+
+// SessionConfig bounds how long a login session started at this orbit stays
+// usable before the user has to authenticate again: LifetimeSeconds after it
+// started, or IdleTimeoutSeconds after it was last used. Last use is recorded
+// at most every TouchIntervalSeconds. Past MaxPerUser sessions a new login
+// signs the user out of the least recently used ones. Zero disables a limit.
+type SessionConfig struct {
+	LifetimeSeconds      int `json:"lifetime_seconds"`
+	IdleTimeoutSeconds   int `json:"idle_timeout_seconds"`
+	TouchIntervalSeconds int `json:"touch_interval_seconds"`
+	MaxPerUser           int `json:"max_per_user"`
+}
+
+func (c SessionConfig) Lifetime() time.Duration {
+	return time.Duration(c.LifetimeSeconds) * time.Second
+}
+
+func (c SessionConfig) IdleTimeout() time.Duration {
+	return time.Duration(c.IdleTimeoutSeconds) * time.Second
+}
+
+func (c SessionConfig) TouchInterval() time.Duration {
+	return time.Duration(c.TouchIntervalSeconds) * time.Second
+}
+
+// ConsentConfig limits how long a consent is remembered. Zero keeps consents
+// until the user revokes them.
+type ConsentConfig struct {
+	LifetimeSeconds int `json:"lifetime_seconds"`
+}
+
+func (c ConsentConfig) Lifetime() time.Duration {
+	return time.Duration(c.LifetimeSeconds) * time.Second
+}
+
+// TOTPConfig describes authenticators enrolled at this orbit. Skew is the
+// number of periods a code may lag or lead the server clock.
+type TOTPConfig struct {
+	Issuer            string `json:"issuer"`
+	Algorithm         string `json:"algorithm"`
+	Digits            int    `json:"digits"`
+	PeriodSeconds     int    `json:"period_seconds"`
+	Skew              int    `json:"skew"`
+	MaxAuthenticators int    `json:"max_authenticators"`
+}
+
+// RecoveryCodeConfig sizes recovery code batches. A security event is raised
+// once no more than LowThreshold unused codes remain.
+type RecoveryCodeConfig struct {
+	Count        int `json:"count"`
+	LowThreshold int `json:"low_threshold"`
+}
+
+// WebAuthnConfig describes passkeys registered at this orbit. The relying
+// party ID is always the orbit domain; Origins only needs to be set when the
+// login pages are served from somewhere other than https://<domain>.
+type WebAuthnConfig struct {
+	RPName           string   `json:"rp_name"`
+	Origins          []string `json:"origins"`
+	UserVerification string   `json:"user_verification"`
+	ResidentKey      string   `json:"resident_key"`
+	Attestation      string   `json:"attestation"`
+	TimeoutSeconds   int      `json:"timeout_seconds"`
+	MaxCredentials   int      `json:"max_credentials"`
+}
+
+func (c WebAuthnConfig) Timeout() time.Duration {
+	return time.Duration(c.TimeoutSeconds) * time.Second
+}
+
+// LockoutConfig throttles password guessing. Failures are counted per account
+// and per client IP within WindowSeconds. Past FreeAttempts every failure
+// doubles the wait before the next attempt is accepted, starting at
+// DelayBaseMillis and capped at DelayMaxMillis. MaxFailures failures lock the
+// account for LockoutSeconds; once PermanentAfter such lockouts happened within
+// HistorySeconds the account stays locked until it is unlocked explicitly.
+// Zero for MaxFailuresPerIP or PermanentAfter disables that stage.
+type LockoutConfig struct {
+	Enabled          bool `json:"enabled"`
+	MaxFailures      int  `json:"max_failures"`
+	MaxFailuresPerIP int  `json:"max_failures_per_ip"`
+	WindowSeconds    int  `json:"window_seconds"`
+	FreeAttempts     int  `json:"free_attempts"`
+	DelayBaseMillis  int  `json:"delay_base_millis"`
+	DelayMaxMillis   int  `json:"delay_max_millis"`
+	LockoutSeconds   int  `json:"lockout_seconds"`
+	PermanentAfter   int  `json:"permanent_after"`
+	HistorySeconds   int  `json:"history_seconds"`
+}
+
+func (c LockoutConfig) Window() time.Duration {
+	return time.Duration(c.WindowSeconds) * time.Second
+}
+
+func (c LockoutConfig) LockoutDuration() time.Duration {
+	return time.Duration(c.LockoutSeconds) * time.Second
+}
+
+func (c LockoutConfig) History() time.Duration {
+	return time.Duration(c.HistorySeconds) * time.Second
+}
+
+// Delay is the wait imposed after the given number of failures.
+func (c LockoutConfig) Delay(failures int64) time.Duration {
+	over := failures - int64(c.FreeAttempts)
+	if over <= 0 || c.DelayBaseMillis <= 0 {
+		return 0
+	}
+	maxDelay := time.Duration(c.DelayMaxMillis) * time.Millisecond
+	delay := time.Duration(c.DelayBaseMillis) * time.Millisecond
+	for i := int64(1); i < over && delay < maxDelay; i++ {
+		delay *= 2
+	}
+	return min(delay, maxDelay)
+}
+
+// EmailConfig controls the mails sent for email verification and password
+// resets. From overrides the deployment's sender and LinkBaseURL the
+// https://<domain> the links point to. Templates are keyed by token purpose;
+// any part left empty falls back to the built-in template.
+type EmailConfig struct {
+	From                    string                   `json:"from"`
+	LinkBaseURL             string                   `json:"link_base_url"`
+	VerificationTTLSeconds  int                      `json:"verification_ttl_seconds"`
+	PasswordResetTTLSeconds int                      `json:"password_reset_ttl_seconds"`
+	MaxPerHour              int                      `json:"max_per_hour"`
+	Templates               map[string]EmailTemplate `json:"templates"`
+}
+
+// EmailTemplate holds text/template sources for the subject and plain text
+// body and an html/template source for the HTML body.
+type EmailTemplate struct {
+	Subject string `json:"subject"`
+	Text    string `json:"text"`
+	HTML    string `json:"html"`
+}
+
+func (c EmailConfig) TTL(purpose string) time.Duration {
+	if purpose == models.UserTokenPurposePasswordReset {
+		return time.Duration(c.PasswordResetTTLSeconds) * time.Second
+	}
+	return time.Duration(c.VerificationTTLSeconds) * time.Second
+}
+
+// OrbitConfig is the typed view of models.Orbit.Config. Sections that are
+// missing from the stored document keep their defaults.
+type OrbitConfig struct {
+	Registration   RegistrationConfig `json:"registration"`
+	ClientSecrets  ClientSecretConfig `json:"client_secrets"`
+	Passwords      hashing.Config     `json:"passwords"`
+	PasswordPolicy PasswordPolicy     `json:"password_policy"`
+	Tokens         TokenConfig        `json:"tokens"`
+	Sessions       SessionConfig      `json:"sessions"`
+	Consents       ConsentConfig      `json:"consents"`
+	TOTP           TOTPConfig         `json:"totp"`
+	RecoveryCodes  RecoveryCodeConfig `json:"recovery_codes"`
+	WebAuthn       WebAuthnConfig     `json:"webauthn"`
+	Lockout        LockoutConfig      `json:"lockout"`
+	Email          EmailConfig        `json:"email"`
+}
+
+func DefaultOrbitConfig() OrbitConfig {
+	return OrbitConfig{
+		Registration: RegistrationConfig{
+			Enabled:                   false,
+			RequireInitialAccessToken: true,
+		},
+		ClientSecrets: ClientSecretConfig{
+			RotationGraceSeconds: 24 * 60 * 60,
+		},
+		Passwords:      hashing.DefaultConfig(),
+		PasswordPolicy: DefaultPasswordPolicy(),
+		Tokens: TokenConfig{
+			AccessTokenTTLSeconds:  60 * 60,
+			IDTokenTTLSeconds:      60 * 60,
+			RefreshTokenTTLSeconds: 30 * 24 * 60 * 60,
+		},
+		Sessions: SessionConfig{
+			LifetimeSeconds:      12 * 60 * 60,
+			IdleTimeoutSeconds:   2 * 60 * 60,
+			TouchIntervalSeconds: 60,
+			MaxPerUser:           10,
+		},
+		TOTP: TOTPConfig{
+			Algorithm:         TOTPAlgorithmSHA1,
+			Digits:            6,
+			PeriodSeconds:     30,
+			Skew:              1,
+			MaxAuthenticators: 5,
+		},
+		RecoveryCodes: RecoveryCodeConfig{
+			Count:        10,
+			LowThreshold: 2,
+		},
+		WebAuthn: WebAuthnConfig{
+			UserVerification: "preferred",
+			ResidentKey:      "preferred",
+			Attestation:      "none",
+			TimeoutSeconds:   5 * 60,
+			MaxCredentials:   10,
+		},
+		Lockout: LockoutConfig{
+			Enabled:          true,
+			MaxFailures:      10,
+			MaxFailuresPerIP: 100,
+			WindowSeconds:    15 * 60,
+			FreeAttempts:     3,
+			DelayBaseMillis:  1000,
+			DelayMaxMillis:   30 * 1000,
+			LockoutSeconds:   15 * 60,
+			PermanentAfter:   5,
+			HistorySeconds:   24 * 60 * 60,
+		},
+		Email: EmailConfig{
+			VerificationTTLSeconds:  24 * 60 * 60,
+			PasswordResetTTLSeconds: 60 * 60,
+			MaxPerHour:              5,
+		},
+	}
+}
+
+func ParseOrbitConfig(orbit *models.Orbit) (OrbitConfig, error) {
+	cfg := DefaultOrbitConfig()
+	if orbit == nil || len(orbit.Config) == 0 {
+		return cfg, nil
+	}
+	if err := json.Unmarshal(orbit.Config, &cfg); err != nil {
+		return cfg, err
+	}
+	return cfg, nil
+}
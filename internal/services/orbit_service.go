@@ -17,7 +17,8 @@ import (
 
 type orbitRepoRead interface {
 	GetByID(ctx context.Context, id int64) (*models.Orbit, error)
-	List(ctx context.Context, limit, offset int) ([]*models.Orbit, error)
+	GetByDomain(ctx context.Context, domain string) (*models.Orbit, error)
+	List(ctx context.Context, page repositories.Page) ([]*models.Orbit, string, error)
 }
 
 type OrbitService struct {
@@ -46,6 +47,10 @@ func (s *OrbitService) key(id int64) string {
 	return fmt.Sprintf("id:%d", id)
 }
 
+func (s *OrbitService) domainKey(domain string) string {
+	return "domain:" + domain
+}
+
 func (s *OrbitService) Create(ctx context.Context, o *models.Orbit) (*models.Orbit, error) {
 	ctx, span := s.tracer.Start(ctx, "Create")
 	defer span.End()
@@ -58,7 +63,9 @@ func (s *OrbitService) Create(ctx context.Context, o *models.Orbit) (*models.Orb
 		return err
 	})
 	if err != nil {
-		s.logger.Error().Err(err).Str("name", o.Name).Msg("orbit create failed")
+		if err = conflictError(err); !isConflict(err) {
+			s.logger.Error().Err(err).Str("name", o.Name).Msg("orbit create failed")
+		}
 		return nil, err
 	}
 
@@ -90,24 +97,84 @@ func (s *OrbitService) GetByID(ctx context.Context, id int64) (*models.Orbit, er
 	return orbit, nil
 }
 
+func (s *OrbitService) GetByDomain(ctx context.Context, domain string) (*models.Orbit, error) {
+	ctx, span := s.tracer.Start(ctx, "GetByDomain")
+	defer span.End()
+
+	c := s.cacheMan.Cache(s.name)
+	var o models.Orbit
+	if err := c.Get(ctx, s.domainKey(domain), &o); err == nil {
+		return &o, nil
+	}
+
+	orbit, err := s.readRepo.GetByDomain(ctx, domain)
+	if err != nil {
+		s.logger.Error().Err(err).Str("domain", domain).Msg("orbit get by domain failed")
+		return nil, err
+	}
+	if orbit != nil {
+		_ = c.Set(ctx, s.domainKey(domain), orbit, s.ttl)
+		_ = c.Set(ctx, s.key(orbit.ID), orbit, s.ttl)
+	}
+	return orbit, nil
+}
+
 func (s *OrbitService) Update(ctx context.Context, o *models.Orbit) (*models.Orbit, error) {
 	ctx, span := s.tracer.Start(ctx, "Update")
 	defer span.End()
 
+	return s.update(ctx, o, false)
+}
+
+// UpdateIfUnmodified updates o only if the stored orbit was last updated at
+// o.UpdatedAt, so a read-modify-write cannot overwrite a concurrent change.
+// Otherwise it fails with ErrVersionMismatch.
+func (s *OrbitService) UpdateIfUnmodified(ctx context.Context, o *models.Orbit) (*models.Orbit, error) {
+	ctx, span := s.tracer.Start(ctx, "UpdateIfUnmodified")
+	defer span.End()
+
+	return s.update(ctx, o, true)
+}
+
+func (s *OrbitService) update(ctx context.Context, o *models.Orbit, ifUnmodified bool) (*models.Orbit, error) {
+	var previousDomain string
 	var updated *models.Orbit
 	err := s.db.WithTx(ctx, func(tx pgx.Tx) error {
 		txRepo := repositories.NewOrbitRepository(tx, s.logger)
-		var err error
+		if ifUnmodified {
+			version, err := txRepo.LockVersion(ctx, o.ID)
+			if err != nil {
+				return err
+			}
+			if err := checkVersion(version, o.UpdatedAt); err != nil {
+				return err
+			}
+		}
+		// The domain may be cached on its own after the id entry expired, so
+		// the stored one is read rather than taken from the cache.
+		previous, err := txRepo.GetByID(ctx, o.ID)
+		if err != nil {
+			return err
+		}
+		if previous != nil {
+			previousDomain = previous.Domain
+		}
 		updated, err = txRepo.Update(ctx, o)
 		return err
 	})
 	if err != nil {
-		s.logger.Error().Err(err).Int64("orbit_id", o.ID).Msg("orbit update failed")
+		if err = conflictError(err); !isConflict(err) {
+			s.logger.Error().Err(err).Int64("orbit_id", o.ID).Msg("orbit update failed")
+		}
 		return nil, err
 	}
 
 	c := s.cacheMan.Cache(s.name)
+	if previousDomain != "" {
+		_ = c.Delete(ctx, s.domainKey(previousDomain))
+	}
 	_ = c.Delete(ctx, s.key(o.ID))
+	_ = c.Delete(ctx, s.domainKey(o.Domain))
 	if updated != nil {
 		_ = c.Set(ctx, s.key(updated.ID), updated, s.ttl)
 	}
@@ -119,8 +186,16 @@ func (s *OrbitService) Delete(ctx context.Context, id int64) error {
 	ctx, span := s.tracer.Start(ctx, "Delete")
 	defer span.End()
 
+	var domain string
 	err := s.db.WithTx(ctx, func(tx pgx.Tx) error {
 		txRepo := repositories.NewOrbitRepository(tx, s.logger)
+		o, err := txRepo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if o != nil {
+			domain = o.Domain
+		}
 		return txRepo.Delete(ctx, id)
 	})
 	if err != nil {
@@ -128,14 +203,18 @@ func (s *OrbitService) Delete(ctx context.Context, id int64) error {
 		return err
 	}
 
-	_ = s.cacheMan.Cache(s.name).Delete(ctx, s.key(id))
+	c := s.cacheMan.Cache(s.name)
+	_ = c.Delete(ctx, s.key(id))
+	if domain != "" {
+		_ = c.Delete(ctx, s.domainKey(domain))
+	}
 	s.logger.Info().Int64("orbit_id", id).Msg("orbit soft-deleted and cache invalidated")
 	return nil
 }
 
-func (s *OrbitService) List(ctx context.Context, limit, offset int) ([]*models.Orbit, error) {
+func (s *OrbitService) List(ctx context.Context, page repositories.Page) ([]*models.Orbit, string, error) {
 	ctx, span := s.tracer.Start(ctx, "List")
 	defer span.End()
 
-	return s.readRepo.List(ctx, limit, offset)
+	return s.readRepo.List(ctx, page)
 }
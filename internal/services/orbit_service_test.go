@@ -0,0 +1,75 @@
+package services_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/testutil"
+)
+
+func TestOrbitServiceUpdateMovesDomain(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	cacheManager, srv := testutil.Redis(t)
+	svc := services.NewOrbitService(dbConn, repositories.NewOrbitRepository(dbConn.Exec(), nop), cacheManager, nop)
+	orbit := fx.Orbit()
+
+	if got, err := svc.GetByDomain(ctx, orbit.Domain); err != nil || got == nil {
+		t.Fatalf("GetByDomain = %+v, %v", got, err)
+	}
+	// Only the domain entry survives, as after the id entry was evicted.
+	srv.Del(fmt.Sprintf("orbits:id:%d", orbit.ID))
+
+	oldDomain := orbit.Domain
+	orbit.Domain = "moved-" + oldDomain
+	if _, err := svc.Update(ctx, orbit); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := svc.GetByDomain(ctx, oldDomain); err != nil || got != nil {
+		t.Fatalf("GetByDomain(old domain) = %+v, %v, want nil", got, err)
+	}
+	if got, err := svc.GetByDomain(ctx, orbit.Domain); err != nil || got == nil || got.ID != orbit.ID {
+		t.Fatalf("GetByDomain(new domain) = %+v, %v", got, err)
+	}
+
+	if err := svc.Delete(ctx, orbit.ID); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := svc.GetByID(ctx, orbit.ID); err != nil || got != nil {
+		t.Fatalf("GetByID after delete = %+v, %v, want nil", got, err)
+	}
+}
+
+func TestOrbitServiceUpdateIfUnmodified(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	cacheManager, _ := testutil.Redis(t)
+	svc := services.NewOrbitService(dbConn, repositories.NewOrbitRepository(dbConn.Exec(), nop), cacheManager, nop)
+	orbit := fx.Orbit()
+
+	first := *orbit
+	first.DisplayName = "first"
+	updated, err := svc.UpdateIfUnmodified(ctx, &first)
+	if err != nil || updated == nil {
+		t.Fatalf("UpdateIfUnmodified = %+v, %v", updated, err)
+	}
+
+	// A second writer that read the orbit before the first update.
+	stale := *orbit
+	stale.DisplayName = "stale"
+	if _, err := svc.UpdateIfUnmodified(ctx, &stale); !errors.Is(err, services.ErrVersionMismatch) {
+		t.Fatalf("UpdateIfUnmodified(stale) error = %v, want ErrVersionMismatch", err)
+	}
+	if got, err := svc.GetByID(ctx, orbit.ID); err != nil || got.DisplayName != "first" {
+		t.Fatalf("GetByID = %+v, %v, want the first update", got, err)
+	}
+
+	dup := fx.Orbit()
+	dup.Name = orbit.Name
+	if _, err := svc.Update(ctx, dup); !errors.Is(err, services.ErrAlreadyExists) {
+		t.Fatalf("Update(duplicate name) error = %v, want ErrAlreadyExists", err)
+	}
+}
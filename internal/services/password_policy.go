@@ -0,0 +1,172 @@
+package services
+
+import (
+	"bufio"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+)
+
+var ErrPasswordPolicy = errors.New("password does not satisfy the password policy")
+
+const (
+	PasswordViolationTooShort = "too_short"
+	PasswordViolationTooLong  = "too_long"
+	PasswordViolationLower    = "missing_lowercase"
+	PasswordViolationUpper    = "missing_uppercase"
+	PasswordViolationDigit    = "missing_digit"
+	PasswordViolationSymbol   = "missing_symbol"
+	PasswordViolationIdentity = "contains_identity"
+	PasswordViolationReused   = "reused"
+	PasswordViolationBreached = "breached"
+)
+
+// PasswordPolicyError lists every rule a rejected password broke so callers
+// can report all of them at once.
+type PasswordPolicyError struct {
+	Violations []string
+}
+
+func (e *PasswordPolicyError) Error() string {
+	return fmt.Sprintf("%s: %s", ErrPasswordPolicy, strings.Join(e.Violations, ", "))
+}
+
+func (e *PasswordPolicyError) Unwrap() error {
+	return ErrPasswordPolicy
+}
+
+type PasswordPolicy struct {
+	MinLength        int  `json:"min_length"`
+	MaxLength        int  `json:"max_length"`
+	RequireLowercase bool `json:"require_lowercase"`
+	RequireUppercase bool `json:"require_uppercase"`
+	RequireDigit     bool `json:"require_digit"`
+	RequireSymbol    bool `json:"require_symbol"`
+	DisallowIdentity bool `json:"disallow_identity"`
+	HistoryDepth     int  `json:"history_depth"`
+	MaxAgeDays       int  `json:"max_age_days"`
+	CheckBreached    bool `json:"check_breached"`
+}
+
+func DefaultPasswordPolicy() PasswordPolicy {
+	return PasswordPolicy{
+		MinLength:        12,
+		MaxLength:        128,
+		DisallowIdentity: true,
+		HistoryDepth:     5,
+		CheckBreached:    true,
+	}
+}
+
+// staticViolations evaluates the rules that need nothing but the password and
+// the user it is meant for.
+func (p PasswordPolicy) staticViolations(password string, user *models.User) []string {
+	var violations []string
+	length := utf8.RuneCountInString(password)
+	if p.MinLength > 0 && length < p.MinLength {
+		violations = append(violations, PasswordViolationTooShort)
+	}
+	if p.MaxLength > 0 && length > p.MaxLength {
+		violations = append(violations, PasswordViolationTooLong)
+	}
+
+	var lower, upper, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.IsSpace(r):
+			symbol = true
+		}
+	}
+	if p.RequireLowercase && !lower {
+		violations = append(violations, PasswordViolationLower)
+	}
+	if p.RequireUppercase && !upper {
+		violations = append(violations, PasswordViolationUpper)
+	}
+	if p.RequireDigit && !digit {
+		violations = append(violations, PasswordViolationDigit)
+	}
+	if p.RequireSymbol && !symbol {
+		violations = append(violations, PasswordViolationSymbol)
+	}
+
+	if p.DisallowIdentity && user != nil {
+		lowered := strings.ToLower(password)
+		local, _, _ := strings.Cut(user.Email, "@")
+		for _, ident := range []string{user.Username, local} {
+			if len(ident) >= 3 && strings.Contains(lowered, strings.ToLower(ident)) {
+				violations = append(violations, PasswordViolationIdentity)
+				break
+			}
+		}
+	}
+	return violations
+}
+
+// Expired reports whether the user has to change their password before any
+// further login succeeds.
+func (p PasswordPolicy) Expired(user *models.User, now time.Time) bool {
+	if p.MaxAgeDays <= 0 || user.LastPasswordChange == nil {
+		return false
+	}
+	return now.After(user.LastPasswordChange.Add(time.Duration(p.MaxAgeDays) * 24 * time.Hour))
+}
+
+type BreachedPasswordChecker interface {
+	IsBreached(ctx context.Context, password string) (bool, error)
+}
+
+// PwnedRangeCorpus checks passwords against a local copy of a k-anonymity
+// range corpus: one <PREFIX>.txt file per 5 hex digit SHA-1 prefix, each line
+// holding the remaining 35 digits and an occurrence count, "SUFFIX:COUNT".
+// Only the prefix file is read, so the corpus can be far larger than memory.
+type PwnedRangeCorpus struct {
+	dir string
+}
+
+func NewPwnedRangeCorpus(dir string) *PwnedRangeCorpus {
+	return &PwnedRangeCorpus{dir: dir}
+}
+
+func (c *PwnedRangeCorpus) IsBreached(_ context.Context, password string) (bool, error) {
+	sum := sha1.Sum([]byte(password))
+	digest := strings.ToUpper(hex.EncodeToString(sum[:]))
+	prefix, suffix := digest[:5], digest[5:]
+
+	f, err := os.Open(filepath.Join(c.dir, prefix+".txt"))
+	if errors.Is(err, os.ErrNotExist) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		hash, count, _ := strings.Cut(line, ":")
+		if !strings.EqualFold(hash, suffix) {
+			continue
+		}
+		// Padding entries carry a zero count and are not real breaches.
+		return count != "0", nil
+	}
+	return false, scanner.Err()
+}
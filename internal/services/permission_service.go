@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -15,6 +16,8 @@ import (
 	"go.opentelemetry.io/otel/trace"
 )
 
+var ErrPermissionNotFound = errors.New("permission not found")
+
 type PermissionService struct {
 	db     *db.DB
 	cache  cache.Manager
@@ -51,7 +54,9 @@ func (s *PermissionService) Create(ctx context.Context, p *models.Permission) (*
 		return err
 	})
 	if err != nil {
-		s.logger.Error().Err(err).Msg("permission create failed")
+		if err = conflictError(err); !isConflict(err) {
+			s.logger.Error().Err(err).Msg("permission create failed")
+		}
 		return nil, err
 	}
 
@@ -59,6 +64,8 @@ func (s *PermissionService) Create(ctx context.Context, p *models.Permission) (*
 	return created, nil
 }
 
+// GetByID returns nil when the permission does not exist or belongs to another
+// orbit.
 func (s *PermissionService) GetByID(ctx context.Context, orbitID, permissionID int64) (*models.Permission, error) {
 	ctx, span := s.tracer.Start(ctx, "GetByID")
 	defer span.End()
@@ -74,6 +81,9 @@ func (s *PermissionService) GetByID(ctx context.Context, orbitID, permissionID i
 		repo := repositories.NewPermissionRepository(tx, s.logger)
 		var err error
 		perm, err = repo.GetByID(ctx, permissionID)
+		if perm != nil && perm.OrbitID != orbitID {
+			perm = nil
+		}
 		return err
 	})
 	if err != nil {
@@ -92,6 +102,10 @@ func (s *PermissionService) Delete(ctx context.Context, orbitID, permissionID in
 
 	err := s.db.WithTx(ctx, func(tx pgx.Tx) error {
 		repo := repositories.NewPermissionRepository(tx, s.logger)
+		perm, err := repo.GetByID(ctx, permissionID)
+		if err != nil || perm == nil || perm.OrbitID != orbitID {
+			return err
+		}
 		return repo.Delete(ctx, permissionID)
 	})
 	if err != nil {
@@ -99,23 +113,20 @@ func (s *PermissionService) Delete(ctx context.Context, orbitID, permissionID in
 		return err
 	}
 	_ = s.cache.Cache(s.prefix).Delete(ctx, s.key(orbitID, permissionID))
+	retireEffectivePermissions(ctx, s.cache, orbitID)
 	return nil
 }
 
-func (s *PermissionService) ListByOrbit(ctx context.Context, orbitID int64, limit, offset int) ([]*models.Permission, error) {
+func (s *PermissionService) ListByOrbit(ctx context.Context, orbitID int64, page repositories.Page) ([]*models.Permission, string, error) {
 	ctx, span := s.tracer.Start(ctx, "ListByOrbit")
 	defer span.End()
 
-	var list []*models.Permission
-	err := s.db.WithTx(ctx, func(tx pgx.Tx) error {
-		repo := repositories.NewPermissionRepository(tx, s.logger)
-		var err error
-		list, err = repo.ListByOrbit(ctx, orbitID, limit, offset)
-		return err
-	})
+	list, next, err := repositories.NewPermissionRepository(s.db.Exec(), s.logger).ListByOrbit(ctx, orbitID, page)
 	if err != nil {
-		s.logger.Error().Err(err).Int64("orbit_id", orbitID).Msg("permission list failed")
-		return nil, err
+		if !errors.Is(err, repositories.ErrInvalidCursor) {
+			s.logger.Error().Err(err).Int64("orbit_id", orbitID).Msg("permission list failed")
+		}
+		return nil, "", err
 	}
-	return list, nil
+	return list, next, nil
 }
@@ -0,0 +1,52 @@
+package services_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/testutil"
+)
+
+func TestPermissionServiceRoundTrip(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	cacheManager, _ := testutil.Redis(t)
+	permissions := services.NewPermissionService(dbConn, cacheManager, nop)
+	orbit := fx.Orbit()
+	other := fx.Orbit()
+
+	created, err := permissions.Create(ctx, &models.Permission{OrbitID: orbit.ID, Name: "invoices:read"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := permissions.Create(ctx, &models.Permission{OrbitID: orbit.ID, Name: "invoices:read"}); !errors.Is(err, services.ErrAlreadyExists) {
+		t.Fatalf("Create of a duplicate name error = %v, want ErrAlreadyExists", err)
+	}
+
+	if got, err := permissions.GetByID(ctx, orbit.ID, created.ID); err != nil || got == nil || got.Name != "invoices:read" {
+		t.Fatalf("GetByID = %+v, %v", got, err)
+	}
+	if got, err := permissions.GetByID(ctx, other.ID, created.ID); err != nil || got != nil {
+		t.Fatalf("GetByID from another orbit = %+v, %v, want nil", got, err)
+	}
+	if list, _, err := permissions.ListByOrbit(ctx, orbit.ID, repositories.Page{}); err != nil || len(list) != 1 {
+		t.Fatalf("ListByOrbit = %d permissions, %v, want 1", len(list), err)
+	}
+
+	// Another orbit cannot delete it, and deleting drops the cached copy.
+	if err := permissions.Delete(ctx, other.ID, created.ID); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := permissions.GetByID(ctx, orbit.ID, created.ID); err != nil || got == nil {
+		t.Fatalf("GetByID after a foreign delete = %+v, %v", got, err)
+	}
+	if err := permissions.Delete(ctx, orbit.ID, created.ID); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := permissions.GetByID(ctx, orbit.ID, created.ID); err != nil || got != nil {
+		t.Fatalf("GetByID after delete = %+v, %v, want nil", got, err)
+	}
+}
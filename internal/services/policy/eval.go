@@ -0,0 +1,278 @@
+package policy
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"unicode/utf8"
+)
+
+type node interface {
+	eval(env map[string]any) (any, error)
+}
+
+type literalNode struct {
+	v any
+}
+
+func (n literalNode) eval(map[string]any) (any, error) {
+	return n.v, nil
+}
+
+type pathNode struct {
+	keys []string
+}
+
+func (n pathNode) eval(env map[string]any) (any, error) {
+	var v any = env
+	for _, key := range n.keys {
+		object, ok := v.(map[string]any)
+		if !ok {
+			return nil, nil
+		}
+		v = object[key]
+	}
+	return v, nil
+}
+
+type listNode struct {
+	items []node
+}
+
+func (n listNode) eval(env map[string]any) (any, error) {
+	out := make([]any, 0, len(n.items))
+	for _, item := range n.items {
+		v, err := item.eval(env)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, v)
+	}
+	return out, nil
+}
+
+type notNode struct {
+	x node
+}
+
+func (n notNode) eval(env map[string]any) (any, error) {
+	b, err := evalBool(n.x, env, "!")
+	if err != nil {
+		return nil, err
+	}
+	return !b, nil
+}
+
+// logicNode is && or ||, which evaluate their right operand only when the left
+// one does not decide the result.
+type logicNode struct {
+	or          bool
+	left, right node
+}
+
+func (n logicNode) eval(env map[string]any) (any, error) {
+	op := "&&"
+	if n.or {
+		op = "||"
+	}
+	left, err := evalBool(n.left, env, op)
+	if err != nil {
+		return nil, err
+	}
+	if left == n.or {
+		return left, nil
+	}
+	return evalBool(n.right, env, op)
+}
+
+func evalBool(n node, env map[string]any, op string) (bool, error) {
+	v, err := n.eval(env)
+	if err != nil {
+		return false, err
+	}
+	b, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("%s needs booleans, not %s", op, typeName(v))
+	}
+	return b, nil
+}
+
+type compareNode struct {
+	op          string
+	left, right node
+}
+
+func (n compareNode) eval(env map[string]any) (any, error) {
+	left, err := n.left.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	right, err := n.right.eval(env)
+	if err != nil {
+		return nil, err
+	}
+	switch n.op {
+	case "==":
+		return equal(left, right), nil
+	case "!=":
+		return !equal(left, right), nil
+	case "in":
+		return in(left, right)
+	}
+	c, err := order(left, right, n.op)
+	if err != nil {
+		return nil, err
+	}
+	switch n.op {
+	case "<":
+		return c < 0, nil
+	case "<=":
+		return c <= 0, nil
+	case ">":
+		return c > 0, nil
+	}
+	return c >= 0, nil
+}
+
+// equal compares JSON values. Values of different types are never equal.
+func equal(a, b any) bool {
+	return reflect.DeepEqual(a, b)
+}
+
+// order compares two numbers or two strings. Strings compare byte-wise, which
+// orders RFC 3339 timestamps of the same offset chronologically.
+func order(a, b any, op string) (int, error) {
+	switch a := a.(type) {
+	case float64:
+		if b, ok := b.(float64); ok {
+			switch {
+			case a < b:
+				return -1, nil
+			case a > b:
+				return 1, nil
+			}
+			return 0, nil
+		}
+	case string:
+		if b, ok := b.(string); ok {
+			return strings.Compare(a, b), nil
+		}
+	}
+	return 0, fmt.Errorf("cannot compare %s %s %s", typeName(a), op, typeName(b))
+}
+
+func in(x, collection any) (any, error) {
+	switch c := collection.(type) {
+	case []any:
+		for _, item := range c {
+			if equal(x, item) {
+				return true, nil
+			}
+		}
+		return false, nil
+	case string:
+		s, ok := x.(string)
+		if !ok {
+			return nil, fmt.Errorf("cannot look for %s in a string", typeName(x))
+		}
+		return strings.Contains(c, s), nil
+	case map[string]any:
+		key, ok := x.(string)
+		if !ok {
+			return nil, fmt.Errorf("cannot look for %s among the keys of an object", typeName(x))
+		}
+		_, found := c[key]
+		return found, nil
+	case nil:
+		// A missing list holds nothing.
+		return false, nil
+	}
+	return nil, fmt.Errorf("cannot look for a value in %s", typeName(collection))
+}
+
+type callNode struct {
+	name string
+	fn   func(args []any) (any, error)
+	args []node
+}
+
+func (n callNode) eval(env map[string]any) (any, error) {
+	args := make([]any, 0, len(n.args))
+	for _, arg := range n.args {
+		v, err := arg.eval(env)
+		if err != nil {
+			return nil, err
+		}
+		args = append(args, v)
+	}
+	v, err := n.fn(args)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", n.name, err)
+	}
+	return v, nil
+}
+
+type function struct {
+	arity int
+	call  func(args []any) (any, error)
+}
+
+// functions are the functions conditions can call.
+var functions = map[string]function{
+	"startsWith": {arity: 2, call: stringTest(strings.HasPrefix)},
+	"endsWith":   {arity: 2, call: stringTest(strings.HasSuffix)},
+	"lower": {arity: 1, call: func(args []any) (any, error) {
+		s, ok := args[0].(string)
+		if !ok {
+			return nil, fmt.Errorf("needs a string, not %s", typeName(args[0]))
+		}
+		return strings.ToLower(s), nil
+	}},
+	"size": {arity: 1, call: func(args []any) (any, error) {
+		switch v := args[0].(type) {
+		case string:
+			return float64(utf8.RuneCountInString(v)), nil
+		case []any:
+			return float64(len(v)), nil
+		case map[string]any:
+			return float64(len(v)), nil
+		case nil:
+			return float64(0), nil
+		}
+		return nil, fmt.Errorf("cannot measure %s", typeName(args[0]))
+	}},
+}
+
+// stringTest lifts a test of two strings into a function. A null first
+// argument, such as a missing attribute, fails the test.
+func stringTest(test func(s, arg string) bool) func(args []any) (any, error) {
+	return func(args []any) (any, error) {
+		if args[0] == nil {
+			return false, nil
+		}
+		s, ok1 := args[0].(string)
+		arg, ok2 := args[1].(string)
+		if !ok1 || !ok2 {
+			return nil, fmt.Errorf("needs strings, not %s and %s", typeName(args[0]), typeName(args[1]))
+		}
+		return test(s, arg), nil
+	}
+}
+
+func typeName(v any) string {
+	switch v.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "a boolean"
+	case float64:
+		return "a number"
+	case string:
+		return "a string"
+	case []any:
+		return "a list"
+	case map[string]any:
+		return "an object"
+	}
+	return fmt.Sprintf("a %T", v)
+}
@@ -0,0 +1,295 @@
+// Package policy implements the condition language of authorization policies:
+// boolean expressions over attributes of the user, the client, the resource
+// and the request.
+//
+//	user.profile.department == resource.department && "approver" in user.roles
+//	resource.amount <= 10000 || startsWith(action, "invoice:read")
+//
+// Operands are literals (strings in single or double quotes, numbers, true,
+// false, null and [lists]), attribute paths such as user.profile.cost_center
+// or resource["owner-id"], and calls of the functions listed in functions.
+// Comparisons are ==, !=, <, <=, >, >= and in, which tests membership of a
+// list, a substring of a string or a key of an object. Conditions combine
+// with &&, || and ! and group with parentheses.
+package policy
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Limits keep conditions cheap to evaluate and parsing off a deep stack.
+const (
+	MaxLength = 4096
+	maxDepth  = 64
+)
+
+// ErrEvaluation is wrapped by every error Eval returns.
+var ErrEvaluation = errors.New("policy condition cannot be evaluated")
+
+// Expr is a compiled condition. It is safe for concurrent use.
+type Expr struct {
+	src  string
+	root node
+}
+
+// Compile parses a condition. When roots is not empty, paths must start with
+// one of them, so a misspelt attribute is reported now rather than silently
+// read as null.
+func Compile(src string, roots ...string) (*Expr, error) {
+	if len(src) > MaxLength {
+		return nil, fmt.Errorf("condition is longer than %d bytes", MaxLength)
+	}
+	tokens, err := lex(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{tokens: tokens, roots: roots}
+	root, err := p.expr(0)
+	if err != nil {
+		return nil, err
+	}
+	if t := p.peek(); t.kind != tokEOF {
+		return nil, fmt.Errorf("unexpected %q at %d", t.text, t.pos)
+	}
+	return &Expr{src: src, root: root}, nil
+}
+
+func (e *Expr) String() string {
+	return e.src
+}
+
+// Eval evaluates the condition against env, whose values must be shaped as
+// encoding/json decodes into an any: nil, bool, float64, string, []any and
+// map[string]any. Paths that lead nowhere read as null.
+func (e *Expr) Eval(env map[string]any) (bool, error) {
+	v, err := e.root.eval(env)
+	if err != nil {
+		return false, fmt.Errorf("%w: %v", ErrEvaluation, err)
+	}
+	b, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("%w: condition is %s, not a boolean", ErrEvaluation, typeName(v))
+	}
+	return b, nil
+}
+
+type parser struct {
+	tokens []token
+	pos    int
+	roots  []string
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.pos]
+}
+
+func (p *parser) next() token {
+	t := p.tokens[p.pos]
+	if t.kind != tokEOF {
+		p.pos++
+	}
+	return t
+}
+
+func (p *parser) accept(op string) bool {
+	if t := p.peek(); t.kind == tokOp && t.text == op {
+		p.pos++
+		return true
+	}
+	return false
+}
+
+func (p *parser) expect(op string) error {
+	if !p.accept(op) {
+		t := p.peek()
+		return fmt.Errorf("expected %q at %d", op, t.pos)
+	}
+	return nil
+}
+
+// expr parses a disjunction, the loosest binding construct.
+func (p *parser) expr(depth int) (node, error) {
+	if depth > maxDepth {
+		return nil, fmt.Errorf("condition is nested deeper than %d", maxDepth)
+	}
+	left, err := p.and(depth)
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("||") {
+		right, err := p.and(depth)
+		if err != nil {
+			return nil, err
+		}
+		left = logicNode{or: true, left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) and(depth int) (node, error) {
+	left, err := p.unary(depth)
+	if err != nil {
+		return nil, err
+	}
+	for p.accept("&&") {
+		right, err := p.unary(depth)
+		if err != nil {
+			return nil, err
+		}
+		left = logicNode{left: left, right: right}
+	}
+	return left, nil
+}
+
+func (p *parser) unary(depth int) (node, error) {
+	if p.accept("!") {
+		if depth > maxDepth {
+			return nil, fmt.Errorf("condition is nested deeper than %d", maxDepth)
+		}
+		x, err := p.unary(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		return notNode{x: x}, nil
+	}
+	return p.comparison(depth)
+}
+
+func (p *parser) comparison(depth int) (node, error) {
+	left, err := p.operand(depth)
+	if err != nil {
+		return nil, err
+	}
+	t := p.peek()
+	switch {
+	case t.kind == tokOp && (t.text == "==" || t.text == "!=" || t.text == "<" || t.text == "<=" || t.text == ">" || t.text == ">="):
+	case t.kind == tokIdent && t.text == "in":
+	default:
+		return left, nil
+	}
+	p.next()
+	right, err := p.operand(depth)
+	if err != nil {
+		return nil, err
+	}
+	return compareNode{op: t.text, left: left, right: right}, nil
+}
+
+func (p *parser) operand(depth int) (node, error) {
+	t := p.next()
+	switch t.kind {
+	case tokString:
+		return literalNode{v: t.text}, nil
+	case tokNumber:
+		f, _ := strconv.ParseFloat(t.text, 64)
+		return literalNode{v: f}, nil
+	case tokIdent:
+		switch t.text {
+		case "true":
+			return literalNode{v: true}, nil
+		case "false":
+			return literalNode{v: false}, nil
+		case "null":
+			return literalNode{v: nil}, nil
+		case "in":
+			return nil, fmt.Errorf("unexpected \"in\" at %d", t.pos)
+		}
+		if p.accept("(") {
+			return p.call(t, depth)
+		}
+		return p.path(t)
+	case tokOp:
+		switch t.text {
+		case "(":
+			x, err := p.expr(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			return x, p.expect(")")
+		case "[":
+			return p.list(depth)
+		}
+	case tokEOF:
+		return nil, errors.New("unexpected end of condition")
+	}
+	return nil, fmt.Errorf("unexpected %q at %d", t.text, t.pos)
+}
+
+func (p *parser) path(root token) (node, error) {
+	if len(p.roots) > 0 && !slices.Contains(p.roots, root.text) {
+		return nil, fmt.Errorf("unknown attribute %q at %d, expected one of %s", root.text, root.pos, strings.Join(p.roots, ", "))
+	}
+	keys := []string{root.text}
+	for {
+		switch {
+		case p.accept("."):
+			t := p.next()
+			if t.kind != tokIdent {
+				return nil, fmt.Errorf("expected an attribute name at %d", t.pos)
+			}
+			keys = append(keys, t.text)
+		case p.accept("["):
+			t := p.next()
+			if t.kind != tokString {
+				return nil, fmt.Errorf("expected a quoted attribute name at %d", t.pos)
+			}
+			keys = append(keys, t.text)
+			if err := p.expect("]"); err != nil {
+				return nil, err
+			}
+		default:
+			return pathNode{keys: keys}, nil
+		}
+	}
+}
+
+func (p *parser) list(depth int) (node, error) {
+	var items []node
+	if p.accept("]") {
+		return listNode{}, nil
+	}
+	for {
+		item, err := p.expr(depth + 1)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+		if p.accept("]") {
+			return listNode{items: items}, nil
+		}
+		if err := p.expect(","); err != nil {
+			return nil, err
+		}
+	}
+}
+
+func (p *parser) call(name token, depth int) (node, error) {
+	fn, ok := functions[name.text]
+	if !ok {
+		return nil, fmt.Errorf("unknown function %q at %d", name.text, name.pos)
+	}
+	var args []node
+	if !p.accept(")") {
+		for {
+			arg, err := p.expr(depth + 1)
+			if err != nil {
+				return nil, err
+			}
+			args = append(args, arg)
+			if p.accept(")") {
+				break
+			}
+			if err := p.expect(","); err != nil {
+				return nil, err
+			}
+		}
+	}
+	if len(args) != fn.arity {
+		return nil, fmt.Errorf("%s at %d takes %d arguments, not %d", name.text, name.pos, fn.arity, len(args))
+	}
+	return callNode{name: name.text, fn: fn.call, args: args}, nil
+}
@@ -0,0 +1,102 @@
+package policy_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services/policy"
+)
+
+var env = map[string]any{
+	"action": "invoice:approve",
+	"user": map[string]any{
+		"id":    float64(7),
+		"roles": []any{"approver", "clerk"},
+		"profile": map[string]any{
+			"department": "finance",
+			"limit":      float64(5000),
+		},
+	},
+	"resource": map[string]any{
+		"amount":     float64(1200),
+		"department": "finance",
+		"owner-id":   float64(9),
+		"created_at": "2026-10-01T09:00:00Z",
+	},
+}
+
+func TestEval(t *testing.T) {
+	for _, tc := range []struct {
+		src  string
+		want bool
+	}{
+		{`true`, true},
+		{`"approver" in user.roles`, true},
+		{`"admin" in user.roles`, false},
+		{`user.profile.department == resource.department && resource.amount <= user.profile.limit`, true},
+		{`resource.amount > user.profile.limit || startsWith(action, "invoice:")`, true},
+		{`!(resource["owner-id"] == user.id)`, true},
+		{`resource.created_at < '2026-11-01T00:00:00Z'`, true},
+		{`user.profile.missing == null && user.nothing.deeper == null`, true},
+		{`"department" in resource && "fin" in resource.department`, true},
+		{`resource.department in ["finance", "legal"]`, true},
+		{`size(user.roles) == 2 && lower("AbC") == "abc"`, true},
+		{`endsWith(user.profile.missing, "x")`, false},
+		{`"x" in user.groups`, false},
+		{`user.id == 7 && user.id != 7.5 && -1 < 0`, true},
+		// && stops at the first false operand, so its type error never happens.
+		{`false && user.id`, false},
+	} {
+		expr, err := policy.Compile(tc.src, "action", "user", "resource")
+		if err != nil {
+			t.Errorf("Compile(%s) error = %v", tc.src, err)
+			continue
+		}
+		if got, err := expr.Eval(env); err != nil || got != tc.want {
+			t.Errorf("Eval(%s) = %v, %v, want %v", tc.src, got, err, tc.want)
+		}
+	}
+}
+
+func TestEvalErrors(t *testing.T) {
+	for _, src := range []string{
+		`user.id`,
+		`user.id && true`,
+		`user.id < "8"`,
+		`1 in user.profile`,
+		`startsWith(user.id, "7")`,
+	} {
+		expr, err := policy.Compile(src)
+		if err != nil {
+			t.Errorf("Compile(%s) error = %v", src, err)
+			continue
+		}
+		if _, err := expr.Eval(env); !errors.Is(err, policy.ErrEvaluation) {
+			t.Errorf("Eval(%s) error = %v, want ErrEvaluation", src, err)
+		}
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	for _, src := range []string{
+		``,
+		`user.id ==`,
+		`(true`,
+		`"unterminated`,
+		`user.id = 7`,
+		`usr.id == 7`,
+		`nope(1)`,
+		`startsWith("a")`,
+		`true true`,
+		`[1, 2`,
+		`resource[owner]`,
+		strings.Repeat("(", 100) + "true" + strings.Repeat(")", 100),
+		strings.Repeat("!", 100) + "true",
+		strings.Repeat("x", policy.MaxLength+1),
+	} {
+		if _, err := policy.Compile(src, "action", "user", "resource"); err == nil {
+			t.Errorf("Compile(%.40s) succeeded, want an error", src)
+		}
+	}
+}
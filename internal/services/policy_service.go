@@ -0,0 +1,425 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories/db"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services/cache"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services/policy"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var ErrInvalidPolicy = errors.New("invalid policy")
+
+// policyAttributes are the attributes conditions can read. See
+// AuthzRequest for what each holds.
+var policyAttributes = []string{"user", "client", "action", "resource", "context", "now"}
+
+const (
+	maxPolicyActions     = 50
+	maxPolicyObligations = 20
+)
+
+// AuthzRequest asks whether a user may perform an action on a resource. The
+// client is the caller, usually a resource server; UserID is nil for
+// decisions that concern no user. Resource and Context carry whatever
+// attributes the caller has about the resource and the request, such as an
+// invoice amount or the network a request came from.
+type AuthzRequest struct {
+	Client   *models.Client
+	UserID   *int64
+	Action   string
+	Resource map[string]any
+	Context  map[string]any
+}
+
+// AuthzDecision answers an AuthzRequest. Policies names the policies that
+// decided, and Obligations are theirs, which the caller must fulfil when it
+// acts on the decision, such as logging an approval or requiring a second
+// approver.
+type AuthzDecision struct {
+	ID          int64
+	Decision    models.PolicyEffect
+	Policies    []string
+	Obligations []map[string]any
+}
+
+// PolicyService stores the authorization policies of orbits and decides
+// requests against them. A policy applies to the actions it lists and, when
+// its condition holds, allows or denies them. Deny wins over allow, and
+// actions no policy allows are denied.
+type PolicyService struct {
+	db     *db.DB
+	cache  cache.Manager
+	users  *UserService
+	roles  *RoleService
+	logger zerolog.Logger
+	tracer trace.Tracer
+	ttl    time.Duration
+	prefix string
+}
+
+func NewPolicyService(dbConn *db.DB, cacheManager cache.Manager, users *UserService, roles *RoleService, logger zerolog.Logger) *PolicyService {
+	return &PolicyService{
+		db:     dbConn,
+		cache:  cacheManager,
+		users:  users,
+		roles:  roles,
+		logger: logger,
+		tracer: otel.Tracer("service.policy"),
+		ttl:    10 * time.Minute,
+		prefix: "policies",
+	}
+}
+
+func (s *PolicyService) activeKey(orbitID int64) string {
+	return fmt.Sprintf("orbit:%d:active", orbitID)
+}
+
+// Create fails with ErrInvalidPolicy when the policy does not compile and
+// with ErrAlreadyExists when the orbit has a policy of that name.
+func (s *PolicyService) Create(ctx context.Context, p *models.Policy) (*models.Policy, error) {
+	ctx, span := s.tracer.Start(ctx, "Create")
+	defer span.End()
+
+	if err := normalizePolicy(p); err != nil {
+		return nil, err
+	}
+	var created *models.Policy
+	err := s.db.WithTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		created, err = repositories.NewPolicyRepository(tx, s.logger).Create(ctx, p)
+		return err
+	})
+	if err != nil {
+		if err = conflictError(err); !isConflict(err) {
+			s.logger.Error().Err(err).Int64("orbit_id", p.OrbitID).Str("name", p.Name).Msg("policy create failed")
+		}
+		return nil, err
+	}
+	_ = s.cache.Cache(s.prefix).Delete(ctx, s.activeKey(created.OrbitID))
+	return created, nil
+}
+
+// GetByID returns nil when the policy does not exist or belongs to another
+// orbit.
+func (s *PolicyService) GetByID(ctx context.Context, orbitID, id int64) (*models.Policy, error) {
+	ctx, span := s.tracer.Start(ctx, "GetByID")
+	defer span.End()
+
+	p, err := repositories.NewPolicyRepository(s.db.Exec(), s.logger).GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("policy_id", id).Msg("policy get failed")
+		return nil, err
+	}
+	if p == nil || p.OrbitID != orbitID {
+		return nil, nil
+	}
+	return p, nil
+}
+
+// UpdateIfUnmodified updates p only if the stored policy was last updated at
+// p.UpdatedAt. Otherwise it fails with ErrVersionMismatch.
+func (s *PolicyService) UpdateIfUnmodified(ctx context.Context, p *models.Policy) (*models.Policy, error) {
+	ctx, span := s.tracer.Start(ctx, "UpdateIfUnmodified")
+	defer span.End()
+
+	if err := normalizePolicy(p); err != nil {
+		return nil, err
+	}
+	var updated *models.Policy
+	err := s.db.WithTx(ctx, func(tx pgx.Tx) error {
+		repo := repositories.NewPolicyRepository(tx, s.logger)
+		version, err := repo.LockVersion(ctx, p.ID)
+		if err != nil {
+			return err
+		}
+		if err := checkVersion(version, p.UpdatedAt); err != nil {
+			return err
+		}
+		updated, err = repo.Update(ctx, p)
+		return err
+	})
+	if err != nil {
+		if err = conflictError(err); !isConflict(err) {
+			s.logger.Error().Err(err).Int64("policy_id", p.ID).Msg("policy update failed")
+		}
+		return nil, err
+	}
+	_ = s.cache.Cache(s.prefix).Delete(ctx, s.activeKey(p.OrbitID))
+	return updated, nil
+}
+
+// Delete removes a policy of the orbit. Unknown policies are ignored.
+func (s *PolicyService) Delete(ctx context.Context, orbitID, id int64) error {
+	ctx, span := s.tracer.Start(ctx, "Delete")
+	defer span.End()
+
+	err := s.db.WithTx(ctx, func(tx pgx.Tx) error {
+		repo := repositories.NewPolicyRepository(tx, s.logger)
+		p, err := repo.GetByID(ctx, id)
+		if err != nil || p == nil || p.OrbitID != orbitID {
+			return err
+		}
+		return repo.Delete(ctx, id)
+	})
+	if err != nil {
+		s.logger.Error().Err(err).Int64("policy_id", id).Msg("policy delete failed")
+		return err
+	}
+	_ = s.cache.Cache(s.prefix).Delete(ctx, s.activeKey(orbitID))
+	return nil
+}
+
+func (s *PolicyService) ListByOrbit(ctx context.Context, orbitID int64, page repositories.Page) ([]*models.Policy, string, error) {
+	ctx, span := s.tracer.Start(ctx, "ListByOrbit")
+	defer span.End()
+
+	list, next, err := repositories.NewPolicyRepository(s.db.Exec(), s.logger).ListByOrbit(ctx, orbitID, page)
+	if err != nil {
+		if !errors.Is(err, repositories.ErrInvalidCursor) {
+			s.logger.Error().Err(err).Int64("orbit_id", orbitID).Msg("policy list failed")
+		}
+		return nil, "", err
+	}
+	return list, next, nil
+}
+
+// ListDecisions pages through the decision log of an orbit, newest first.
+func (s *PolicyService) ListDecisions(ctx context.Context, orbitID int64, filter repositories.PolicyDecisionFilter, page repositories.Page) ([]*models.PolicyDecision, string, error) {
+	ctx, span := s.tracer.Start(ctx, "ListDecisions")
+	defer span.End()
+
+	return repositories.NewPolicyDecisionRepository(s.db.Exec(), s.logger).ListByOrbit(ctx, orbitID, filter, page)
+}
+
+// Evaluate decides req against the active policies of the client's orbit and
+// records the decision. It fails with ErrUserNotFound when the user is not
+// one of the orbit. Users who cannot sign in are denied everything.
+//
+// A condition that cannot be evaluated, say because it compares a missing
+// attribute with a number, fails closed: an allow policy does not apply and
+// a deny policy does. Such errors are kept in the decision log.
+func (s *PolicyService) Evaluate(ctx context.Context, req AuthzRequest) (*AuthzDecision, error) {
+	ctx, span := s.tracer.Start(ctx, "Evaluate")
+	defer span.End()
+
+	orbitID := req.Client.OrbitID
+	var user *models.User
+	if req.UserID != nil {
+		var err error
+		if user, err = s.users.GetByID(ctx, *req.UserID); err != nil {
+			return nil, err
+		}
+		if user == nil || user.OrbitID != orbitID || user.DeletedAt != nil {
+			return nil, ErrUserNotFound
+		}
+	}
+
+	decision := &AuthzDecision{Decision: models.PolicyEffectDeny, Obligations: []map[string]any{}}
+	var evalErrors []string
+	if user == nil || user.IsActive && !user.IsLocked {
+		env, err := s.environment(ctx, req, user)
+		if err != nil {
+			return nil, err
+		}
+		policies, err := s.activePolicies(ctx, orbitID)
+		if err != nil {
+			return nil, err
+		}
+		evalErrors = decide(decision, policies, req.Action, env)
+	}
+
+	entry := &models.PolicyDecision{
+		OrbitID:  orbitID,
+		ClientID: &req.Client.ID,
+		UserID:   req.UserID,
+		Action:   req.Action,
+		Decision: decision.Decision,
+		Policies: decision.Policies,
+		Request:  map[string]any{"resource": req.Resource, "context": req.Context},
+		Errors:   evalErrors,
+	}
+	// A decision the log missed is still the right answer, so failing to
+	// record it is only logged.
+	if _, err := repositories.NewPolicyDecisionRepository(s.db.Exec(), s.logger).Create(ctx, entry); err != nil {
+		s.logger.Error().Err(err).Int64("orbit_id", orbitID).Str("action", req.Action).Msg("policy decision record failed")
+	} else {
+		decision.ID = entry.ID
+	}
+	return decision, nil
+}
+
+// decide fills in decision from the policies that apply to action and
+// returns the errors met evaluating their conditions.
+func decide(decision *AuthzDecision, policies []*models.Policy, action string, env map[string]any) []string {
+	var allows, denies []*models.Policy
+	var evalErrors []string
+	for _, p := range policies {
+		if !policyCovers(p, action) {
+			continue
+		}
+		holds := true
+		if p.Condition != "" {
+			expr, err := policy.Compile(p.Condition, policyAttributes...)
+			if err == nil {
+				holds, err = expr.Eval(env)
+			}
+			if err != nil {
+				evalErrors = append(evalErrors, p.Name+": "+err.Error())
+				holds = p.Effect == models.PolicyEffectDeny
+			}
+		}
+		if !holds {
+			continue
+		}
+		if p.Effect == models.PolicyEffectDeny {
+			denies = append(denies, p)
+		} else {
+			allows = append(allows, p)
+		}
+	}
+
+	deciding := denies
+	if len(denies) == 0 && len(allows) > 0 {
+		decision.Decision = models.PolicyEffectAllow
+		deciding = allows
+	}
+	for _, p := range deciding {
+		decision.Policies = append(decision.Policies, p.Name)
+		decision.Obligations = append(decision.Obligations, p.Obligations...)
+	}
+	return evalErrors
+}
+
+// policyCovers reports whether one of the action patterns of p matches
+// action. A pattern ending in * matches every action it prefixes.
+func policyCovers(p *models.Policy, action string) bool {
+	for _, pattern := range p.Actions {
+		if prefix, ok := strings.CutSuffix(pattern, "*"); ok && strings.HasPrefix(action, prefix) || pattern == action {
+			return true
+		}
+	}
+	return false
+}
+
+// environment gathers the attributes conditions read, shaped as JSON decodes
+// them so that numbers compare alike wherever they come from.
+func (s *PolicyService) environment(ctx context.Context, req AuthzRequest, user *models.User) (map[string]any, error) {
+	env := map[string]any{
+		"action":   req.Action,
+		"resource": req.Resource,
+		"context":  req.Context,
+		"now":      time.Now().UTC().Format(time.RFC3339),
+		"client": map[string]any{
+			"client_id": req.Client.ClientID,
+			"name":      req.Client.Name,
+		},
+	}
+	if user != nil {
+		roles, err := s.roles.RoleNames(ctx, user.OrbitID, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		perms, err := s.roles.EffectivePermissions(ctx, user.OrbitID, user.ID)
+		if err != nil {
+			return nil, err
+		}
+		profile := map[string]any{}
+		if len(user.Profile) > 0 {
+			_ = json.Unmarshal(user.Profile, &profile)
+		}
+		env["user"] = map[string]any{
+			"id":             user.ID,
+			"username":       user.Username,
+			"email":          user.Email,
+			"email_verified": user.EmailVerified,
+			"display_name":   user.DisplayName,
+			"mfa_enabled":    user.MFAEnabled,
+			"profile":        profile,
+			"roles":          roles,
+			"permissions":    perms,
+		}
+	}
+
+	data, err := json.Marshal(env)
+	if err != nil {
+		return nil, err
+	}
+	var out map[string]any
+	if err := json.Unmarshal(data, &out); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// activePolicies returns the active policies of an orbit, cached until one of
+// them changes.
+func (s *PolicyService) activePolicies(ctx context.Context, orbitID int64) ([]*models.Policy, error) {
+	key := s.activeKey(orbitID)
+	var cached []*models.Policy
+	if err := s.cache.Cache(s.prefix).Get(ctx, key, &cached); err == nil {
+		return cached, nil
+	}
+
+	policies, err := repositories.NewPolicyRepository(s.db.Exec(), s.logger).ListActiveByOrbit(ctx, orbitID)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("orbit_id", orbitID).Msg("active policies lookup failed")
+		return nil, err
+	}
+	_ = s.cache.Cache(s.prefix).Set(ctx, key, policies, s.ttl)
+	return policies, nil
+}
+
+func normalizePolicy(p *models.Policy) error {
+	p.Name = strings.TrimSpace(p.Name)
+	p.Condition = strings.TrimSpace(p.Condition)
+	if p.Name == "" || len(p.Name) > 200 {
+		return fmt.Errorf("%w: name must have 1 to 200 characters", ErrInvalidPolicy)
+	}
+	if p.Effect != models.PolicyEffectAllow && p.Effect != models.PolicyEffectDeny {
+		return fmt.Errorf("%w: effect must be allow or deny", ErrInvalidPolicy)
+	}
+
+	var actions []string
+	for _, a := range p.Actions {
+		a = strings.TrimSpace(a)
+		if a == "" || strings.ContainsAny(a, " \t\r\n") || strings.Contains(strings.TrimSuffix(a, "*"), "*") {
+			return fmt.Errorf("%w: action %q must be a name, optionally ending in *", ErrInvalidPolicy, a)
+		}
+		if !slices.Contains(actions, a) {
+			actions = append(actions, a)
+		}
+	}
+	if len(actions) == 0 || len(actions) > maxPolicyActions {
+		return fmt.Errorf("%w: a policy covers 1 to %d actions", ErrInvalidPolicy, maxPolicyActions)
+	}
+	p.Actions = actions
+
+	if p.Condition != "" {
+		if _, err := policy.Compile(p.Condition, policyAttributes...); err != nil {
+			return fmt.Errorf("%w: condition: %v", ErrInvalidPolicy, err)
+		}
+	}
+
+	if len(p.Obligations) > maxPolicyObligations {
+		return fmt.Errorf("%w: at most %d obligations", ErrInvalidPolicy, maxPolicyObligations)
+	}
+	for _, o := range p.Obligations {
+		if kind, _ := o["type"].(string); kind == "" {
+			return fmt.Errorf("%w: every obligation needs a type", ErrInvalidPolicy)
+		}
+	}
+	return nil
+}
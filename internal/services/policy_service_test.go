@@ -0,0 +1,101 @@
+package services_test
+
+import (
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/testutil"
+)
+
+func TestPolicyServiceEvaluate(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	cacheManager, _ := testutil.Redis(t)
+	roles := services.NewRoleService(dbConn, cacheManager, nop)
+	policies := services.NewPolicyService(dbConn, cacheManager, newUserService(dbConn, cacheManager), roles, nop)
+	orbit := fx.Orbit()
+	client := fx.Client(orbit)
+	finance := func(u *models.User) { u.Profile = []byte(`{"department": "finance", "limit": 5000}`) }
+	user := fx.User(orbit, finance)
+	locked := fx.User(orbit, finance, func(u *models.User) { u.IsLocked = true })
+
+	role, err := roles.Create(ctx, &models.Role{OrbitID: orbit.ID, Name: "approver"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, u := range []*models.User{user, locked} {
+		if err := roles.AssignToUser(ctx, orbit.ID, role.ID, u.ID); err != nil {
+			t.Fatal(err)
+		}
+	}
+	for _, p := range []*models.Policy{
+		{
+			Name:        "approvers",
+			Effect:      models.PolicyEffectAllow,
+			Actions:     []string{"invoice:*"},
+			Condition:   `"approver" in user.roles && user.profile.department == resource.department`,
+			Obligations: []map[string]any{{"type": "notify", "to": "finance"}},
+			IsActive:    true,
+		},
+		{
+			Name:      "over-limit",
+			Effect:    models.PolicyEffectDeny,
+			Actions:   []string{"invoice:approve"},
+			Condition: `resource.amount > user.profile.limit`,
+			IsActive:  true,
+		},
+	} {
+		p.OrbitID = orbit.ID
+		if _, err := policies.Create(ctx, p); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	evaluate := func(u *models.User, action string, resource map[string]any) *services.AuthzDecision {
+		t.Helper()
+		decision, err := policies.Evaluate(ctx, services.AuthzRequest{Client: client, UserID: &u.ID, Action: action, Resource: resource})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return decision
+	}
+	d := evaluate(user, "invoice:approve", map[string]any{"department": "finance", "amount": 1200})
+	if d.Decision != models.PolicyEffectAllow || !slices.Equal(d.Policies, []string{"approvers"}) || len(d.Obligations) != 1 || d.ID == 0 {
+		t.Fatalf("within limit = %+v, want allowed by approvers with its obligation", d)
+	}
+	d = evaluate(user, "invoice:approve", map[string]any{"department": "finance", "amount": 9000})
+	if d.Decision != models.PolicyEffectDeny || !slices.Equal(d.Policies, []string{"over-limit"}) || len(d.Obligations) != 0 {
+		t.Fatalf("over limit = %+v, want denied by over-limit", d)
+	}
+	// The amount is missing, so over-limit cannot be evaluated and fails closed.
+	if d = evaluate(user, "invoice:approve", map[string]any{"department": "finance"}); d.Decision != models.PolicyEffectDeny {
+		t.Fatalf("without an amount = %+v, want denied", d)
+	}
+	if d = evaluate(user, "invoice:read", map[string]any{"department": "legal"}); d.Decision != models.PolicyEffectDeny || len(d.Policies) != 0 {
+		t.Fatalf("other department = %+v, want denied by default", d)
+	}
+	if d = evaluate(locked, "invoice:read", map[string]any{"department": "finance"}); d.Decision != models.PolicyEffectDeny {
+		t.Fatalf("locked user = %+v, want denied", d)
+	}
+
+	logged, _, err := policies.ListDecisions(ctx, orbit.ID, repositories.PolicyDecisionFilter{Action: "invoice:approve"}, repositories.Page{})
+	if err != nil || len(logged) != 3 {
+		t.Fatalf("ListDecisions = %d entries, %v, want 3", len(logged), err)
+	}
+	if len(logged[0].Errors) != 1 || logged[0].Decision != models.PolicyEffectDeny {
+		t.Errorf("newest entry = %+v, want the evaluation error of over-limit", logged[0])
+	}
+
+	stranger := fx.User(fx.Orbit())
+	if _, err := policies.Evaluate(ctx, services.AuthzRequest{Client: client, UserID: &stranger.ID, Action: "invoice:read"}); !errors.Is(err, services.ErrUserNotFound) {
+		t.Fatalf("Evaluate(user of another orbit) error = %v, want ErrUserNotFound", err)
+	}
+	bad := &models.Policy{OrbitID: orbit.ID, Name: "typo", Effect: models.PolicyEffectAllow, Actions: []string{"x"}, Condition: `usr.id == 1`}
+	if _, err := policies.Create(ctx, bad); !errors.Is(err, services.ErrInvalidPolicy) {
+		t.Fatalf("Create(unknown attribute) error = %v, want ErrInvalidPolicy", err)
+	}
+}
@@ -0,0 +1,189 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"strings"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var ErrInvalidRecoveryCode = errors.New("invalid recovery code")
+
+const (
+	// Crockford's base32 alphabet leaves out letters that are easily confused
+	// with digits when a code is copied from paper.
+	recoveryCodeAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+	// 16 symbols of 5 bits each give 80 bits of entropy per code.
+	recoveryCodeLength = 16
+	recoveryCodeGroup  = 4
+	// Upper bound on stored codes per user; batches are far smaller.
+	maxRecoveryCodesScan = 100
+)
+
+type RecoveryCodeService struct {
+	db     *db.DB
+	events *SecurityEventService
+	logger zerolog.Logger
+	tracer trace.Tracer
+}
+
+func NewRecoveryCodeService(dbConn *db.DB, events *SecurityEventService, logger zerolog.Logger) *RecoveryCodeService {
+	return &RecoveryCodeService{
+		db:     dbConn,
+		events: events,
+		logger: logger,
+		tracer: otel.Tracer("service.recovery_code"),
+	}
+}
+
+// Generate replaces all recovery codes of the user with a fresh batch. The
+// plaintext codes are returned once and only their hashes are stored.
+func (s *RecoveryCodeService) Generate(ctx context.Context, orbit *models.Orbit, userID int64) ([]string, error) {
+	ctx, span := s.tracer.Start(ctx, "Generate")
+	defer span.End()
+
+	cfg, err := ParseOrbitConfig(orbit)
+	if err != nil {
+		return nil, err
+	}
+	codes := make([]string, cfg.RecoveryCodes.Count)
+	for i := range codes {
+		if codes[i], err = newRecoveryCode(); err != nil {
+			return nil, err
+		}
+	}
+
+	err = s.db.WithTx(ctx, func(tx pgx.Tx) error {
+		repo := repositories.NewRecoveryCodeRepository(tx, s.logger)
+		if err := repo.DeleteByUser(ctx, userID); err != nil {
+			return err
+		}
+		for _, code := range codes {
+			rc := &models.RecoveryCode{UserID: userID, CodeHash: hashToken(normalizeRecoveryCode(code))}
+			if _, err := repo.Create(ctx, rc); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		s.logger.Error().Err(err).Int64("user_id", userID).Msg("recovery code generation failed")
+		return nil, err
+	}
+
+	s.events.Record(ctx, orbit.ID, &userID, models.SecurityEventRecoveryCodesGenerated, models.SecuritySeverityInfo, map[string]any{
+		"count": len(codes),
+	})
+	return codes, nil
+}
+
+// Redeem consumes one unused code of the user. Every stored hash is compared
+// so the time taken does not depend on which code matched.
+func (s *RecoveryCodeService) Redeem(ctx context.Context, orbit *models.Orbit, userID int64, code string) error {
+	ctx, span := s.tracer.Start(ctx, "Redeem")
+	defer span.End()
+
+	cfg, err := ParseOrbitConfig(orbit)
+	if err != nil {
+		return err
+	}
+	repo := repositories.NewRecoveryCodeRepository(s.db.Exec(), s.logger)
+	list, _, err := repo.ListByUser(ctx, userID, repositories.Page{Limit: maxRecoveryCodesScan})
+	if err != nil {
+		return err
+	}
+
+	normalized := normalizeRecoveryCode(code)
+	var matched *models.RecoveryCode
+	unused := 0
+	for _, rc := range list {
+		if rc.UsedAt != nil {
+			continue
+		}
+		unused++
+		if tokenHashEqual(normalized, rc.CodeHash) && matched == nil {
+			matched = rc
+		}
+	}
+	if matched == nil {
+		return ErrInvalidRecoveryCode
+	}
+	used, err := repo.Use(ctx, matched.ID)
+	if err != nil {
+		return err
+	}
+	if !used {
+		return ErrInvalidRecoveryCode
+	}
+
+	remaining := unused - 1
+	s.events.Record(ctx, orbit.ID, &userID, models.SecurityEventRecoveryCodeUsed, models.SecuritySeverityInfo, map[string]any{
+		"remaining": remaining,
+	})
+	if remaining <= cfg.RecoveryCodes.LowThreshold {
+		s.events.Record(ctx, orbit.ID, &userID, models.SecurityEventRecoveryCodesLow, models.SecuritySeverityWarning, map[string]any{
+			"remaining": remaining,
+		})
+	}
+	return nil
+}
+
+// Remaining counts the unused codes of the user.
+func (s *RecoveryCodeService) Remaining(ctx context.Context, userID int64) (int, error) {
+	ctx, span := s.tracer.Start(ctx, "Remaining")
+	defer span.End()
+
+	list, _, err := repositories.NewRecoveryCodeRepository(s.db.Exec(), s.logger).ListByUser(ctx, userID, repositories.Page{Limit: maxRecoveryCodesScan})
+	if err != nil {
+		return 0, err
+	}
+	remaining := 0
+	for _, rc := range list {
+		if rc.UsedAt == nil {
+			remaining++
+		}
+	}
+	return remaining, nil
+}
+
+func newRecoveryCode() (string, error) {
+	buf := make([]byte, recoveryCodeLength)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+	var b strings.Builder
+	for i, v := range buf {
+		if i > 0 && i%recoveryCodeGroup == 0 {
+			b.WriteByte('-')
+		}
+		// 256 is a multiple of 32, so masking keeps the distribution uniform.
+		b.WriteByte(recoveryCodeAlphabet[v&31])
+	}
+	return b.String(), nil
+}
+
+// normalizeRecoveryCode drops separators and applies Crockford's decoding
+// rules so codes typed with lower case or look-alike letters still match.
+func normalizeRecoveryCode(code string) string {
+	var b strings.Builder
+	for _, r := range strings.ToUpper(code) {
+		switch r {
+		case '-', ' ':
+			continue
+		case 'O':
+			r = '0'
+		case 'I', 'L':
+			r = '1'
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -0,0 +1,141 @@
+package services_test
+
+import (
+	"encoding/json"
+	"errors"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/testutil"
+)
+
+func TestNormalizeRecoveryCode(t *testing.T) {
+	for _, c := range []struct{ in, want string }{
+		{"ABCD-EFGH-JKMN-PQRS", "ABCDEFGHJKMNPQRS"},
+		{"abcd efgh jkmn pqrs", "ABCDEFGHJKMNPQRS"},
+		{"O0o0-1IiL-l111-2345", "0000111111112345"},
+		{" 7XYZ--TVWX ", "7XYZTVWX"},
+	} {
+		if got := services.NormalizeRecoveryCode(c.in); got != c.want {
+			t.Errorf("NormalizeRecoveryCode(%q) = %q, want %q", c.in, got, c.want)
+		}
+	}
+}
+
+// recoveryOrbit hands out batches of four codes and warns at two left.
+func recoveryOrbit(t *testing.T, fx *testutil.Fixtures) *models.Orbit {
+	t.Helper()
+	cfg, err := json.Marshal(map[string]any{"recovery_codes": map[string]any{"count": 4, "low_threshold": 2}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return fx.Orbit(func(o *models.Orbit) { o.Config = cfg })
+}
+
+func TestRecoveryCodeServiceRedeem(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	events := services.NewSecurityEventService(dbConn, nop)
+	codes := services.NewRecoveryCodeService(dbConn, events, nop)
+	orbit := recoveryOrbit(t, fx)
+	user := fx.User(orbit)
+
+	first, err := codes.Generate(ctx, orbit, user.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	format := regexp.MustCompile(`^[0-9A-HJKMNP-TV-Z]{4}(-[0-9A-HJKMNP-TV-Z]{4}){3}$`)
+	if len(first) != 4 {
+		t.Fatalf("Generate = %d codes, want 4", len(first))
+	}
+	for _, code := range first {
+		if !format.MatchString(code) {
+			t.Fatalf("code %q is not four groups of Crockford base32", code)
+		}
+	}
+
+	// A new batch invalidates the old one.
+	second, err := codes.Generate(ctx, orbit, user.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := codes.Redeem(ctx, orbit, user.ID, first[0]); !errors.Is(err, services.ErrInvalidRecoveryCode) {
+		t.Fatalf("Redeem of a replaced code error = %v, want ErrInvalidRecoveryCode", err)
+	}
+	if err := codes.Redeem(ctx, orbit, fx.User(orbit).ID, second[0]); !errors.Is(err, services.ErrInvalidRecoveryCode) {
+		t.Fatalf("Redeem of another user's code error = %v, want ErrInvalidRecoveryCode", err)
+	}
+
+	// Codes are typed back however the user likes.
+	if err := codes.Redeem(ctx, orbit, user.ID, strings.ToLower(strings.ReplaceAll(second[0], "-", " "))); err != nil {
+		t.Fatalf("Redeem of a retyped code = %v", err)
+	}
+	if err := codes.Redeem(ctx, orbit, user.ID, second[0]); !errors.Is(err, services.ErrInvalidRecoveryCode) {
+		t.Fatalf("second Redeem error = %v, want ErrInvalidRecoveryCode", err)
+	}
+
+	// Of two concurrent redemptions of one code only one succeeds.
+	var wg sync.WaitGroup
+	errs := make([]error, 2)
+	for i := range errs {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = codes.Redeem(ctx, orbit, user.ID, second[1])
+		}()
+	}
+	wg.Wait()
+	if (errs[0] == nil) == (errs[1] == nil) {
+		t.Fatalf("concurrent Redeem errors = %v, %v, want exactly one success", errs[0], errs[1])
+	}
+	for _, err := range errs {
+		if err != nil && !errors.Is(err, services.ErrInvalidRecoveryCode) {
+			t.Fatalf("losing Redeem error = %v, want ErrInvalidRecoveryCode", err)
+		}
+	}
+	if remaining, err := codes.Remaining(ctx, user.ID); err != nil || remaining != 2 {
+		t.Fatalf("Remaining = %d, %v, want 2", remaining, err)
+	}
+}
+
+func TestRecoveryCodeServiceWarnsWhenLow(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	events := services.NewSecurityEventService(dbConn, nop)
+	codes := services.NewRecoveryCodeService(dbConn, events, nop)
+	orbit := recoveryOrbit(t, fx)
+	user := fx.User(orbit)
+
+	batch, err := codes.Generate(ctx, orbit, user.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	low := func() []*models.SecurityEvent {
+		t.Helper()
+		list, _, err := events.ListByOrbit(ctx, orbit.ID, repositories.SecurityEventFilter{EventType: models.SecurityEventRecoveryCodesLow, UserID: &user.ID}, repositories.Page{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return list
+	}
+
+	// Three codes left is above the threshold of two.
+	if err := codes.Redeem(ctx, orbit, user.ID, batch[0]); err != nil {
+		t.Fatal(err)
+	}
+	if list := low(); len(list) != 0 {
+		t.Fatalf("low events with 3 codes left = %d, want 0", len(list))
+	}
+	if err := codes.Redeem(ctx, orbit, user.ID, batch[1]); err != nil {
+		t.Fatal(err)
+	}
+	list := low()
+	if len(list) != 1 || list[0].Severity != models.SecuritySeverityWarning || list[0].Metadata["remaining"] != float64(2) {
+		t.Fatalf("low events with 2 codes left = %+v, want one warning", list)
+	}
+}
@@ -0,0 +1,150 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+)
+
+var (
+	ErrInvalidRedirectURI  = errors.New("invalid redirect uri")
+	ErrRedirectURIMismatch = errors.New("redirect uri is not registered for this client")
+)
+
+var forbiddenRedirectSchemes = map[string]bool{
+	"javascript": true,
+	"data":       true,
+	"vbscript":   true,
+	"file":       true,
+}
+
+// ValidateRedirectURI checks a redirect or post-logout redirect URI at
+// registration time. Web clients must use https (plain http is only accepted
+// for loopback hosts during development); native clients may additionally use
+// loopback http and private-use schemes as described in RFC 8252.
+func ValidateRedirectURI(raw string, appType string) error {
+	u, err := url.Parse(raw)
+	if err != nil || !u.IsAbs() {
+		return fmt.Errorf("%w: %q is not an absolute uri", ErrInvalidRedirectURI, raw)
+	}
+	if u.Fragment != "" || strings.Contains(raw, "#") {
+		return fmt.Errorf("%w: %q must not contain a fragment", ErrInvalidRedirectURI, raw)
+	}
+	if u.User != nil {
+		return fmt.Errorf("%w: %q must not contain user info", ErrInvalidRedirectURI, raw)
+	}
+	scheme := strings.ToLower(u.Scheme)
+	if forbiddenRedirectSchemes[scheme] {
+		return fmt.Errorf("%w: scheme %q is not allowed", ErrInvalidRedirectURI, u.Scheme)
+	}
+	if strings.Contains(u.Host, "*") {
+		return fmt.Errorf("%w: %q must not contain wildcards", ErrInvalidRedirectURI, raw)
+	}
+	if err := rejectNestedRedirect(u); err != nil {
+		return err
+	}
+
+	switch scheme {
+	case "https":
+		if u.Host == "" {
+			return fmt.Errorf("%w: %q has no host", ErrInvalidRedirectURI, raw)
+		}
+		return nil
+	case "http":
+		if isLoopbackHost(u.Hostname()) {
+			return nil
+		}
+		return fmt.Errorf("%w: %q must use https", ErrInvalidRedirectURI, raw)
+	}
+
+	// RFC 8252 section 7.1: private-use schemes are reverse domain names.
+	if appType == AppTypeNative && strings.Contains(scheme, ".") {
+		return nil
+	}
+	return fmt.Errorf("%w: scheme %q is not allowed for %s clients", ErrInvalidRedirectURI, u.Scheme, appTypeOrDefault(appType))
+}
+
+// MatchRedirectURI picks the registered URI a request refers to. Matching is by
+// exact string comparison, except that native clients redirecting to a
+// loopback IP literal may use any port (RFC 8252 section 7.3). An empty
+// request is only accepted when a single URI is registered.
+func MatchRedirectURI(registered []string, requested string, appType string) (string, error) {
+	if requested == "" {
+		if len(registered) == 1 {
+			return registered[0], nil
+		}
+		return "", fmt.Errorf("%w: redirect_uri is required", ErrRedirectURIMismatch)
+	}
+	if strings.Contains(requested, "#") {
+		return "", fmt.Errorf("%w: %q must not contain a fragment", ErrInvalidRedirectURI, requested)
+	}
+	for _, r := range registered {
+		if r == requested {
+			return requested, nil
+		}
+	}
+	if appType == AppTypeNative {
+		req, err := url.Parse(requested)
+		if err == nil && req.Scheme == "http" && isLoopbackIP(req.Hostname()) {
+			for _, r := range registered {
+				reg, err := url.Parse(r)
+				if err != nil || reg.Scheme != "http" || !isLoopbackIP(reg.Hostname()) {
+					continue
+				}
+				if reg.Hostname() == req.Hostname() && reg.EscapedPath() == req.EscapedPath() && reg.RawQuery == req.RawQuery {
+					return requested, nil
+				}
+			}
+		}
+	}
+	return "", ErrRedirectURIMismatch
+}
+
+// ResolveRedirectURI validates the redirect_uri of an authorization request.
+func ResolveRedirectURI(c *models.Client, requested string) (string, error) {
+	return MatchRedirectURI(decodeStringList(c.RedirectURIs), requested, c.AppType)
+}
+
+// ResolvePostLogoutRedirectURI validates the post_logout_redirect_uri of an
+// RP-initiated logout. Unlike authorization requests there is no default.
+func ResolvePostLogoutRedirectURI(c *models.Client, requested string) (string, error) {
+	if requested == "" {
+		return "", nil
+	}
+	return MatchRedirectURI(decodeStringList(c.PostLogoutRedirectURIs), requested, c.AppType)
+}
+
+// rejectNestedRedirect refuses URIs that forward to another absolute URL via
+// their query, a common way of turning a registered endpoint into an open
+// redirector.
+func rejectNestedRedirect(u *url.URL) error {
+	for key, values := range u.Query() {
+		for _, v := range values {
+			nested, err := url.Parse(v)
+			if err == nil && nested.IsAbs() && nested.Host != "" {
+				return fmt.Errorf("%w: query parameter %q carries an absolute url", ErrInvalidRedirectURI, key)
+			}
+		}
+	}
+	return nil
+}
+
+func isLoopbackHost(host string) bool {
+	return strings.EqualFold(host, "localhost") || isLoopbackIP(host)
+}
+
+func isLoopbackIP(host string) bool {
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsLoopback()
+}
+
+func appTypeOrDefault(appType string) string {
+	if appType == "" {
+		return AppTypeWeb
+	}
+	return appType
+}
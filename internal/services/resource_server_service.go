@@ -0,0 +1,312 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories/db"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services/cache"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var ErrInvalidTarget = errors.New("requested resource is invalid, unknown or not allowed")
+
+// Scopes that address the authorization server itself rather than a resource
+// server, so they are never filtered against a resource's allowed scopes.
+var oidcScopes = map[string]bool{
+	"openid":         true,
+	"profile":        true,
+	"email":          true,
+	"address":        true,
+	"phone":          true,
+	"offline_access": true,
+}
+
+type AudienceGrant struct {
+	Audience    []string
+	Scopes      []string
+	TokenFormat models.TokenFormat
+	TTL         time.Duration
+}
+
+func (g *AudienceGrant) Apply(token *models.AccessToken) {
+	token.Audience = encodeStringList(g.Audience)
+	token.Scope = encodeStringList(g.Scopes)
+	token.IsJWT = g.TokenFormat != models.TokenFormatOpaque
+	if g.TTL > 0 && !token.IssuedAt.IsZero() {
+		if exp := token.IssuedAt.Add(g.TTL); token.ExpiresAt.IsZero() || exp.Before(token.ExpiresAt) {
+			token.ExpiresAt = exp
+		}
+	}
+}
+
+type ResourceServerService struct {
+	db     *db.DB
+	cache  cache.Manager
+	logger zerolog.Logger
+	tracer trace.Tracer
+	ttl    time.Duration
+	prefix string
+}
+
+func NewResourceServerService(dbConn *db.DB, cacheManager cache.Manager, logger zerolog.Logger) *ResourceServerService {
+	return &ResourceServerService{
+		db:     dbConn,
+		cache:  cacheManager,
+		logger: logger,
+		tracer: otel.Tracer("service.resource_server"),
+		ttl:    30 * time.Minute,
+		prefix: "resource_servers",
+	}
+}
+
+func (s *ResourceServerService) key(orbitID int64, identifier string) string {
+	return fmt.Sprintf("orbit:%d:resource:%s", orbitID, identifier)
+}
+
+func (s *ResourceServerService) Create(ctx context.Context, rs *models.ResourceServer) (*models.ResourceServer, error) {
+	ctx, span := s.tracer.Start(ctx, "Create")
+	defer span.End()
+
+	if err := validateResourceIdentifier(rs.Identifier); err != nil {
+		return nil, err
+	}
+	if rs.TokenFormat == "" {
+		rs.TokenFormat = models.TokenFormatJWT
+	}
+
+	var created *models.ResourceServer
+	err := s.db.WithTx(ctx, func(tx pgx.Tx) error {
+		repo := repositories.NewResourceServerRepository(tx, s.logger)
+		var err error
+		created, err = repo.Create(ctx, rs)
+		return err
+	})
+	if err != nil {
+		s.logger.Error().Err(err).Str("identifier", rs.Identifier).Msg("resource server create failed")
+		return nil, err
+	}
+	_ = s.cache.Cache(s.prefix).Set(ctx, s.key(created.OrbitID, created.Identifier), created, s.ttl)
+	return created, nil
+}
+
+func (s *ResourceServerService) GetByIdentifier(ctx context.Context, orbitID int64, identifier string) (*models.ResourceServer, error) {
+	ctx, span := s.tracer.Start(ctx, "GetByIdentifier")
+	defer span.End()
+
+	key := s.key(orbitID, identifier)
+	var cached models.ResourceServer
+	if err := s.cache.Cache(s.prefix).Get(ctx, key, &cached); err == nil {
+		return &cached, nil
+	}
+
+	var rs *models.ResourceServer
+	err := s.db.WithTx(ctx, func(tx pgx.Tx) error {
+		repo := repositories.NewResourceServerRepository(tx, s.logger)
+		var err error
+		rs, err = repo.GetByIdentifier(ctx, orbitID, identifier)
+		return err
+	})
+	if err != nil {
+		s.logger.Error().Err(err).Str("identifier", identifier).Msg("resource server get by identifier failed")
+		return nil, err
+	}
+	if rs != nil {
+		_ = s.cache.Cache(s.prefix).Set(ctx, key, rs, s.ttl)
+	}
+	return rs, nil
+}
+
+func (s *ResourceServerService) Update(ctx context.Context, rs *models.ResourceServer) (*models.ResourceServer, error) {
+	ctx, span := s.tracer.Start(ctx, "Update")
+	defer span.End()
+
+	var previousIdentifier string
+	var updated *models.ResourceServer
+	err := s.db.WithTx(ctx, func(tx pgx.Tx) error {
+		repo := repositories.NewResourceServerRepository(tx, s.logger)
+		// The entry under the identifier the update replaces must go too, or
+		// audiences would keep resolving to the old settings.
+		previous, err := repo.GetByID(ctx, rs.ID)
+		if err != nil {
+			return err
+		}
+		if previous != nil {
+			previousIdentifier = previous.Identifier
+		}
+		updated, err = repo.Update(ctx, rs)
+		return err
+	})
+	if err != nil {
+		s.logger.Error().Err(err).Int64("resource_server_id", rs.ID).Msg("resource server update failed")
+		return nil, err
+	}
+	c := s.cache.Cache(s.prefix)
+	if previousIdentifier != "" {
+		_ = c.Delete(ctx, s.key(rs.OrbitID, previousIdentifier))
+	}
+	_ = c.Delete(ctx, s.key(rs.OrbitID, rs.Identifier))
+	return updated, nil
+}
+
+func (s *ResourceServerService) Delete(ctx context.Context, id int64) error {
+	ctx, span := s.tracer.Start(ctx, "Delete")
+	defer span.End()
+
+	var orbitID int64
+	var identifier string
+	err := s.db.WithTx(ctx, func(tx pgx.Tx) error {
+		repo := repositories.NewResourceServerRepository(tx, s.logger)
+		rs, err := repo.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+		if rs == nil {
+			return nil
+		}
+		orbitID = rs.OrbitID
+		identifier = rs.Identifier
+		return repo.Delete(ctx, id)
+	})
+	if err != nil {
+		s.logger.Error().Err(err).Int64("resource_server_id", id).Msg("resource server delete failed")
+		return err
+	}
+	_ = s.cache.Cache(s.prefix).Delete(ctx, s.key(orbitID, identifier))
+	return nil
+}
+
+func (s *ResourceServerService) ListByOrbit(ctx context.Context, orbitID int64, page repositories.Page) ([]*models.ResourceServer, string, error) {
+	ctx, span := s.tracer.Start(ctx, "ListByOrbit")
+	defer span.End()
+
+	list, next, err := repositories.NewResourceServerRepository(s.db.Exec(), s.logger).ListByOrbit(ctx, orbitID, page)
+	if err != nil {
+		if !errors.Is(err, repositories.ErrInvalidCursor) {
+			s.logger.Error().Err(err).Int64("orbit_id", orbitID).Msg("resource server list failed")
+		}
+		return nil, "", err
+	}
+	return list, next, nil
+}
+
+// ResolveAudience maps the RFC 8707 resource parameters of an authorization or
+// token request onto registered resource servers. Requested scopes are narrowed
+// to those at least one of the target resources accepts, and all resources must
+// agree on the token format since a single access token is minted for them.
+func (s *ResourceServerService) ResolveAudience(ctx context.Context, orbitID int64, resources []string, scopes []string) (*AudienceGrant, error) {
+	ctx, span := s.tracer.Start(ctx, "ResolveAudience")
+	defer span.End()
+
+	grant := &AudienceGrant{TokenFormat: models.TokenFormatJWT, Scopes: scopes}
+	if len(resources) == 0 {
+		return grant, nil
+	}
+
+	allowed := make(map[string]bool)
+	seen := make(map[string]bool)
+	for i, resource := range resources {
+		if seen[resource] {
+			continue
+		}
+		seen[resource] = true
+
+		if err := validateResourceIdentifier(resource); err != nil {
+			return nil, err
+		}
+		rs, err := s.GetByIdentifier(ctx, orbitID, resource)
+		if err != nil {
+			return nil, err
+		}
+		if rs == nil || !rs.IsActive {
+			s.logger.Warn().Int64("orbit_id", orbitID).Str("resource", resource).Msg("unknown or inactive resource requested")
+			return nil, ErrInvalidTarget
+		}
+		if i == 0 {
+			grant.TokenFormat = rs.TokenFormat
+		} else if rs.TokenFormat != grant.TokenFormat {
+			s.logger.Warn().Int64("orbit_id", orbitID).Strs("resources", resources).Msg("requested resources disagree on token format")
+			return nil, ErrInvalidTarget
+		}
+		ttl := time.Duration(rs.AccessTokenTTL) * time.Second
+		if ttl > 0 && (grant.TTL == 0 || ttl < grant.TTL) {
+			grant.TTL = ttl
+		}
+		for _, scope := range decodeStringList(rs.AllowedScopes) {
+			allowed[scope] = true
+		}
+		grant.Audience = append(grant.Audience, rs.Identifier)
+	}
+
+	grant.Scopes = nil
+	for _, scope := range scopes {
+		if oidcScopes[scope] || allowed[scope] {
+			grant.Scopes = append(grant.Scopes, scope)
+		}
+	}
+	return grant, nil
+}
+
+// NarrowResources enforces that resources requested at the token endpoint are
+// a subset of those the original grant was issued for. An empty request keeps
+// the full original set.
+func NarrowResources(granted []string, requested []string) ([]string, error) {
+	if len(requested) == 0 {
+		return granted, nil
+	}
+	set := make(map[string]bool, len(granted))
+	for _, g := range granted {
+		set[g] = true
+	}
+	for _, r := range requested {
+		if !set[r] {
+			return nil, ErrInvalidTarget
+		}
+	}
+	return requested, nil
+}
+
+func validateResourceIdentifier(resource string) error {
+	u, err := url.Parse(resource)
+	if err != nil || !u.IsAbs() || u.Host == "" || u.Fragment != "" {
+		return ErrInvalidTarget
+	}
+	return nil
+}
+
+func decodeStringList(raw json.RawMessage) []string {
+	if len(raw) == 0 {
+		return nil
+	}
+	var list []string
+	if err := json.Unmarshal(raw, &list); err != nil {
+		return nil
+	}
+	return list
+}
+
+func encodeStringList(list []string) json.RawMessage {
+	if list == nil {
+		list = []string{}
+	}
+	data, _ := json.Marshal(list)
+	return data
+}
+
+func containsString(list []string, value string) bool {
+	for _, v := range list {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,45 @@
+package services_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/testutil"
+)
+
+func TestResourceServerServiceUpdateMovesIdentifier(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	cacheManager, _ := testutil.Redis(t)
+	resources := services.NewResourceServerService(dbConn, cacheManager, nop)
+	orbit := fx.Orbit()
+
+	rs, err := resources.Create(ctx, &models.ResourceServer{
+		OrbitID:       orbit.ID,
+		Name:          "Orders",
+		Identifier:    "https://orders.example.test",
+		AllowedScopes: json.RawMessage(`["orders:read"]`),
+		TokenFormat:   models.TokenFormatJWT,
+		IsActive:      true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, err := resources.GetByIdentifier(ctx, orbit.ID, rs.Identifier); err != nil || got == nil {
+		t.Fatalf("GetByIdentifier = %+v, %v", got, err)
+	}
+
+	oldIdentifier := rs.Identifier
+	rs.Identifier = "https://orders-v2.example.test"
+	if _, err := resources.Update(ctx, rs); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := resources.GetByIdentifier(ctx, orbit.ID, oldIdentifier); err != nil || got != nil {
+		t.Fatalf("GetByIdentifier(old identifier) = %+v, %v, want nil", got, err)
+	}
+	if got, err := resources.GetByIdentifier(ctx, orbit.ID, rs.Identifier); err != nil || got == nil || got.ID != rs.ID {
+		t.Fatalf("GetByIdentifier(new identifier) = %+v, %v", got, err)
+	}
+}
@@ -2,6 +2,7 @@ package services
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -15,6 +16,18 @@ import (
 	"go.opentelemetry.io/otel/trace"
 )
 
+var (
+	ErrRoleNotFound = errors.New("role not found")
+	ErrRoleCycle    = errors.New("role would include itself")
+)
+
+// effectivePermissionsTTL bounds how long a user keeps permissions after a
+// change the cache was not told about, such as one made straight in the
+// database.
+const effectivePermissionsTTL = 10 * time.Minute
+
+const rolesCacheName = "roles"
+
 type RoleService struct {
 	db     *db.DB
 	cache  cache.Manager
@@ -31,7 +44,7 @@ func NewRoleService(dbConn *db.DB, cacheManager cache.Manager, logger zerolog.Lo
 		logger: logger,
 		tracer: otel.Tracer("service.role"),
 		ttl:    30 * time.Minute,
-		prefix: "roles",
+		prefix: rolesCacheName,
 	}
 }
 
@@ -39,6 +52,34 @@ func (s *RoleService) key(orbitID int64, roleID int64) string {
 	return fmt.Sprintf("orbit:%d:role:%d", orbitID, roleID)
 }
 
+func (s *RoleService) permissionsKey(orbitID, generation, userID int64) string {
+	return fmt.Sprintf("orbit:%d:gen:%d:user:%d:permissions", orbitID, generation, userID)
+}
+
+func (s *RoleService) userRolesKey(orbitID, generation, userID int64) string {
+	return fmt.Sprintf("orbit:%d:gen:%d:user:%d:roles", orbitID, generation, userID)
+}
+
+// generation versions the effective permissions cached for the users of an
+// orbit. Changing what a role grants moves it on, which retires the cached
+// permissions of every user at once instead of finding the users affected.
+func (s *RoleService) generation(ctx context.Context, orbitID int64) int64 {
+	var generation int64
+	_ = s.cache.Cache(s.prefix).Get(ctx, generationKey(orbitID), &generation)
+	return generation
+}
+
+func generationKey(orbitID int64) string {
+	return fmt.Sprintf("orbit:%d:generation", orbitID)
+}
+
+// retireEffectivePermissions drops the effective permissions cached for every
+// user of an orbit. Generations are timestamps rather than counters so one
+// never comes back after the key expires.
+func retireEffectivePermissions(ctx context.Context, cacheManager cache.Manager, orbitID int64) {
+	_ = cacheManager.Cache(rolesCacheName).Set(ctx, generationKey(orbitID), time.Now().UnixNano(), 30*24*time.Hour)
+}
+
 func (s *RoleService) Create(ctx context.Context, r *models.Role) (*models.Role, error) {
 	ctx, span := s.tracer.Start(ctx, "Create")
 	defer span.End()
@@ -51,7 +92,9 @@ func (s *RoleService) Create(ctx context.Context, r *models.Role) (*models.Role,
 		return err
 	})
 	if err != nil {
-		s.logger.Error().Err(err).Msg("role create failed")
+		if err = conflictError(err); !isConflict(err) {
+			s.logger.Error().Err(err).Msg("role create failed")
+		}
 		return nil, err
 	}
 
@@ -59,6 +102,7 @@ func (s *RoleService) Create(ctx context.Context, r *models.Role) (*models.Role,
 	return created, nil
 }
 
+// GetByID returns nil when the role does not exist or belongs to another orbit.
 func (s *RoleService) GetByID(ctx context.Context, orbitID, roleID int64) (*models.Role, error) {
 	ctx, span := s.tracer.Start(ctx, "GetByID")
 	defer span.End()
@@ -74,6 +118,9 @@ func (s *RoleService) GetByID(ctx context.Context, orbitID, roleID int64) (*mode
 		repo := repositories.NewRoleRepository(tx, s.logger)
 		var err error
 		r, err = repo.GetByID(ctx, roleID)
+		if r != nil && r.OrbitID != orbitID {
+			r = nil
+		}
 		return err
 	})
 	if err != nil {
@@ -92,6 +139,10 @@ func (s *RoleService) Delete(ctx context.Context, orbitID, roleID int64) error {
 
 	err := s.db.WithTx(ctx, func(tx pgx.Tx) error {
 		repo := repositories.NewRoleRepository(tx, s.logger)
+		r, err := repo.GetByID(ctx, roleID)
+		if err != nil || r == nil || r.OrbitID != orbitID {
+			return err
+		}
 		return repo.Delete(ctx, roleID)
 	})
 	if err != nil {
@@ -100,23 +151,305 @@ func (s *RoleService) Delete(ctx context.Context, orbitID, roleID int64) error {
 	}
 
 	_ = s.cache.Cache(s.prefix).Delete(ctx, s.key(orbitID, roleID))
+	retireEffectivePermissions(ctx, s.cache, orbitID)
 	return nil
 }
 
-func (s *RoleService) ListByOrbit(ctx context.Context, orbitID int64, limit, offset int) ([]*models.Role, error) {
+func (s *RoleService) ListByOrbit(ctx context.Context, orbitID int64, page repositories.Page) ([]*models.Role, string, error) {
 	ctx, span := s.tracer.Start(ctx, "ListByOrbit")
 	defer span.End()
 
-	var list []*models.Role
+	list, next, err := repositories.NewRoleRepository(s.db.Exec(), s.logger).ListByOrbit(ctx, orbitID, page)
+	if err != nil {
+		if !errors.Is(err, repositories.ErrInvalidCursor) {
+			s.logger.Error().Err(err).Int64("orbit_id", orbitID).Msg("role list failed")
+		}
+		return nil, "", err
+	}
+	return list, next, nil
+}
+
+// AssignToUser gives a user of the orbit a role of the same orbit. Assigning
+// a role the user already holds is not an error.
+func (s *RoleService) AssignToUser(ctx context.Context, orbitID, roleID, userID int64) error {
+	ctx, span := s.tracer.Start(ctx, "AssignToUser")
+	defer span.End()
+
 	err := s.db.WithTx(ctx, func(tx pgx.Tx) error {
-		repo := repositories.NewRoleRepository(tx, s.logger)
-		var err error
-		list, err = repo.ListByOrbit(ctx, orbitID, limit, offset)
+		if err := s.checkMembers(ctx, tx, orbitID, roleID, userID); err != nil {
+			return err
+		}
+		return repositories.NewUserRoleRepository(tx, s.logger).Assign(ctx, userID, roleID)
+	})
+	if err != nil {
+		if !errors.Is(err, ErrRoleNotFound) && !errors.Is(err, ErrUserNotFound) {
+			s.logger.Error().Err(err).Int64("role_id", roleID).Int64("user_id", userID).Msg("role assignment failed")
+		}
+		return err
+	}
+	s.forgetUser(ctx, orbitID, userID)
+	return nil
+}
+
+func (s *RoleService) RevokeFromUser(ctx context.Context, orbitID, roleID, userID int64) error {
+	ctx, span := s.tracer.Start(ctx, "RevokeFromUser")
+	defer span.End()
+
+	err := s.db.WithTx(ctx, func(tx pgx.Tx) error {
+		if err := s.checkMembers(ctx, tx, orbitID, roleID, userID); err != nil {
+			return err
+		}
+		return repositories.NewUserRoleRepository(tx, s.logger).Revoke(ctx, userID, roleID)
+	})
+	if err != nil {
+		if !errors.Is(err, ErrRoleNotFound) && !errors.Is(err, ErrUserNotFound) {
+			s.logger.Error().Err(err).Int64("role_id", roleID).Int64("user_id", userID).Msg("role revocation failed")
+		}
 		return err
+	}
+	s.forgetUser(ctx, orbitID, userID)
+	return nil
+}
+
+// checkMembers makes sure the role and the user both belong to the orbit.
+func (s *RoleService) checkMembers(ctx context.Context, tx pgx.Tx, orbitID, roleID, userID int64) error {
+	role, err := repositories.NewRoleRepository(tx, s.logger).GetByID(ctx, roleID)
+	if err != nil {
+		return err
+	}
+	if role == nil || role.OrbitID != orbitID {
+		return ErrRoleNotFound
+	}
+	user, err := repositories.NewUserRepository(tx, s.logger).GetByID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	if user == nil || user.OrbitID != orbitID || user.DeletedAt != nil {
+		return ErrUserNotFound
+	}
+	return nil
+}
+
+func (s *RoleService) forgetUser(ctx context.Context, orbitID, userID int64) {
+	generation := s.generation(ctx, orbitID)
+	_ = s.cache.Cache(s.prefix).Delete(ctx, s.permissionsKey(orbitID, generation, userID))
+	_ = s.cache.Cache(s.prefix).Delete(ctx, s.userRolesKey(orbitID, generation, userID))
+}
+
+// GrantPermission adds a permission of the orbit to a role of the orbit.
+func (s *RoleService) GrantPermission(ctx context.Context, orbitID, roleID, permissionID int64) error {
+	ctx, span := s.tracer.Start(ctx, "GrantPermission")
+	defer span.End()
+
+	return s.changePermission(ctx, orbitID, roleID, permissionID, func(repo *repositories.RolePermissionRepository) error {
+		return repo.Assign(ctx, roleID, permissionID)
+	})
+}
+
+func (s *RoleService) RevokePermission(ctx context.Context, orbitID, roleID, permissionID int64) error {
+	ctx, span := s.tracer.Start(ctx, "RevokePermission")
+	defer span.End()
+
+	return s.changePermission(ctx, orbitID, roleID, permissionID, func(repo *repositories.RolePermissionRepository) error {
+		return repo.Revoke(ctx, roleID, permissionID)
+	})
+}
+
+func (s *RoleService) changePermission(ctx context.Context, orbitID, roleID, permissionID int64, change func(*repositories.RolePermissionRepository) error) error {
+	err := s.db.WithTx(ctx, func(tx pgx.Tx) error {
+		role, err := repositories.NewRoleRepository(tx, s.logger).GetByID(ctx, roleID)
+		if err != nil {
+			return err
+		}
+		if role == nil || role.OrbitID != orbitID {
+			return ErrRoleNotFound
+		}
+		perm, err := repositories.NewPermissionRepository(tx, s.logger).GetByID(ctx, permissionID)
+		if err != nil {
+			return err
+		}
+		if perm == nil || perm.OrbitID != orbitID {
+			return ErrPermissionNotFound
+		}
+		return change(repositories.NewRolePermissionRepository(tx, s.logger))
+	})
+	if err != nil {
+		if !errors.Is(err, ErrRoleNotFound) && !errors.Is(err, ErrPermissionNotFound) {
+			s.logger.Error().Err(err).Int64("role_id", roleID).Int64("permission_id", permissionID).Msg("role permission change failed")
+		}
+		return err
+	}
+	retireEffectivePermissions(ctx, s.cache, orbitID)
+	return nil
+}
+
+// IncludeRole makes a role of the orbit include another, so that it grants
+// the permissions of that role too. Inclusions that would make a role include
+// itself fail with ErrRoleCycle.
+func (s *RoleService) IncludeRole(ctx context.Context, orbitID, roleID, includedRoleID int64) error {
+	ctx, span := s.tracer.Start(ctx, "IncludeRole")
+	defer span.End()
+
+	return s.changeInclusion(ctx, orbitID, roleID, includedRoleID, func(repo *repositories.RoleInclusionRepository) error {
+		if err := repo.LockOrbit(ctx, orbitID); err != nil {
+			return err
+		}
+		cycle, err := repo.Reaches(ctx, includedRoleID, roleID)
+		if err != nil {
+			return err
+		}
+		if cycle {
+			return ErrRoleCycle
+		}
+		return repo.Include(ctx, roleID, includedRoleID)
 	})
+}
+
+func (s *RoleService) ExcludeRole(ctx context.Context, orbitID, roleID, includedRoleID int64) error {
+	ctx, span := s.tracer.Start(ctx, "ExcludeRole")
+	defer span.End()
+
+	return s.changeInclusion(ctx, orbitID, roleID, includedRoleID, func(repo *repositories.RoleInclusionRepository) error {
+		return repo.Exclude(ctx, roleID, includedRoleID)
+	})
+}
+
+func (s *RoleService) changeInclusion(ctx context.Context, orbitID, roleID, includedRoleID int64, change func(*repositories.RoleInclusionRepository) error) error {
+	err := s.db.WithTx(ctx, func(tx pgx.Tx) error {
+		roles := repositories.NewRoleRepository(tx, s.logger)
+		for _, id := range []int64{roleID, includedRoleID} {
+			role, err := roles.GetByID(ctx, id)
+			if err != nil {
+				return err
+			}
+			if role == nil || role.OrbitID != orbitID {
+				return ErrRoleNotFound
+			}
+		}
+		return change(repositories.NewRoleInclusionRepository(tx, s.logger))
+	})
+	if err != nil {
+		if !errors.Is(err, ErrRoleNotFound) && !errors.Is(err, ErrRoleCycle) {
+			s.logger.Error().Err(err).Int64("role_id", roleID).Int64("included_role_id", includedRoleID).Msg("role inclusion change failed")
+		}
+		return err
+	}
+	retireEffectivePermissions(ctx, s.cache, orbitID)
+	return nil
+}
+
+// ListIncluded returns the roles a role includes directly.
+func (s *RoleService) ListIncluded(ctx context.Context, orbitID, roleID int64) ([]*models.Role, error) {
+	ctx, span := s.tracer.Start(ctx, "ListIncluded")
+	defer span.End()
+
+	role, err := s.GetByID(ctx, orbitID, roleID)
+	if err != nil {
+		return nil, err
+	}
+	if role == nil {
+		return nil, ErrRoleNotFound
+	}
+	return repositories.NewRoleInclusionRepository(s.db.Exec(), s.logger).ListIncluded(ctx, roleID)
+}
+
+// ListPermissions returns the permissions a role grants.
+func (s *RoleService) ListPermissions(ctx context.Context, orbitID, roleID int64) ([]*models.Permission, error) {
+	ctx, span := s.tracer.Start(ctx, "ListPermissions")
+	defer span.End()
+
+	role, err := s.GetByID(ctx, orbitID, roleID)
+	if err != nil {
+		return nil, err
+	}
+	if role == nil {
+		return nil, ErrRoleNotFound
+	}
+	var perms []*models.Permission
+	repo := repositories.NewRolePermissionRepository(s.db.Exec(), s.logger)
+	page := repositories.Page{Limit: repositories.MaxPageLimit}
+	for {
+		list, next, err := repo.ListPermissionsByRole(ctx, roleID, page)
+		if err != nil {
+			return nil, err
+		}
+		perms = append(perms, list...)
+		if next == "" {
+			return perms, nil
+		}
+		page.Cursor = next
+	}
+}
+
+// ListByUser returns the roles assigned to a user of the orbit directly.
+func (s *RoleService) ListByUser(ctx context.Context, orbitID, userID int64) ([]*models.Role, error) {
+	ctx, span := s.tracer.Start(ctx, "ListByUser")
+	defer span.End()
+
+	roles, err := repositories.NewRoleRepository(s.db.Exec(), s.logger).ListByUser(ctx, orbitID, userID)
 	if err != nil {
-		s.logger.Error().Err(err).Int64("orbit_id", orbitID).Msg("role list failed")
+		s.logger.Error().Err(err).Int64("user_id", userID).Msg("role list by user failed")
 		return nil, err
 	}
-	return list, nil
+	return roles, nil
+}
+
+// RoleNames returns the names of the roles a user holds in the orbit, sorted:
+// those assigned to the user or its groups and the roles they include.
+func (s *RoleService) RoleNames(ctx context.Context, orbitID, userID int64) ([]string, error) {
+	ctx, span := s.tracer.Start(ctx, "RoleNames")
+	defer span.End()
+
+	key := s.userRolesKey(orbitID, s.generation(ctx, orbitID), userID)
+	var cached []string
+	if err := s.cache.Cache(s.prefix).Get(ctx, key, &cached); err == nil {
+		return cached, nil
+	}
+
+	roles, err := repositories.NewRoleRepository(s.db.Exec(), s.logger).ListHeldByUser(ctx, orbitID, userID)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("user_id", userID).Msg("held roles lookup failed")
+		return nil, err
+	}
+	names := make([]string, 0, len(roles))
+	for _, role := range roles {
+		names = append(names, role.Name)
+	}
+	_ = s.cache.Cache(s.prefix).Set(ctx, key, names, effectivePermissionsTTL)
+	return names, nil
+}
+
+// UserPermissions returns the permissions a user holds in the orbit through
+// the roles RoleNames lists, sorted by name.
+func (s *RoleService) UserPermissions(ctx context.Context, orbitID, userID int64) ([]*models.Permission, error) {
+	ctx, span := s.tracer.Start(ctx, "UserPermissions")
+	defer span.End()
+
+	key := s.permissionsKey(orbitID, s.generation(ctx, orbitID), userID)
+	var cached []*models.Permission
+	if err := s.cache.Cache(s.prefix).Get(ctx, key, &cached); err == nil {
+		return cached, nil
+	}
+
+	perms, err := repositories.NewUserRoleRepository(s.db.Exec(), s.logger).ListPermissions(ctx, orbitID, userID)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("user_id", userID).Msg("effective permissions lookup failed")
+		return nil, err
+	}
+	_ = s.cache.Cache(s.prefix).Set(ctx, key, perms, effectivePermissionsTTL)
+	return perms, nil
+}
+
+// EffectivePermissions returns the names of the permissions a user holds in
+// the orbit through its roles, sorted.
+func (s *RoleService) EffectivePermissions(ctx context.Context, orbitID, userID int64) ([]string, error) {
+	perms, err := s.UserPermissions(ctx, orbitID, userID)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, 0, len(perms))
+	for _, p := range perms {
+		names = append(names, p.Name)
+	}
+	return names, nil
 }
@@ -0,0 +1,120 @@
+package services_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/testutil"
+)
+
+func TestRoleServiceEffectivePermissionsFollowChanges(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	cacheManager, _ := testutil.Redis(t)
+	roles := services.NewRoleService(dbConn, cacheManager, nop)
+	permissions := services.NewPermissionService(dbConn, cacheManager, nop)
+	orbit := fx.Orbit()
+	user := fx.User(orbit)
+
+	role, err := roles.Create(ctx, &models.Role{OrbitID: orbit.ID, Name: "client-admin"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	perm, err := permissions.Create(ctx, &models.Permission{OrbitID: orbit.ID, Name: "orbit:clients:write"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := func(step string, names ...string) {
+		t.Helper()
+		got, err := roles.EffectivePermissions(ctx, orbit.ID, user.ID)
+		if err != nil || !slices.Equal(got, names) {
+			t.Fatalf("EffectivePermissions %s = %v, %v, want %v", step, got, err, names)
+		}
+	}
+
+	// Each step follows a cached answer to the previous one.
+	want("initially")
+	if err := roles.AssignToUser(ctx, orbit.ID, role.ID, user.ID); err != nil {
+		t.Fatal(err)
+	}
+	want("after assigning an empty role")
+	if err := roles.GrantPermission(ctx, orbit.ID, role.ID, perm.ID); err != nil {
+		t.Fatal(err)
+	}
+	want("after the grant", perm.Name)
+	if err := roles.RevokeFromUser(ctx, orbit.ID, role.ID, user.ID); err != nil {
+		t.Fatal(err)
+	}
+	want("after revoking the role")
+	if err := roles.AssignToUser(ctx, orbit.ID, role.ID, user.ID); err != nil {
+		t.Fatal(err)
+	}
+	want("after assigning again", perm.Name)
+	if err := permissions.Delete(ctx, orbit.ID, perm.ID); err != nil {
+		t.Fatal(err)
+	}
+	want("after deleting the permission")
+}
+
+func TestAuthorizerAllowed(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	cacheManager, _ := testutil.Redis(t)
+	roles := services.NewRoleService(dbConn, cacheManager, nop)
+	permissions := services.NewPermissionService(dbConn, cacheManager, nop)
+	master := fx.Orbit()
+	tenant := fx.Orbit()
+	authorizer := services.NewAuthorizer(roles, master.ID)
+
+	grant := func(user *models.User, names ...string) {
+		t.Helper()
+		role, err := roles.Create(ctx, &models.Role{OrbitID: user.OrbitID, Name: "admin-" + user.Username})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, name := range names {
+			perm, err := permissions.Create(ctx, &models.Permission{OrbitID: user.OrbitID, Name: name})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := roles.GrantPermission(ctx, user.OrbitID, role.ID, perm.ID); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if err := roles.AssignToUser(ctx, user.OrbitID, role.ID, user.ID); err != nil {
+			t.Fatal(err)
+		}
+	}
+	operator := fx.User(master)
+	grant(operator, "orbits:write", "orbit:clients:write")
+	tenantAdmin := fx.User(tenant)
+	grant(tenantAdmin, "orbits:write", "orbit:clients:write")
+	locked := fx.User(tenant, func(u *models.User) { u.IsLocked = true })
+	grant(locked, "orbit:users:write")
+
+	for _, tc := range []struct {
+		name       string
+		user       *models.User
+		orbitID    int64
+		permission string
+		want       bool
+	}{
+		{"master user in another orbit", operator, tenant.ID, "orbit:clients:write", true},
+		{"master user on the orbits", operator, 0, "orbits:write", true},
+		{"master user without the permission", operator, tenant.ID, "orbit:keys:write", false},
+		{"user in their orbit", tenantAdmin, tenant.ID, "orbit:clients:write", true},
+		{"user in another orbit", tenantAdmin, master.ID, "orbit:clients:write", false},
+		{"user on the orbits", tenantAdmin, 0, "orbits:write", false},
+		{"locked user", locked, tenant.ID, "orbit:users:write", false},
+		{"no user", nil, tenant.ID, "orbit:clients:write", false},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := authorizer.Allowed(ctx, tc.user, tc.orbitID, tc.permission)
+			if err != nil || got != tc.want {
+				t.Fatalf("Allowed = %v, %v, want %v", got, err, tc.want)
+			}
+		})
+	}
+}
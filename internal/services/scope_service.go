@@ -0,0 +1,141 @@
+package services
+
+import (
+	"context"
+	"errors"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type ScopeService struct {
+	db     *db.DB
+	logger zerolog.Logger
+	tracer trace.Tracer
+}
+
+func NewScopeService(dbConn *db.DB, logger zerolog.Logger) *ScopeService {
+	return &ScopeService{
+		db:     dbConn,
+		logger: logger,
+		tracer: otel.Tracer("service.scope"),
+	}
+}
+
+// Create fails with ErrAlreadyExists when the orbit has a scope of that name.
+func (s *ScopeService) Create(ctx context.Context, scope *models.Scope) (*models.Scope, error) {
+	ctx, span := s.tracer.Start(ctx, "Create")
+	defer span.End()
+
+	var created *models.Scope
+	err := s.db.WithTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		created, err = repositories.NewScopeRepository(tx, s.logger).Create(ctx, scope)
+		return err
+	})
+	if err != nil {
+		if err = conflictError(err); !isConflict(err) {
+			s.logger.Error().Err(err).Int64("orbit_id", scope.OrbitID).Str("name", scope.Name).Msg("scope create failed")
+		}
+		return nil, err
+	}
+	return created, nil
+}
+
+// GetByID returns nil when the scope does not exist or belongs to another
+// orbit.
+func (s *ScopeService) GetByID(ctx context.Context, orbitID, id int64) (*models.Scope, error) {
+	ctx, span := s.tracer.Start(ctx, "GetByID")
+	defer span.End()
+
+	scope, err := repositories.NewScopeRepository(s.db.Exec(), s.logger).GetByID(ctx, id)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("scope_id", id).Msg("scope get failed")
+		return nil, err
+	}
+	if scope == nil || scope.OrbitID != orbitID {
+		return nil, nil
+	}
+	return scope, nil
+}
+
+func (s *ScopeService) Update(ctx context.Context, scope *models.Scope) (*models.Scope, error) {
+	ctx, span := s.tracer.Start(ctx, "Update")
+	defer span.End()
+
+	return s.update(ctx, scope, false)
+}
+
+// UpdateIfUnmodified updates scope only if the stored scope was last updated
+// at scope.UpdatedAt. Otherwise it fails with ErrVersionMismatch.
+func (s *ScopeService) UpdateIfUnmodified(ctx context.Context, scope *models.Scope) (*models.Scope, error) {
+	ctx, span := s.tracer.Start(ctx, "UpdateIfUnmodified")
+	defer span.End()
+
+	return s.update(ctx, scope, true)
+}
+
+func (s *ScopeService) update(ctx context.Context, scope *models.Scope, ifUnmodified bool) (*models.Scope, error) {
+	var updated *models.Scope
+	err := s.db.WithTx(ctx, func(tx pgx.Tx) error {
+		repo := repositories.NewScopeRepository(tx, s.logger)
+		if ifUnmodified {
+			version, err := repo.LockVersion(ctx, scope.ID)
+			if err != nil {
+				return err
+			}
+			if err := checkVersion(version, scope.UpdatedAt); err != nil {
+				return err
+			}
+		}
+		var err error
+		updated, err = repo.Update(ctx, scope)
+		return err
+	})
+	if err != nil {
+		if !isConflict(err) {
+			s.logger.Error().Err(err).Int64("scope_id", scope.ID).Msg("scope update failed")
+		}
+		return nil, err
+	}
+	return updated, nil
+}
+
+// Delete soft-deletes a scope of the orbit. Unknown scopes are ignored.
+func (s *ScopeService) Delete(ctx context.Context, orbitID, id int64) error {
+	ctx, span := s.tracer.Start(ctx, "Delete")
+	defer span.End()
+
+	err := s.db.WithTx(ctx, func(tx pgx.Tx) error {
+		repo := repositories.NewScopeRepository(tx, s.logger)
+		scope, err := repo.GetByID(ctx, id)
+		if err != nil || scope == nil || scope.OrbitID != orbitID {
+			return err
+		}
+		return repo.SoftDelete(ctx, id)
+	})
+	if err != nil {
+		s.logger.Error().Err(err).Int64("scope_id", id).Msg("scope delete failed")
+		return err
+	}
+	return nil
+}
+
+func (s *ScopeService) ListByOrbit(ctx context.Context, orbitID int64, page repositories.Page) ([]*models.Scope, string, error) {
+	ctx, span := s.tracer.Start(ctx, "ListByOrbit")
+	defer span.End()
+
+	list, next, err := repositories.NewScopeRepository(s.db.Exec(), s.logger).ListByOrbit(ctx, orbitID, page)
+	if err != nil {
+		if !errors.Is(err, repositories.ErrInvalidCursor) {
+			s.logger.Error().Err(err).Int64("orbit_id", orbitID).Msg("scope list failed")
+		}
+		return nil, "", err
+	}
+	return list, next, nil
+}
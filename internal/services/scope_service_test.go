@@ -0,0 +1,66 @@
+package services_test
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+)
+
+func TestScopeServiceRoundTrip(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	scopes := services.NewScopeService(dbConn, nop)
+	orbit := fx.Orbit()
+	other := fx.Orbit()
+
+	newScope := func() (*models.Scope, error) {
+		return scopes.Create(ctx, &models.Scope{OrbitID: orbit.ID, Name: "read:invoices", IsActive: true, Metadata: json.RawMessage(`{}`)})
+	}
+	created, err := newScope()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := newScope(); !errors.Is(err, services.ErrAlreadyExists) {
+		t.Fatalf("Create of a duplicate name error = %v, want ErrAlreadyExists", err)
+	}
+	if got, err := scopes.GetByID(ctx, other.ID, created.ID); err != nil || got != nil {
+		t.Fatalf("GetByID from another orbit = %+v, %v, want nil", got, err)
+	}
+
+	first := *created
+	first.Description = "first"
+	if _, err := scopes.UpdateIfUnmodified(ctx, &first); err != nil {
+		t.Fatal(err)
+	}
+	stale := *created
+	stale.Description = "stale"
+	if _, err := scopes.UpdateIfUnmodified(ctx, &stale); !errors.Is(err, services.ErrVersionMismatch) {
+		t.Fatalf("UpdateIfUnmodified(stale) error = %v, want ErrVersionMismatch", err)
+	}
+	if got, err := scopes.GetByID(ctx, orbit.ID, created.ID); err != nil || got == nil || got.Description != "first" {
+		t.Fatalf("GetByID = %+v, %v, want the first update", got, err)
+	}
+
+	if err := scopes.Delete(ctx, other.ID, created.ID); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := scopes.GetByID(ctx, orbit.ID, created.ID); err != nil || got == nil {
+		t.Fatalf("GetByID after a foreign delete = %+v, %v", got, err)
+	}
+	if err := scopes.Delete(ctx, orbit.ID, created.ID); err != nil {
+		t.Fatal(err)
+	}
+	if got, err := scopes.GetByID(ctx, orbit.ID, created.ID); err != nil || got != nil {
+		t.Fatalf("GetByID after delete = %+v, %v, want nil", got, err)
+	}
+	if _, err := newScope(); err != nil {
+		t.Fatalf("Create after delete = %v, want the name to be free again", err)
+	}
+	if list, _, err := scopes.ListByOrbit(ctx, orbit.ID, repositories.Page{}); err != nil || len(list) != 1 {
+		t.Fatalf("ListByOrbit = %d scopes, %v, want 1", len(list), err)
+	}
+}
@@ -0,0 +1,51 @@
+package services
+
+import (
+	"context"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories/db"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type SecurityEventService struct {
+	db     *db.DB
+	logger zerolog.Logger
+	tracer trace.Tracer
+}
+
+func NewSecurityEventService(dbConn *db.DB, logger zerolog.Logger) *SecurityEventService {
+	return &SecurityEventService{
+		db:     dbConn,
+		logger: logger,
+		tracer: otel.Tracer("service.security_event"),
+	}
+}
+
+// Record stores an event outside of any caller transaction so it survives a
+// rollback of the operation that was rejected. Failures are only logged.
+func (s *SecurityEventService) Record(ctx context.Context, orbitID int64, userID *int64, eventType, severity string, metadata map[string]any) {
+	ctx, span := s.tracer.Start(ctx, "Record")
+	defer span.End()
+
+	event := &models.SecurityEvent{
+		OrbitID:   orbitID,
+		UserID:    userID,
+		EventType: eventType,
+		Severity:  severity,
+		Metadata:  metadata,
+	}
+	if _, err := repositories.NewSecurityEventRepository(s.db.Exec(), s.logger).Create(ctx, event); err != nil {
+		s.logger.Error().Err(err).Int64("orbit_id", orbitID).Str("event_type", eventType).Msg("security event record failed")
+	}
+}
+
+func (s *SecurityEventService) ListByOrbit(ctx context.Context, orbitID int64, filter repositories.SecurityEventFilter, page repositories.Page) ([]*models.SecurityEvent, string, error) {
+	ctx, span := s.tracer.Start(ctx, "ListByOrbit")
+	defer span.End()
+
+	return repositories.NewSecurityEventRepository(s.db.Exec(), s.logger).ListByOrbit(ctx, orbitID, filter, page)
+}
@@ -0,0 +1,38 @@
+package services_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/jackc/pgx/v5"
+)
+
+func TestSecurityEventServiceRecordSurvivesRollback(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	events := services.NewSecurityEventService(dbConn, nop)
+	orbit := fx.Orbit()
+	user := fx.User(orbit)
+
+	// The event of a rejected operation is kept although the operation's
+	// transaction rolls back.
+	_ = dbConn.WithTx(ctx, func(tx pgx.Tx) error {
+		events.Record(ctx, orbit.ID, &user.ID, models.SecurityEventRecoveryCodesGenerated, models.SecuritySeverityInfo, map[string]any{"count": 10})
+		return context.Canceled
+	})
+	events.Record(ctx, orbit.ID, nil, models.SecurityEventSoftwareStatementRejected, models.SecuritySeverityWarning, nil)
+
+	list, _, err := events.ListByOrbit(ctx, orbit.ID, repositories.SecurityEventFilter{UserID: &user.ID}, repositories.Page{})
+	if err != nil || len(list) != 1 {
+		t.Fatalf("ListByOrbit = %d events, %v, want 1", len(list), err)
+	}
+	if got := list[0]; got.EventType != models.SecurityEventRecoveryCodesGenerated || got.Metadata["count"] != float64(10) {
+		t.Fatalf("event = %+v", got)
+	}
+	if list, _, err := events.ListByOrbit(ctx, orbit.ID, repositories.SecurityEventFilter{}, repositories.Page{}); err != nil || len(list) != 2 {
+		t.Fatalf("ListByOrbit = %d events, %v, want 2", len(list), err)
+	}
+}
@@ -0,0 +1,287 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var ErrSessionNotFound = errors.New("session not found")
+
+// SessionService manages login sessions within the limits of
+// OrbitConfig.Sessions. Signing a session out also revokes the refresh tokens
+// that were issued within it.
+type SessionService struct {
+	db     *db.DB
+	users  *UserService
+	logger zerolog.Logger
+	tracer trace.Tracer
+}
+
+func NewSessionService(dbConn *db.DB, users *UserService, logger zerolog.Logger) *SessionService {
+	return &SessionService{
+		db:     dbConn,
+		users:  users,
+		logger: logger,
+		tracer: otel.Tracer("service.session"),
+	}
+}
+
+// Start stores a new session and sets its absolute expiry. When the user now
+// has more sessions than the orbit allows, the least recently used ones are
+// signed out.
+func (s *SessionService) Start(ctx context.Context, orbit *models.Orbit, session *models.Session) (*models.Session, error) {
+	ctx, span := s.tracer.Start(ctx, "Start")
+	defer span.End()
+
+	cfg, err := ParseOrbitConfig(orbit)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	if lifetime := cfg.Sessions.Lifetime(); lifetime > 0 {
+		expiresAt := session.StartedAt.Add(lifetime)
+		session.ExpiresAt = &expiresAt
+	}
+
+	var created *models.Session
+	var evicted []int64
+	err = s.db.WithTx(ctx, func(tx pgx.Tx) error {
+		repo := repositories.NewSessionRepository(tx, s.logger)
+		var err error
+		if created, err = repo.Create(ctx, session); err != nil {
+			return err
+		}
+		if cfg.Sessions.MaxPerUser <= 0 {
+			return nil
+		}
+		active, err := repo.ListActiveByUser(ctx, session.UserID, now)
+		if err != nil {
+			return err
+		}
+		kept := 0
+		for _, other := range active {
+			if !s.usable(cfg.Sessions, other, now) {
+				continue
+			}
+			if other.ID == created.ID || kept < cfg.Sessions.MaxPerUser-1 {
+				kept++
+				continue
+			}
+			if err := repo.Revoke(ctx, other.ID); err != nil {
+				return err
+			}
+			evicted = append(evicted, other.ID)
+		}
+		_, err = repositories.NewRefreshTokenRepository(tx, s.logger).RevokeBySessions(ctx, evicted)
+		return err
+	})
+	if err != nil {
+		s.logger.Error().Err(err).Int64("user_id", session.UserID).Msg("session create failed")
+		return nil, err
+	}
+	if len(evicted) > 0 {
+		s.logger.Info().Int64("user_id", session.UserID).Ints64("session_ids", evicted).Msg("session limit reached, oldest sessions revoked")
+	}
+	return created, nil
+}
+
+// usable reports whether the session is within both its absolute and its idle
+// timeout. The absolute timeout is checked against the current configuration
+// too, so shortening it takes effect for sessions that already exist.
+func (s *SessionService) usable(cfg SessionConfig, session *models.Session, now time.Time) bool {
+	if session.Revoked {
+		return false
+	}
+	if session.ExpiresAt != nil && !now.Before(*session.ExpiresAt) {
+		return false
+	}
+	if lifetime := cfg.Lifetime(); lifetime > 0 && !now.Before(session.StartedAt.Add(lifetime)) {
+		return false
+	}
+	if idle := cfg.IdleTimeout(); idle > 0 && !now.Before(session.LastActiveAt.Add(idle)) {
+		return false
+	}
+	return true
+}
+
+// Active loads the session a cookie points to and records it as used.
+// Sessions that are revoked, timed out, belong to another orbit or to a user
+// who can no longer log in are reported as absent. Last activity is written at
+// most once per touch interval, so the idle timeout may run up to that much
+// late.
+func (s *SessionService) Active(ctx context.Context, orbit *models.Orbit, sessionID int64) (*models.Session, *models.User, error) {
+	ctx, span := s.tracer.Start(ctx, "Active")
+	defer span.End()
+
+	cfg, err := ParseOrbitConfig(orbit)
+	if err != nil {
+		return nil, nil, err
+	}
+	repo := repositories.NewSessionRepository(s.db.Exec(), s.logger)
+	session, err := repo.GetByID(ctx, sessionID)
+	if err != nil {
+		return nil, nil, err
+	}
+	now := time.Now().UTC()
+	if session == nil || session.OrbitID != orbit.ID || !s.usable(cfg.Sessions, session, now) {
+		return nil, nil, nil
+	}
+
+	user, err := s.users.GetByID(ctx, session.UserID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if user == nil || !user.IsActive || user.IsLocked || user.DeletedAt != nil {
+		return nil, nil, nil
+	}
+
+	if now.Sub(session.LastActiveAt) >= cfg.Sessions.TouchInterval() {
+		if err := repo.Touch(ctx, session.ID, now); err != nil {
+			return nil, nil, err
+		}
+		session.LastActiveAt = now
+	}
+	return session, user, nil
+}
+
+// ListByUser returns the user's usable sessions, most recently active first.
+func (s *SessionService) ListByUser(ctx context.Context, orbit *models.Orbit, userID int64) ([]*models.Session, error) {
+	ctx, span := s.tracer.Start(ctx, "ListByUser")
+	defer span.End()
+
+	cfg, err := ParseOrbitConfig(orbit)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	list, err := repositories.NewSessionRepository(s.db.Exec(), s.logger).ListActiveByUser(ctx, userID, now)
+	if err != nil {
+		return nil, err
+	}
+	out := list[:0]
+	for _, session := range list {
+		if session.OrbitID == orbit.ID && s.usable(cfg.Sessions, session, now) {
+			out = append(out, session)
+		}
+	}
+	return out, nil
+}
+
+// StepUp records additional methods the user has just proven on the session.
+// auth_time only moves when the factor the session was started with is proven
+// again: a second factor raises the ACR but does not make the original
+// authentication any more recent for max_age.
+func (s *SessionService) StepUp(ctx context.Context, session *models.Session, amr ...string) (*models.Session, error) {
+	ctx, span := s.tracer.Start(ctx, "StepUp")
+	defer span.End()
+
+	current := SessionAuthentication(session)
+	authTime := time.Unix(current.AuthTime, 0)
+	if len(current.AMR) > 0 && containsString(amr, current.AMR[0]) {
+		authTime = time.Now().UTC()
+	}
+	auth := newAuthentication(append(current.AMR, amr...), authTime)
+	if err := setSessionAuthentication(session, auth); err != nil {
+		return nil, err
+	}
+	updated, err := repositories.NewSessionRepository(s.db.Exec(), s.logger).Update(ctx, session)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("session_id", session.ID).Msg("session step-up failed")
+		return nil, err
+	}
+	if updated == nil {
+		return nil, ErrSessionNotFound
+	}
+	s.logger.Info().Int64("session_id", session.ID).Str("acr", auth.ACR).Msg("session stepped up")
+	return updated, nil
+}
+
+// Revoke signs the user out of one of their sessions, for instance one left
+// open on a lost device.
+func (s *SessionService) Revoke(ctx context.Context, orbit *models.Orbit, userID, sessionID int64) error {
+	ctx, span := s.tracer.Start(ctx, "Revoke")
+	defer span.End()
+
+	err := s.db.WithTx(ctx, func(tx pgx.Tx) error {
+		repo := repositories.NewSessionRepository(tx, s.logger)
+		session, err := repo.GetByID(ctx, sessionID)
+		if err != nil {
+			return err
+		}
+		if session == nil || session.Revoked || session.OrbitID != orbit.ID || session.UserID != userID {
+			return ErrSessionNotFound
+		}
+		return s.revoke(ctx, tx, sessionID)
+	})
+	if err != nil {
+		if !errors.Is(err, ErrSessionNotFound) {
+			s.logger.Error().Err(err).Int64("session_id", sessionID).Msg("session revoke failed")
+		}
+		return err
+	}
+	s.logger.Info().Int64("user_id", userID).Int64("session_id", sessionID).Msg("session revoked")
+	return nil
+}
+
+// RevokeAll signs the user out of every session but keepID, which is zero to
+// sign out everywhere, and reports how many sessions were live.
+func (s *SessionService) RevokeAll(ctx context.Context, orbit *models.Orbit, userID, keepID int64) (int64, error) {
+	ctx, span := s.tracer.Start(ctx, "RevokeAll")
+	defer span.End()
+
+	var ids []int64
+	err := s.db.WithTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		if ids, err = repositories.NewSessionRepository(tx, s.logger).RevokeByUserExcept(ctx, userID, keepID); err != nil {
+			return err
+		}
+		_, err = repositories.NewRefreshTokenRepository(tx, s.logger).RevokeBySessions(ctx, ids)
+		return err
+	})
+	if err != nil {
+		s.logger.Error().Err(err).Int64("orbit_id", orbit.ID).Int64("user_id", userID).Msg("session revoke failed")
+		return 0, err
+	}
+	s.logger.Info().Int64("user_id", userID).Int("sessions", len(ids)).Msg("sessions revoked")
+	return int64(len(ids)), nil
+}
+
+// End signs out of the session a cookie points to. Unknown and already
+// revoked sessions are ignored.
+func (s *SessionService) End(ctx context.Context, orbit *models.Orbit, sessionID int64) error {
+	ctx, span := s.tracer.Start(ctx, "End")
+	defer span.End()
+
+	err := s.db.WithTx(ctx, func(tx pgx.Tx) error {
+		session, err := repositories.NewSessionRepository(tx, s.logger).GetByID(ctx, sessionID)
+		if err != nil {
+			return err
+		}
+		if session == nil || session.Revoked || session.OrbitID != orbit.ID {
+			return nil
+		}
+		return s.revoke(ctx, tx, sessionID)
+	})
+	if err != nil {
+		s.logger.Error().Err(err).Int64("session_id", sessionID).Msg("session revoke failed")
+		return err
+	}
+	return nil
+}
+
+func (s *SessionService) revoke(ctx context.Context, tx pgx.Tx, sessionID int64) error {
+	if err := repositories.NewSessionRepository(tx, s.logger).Revoke(ctx, sessionID); err != nil {
+		return err
+	}
+	_, err := repositories.NewRefreshTokenRepository(tx, s.logger).RevokeBySessions(ctx, []int64{sessionID})
+	return err
+}
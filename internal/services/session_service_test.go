@@ -0,0 +1,202 @@
+package services_test
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/testutil"
+)
+
+func TestSessionServiceLimitsAndTimeouts(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	cacheManager, _ := testutil.Redis(t)
+	sessions := services.NewSessionService(dbConn, newUserService(dbConn, cacheManager), nop)
+	tokens := repositories.NewRefreshTokenRepository(dbConn.Exec(), nop)
+	orbit := fx.Orbit(func(o *models.Orbit) {
+		o.Config = json.RawMessage(`{"sessions":{"lifetime_seconds":3600,"idle_timeout_seconds":600,"touch_interval_seconds":60,"max_per_user":2}}`)
+	})
+	client := fx.Client(orbit)
+	user := fx.User(orbit)
+	other := fx.User(orbit)
+
+	now := time.Now().UTC()
+	issued := 0
+	start := func(userID int64, lastActive time.Time) *models.Session {
+		t.Helper()
+		s, err := sessions.Start(ctx, orbit, &models.Session{
+			OrbitID:      orbit.ID,
+			UserID:       userID,
+			ClientID:     &client.ID,
+			StartedAt:    now,
+			LastActiveAt: lastActive,
+			DeviceInfo:   "test",
+			IP:           "192.0.2.1",
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		issued++
+		if _, err := tokens.Create(ctx, &models.RefreshToken{
+			ExpiresAt: now.Add(time.Hour),
+			JTI:       "rt-" + strconv.Itoa(issued),
+			OrbitID:   orbit.ID,
+			ClientID:  client.ID,
+			UserID:    &userID,
+			SessionID: &s.ID,
+		}); err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+	active := func(id int64) bool {
+		t.Helper()
+		s, u, err := sessions.Active(ctx, orbit, id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s != nil && u != nil
+	}
+
+	idle := start(user.ID, now.Add(-time.Hour))
+	if active(idle.ID) {
+		t.Error("session idle past its timeout is active")
+	}
+	if idle.ExpiresAt == nil || !idle.ExpiresAt.Equal(now.Add(time.Hour)) {
+		t.Errorf("ExpiresAt = %v, want the lifetime after the start", idle.ExpiresAt)
+	}
+
+	oldest := start(user.ID, now.Add(-5*time.Minute))
+	if !active(oldest.ID) {
+		t.Fatal("fresh session is not active")
+	}
+	if got, _ := repositories.NewSessionRepository(dbConn.Exec(), nop).GetByID(ctx, oldest.ID); got.LastActiveAt.Before(now) {
+		t.Errorf("LastActiveAt = %v, want it touched", got.LastActiveAt)
+	}
+
+	// The idle session does not count towards the limit, the touched one is
+	// now the most recently used, so the third login evicts the second.
+	second := start(user.ID, now.Add(-time.Minute))
+	third := start(user.ID, now)
+	if !active(oldest.ID) || active(second.ID) || !active(third.ID) {
+		t.Fatal("session limit did not evict the least recently used session")
+	}
+	list, err := sessions.ListByUser(ctx, orbit, user.ID)
+	if err != nil || len(list) != 2 {
+		t.Fatalf("ListByUser = %d sessions, %v, want 2", len(list), err)
+	}
+
+	theirs := start(other.ID, now)
+	if err := sessions.Revoke(ctx, orbit, user.ID, theirs.ID); !errors.Is(err, services.ErrSessionNotFound) {
+		t.Fatalf("Revoke of another user's session error = %v, want ErrSessionNotFound", err)
+	}
+	if err := sessions.Revoke(ctx, orbit, user.ID, third.ID); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := sessions.RevokeAll(ctx, orbit, user.ID, 0); err != nil || n != 2 {
+		t.Fatalf("RevokeAll = %d, %v, want the remaining and the idle session", n, err)
+	}
+	if active(oldest.ID) || !active(theirs.ID) {
+		t.Fatal("RevokeAll signed out the wrong sessions")
+	}
+
+	// Every token of the user went with their sessions; the other user's stayed.
+	if n, err := tokens.RevokeByUser(ctx, user.ID); err != nil || n != 0 {
+		t.Fatalf("live refresh tokens of the user = %d, %v, want 0", n, err)
+	}
+	if n, err := tokens.RevokeByUser(ctx, other.ID); err != nil || n != 1 {
+		t.Fatalf("live refresh tokens of the other user = %d, %v, want 1", n, err)
+	}
+}
+
+func TestSessionServiceStepUpKeepsAuthTime(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	cacheManager, _ := testutil.Redis(t)
+	sessions := services.NewSessionService(dbConn, newUserService(dbConn, cacheManager), nop)
+	orbit := fx.Orbit()
+	user := fx.User(orbit)
+
+	started := time.Now().UTC().Add(-30 * time.Minute).Truncate(time.Second)
+	session, err := sessions.Start(ctx, orbit, &models.Session{
+		OrbitID:      orbit.ID,
+		UserID:       user.ID,
+		StartedAt:    started,
+		LastActiveAt: started,
+		Metadata:     json.RawMessage(`{"acr":"urn:orbitum:acr:sfa","amr":["pwd"],"auth_time":` + strconv.FormatInt(started.Unix(), 10) + `}`),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	session, err = sessions.StepUp(ctx, session, services.AMROTP)
+	if err != nil {
+		t.Fatal(err)
+	}
+	auth := services.SessionAuthentication(session)
+	if auth.ACR != services.ACRMultiFactor || auth.AuthTime != started.Unix() {
+		t.Fatalf("after a second factor acr, auth_time = %q, %d, want mfa at the original time %d", auth.ACR, auth.AuthTime, started.Unix())
+	}
+
+	session, err = sessions.StepUp(ctx, session, services.AMRPassword)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if auth := services.SessionAuthentication(session); auth.AuthTime <= started.Unix() {
+		t.Fatalf("auth_time = %d after the password was proven again, want it moved on", auth.AuthTime)
+	}
+}
+
+func TestSessionServiceStepUpWithRecoveryCode(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	cacheManager, _ := testutil.Redis(t)
+	sessions := services.NewSessionService(dbConn, newUserService(dbConn, cacheManager), nop)
+	orbit := fx.Orbit()
+	user := fx.User(orbit)
+
+	now := time.Now().UTC()
+	session, err := sessions.Start(ctx, orbit, &models.Session{
+		OrbitID:      orbit.ID,
+		UserID:       user.ID,
+		StartedAt:    now,
+		LastActiveAt: now,
+		Metadata:     json.RawMessage(`{"acr":"urn:orbitum:acr:sfa","amr":["pwd"],"auth_time":` + strconv.FormatInt(now.Unix(), 10) + `}`),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	session, err = sessions.StepUp(ctx, session, services.AMRRecoveryCode)
+	if err != nil {
+		t.Fatal(err)
+	}
+	auth := services.SessionAuthentication(session)
+	if auth.ACR != services.ACRMultiFactor || !slices.Equal(auth.AMR, []string{"pwd", "recovery", "mfa"}) {
+		t.Fatalf("after a recovery code acr, amr = %q, %q, want mfa with pwd, recovery, mfa", auth.ACR, auth.AMR)
+	}
+}
+
+func TestSessionComplete(t *testing.T) {
+	started := time.Now().Add(-time.Minute)
+	password := &models.Session{StartedAt: started}
+	stepped := &models.Session{StartedAt: started, Metadata: json.RawMessage(`{"acr":"` + services.ACRMultiFactor + `","amr":["pwd","otp","mfa"]}`)}
+	mfa := &models.User{MFAEnabled: true}
+	plain := &models.User{}
+
+	if services.SessionComplete(password, mfa) {
+		t.Error("a password-only session of an MFA user is complete")
+	}
+	if !services.SessionComplete(stepped, mfa) {
+		t.Error("a stepped-up session of an MFA user is incomplete")
+	}
+	if !services.SessionComplete(password, plain) {
+		t.Error("a password session of a user without MFA is incomplete")
+	}
+}
@@ -0,0 +1,134 @@
+package services
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+)
+
+var (
+	ErrInvalidSoftwareStatement    = errors.New("invalid software statement")
+	ErrUnapprovedSoftwareStatement = errors.New("software statement is not approved")
+)
+
+var softwareStatementAlgs = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.EdDSA,
+}
+
+const softwareStatementLeeway = time.Minute
+
+// softwareStatementClaims are the RFC 7591 section 2.3 claims. Registered
+// client metadata names are read from the same payload.
+type softwareStatementClaims struct {
+	jwt.Claims
+	SoftwareID              string          `json:"software_id"`
+	SoftwareVersion         string          `json:"software_version"`
+	RedirectURIs            []string        `json:"redirect_uris"`
+	PostLogoutRedirectURIs  []string        `json:"post_logout_redirect_uris"`
+	TokenEndpointAuthMethod string          `json:"token_endpoint_auth_method"`
+	GrantTypes              []string        `json:"grant_types"`
+	ResponseTypes           []string        `json:"response_types"`
+	ApplicationType         string          `json:"application_type"`
+	ClientName              string          `json:"client_name"`
+	ClientURI               string          `json:"client_uri"`
+	LogoURI                 string          `json:"logo_uri"`
+	Scope                   string          `json:"scope"`
+	Contacts                []string        `json:"contacts"`
+	JWKS                    json.RawMessage `json:"jwks"`
+	JWKSURI                 string          `json:"jwks_uri"`
+}
+
+// verifySoftwareStatement checks the statement signature against the keys of
+// the directory named by its iss claim and returns the verified claims.
+func verifySoftwareStatement(raw string, directories []TrustedDirectory, now time.Time) (*softwareStatementClaims, error) {
+	token, err := jwt.ParseSigned(raw, softwareStatementAlgs)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSoftwareStatement, err)
+	}
+
+	var unverified jwt.Claims
+	if err := token.UnsafeClaimsWithoutVerification(&unverified); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSoftwareStatement, err)
+	}
+	var directory *TrustedDirectory
+	for i := range directories {
+		if directories[i].Issuer == unverified.Issuer {
+			directory = &directories[i]
+			break
+		}
+	}
+	if unverified.Issuer == "" || directory == nil {
+		return nil, fmt.Errorf("%w: issuer %q is not trusted", ErrUnapprovedSoftwareStatement, unverified.Issuer)
+	}
+
+	var keys jose.JSONWebKeySet
+	if err := json.Unmarshal(directory.JWKS, &keys); err != nil {
+		return nil, fmt.Errorf("directory %q has malformed jwks: %w", directory.Issuer, err)
+	}
+
+	claims := &softwareStatementClaims{}
+	if err := token.Claims(&keys, claims); err != nil {
+		return nil, fmt.Errorf("%w: signature verification failed", ErrInvalidSoftwareStatement)
+	}
+	if err := claims.ValidateWithLeeway(jwt.Expected{Issuer: directory.Issuer, Time: now}, softwareStatementLeeway); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidSoftwareStatement, err)
+	}
+	return claims, nil
+}
+
+// applyTo overrides client supplied metadata with every value the statement
+// asserts, as required by RFC 7591 section 2.3.
+func (c *softwareStatementClaims) applyTo(md ClientMetadata) ClientMetadata {
+	if c.RedirectURIs != nil {
+		md.RedirectURIs = c.RedirectURIs
+	}
+	if c.PostLogoutRedirectURIs != nil {
+		md.PostLogoutRedirectURIs = c.PostLogoutRedirectURIs
+	}
+	if c.TokenEndpointAuthMethod != "" {
+		md.TokenEndpointAuthMethod = c.TokenEndpointAuthMethod
+	}
+	if c.GrantTypes != nil {
+		md.GrantTypes = c.GrantTypes
+	}
+	if c.ResponseTypes != nil {
+		md.ResponseTypes = c.ResponseTypes
+	}
+	if c.ApplicationType != "" {
+		md.ApplicationType = c.ApplicationType
+	}
+	if c.ClientName != "" {
+		md.ClientName = c.ClientName
+	}
+	if c.ClientURI != "" {
+		md.ClientURI = c.ClientURI
+	}
+	if c.LogoURI != "" {
+		md.LogoURI = c.LogoURI
+	}
+	if c.Scope != "" {
+		md.Scope = c.Scope
+	}
+	if c.Contacts != nil {
+		md.Contacts = c.Contacts
+	}
+	if len(c.JWKS) > 0 && string(c.JWKS) != "null" {
+		md.JWKS = c.JWKS
+		md.JWKSURI = ""
+	}
+	if c.JWKSURI != "" {
+		md.JWKSURI = c.JWKSURI
+		md.JWKS = nil
+	}
+	md.SoftwareID = c.SoftwareID
+	md.SoftwareVersion = c.SoftwareVersion
+	md.SoftwareStatementIssuer = c.Issuer
+	return md
+}
@@ -0,0 +1,658 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories/db"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	ErrInvalidGrant         = errors.New("grant is invalid, expired or revoked")
+	ErrUnsupportedGrantType = errors.New("unsupported grant type")
+	ErrUnauthorizedClient   = errors.New("client is not allowed to use this grant type")
+	ErrInvalidIDTokenHint   = errors.New("id_token_hint is not an id token of this orbit")
+)
+
+// accessTokenAlgs are the algorithms JWKService.Generate creates keys for.
+var accessTokenAlgs = []jose.SignatureAlgorithm{jose.ES256, jose.RS256}
+
+// TokenRequest is a token endpoint request of an authenticated client. Scopes
+// and Resources are nil when the request does not name any.
+type TokenRequest struct {
+	Client       *models.Client
+	GrantType    string
+	Code         string
+	RedirectURI  string
+	CodeVerifier string
+	RefreshToken string
+	Scopes       []string
+	Resources    []string
+}
+
+// TokenResponse holds the tokens of a successful token request.
+type TokenResponse struct {
+	AccessToken  string
+	RefreshToken string
+	IDToken      string
+	ExpiresIn    int
+	Scopes       []string
+}
+
+// Introspection is what introspection reveals about an active token.
+type Introspection struct {
+	TokenType string
+	OrbitID   int64
+	ClientID  int64
+	UserID    *int64
+	Scopes    []string
+	Audience  []string
+	JTI       string
+	IssuedAt  time.Time
+	ExpiresAt time.Time
+}
+
+// accessTokenClaims are the claims of JWT access tokens, following RFC 9068.
+type accessTokenClaims struct {
+	jwt.Claims
+	ClientID string `json:"client_id"`
+	Scope    string `json:"scope,omitempty"`
+}
+
+// idTokenClaims are the claims of ID tokens (OpenID Connect Core section 2).
+// The authentication is the one of the session the grant was made in.
+type idTokenClaims struct {
+	jwt.Claims
+	Authentication
+	AuthorizedParty string `json:"azp"`
+	Nonce           string `json:"nonce,omitempty"`
+}
+
+// TokenService implements the token and introspection endpoints. Access
+// tokens are restricted to the resource servers of the grant, see
+// ResourceServerService.ResolveAudience, and minted as JWTs or as opaque
+// values as those servers require. Opaque tokens and refresh tokens are
+// stored by their hash, so the database never holds a usable token.
+type TokenService struct {
+	db        *db.DB
+	users     *UserService
+	authCodes *AuthCodeService
+	access    *AccessTokenService
+	resources *ResourceServerService
+	keys      *JWKService
+	roles     *RoleService
+	logger    zerolog.Logger
+	tracer    trace.Tracer
+}
+
+func NewTokenService(dbConn *db.DB, users *UserService, authCodes *AuthCodeService, access *AccessTokenService, resources *ResourceServerService, keys *JWKService, roles *RoleService, logger zerolog.Logger) *TokenService {
+	return &TokenService{
+		db:        dbConn,
+		users:     users,
+		authCodes: authCodes,
+		access:    access,
+		resources: resources,
+		keys:      keys,
+		roles:     roles,
+		logger:    logger,
+		tracer:    otel.Tracer("service.token"),
+	}
+}
+
+// Exchange answers a token request.
+func (s *TokenService) Exchange(ctx context.Context, orbit *models.Orbit, req *TokenRequest) (*TokenResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "Exchange")
+	defer span.End()
+
+	if !containsString(clientGrantTypes(req.Client), req.GrantType) {
+		switch req.GrantType {
+		case GrantTypeAuthorizationCode, GrantTypeRefreshToken, GrantTypeClientCredentials:
+			return nil, ErrUnauthorizedClient
+		}
+		return nil, ErrUnsupportedGrantType
+	}
+	switch req.GrantType {
+	case GrantTypeAuthorizationCode:
+		return s.exchangeCode(ctx, orbit, req)
+	case GrantTypeRefreshToken:
+		return s.refresh(ctx, orbit, req)
+	case GrantTypeClientCredentials:
+		return s.clientCredentials(ctx, orbit, req)
+	}
+	return nil, ErrUnsupportedGrantType
+}
+
+// clientGrantTypes defaults to the authorization code grant, as RFC 7591
+// does for clients registered without grant_types.
+func clientGrantTypes(c *models.Client) []string {
+	if types := decodeStringList(c.GrantTypes); len(types) > 0 {
+		return types
+	}
+	return []string{GrantTypeAuthorizationCode}
+}
+
+// grant is what a token request is entitled to once it has been checked.
+type grant struct {
+	orbit     *models.Orbit
+	client    *models.Client
+	user      *models.User
+	sessionID *int64
+	// auth is how the user authenticated for the grant, carried into ID
+	// tokens; nonce is only set for code exchanges.
+	auth   *Authentication
+	nonce  string
+	scopes []string
+	// resources are all resources of the grant; a request may narrow the
+	// audience of one access token to some of them.
+	resources []string
+	audience  []string
+}
+
+func (s *TokenService) exchangeCode(ctx context.Context, orbit *models.Orbit, req *TokenRequest) (*TokenResponse, error) {
+	code, err := s.authCodes.Consume(ctx, req.Code)
+	if errors.Is(err, ErrAuthCodeNotFound) || errors.Is(err, ErrAuthCodeAlreadyUsed) {
+		return nil, ErrInvalidGrant
+	}
+	if err != nil {
+		return nil, err
+	}
+	if code.OrbitID != orbit.ID || code.ClientID != req.Client.ID || code.UserID == nil || !time.Now().Before(code.ExpiresAt) {
+		return nil, ErrInvalidGrant
+	}
+	// RFC 6749 section 4.1.3: redirect_uri must be repeated exactly if the
+	// authorization request sent it, and may be left out otherwise.
+	if req.RedirectURI != code.RedirectURI && (req.RedirectURI != "" || CodeRedirectURISent(code)) {
+		return nil, fmt.Errorf("%w: redirect_uri does not match the authorization request", ErrInvalidGrant)
+	}
+	if req.Client.IsPublic && code.CodeChallenge == "" {
+		return nil, fmt.Errorf("%w: public clients must use PKCE", ErrInvalidGrant)
+	}
+	if err := verifyCodeChallenge(code, req.CodeVerifier); err != nil {
+		return nil, err
+	}
+
+	auth, err := CodeAuthentication(code)
+	if err != nil {
+		return nil, err
+	}
+	g := &grant{
+		orbit:     orbit,
+		client:    req.Client,
+		sessionID: CodeSessionID(code),
+		auth:      &auth,
+		nonce:     CodeNonce(code),
+		scopes:    decodeStringList(code.Scope),
+		resources: decodeStringList(code.Resources),
+	}
+	if g.user, err = s.activeUser(ctx, orbit, *code.UserID); err != nil {
+		return nil, err
+	}
+	if g.sessionID != nil {
+		// A code redeemed after its session was signed out must not outlive
+		// the sign-out as a refresh token.
+		session, err := repositories.NewSessionRepository(s.db.Exec(), s.logger).GetByID(ctx, *g.sessionID)
+		if err != nil {
+			return nil, err
+		}
+		if session == nil || session.Revoked {
+			return nil, fmt.Errorf("%w: the session has ended", ErrInvalidGrant)
+		}
+	}
+	if g.audience, err = NarrowResources(g.resources, req.Resources); err != nil {
+		return nil, err
+	}
+	return s.issue(ctx, g, nil)
+}
+
+// verifyCodeChallenge checks the PKCE code verifier (RFC 7636 section 4.6).
+func verifyCodeChallenge(code *models.AuthCode, verifier string) error {
+	if code.CodeChallenge == "" {
+		if verifier != "" {
+			return fmt.Errorf("%w: code_verifier sent for a request without code_challenge", ErrInvalidGrant)
+		}
+		return nil
+	}
+	computed := verifier
+	if code.CodeChallengeMethod == "S256" {
+		sum := sha256.Sum256([]byte(verifier))
+		computed = base64.RawURLEncoding.EncodeToString(sum[:])
+	}
+	if verifier == "" || subtle.ConstantTimeCompare([]byte(computed), []byte(code.CodeChallenge)) != 1 {
+		return fmt.Errorf("%w: code_verifier does not match the code_challenge", ErrInvalidGrant)
+	}
+	return nil
+}
+
+func (s *TokenService) refresh(ctx context.Context, orbit *models.Orbit, req *TokenRequest) (*TokenResponse, error) {
+	var out *TokenResponse
+	err := s.db.WithTx(ctx, func(tx pgx.Tx) error {
+		repo := repositories.NewRefreshTokenRepository(tx, s.logger)
+		old, err := repo.LockByJTI(ctx, hashToken(req.RefreshToken))
+		if err != nil {
+			return err
+		}
+		if old == nil || old.OrbitID != orbit.ID || old.ClientID != req.Client.ID || old.UserID == nil || !time.Now().Before(old.ExpiresAt) {
+			return ErrInvalidGrant
+		}
+		if old.Revoked || old.RotatedToID != nil {
+			return ErrInvalidGrant
+		}
+
+		g := &grant{
+			orbit:     orbit,
+			client:    req.Client,
+			sessionID: old.SessionID,
+			auth:      refreshAuthentication(old),
+			scopes:    decodeStringList(old.Scopes),
+			resources: decodeStringList(old.Resources),
+		}
+		if req.Scopes != nil {
+			for _, scope := range req.Scopes {
+				if !containsString(g.scopes, scope) {
+					return fmt.Errorf("%w: %q was not granted", ErrInvalidScope, scope)
+				}
+			}
+			g.scopes = req.Scopes
+		}
+		if g.user, err = s.activeUser(ctx, orbit, *old.UserID); err != nil {
+			return err
+		}
+		if g.audience, err = NarrowResources(g.resources, req.Resources); err != nil {
+			return err
+		}
+		out, err = s.issueTx(ctx, tx, g, old)
+		return err
+	})
+	if errors.Is(err, ErrInvalidGrant) {
+		s.revokeReplayed(ctx, req.RefreshToken)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// revokeReplayed handles a refresh token presented after it was rotated or
+// revoked. Only a thief or a client that lost a race holds such a token, so
+// every token of the session, or of the user at the client when there is no
+// session, is revoked (RFC 9700 section 4.14.2).
+func (s *TokenService) revokeReplayed(ctx context.Context, token string) {
+	repo := repositories.NewRefreshTokenRepository(s.db.Exec(), s.logger)
+	rt, err := repo.GetByJTI(ctx, hashToken(token))
+	if err != nil || rt == nil || rt.UserID == nil || !rt.Revoked && rt.RotatedToID == nil {
+		return
+	}
+	var n int64
+	if rt.SessionID != nil {
+		n, err = repo.RevokeBySessions(ctx, []int64{*rt.SessionID})
+	} else {
+		n, err = repo.RevokeByUserAndClient(ctx, *rt.UserID, rt.ClientID)
+	}
+	if err != nil {
+		s.logger.Error().Err(err).Int64("refresh_token_id", rt.ID).Msg("revoking replayed refresh token family failed")
+		return
+	}
+	s.logger.Warn().Int64("refresh_token_id", rt.ID).Int64("user_id", *rt.UserID).Int64("revoked", n).Msg("refresh token replayed, token family revoked")
+}
+
+func (s *TokenService) clientCredentials(ctx context.Context, orbit *models.Orbit, req *TokenRequest) (*TokenResponse, error) {
+	if req.Client.IsPublic {
+		return nil, ErrUnauthorizedClient
+	}
+	if err := CheckScopes(req.Client, req.Scopes); err != nil {
+		return nil, err
+	}
+	g := &grant{
+		orbit:     orbit,
+		client:    req.Client,
+		scopes:    req.Scopes,
+		resources: req.Resources,
+		audience:  req.Resources,
+	}
+	return s.issue(ctx, g, nil)
+}
+
+// activeUser loads the user a grant was issued to, who must still be able to
+// sign in.
+func (s *TokenService) activeUser(ctx context.Context, orbit *models.Orbit, userID int64) (*models.User, error) {
+	user, err := s.users.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil || user.OrbitID != orbit.ID || !user.IsActive || user.IsLocked || user.DeletedAt != nil {
+		return nil, fmt.Errorf("%w: the user can no longer sign in", ErrInvalidGrant)
+	}
+	return user, nil
+}
+
+func (s *TokenService) issue(ctx context.Context, g *grant, rotated *models.RefreshToken) (*TokenResponse, error) {
+	var out *TokenResponse
+	err := s.db.WithTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		out, err = s.issueTx(ctx, tx, g, rotated)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// issueTx mints the access token of a grant and, for grants of a user to a
+// client that may refresh, a refresh token that replaces rotated.
+func (s *TokenService) issueTx(ctx context.Context, tx pgx.Tx, g *grant, rotated *models.RefreshToken) (*TokenResponse, error) {
+	cfg, err := ParseOrbitConfig(g.orbit)
+	if err != nil {
+		return nil, err
+	}
+	audience, err := s.resources.ResolveAudience(ctx, g.orbit.ID, g.audience, g.scopes)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	out := &TokenResponse{Scopes: audience.Scopes}
+	var refresh *models.RefreshToken
+	if g.user != nil && containsString(clientGrantTypes(g.client), GrantTypeRefreshToken) {
+		if out.RefreshToken, err = newOpaqueToken(32); err != nil {
+			return nil, err
+		}
+		refresh = &models.RefreshToken{
+			ExpiresAt: now.Add(cfg.Tokens.RefreshTokenTTL()),
+			JTI:       hashToken(out.RefreshToken),
+			OrbitID:   g.orbit.ID,
+			ClientID:  g.client.ID,
+			UserID:    &g.user.ID,
+			SessionID: g.sessionID,
+			Scopes:    encodeStringList(g.scopes),
+			Resources: encodeStringList(g.resources),
+			CreatedAt: now,
+		}
+		if g.auth != nil {
+			if refresh.Metadata, err = json.Marshal(g.auth); err != nil {
+				return nil, err
+			}
+		}
+		repo := repositories.NewRefreshTokenRepository(tx, s.logger)
+		if rotated != nil {
+			// Rotation keeps the grant from being extended indefinitely.
+			refresh.ExpiresAt = rotated.ExpiresAt
+			refresh.RotatedFromID = &rotated.ID
+			// The replaced token keeps the scopes it was granted, so a
+			// narrowed refresh does not shrink the grant for good.
+			refresh.Scopes = rotated.Scopes
+		}
+		if refresh, err = repo.Create(ctx, refresh); err != nil {
+			return nil, err
+		}
+		if rotated != nil {
+			if err := repo.Rotate(ctx, rotated.ID, refresh.ID); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	token := &models.AccessToken{
+		OrbitID:   g.orbit.ID,
+		ClientID:  g.client.ID,
+		IssuedAt:  now,
+		ExpiresAt: now.Add(cfg.Tokens.AccessTokenTTL()),
+		TokenType: "Bearer",
+	}
+	if g.user != nil {
+		token.UserID = &g.user.ID
+	}
+	if refresh != nil {
+		token.RefreshTokenID = &refresh.ID
+	}
+	audience.Apply(token)
+	if out.AccessToken, err = s.mintAccessToken(ctx, g, token, audience); err != nil {
+		return nil, err
+	}
+	if _, err := repositories.NewAccessTokenRepository(tx, s.logger).Create(ctx, token); err != nil {
+		return nil, err
+	}
+	out.ExpiresIn = int(token.ExpiresAt.Sub(now) / time.Second)
+
+	if g.user != nil && containsString(g.scopes, "openid") {
+		if out.IDToken, err = s.mintIDToken(ctx, g, now, now.Add(cfg.Tokens.IDTokenTTL())); err != nil {
+			return nil, err
+		}
+	}
+	return out, nil
+}
+
+// refreshAuthentication reads the authentication a refresh token was issued
+// for, so ID tokens minted on refresh keep the original auth_time, acr and amr.
+func refreshAuthentication(rt *models.RefreshToken) *Authentication {
+	var a Authentication
+	if len(rt.Metadata) == 0 || json.Unmarshal(rt.Metadata, &a) != nil || a.AuthTime == 0 {
+		return nil
+	}
+	return &a
+}
+
+// mintIDToken signs the ID token of a grant to a user. Its audience is the
+// client, whatever resources the access token is for.
+func (s *TokenService) mintIDToken(ctx context.Context, g *grant, issuedAt, expiresAt time.Time) (string, error) {
+	claims := idTokenClaims{
+		Claims: jwt.Claims{
+			Issuer:   issuerOf(g.orbit),
+			Subject:  subjectOf(g),
+			Audience: jwt.Audience{g.client.ClientID},
+			Expiry:   jwt.NewNumericDate(expiresAt),
+			IssuedAt: jwt.NewNumericDate(issuedAt),
+		},
+		AuthorizedParty: g.client.ClientID,
+		Nonce:           g.nonce,
+	}
+	if g.auth != nil {
+		claims.Authentication = *g.auth
+	}
+	return s.sign(ctx, g, claims, TokenTypeID, []string{g.client.ClientID})
+}
+
+// sign signs claims with the orbit's current key, together with the claims
+// the client's claim mappers add for the user and audience.
+func (s *TokenService) sign(ctx context.Context, g *grant, claims any, tokenType string, audience []string) (string, error) {
+	signer, err := s.keys.Signer(ctx, g.orbit.ID)
+	if err != nil {
+		return "", err
+	}
+	builder := jwt.Signed(signer).Claims(claims)
+	if g.user != nil {
+		mapped, err := s.roles.TokenClaims(ctx, g.client, g.user.ID, tokenType, audience)
+		if err != nil {
+			return "", err
+		}
+		if len(mapped) > 0 {
+			builder = builder.Claims(mapped)
+		}
+	}
+	return builder.Serialize()
+}
+
+// mintAccessToken fills in the jti of token and returns the value handed to
+// the client: a signed JWT, or for opaque resource servers a random value
+// whose hash is the jti.
+func (s *TokenService) mintAccessToken(ctx context.Context, g *grant, token *models.AccessToken, audience *AudienceGrant) (string, error) {
+	if !token.IsJWT {
+		value, err := newOpaqueToken(32)
+		if err != nil {
+			return "", err
+		}
+		token.JTI = hashToken(value)
+		return value, nil
+	}
+
+	jti, err := newOpaqueToken(16)
+	if err != nil {
+		return "", err
+	}
+	token.JTI = jti
+	claims := accessTokenClaims{
+		Claims: jwt.Claims{
+			Issuer:   issuerOf(g.orbit),
+			Subject:  subjectOf(g),
+			Audience: jwt.Audience(audience.Audience),
+			Expiry:   jwt.NewNumericDate(token.ExpiresAt),
+			IssuedAt: jwt.NewNumericDate(token.IssuedAt),
+			ID:       jti,
+		},
+		ClientID: g.client.ClientID,
+		Scope:    strings.Join(audience.Scopes, " "),
+	}
+	return s.sign(ctx, g, claims, TokenTypeAccess, audience.Audience)
+}
+
+func issuerOf(orbit *models.Orbit) string {
+	return strings.TrimRight(orbit.Issuer, "/")
+}
+
+// subjectOf is the user id, or the client id for tokens a client obtained
+// for itself.
+func subjectOf(g *grant) string {
+	if g.user != nil {
+		return strconv.FormatInt(g.user.ID, 10)
+	}
+	return g.client.ClientID
+}
+
+// Introspect reports on an access or refresh token of the orbit (RFC 7662).
+// It returns nil for tokens that are unknown, expired, revoked or, when
+// resource is set, not issued for that resource.
+func (s *TokenService) Introspect(ctx context.Context, orbit *models.Orbit, token, resource string) (*Introspection, error) {
+	ctx, span := s.tracer.Start(ctx, "Introspect")
+	defer span.End()
+
+	now := time.Now()
+	jti := hashToken(token)
+	if strings.Count(token, ".") == 2 {
+		if jti = s.verifiedJTI(ctx, orbit, token); jti == "" {
+			return nil, nil
+		}
+	}
+
+	var at *models.AccessToken
+	var active bool
+	var err error
+	if resource != "" {
+		at, active, err = s.access.IntrospectForResource(ctx, jti, resource)
+		if errors.Is(err, ErrAudienceMismatch) {
+			return nil, nil
+		}
+	} else {
+		at, active, err = s.access.Introspect(ctx, jti)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if at != nil {
+		if !active || at.OrbitID != orbit.ID || !now.Before(at.ExpiresAt) {
+			return nil, nil
+		}
+		return &Introspection{
+			TokenType: "access_token",
+			OrbitID:   at.OrbitID,
+			ClientID:  at.ClientID,
+			UserID:    at.UserID,
+			Scopes:    decodeStringList(at.Scope),
+			Audience:  decodeStringList(at.Audience),
+			JTI:       at.JTI,
+			IssuedAt:  at.IssuedAt,
+			ExpiresAt: at.ExpiresAt,
+		}, nil
+	}
+
+	// Refresh tokens are only ever presented by the client, which has no
+	// resource to name.
+	if resource != "" {
+		return nil, nil
+	}
+	rt, err := repositories.NewRefreshTokenRepository(s.db.Exec(), s.logger).GetByJTI(ctx, jti)
+	if err != nil {
+		return nil, err
+	}
+	if rt == nil || rt.Revoked || rt.RotatedToID != nil || rt.OrbitID != orbit.ID || !now.Before(rt.ExpiresAt) {
+		return nil, nil
+	}
+	return &Introspection{
+		TokenType: "refresh_token",
+		OrbitID:   rt.OrbitID,
+		ClientID:  rt.ClientID,
+		UserID:    rt.UserID,
+		Scopes:    decodeStringList(rt.Scopes),
+		Audience:  decodeStringList(rt.Resources),
+		IssuedAt:  rt.CreatedAt,
+		ExpiresAt: rt.ExpiresAt,
+	}, nil
+}
+
+// IDTokenHint is who an ID token sent back as id_token_hint was issued to.
+type IDTokenHint struct {
+	UserID   int64
+	ClientID string
+}
+
+// VerifyIDTokenHint checks an ID token the orbit signed, as a client sends it
+// back in id_token_hint. Expired tokens are accepted: the hint names the user
+// whose session ends rather than authenticating anyone (OpenID Connect
+// RP-Initiated Logout section 2).
+func (s *TokenService) VerifyIDTokenHint(ctx context.Context, orbit *models.Orbit, token string) (*IDTokenHint, error) {
+	ctx, span := s.tracer.Start(ctx, "VerifyIDTokenHint")
+	defer span.End()
+
+	parsed, err := jwt.ParseSigned(token, accessTokenAlgs)
+	if err != nil {
+		return nil, ErrInvalidIDTokenHint
+	}
+	keys, err := s.keys.PublicKeys(ctx, orbit.ID)
+	if err != nil {
+		return nil, err
+	}
+	var claims idTokenClaims
+	if err := parsed.Claims(keys, &claims); err != nil || claims.Issuer != issuerOf(orbit) {
+		return nil, ErrInvalidIDTokenHint
+	}
+	// Access tokens carry no azp, and client tokens no user subject.
+	userID, err := strconv.ParseInt(claims.Subject, 10, 64)
+	if err != nil || claims.AuthorizedParty == "" || !claims.Audience.Contains(claims.AuthorizedParty) {
+		return nil, ErrInvalidIDTokenHint
+	}
+	return &IDTokenHint{UserID: userID, ClientID: claims.AuthorizedParty}, nil
+}
+
+// verifiedJTI returns the jti of a JWT access token signed by the orbit, or
+// an empty string when its signature or issuer does not check out.
+func (s *TokenService) verifiedJTI(ctx context.Context, orbit *models.Orbit, token string) string {
+	parsed, err := jwt.ParseSigned(token, accessTokenAlgs)
+	if err != nil {
+		return ""
+	}
+	keys, err := s.keys.PublicKeys(ctx, orbit.ID)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("orbit_id", orbit.ID).Msg("public keys unavailable for introspection")
+		return ""
+	}
+	var claims jwt.Claims
+	if err := parsed.Claims(keys, &claims); err != nil || claims.Issuer != issuerOf(orbit) {
+		return ""
+	}
+	return claims.ID
+}
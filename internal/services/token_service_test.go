@@ -0,0 +1,516 @@
+package services_test
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/testutil"
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+)
+
+func TestResourceServerServiceResolveAudience(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	cacheManager, _ := testutil.Redis(t)
+	resources := services.NewResourceServerService(dbConn, cacheManager, nop)
+	orbit := fx.Orbit()
+
+	for _, rs := range []*models.ResourceServer{
+		{Identifier: "https://orders.example.test", AllowedScopes: json.RawMessage(`["orders:read"]`), TokenFormat: models.TokenFormatJWT, AccessTokenTTL: 300, IsActive: true},
+		{Identifier: "https://billing.example.test", AllowedScopes: json.RawMessage(`["billing:read"]`), TokenFormat: models.TokenFormatJWT, AccessTokenTTL: 600, IsActive: true},
+		{Identifier: "https://ledger.example.test", AllowedScopes: json.RawMessage(`[]`), TokenFormat: models.TokenFormatOpaque, IsActive: true},
+		{Identifier: "https://retired.example.test", AllowedScopes: json.RawMessage(`[]`), TokenFormat: models.TokenFormatJWT},
+	} {
+		rs.OrbitID = orbit.ID
+		rs.Name = rs.Identifier
+		if _, err := resources.Create(ctx, rs); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	for name, requested := range map[string][]string{
+		"unknown":       {"https://unknown.example.test"},
+		"inactive":      {"https://retired.example.test"},
+		"fragment":      {"https://orders.example.test#x"},
+		"format clash":  {"https://orders.example.test", "https://ledger.example.test"},
+		"one of many":   {"https://orders.example.test", "https://unknown.example.test"},
+		"not absolute":  {"orders"},
+		"relative path": {"/orders"},
+	} {
+		if _, err := resources.ResolveAudience(ctx, orbit.ID, requested, nil); !errors.Is(err, services.ErrInvalidTarget) {
+			t.Errorf("%s: ResolveAudience error = %v, want ErrInvalidTarget", name, err)
+		}
+	}
+
+	grant, err := resources.ResolveAudience(ctx, orbit.ID,
+		[]string{"https://orders.example.test", "https://billing.example.test"},
+		[]string{"openid", "orders:read", "billing:read", "admin"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(grant.Audience, []string{"https://orders.example.test", "https://billing.example.test"}) {
+		t.Errorf("Audience = %v", grant.Audience)
+	}
+	if !slices.Equal(grant.Scopes, []string{"openid", "orders:read", "billing:read"}) {
+		t.Errorf("Scopes = %v, want the scopes no resource accepts dropped", grant.Scopes)
+	}
+	if grant.TTL != 300*time.Second || grant.TokenFormat != models.TokenFormatJWT {
+		t.Errorf("TTL, TokenFormat = %v, %v, want the shortest TTL of the jwt servers", grant.TTL, grant.TokenFormat)
+	}
+
+	granted := []string{"https://orders.example.test", "https://billing.example.test"}
+	if got, err := services.NarrowResources(granted, []string{"https://billing.example.test"}); err != nil || !slices.Equal(got, []string{"https://billing.example.test"}) {
+		t.Errorf("NarrowResources = %v, %v, want billing alone", got, err)
+	}
+	if got, err := services.NarrowResources(granted, nil); err != nil || !slices.Equal(got, granted) {
+		t.Errorf("NarrowResources(nil) = %v, %v, want the whole grant", got, err)
+	}
+	if _, err := services.NarrowResources(granted, []string{"https://ledger.example.test"}); !errors.Is(err, services.ErrInvalidTarget) {
+		t.Errorf("NarrowResources beyond the grant error = %v, want ErrInvalidTarget", err)
+	}
+}
+
+func TestTokenServiceAudienceRestrictedTokens(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	cacheManager, _ := testutil.Redis(t)
+	secretCipher, err := services.NewAESGCMCipher(testutil.EncryptionKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	authCodes := services.NewAuthCodeService(dbConn, cacheManager, nop)
+	resources := services.NewResourceServerService(dbConn, cacheManager, nop)
+	keys := services.NewJWKService(dbConn, cacheManager, secretCipher, nop)
+	tokens := services.NewTokenService(dbConn, newUserService(dbConn, cacheManager), authCodes,
+		services.NewAccessTokenService(dbConn, cacheManager, nop), resources, keys, services.NewRoleService(dbConn, cacheManager, nop), nop)
+
+	orbit := fx.Orbit()
+	fx.Key(orbit)
+	client := fx.Client(orbit)
+	user := fx.User(orbit)
+	for _, rs := range []*models.ResourceServer{
+		{Identifier: "https://orders.example.test", AllowedScopes: json.RawMessage(`["orders:read"]`), TokenFormat: models.TokenFormatJWT, AccessTokenTTL: 300, IsActive: true},
+		{Identifier: "https://billing.example.test", AllowedScopes: json.RawMessage(`["billing:read"]`), TokenFormat: models.TokenFormatJWT, IsActive: true},
+	} {
+		rs.OrbitID = orbit.ID
+		rs.Name = rs.Identifier
+		if _, err := resources.Create(ctx, rs); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	verifier := "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"
+	sum := sha256.Sum256([]byte(verifier))
+	now := time.Now().UTC()
+	code, err := authCodes.Create(ctx, &models.AuthCode{
+		Code:                "code-" + t.Name(),
+		OrbitID:             orbit.ID,
+		ClientID:            client.ID,
+		UserID:              &user.ID,
+		RedirectURI:         "https://app.example.test/callback",
+		Scope:               json.RawMessage(`["openid","orders:read","billing:read"]`),
+		Resources:           json.RawMessage(`["https://orders.example.test","https://billing.example.test"]`),
+		CodeChallenge:       base64.RawURLEncoding.EncodeToString(sum[:]),
+		CodeChallengeMethod: "S256",
+		CreatedAt:           now,
+		ExpiresAt:           now.Add(time.Minute),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	exchange := &services.TokenRequest{
+		Client:       client,
+		GrantType:    services.GrantTypeAuthorizationCode,
+		Code:         code.Code,
+		RedirectURI:  code.RedirectURI,
+		CodeVerifier: verifier,
+		Resources:    []string{"https://orders.example.test"},
+	}
+	out, err := tokens.Exchange(ctx, orbit, exchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out.RefreshToken == "" || out.ExpiresIn > 300 {
+		t.Errorf("RefreshToken, ExpiresIn = %q, %d, want a refresh token and the resource TTL", out.RefreshToken, out.ExpiresIn)
+	}
+
+	parsed, err := jwt.ParseSigned(out.AccessToken, []jose.SignatureAlgorithm{jose.ES256})
+	if err != nil {
+		t.Fatal(err)
+	}
+	set, err := keys.PublicKeys(ctx, orbit.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var claims struct {
+		jwt.Claims
+		Scope string `json:"scope"`
+	}
+	if err := parsed.Claims(set, &claims); err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal([]string(claims.Audience), []string{"https://orders.example.test"}) || claims.Scope != "openid orders:read" {
+		t.Errorf("aud, scope = %v, %q, want the token narrowed to orders", claims.Audience, claims.Scope)
+	}
+
+	for resource, want := range map[string]bool{"": true, "https://orders.example.test": true, "https://billing.example.test": false} {
+		info, err := tokens.Introspect(ctx, orbit, out.AccessToken, resource)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if (info != nil) != want {
+			t.Errorf("Introspect for %q active = %v, want %v", resource, info != nil, want)
+		}
+	}
+	if _, err := tokens.Exchange(ctx, orbit, exchange); !errors.Is(err, services.ErrInvalidGrant) {
+		t.Fatalf("second code exchange error = %v, want ErrInvalidGrant", err)
+	}
+
+	refresh := &services.TokenRequest{
+		Client:       client,
+		GrantType:    services.GrantTypeRefreshToken,
+		RefreshToken: out.RefreshToken,
+		Resources:    []string{"https://unknown.example.test"},
+	}
+	if _, err := tokens.Exchange(ctx, orbit, refresh); !errors.Is(err, services.ErrInvalidTarget) {
+		t.Fatalf("refresh for a resource outside the grant error = %v, want ErrInvalidTarget", err)
+	}
+	refresh.Resources = []string{"https://billing.example.test"}
+	rotated, err := tokens.Exchange(ctx, orbit, refresh)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !slices.Equal(rotated.Scopes, []string{"openid", "billing:read"}) {
+		t.Errorf("refreshed Scopes = %v, want the billing scopes", rotated.Scopes)
+	}
+
+	// Replaying the rotated token revokes the whole family.
+	if _, err := tokens.Exchange(ctx, orbit, refresh); !errors.Is(err, services.ErrInvalidGrant) {
+		t.Fatalf("replayed refresh error = %v, want ErrInvalidGrant", err)
+	}
+	if info, err := tokens.Introspect(ctx, orbit, rotated.RefreshToken, ""); err != nil || info != nil {
+		t.Fatalf("Introspect of the successor after replay = %v, %v, want inactive", info, err)
+	}
+}
+
+func TestTokenServiceIDTokenCarriesAuthentication(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	cacheManager, _ := testutil.Redis(t)
+	secretCipher, err := services.NewAESGCMCipher(testutil.EncryptionKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	authCodes := services.NewAuthCodeService(dbConn, cacheManager, nop)
+	keys := services.NewJWKService(dbConn, cacheManager, secretCipher, nop)
+	tokens := services.NewTokenService(dbConn, newUserService(dbConn, cacheManager), authCodes,
+		services.NewAccessTokenService(dbConn, cacheManager, nop), services.NewResourceServerService(dbConn, cacheManager, nop), keys, services.NewRoleService(dbConn, cacheManager, nop), nop)
+
+	orbit := fx.Orbit()
+	fx.Key(orbit)
+	client := fx.Client(orbit)
+	user := fx.User(orbit)
+
+	authTime := time.Now().Add(-time.Hour).Unix()
+	metadata, err := json.Marshal(map[string]any{
+		"acr":       services.ACRMultiFactor,
+		"amr":       []string{services.AMRPassword, services.AMROTP, services.AMRMultiFactor},
+		"auth_time": authTime,
+		"nonce":     "n-0S6_WzA2Mj",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	now := time.Now().UTC()
+	code, err := authCodes.Create(ctx, &models.AuthCode{
+		Code:        "code-" + t.Name(),
+		OrbitID:     orbit.ID,
+		ClientID:    client.ID,
+		UserID:      &user.ID,
+		RedirectURI: "https://app.example.test/callback",
+		Scope:       json.RawMessage(`["openid","profile"]`),
+		Resources:   json.RawMessage(`[]`),
+		Metadata:    metadata,
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(time.Minute),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := tokens.Exchange(ctx, orbit, &services.TokenRequest{
+		Client:      client,
+		GrantType:   services.GrantTypeAuthorizationCode,
+		Code:        code.Code,
+		RedirectURI: code.RedirectURI,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	set, err := keys.PublicKeys(ctx, orbit.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	type idToken struct {
+		jwt.Claims
+		ACR      string   `json:"acr"`
+		AMR      []string `json:"amr"`
+		AuthTime int64    `json:"auth_time"`
+		Nonce    string   `json:"nonce"`
+	}
+	parse := func(raw string) idToken {
+		t.Helper()
+		parsed, err := jwt.ParseSigned(raw, []jose.SignatureAlgorithm{jose.ES256})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var claims idToken
+		if err := parsed.Claims(set, &claims); err != nil {
+			t.Fatal(err)
+		}
+		return claims
+	}
+
+	claims := parse(out.IDToken)
+	if claims.ACR != services.ACRMultiFactor || !slices.Equal(claims.AMR, []string{"pwd", "otp", "mfa"}) || claims.AuthTime != authTime {
+		t.Errorf("acr, amr, auth_time = %q, %v, %d, want the authentication of the code", claims.ACR, claims.AMR, claims.AuthTime)
+	}
+	if claims.Nonce != "n-0S6_WzA2Mj" || !slices.Equal([]string(claims.Audience), []string{client.ClientID}) {
+		t.Errorf("nonce, aud = %q, %v", claims.Nonce, claims.Audience)
+	}
+
+	// The ID token names its user and client when sent back as a logout hint,
+	// but only to the orbit that signed it.
+	if hint, err := tokens.VerifyIDTokenHint(ctx, orbit, out.IDToken); err != nil || hint.UserID != user.ID || hint.ClientID != client.ClientID {
+		t.Errorf("VerifyIDTokenHint = %+v, %v, want user %d of client %s", hint, err, user.ID, client.ClientID)
+	}
+	other := fx.Orbit()
+	fx.Key(other)
+	for name, check := range map[string]func() error{
+		"another orbit": func() error { _, err := tokens.VerifyIDTokenHint(ctx, other, out.IDToken); return err },
+		"not a jwt":     func() error { _, err := tokens.VerifyIDTokenHint(ctx, orbit, "garbage"); return err },
+	} {
+		if err := check(); !errors.Is(err, services.ErrInvalidIDTokenHint) {
+			t.Errorf("VerifyIDTokenHint of %s error = %v, want ErrInvalidIDTokenHint", name, err)
+		}
+	}
+
+	refreshed, err := tokens.Exchange(ctx, orbit, &services.TokenRequest{
+		Client:       client,
+		GrantType:    services.GrantTypeRefreshToken,
+		RefreshToken: out.RefreshToken,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	claims = parse(refreshed.IDToken)
+	if claims.AuthTime != authTime || claims.ACR != services.ACRMultiFactor || claims.Nonce != "" {
+		t.Errorf("refreshed auth_time, acr, nonce = %d, %q, %q, want the original authentication without nonce", claims.AuthTime, claims.ACR, claims.Nonce)
+	}
+}
+
+func TestTokenServiceMapsRolesIntoTokens(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	cacheManager, _ := testutil.Redis(t)
+	secretCipher, err := services.NewAESGCMCipher(testutil.EncryptionKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	authCodes := services.NewAuthCodeService(dbConn, cacheManager, nop)
+	resources := services.NewResourceServerService(dbConn, cacheManager, nop)
+	keys := services.NewJWKService(dbConn, cacheManager, secretCipher, nop)
+	roles := services.NewRoleService(dbConn, cacheManager, nop)
+	permissions := services.NewPermissionService(dbConn, cacheManager, nop)
+	tokens := services.NewTokenService(dbConn, newUserService(dbConn, cacheManager), authCodes,
+		services.NewAccessTokenService(dbConn, cacheManager, nop), resources, keys, roles, nop)
+
+	orbit := fx.Orbit()
+	fx.Key(orbit)
+	user := fx.User(orbit)
+	client, _, err := services.NewClientService(dbConn, cacheManager, nop).CreateManaged(ctx, orbit, services.ClientSettings{
+		Metadata: services.ClientMetadata{RedirectURIs: []string{"https://app.example.test/callback"}},
+		IsActive: true,
+		ClaimMappers: []services.ClaimMapper{
+			{Source: services.ClaimSourceRoles, Claim: "roles", Tokens: []string{services.TokenTypeID}},
+			{Source: services.ClaimSourcePermissions, Claim: "authorities", Prefix: "SCOPE_", Tokens: []string{services.TokenTypeAccess}},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := resources.Create(ctx, &models.ResourceServer{
+		OrbitID:     orbit.ID,
+		Identifier:  "https://orders.example.test",
+		Name:        "Orders",
+		TokenFormat: models.TokenFormatJWT,
+		IsActive:    true,
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	role, err := roles.Create(ctx, &models.Role{OrbitID: orbit.ID, Name: "clerk"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, audiences := range map[string][]any{
+		"orders:read":  {"https://orders.example.test"},
+		"billing:read": {"https://billing.example.test"},
+	} {
+		perm, err := permissions.Create(ctx, &models.Permission{OrbitID: orbit.ID, Name: name, Metadata: map[string]any{"audiences": audiences}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := roles.GrantPermission(ctx, orbit.ID, role.ID, perm.ID); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := roles.AssignToUser(ctx, orbit.ID, role.ID, user.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now().UTC()
+	code, err := authCodes.Create(ctx, &models.AuthCode{
+		Code:        "code-" + t.Name(),
+		OrbitID:     orbit.ID,
+		ClientID:    client.ID,
+		UserID:      &user.ID,
+		RedirectURI: "https://app.example.test/callback",
+		Scope:       json.RawMessage(`["openid"]`),
+		Resources:   json.RawMessage(`["https://orders.example.test"]`),
+		CreatedAt:   now,
+		ExpiresAt:   now.Add(time.Minute),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := tokens.Exchange(ctx, orbit, &services.TokenRequest{
+		Client:      client,
+		GrantType:   services.GrantTypeAuthorizationCode,
+		Code:        code.Code,
+		RedirectURI: code.RedirectURI,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	set, err := keys.PublicKeys(ctx, orbit.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	claimsOf := func(raw string) map[string]any {
+		t.Helper()
+		parsed, err := jwt.ParseSigned(raw, []jose.SignatureAlgorithm{jose.ES256})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var claims map[string]any
+		if err := parsed.Claims(set, &claims); err != nil {
+			t.Fatal(err)
+		}
+		return claims
+	}
+	access := claimsOf(out.AccessToken)
+	if got, _ := access["authorities"].([]any); len(got) != 1 || got[0] != "SCOPE_orders:read" {
+		t.Errorf("access token authorities = %v, want the orders permission alone", access["authorities"])
+	}
+	if _, ok := access["roles"]; ok {
+		t.Errorf("access token carries roles mapped for ID tokens only")
+	}
+	id := claimsOf(out.IDToken)
+	if got, _ := id["roles"].([]any); len(got) != 1 || got[0] != "clerk" {
+		t.Errorf("ID token roles = %v, want clerk", id["roles"])
+	}
+	if _, ok := id["authorities"]; ok {
+		t.Errorf("ID token carries authorities mapped for access tokens only")
+	}
+}
+
+// RFC 6749 section 4.1.3 only requires the token request to repeat
+// redirect_uri when the authorization request sent it.
+func TestTokenServiceRedirectURIOnlyWhenSent(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	cacheManager, _ := testutil.Redis(t)
+	secretCipher, err := services.NewAESGCMCipher(testutil.EncryptionKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	authCodes := services.NewAuthCodeService(dbConn, cacheManager, nop)
+	keys := services.NewJWKService(dbConn, cacheManager, secretCipher, nop)
+	tokens := services.NewTokenService(dbConn, newUserService(dbConn, cacheManager), authCodes,
+		services.NewAccessTokenService(dbConn, cacheManager, nop), services.NewResourceServerService(dbConn, cacheManager, nop), keys, services.NewRoleService(dbConn, cacheManager, nop), nop)
+
+	orbit := fx.Orbit()
+	fx.Key(orbit)
+	client := fx.Client(orbit)
+	user := fx.User(orbit)
+
+	for i, tc := range []struct {
+		sent        bool
+		redirectURI string
+		ok          bool
+	}{
+		{sent: false, redirectURI: "", ok: true},
+		{sent: false, redirectURI: "https://app.example.test/callback", ok: true},
+		{sent: false, redirectURI: "https://app.example.test/other", ok: false},
+		{sent: true, redirectURI: "", ok: false},
+		{sent: true, redirectURI: "https://app.example.test/callback", ok: true},
+	} {
+		metadata, err := json.Marshal(map[string]any{"redirect_uri_sent": tc.sent})
+		if err != nil {
+			t.Fatal(err)
+		}
+		now := time.Now().UTC()
+		code, err := authCodes.Create(ctx, &models.AuthCode{
+			Code:        fmt.Sprintf("code-%s-%d", t.Name(), i),
+			OrbitID:     orbit.ID,
+			ClientID:    client.ID,
+			UserID:      &user.ID,
+			RedirectURI: "https://app.example.test/callback",
+			Scope:       json.RawMessage(`["profile"]`),
+			Resources:   json.RawMessage(`[]`),
+			Metadata:    metadata,
+			CreatedAt:   now,
+			ExpiresAt:   now.Add(time.Minute),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = tokens.Exchange(ctx, orbit, &services.TokenRequest{
+			Client:      client,
+			GrantType:   services.GrantTypeAuthorizationCode,
+			Code:        code.Code,
+			RedirectURI: tc.redirectURI,
+		})
+		if tc.ok && err != nil {
+			t.Errorf("sent %v, redirect_uri %q: Exchange error = %v, want none", tc.sent, tc.redirectURI, err)
+		}
+		if !tc.ok && !errors.Is(err, services.ErrInvalidGrant) {
+			t.Errorf("sent %v, redirect_uri %q: Exchange error = %v, want ErrInvalidGrant", tc.sent, tc.redirectURI, err)
+		}
+	}
+}
+
+func TestCodeRedirectURISent(t *testing.T) {
+	for raw, want := range map[string]bool{
+		``:                            true,
+		`{"nonce":"n"}`:               true,
+		`{"redirect_uri_sent":true}`:  true,
+		`{"redirect_uri_sent":false}`: false,
+	} {
+		code := &models.AuthCode{Metadata: json.RawMessage(raw)}
+		if got := services.CodeRedirectURISent(code); got != want {
+			t.Errorf("CodeRedirectURISent(%s) = %v, want %v", raw, got, want)
+		}
+	}
+}
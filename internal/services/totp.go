@@ -0,0 +1,113 @@
+package services
+
+import (
+	"crypto/hmac"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	TOTPAlgorithmSHA1   = "SHA1"
+	TOTPAlgorithmSHA256 = "SHA256"
+	TOTPAlgorithmSHA512 = "SHA512"
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+func totpHash(algorithm string) (func() hash.Hash, error) {
+	switch strings.ToUpper(algorithm) {
+	case TOTPAlgorithmSHA1:
+		return sha1.New, nil
+	case TOTPAlgorithmSHA256:
+		return sha256.New, nil
+	case TOTPAlgorithmSHA512:
+		return sha512.New, nil
+	}
+	return nil, fmt.Errorf("unsupported totp algorithm %q", algorithm)
+}
+
+// totpSecretSize follows the key lengths recommended by RFC 6238 for each
+// HMAC variant.
+func totpSecretSize(algorithm string) int {
+	switch strings.ToUpper(algorithm) {
+	case TOTPAlgorithmSHA256:
+		return 32
+	case TOTPAlgorithmSHA512:
+		return 64
+	}
+	return 20
+}
+
+func totpStep(t time.Time, period int) int64 {
+	return t.Unix() / int64(period)
+}
+
+// hotpCode computes the RFC 4226 value for a counter.
+func hotpCode(newHash func() hash.Hash, key []byte, counter int64, digits int) string {
+	var msg [8]byte
+	binary.BigEndian.PutUint64(msg[:], uint64(counter))
+	mac := hmac.New(newHash, key)
+	mac.Write(msg[:])
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	mod := uint32(1)
+	for i := 0; i < digits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", digits, value%mod)
+}
+
+// matchTOTPStep looks for the step within skew of now that produces code and
+// is newer than lastUsed. It returns false when the code is wrong or was
+// already used.
+func matchTOTPStep(newHash func() hash.Hash, key []byte, code string, digits, period, skew int, lastUsed int64, now time.Time) (int64, bool) {
+	if len(code) != digits {
+		return 0, false
+	}
+	current := totpStep(now, period)
+	var matched int64
+	found := false
+	for delta := -skew; delta <= skew; delta++ {
+		step := current + int64(delta)
+		if step <= lastUsed {
+			continue
+		}
+		// Evaluate every candidate so timing does not reveal which step
+		// matched.
+		if subtle.ConstantTimeCompare([]byte(hotpCode(newHash, key, step, digits)), []byte(code)) == 1 && !found {
+			matched, found = step, true
+		}
+	}
+	return matched, found
+}
+
+// totpURI builds the otpauth:// key URI understood by authenticator apps. It
+// is also the payload to encode into an enrollment QR code.
+func totpURI(issuer, account string, secret []byte, algorithm string, digits, period int) string {
+	label := account
+	if issuer != "" {
+		label = issuer + ":" + account
+	}
+	q := url.Values{}
+	q.Set("secret", totpEncoding.EncodeToString(secret))
+	if issuer != "" {
+		q.Set("issuer", issuer)
+	}
+	q.Set("algorithm", strings.ToUpper(algorithm))
+	q.Set("digits", strconv.Itoa(digits))
+	q.Set("period", strconv.Itoa(period))
+	u := url.URL{Scheme: "otpauth", Host: "totp", Path: "/" + label, RawQuery: q.Encode()}
+	return u.String()
+}
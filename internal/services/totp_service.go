@@ -0,0 +1,307 @@
+package services
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories/db"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	ErrTOTPNotFound          = errors.New("totp authenticator not found")
+	ErrTOTPAlreadyConfirmed  = errors.New("totp authenticator is already confirmed")
+	ErrTOTPNameTaken         = errors.New("totp authenticator name is already in use")
+	ErrTOTPLimitReached      = errors.New("maximum number of totp authenticators reached")
+	ErrInvalidTOTPCode       = errors.New("invalid totp code")
+	ErrInvalidTOTPEnrollment = errors.New("invalid totp enrollment")
+)
+
+// maxTOTPAuthenticatorsScan bounds how many rows are read per user; orbits
+// cannot configure more authenticators than this.
+const maxTOTPAuthenticatorsScan = 100
+
+// TOTPEnrollment is returned once when an authenticator is enrolled. The
+// secret is never readable again afterwards.
+type TOTPEnrollment struct {
+	TOTP   *models.TOTP
+	Secret string
+	// URI is the otpauth:// key URI, also used as the QR code payload.
+	URI string
+}
+
+type TOTPService struct {
+	db     *db.DB
+	cipher SecretCipher
+	users  *UserService
+	logger zerolog.Logger
+	tracer trace.Tracer
+	now    func() time.Time
+}
+
+func NewTOTPService(dbConn *db.DB, secretCipher SecretCipher, users *UserService, logger zerolog.Logger) *TOTPService {
+	return &TOTPService{
+		db:     dbConn,
+		cipher: secretCipher,
+		users:  users,
+		logger: logger,
+		tracer: otel.Tracer("service.totp"),
+		now:    time.Now,
+	}
+}
+
+func totpAssociatedData(userID int64) []byte {
+	return []byte("totp:" + strconv.FormatInt(userID, 10))
+}
+
+// Enroll creates an unconfirmed authenticator. It does not count as a second
+// factor until Confirm has seen a valid code from it.
+func (s *TOTPService) Enroll(ctx context.Context, orbit *models.Orbit, user *models.User, name string) (*TOTPEnrollment, error) {
+	ctx, span := s.tracer.Start(ctx, "Enroll")
+	defer span.End()
+
+	cfg, err := ParseOrbitConfig(orbit)
+	if err != nil {
+		return nil, err
+	}
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > 100 {
+		return nil, fmt.Errorf("%w: name must be between 1 and 100 characters", ErrInvalidTOTPEnrollment)
+	}
+	if _, err := totpHash(cfg.TOTP.Algorithm); err != nil {
+		return nil, err
+	}
+
+	secret := make([]byte, totpSecretSize(cfg.TOTP.Algorithm))
+	if _, err := rand.Read(secret); err != nil {
+		return nil, err
+	}
+	sealed, err := s.cipher.Seal(secret, totpAssociatedData(user.ID))
+	if err != nil {
+		return nil, err
+	}
+
+	issuer := cfg.TOTP.Issuer
+	if issuer == "" {
+		issuer = orbit.DisplayName
+	}
+	if issuer == "" {
+		issuer = orbit.Name
+	}
+	account := user.Email
+	if account == "" {
+		account = user.Username
+	}
+
+	var created *models.TOTP
+	err = s.db.WithTx(ctx, func(tx pgx.Tx) error {
+		repo := repositories.NewTOTPRepository(tx, s.logger)
+		existing, _, err := repo.ListByUser(ctx, user.ID, repositories.Page{Limit: maxTOTPAuthenticatorsScan})
+		if err != nil {
+			return err
+		}
+		if cfg.TOTP.MaxAuthenticators > 0 && len(existing) >= cfg.TOTP.MaxAuthenticators {
+			return ErrTOTPLimitReached
+		}
+		for _, t := range existing {
+			if strings.EqualFold(t.Name, name) {
+				return ErrTOTPNameTaken
+			}
+		}
+		created, err = repo.Create(ctx, &models.TOTP{
+			UserID:       user.ID,
+			OrbitID:      orbit.ID,
+			SecretCipher: sealed,
+			Algorithm:    strings.ToUpper(cfg.TOTP.Algorithm),
+			Digits:       cfg.TOTP.Digits,
+			Period:       cfg.TOTP.PeriodSeconds,
+			Issuer:       issuer,
+			Label:        account,
+			Name:         name,
+		})
+		return err
+	})
+	if err != nil {
+		if !errors.Is(err, ErrTOTPLimitReached) && !errors.Is(err, ErrTOTPNameTaken) {
+			s.logger.Error().Err(err).Int64("user_id", user.ID).Msg("totp enroll failed")
+		}
+		return nil, err
+	}
+
+	return &TOTPEnrollment{
+		TOTP:   created,
+		Secret: totpEncoding.EncodeToString(secret),
+		URI:    totpURI(issuer, account, secret, created.Algorithm, created.Digits, created.Period),
+	}, nil
+}
+
+// Confirm activates an enrolled authenticator with its first valid code and
+// turns on MFA for the user.
+func (s *TOTPService) Confirm(ctx context.Context, orbit *models.Orbit, userID, totpID int64, code string) error {
+	ctx, span := s.tracer.Start(ctx, "Confirm")
+	defer span.End()
+
+	cfg, err := ParseOrbitConfig(orbit)
+	if err != nil {
+		return err
+	}
+	var changed *models.User
+	err = s.db.WithTx(ctx, func(tx pgx.Tx) error {
+		repo := repositories.NewTOTPRepository(tx, s.logger)
+		t, err := repo.GetByID(ctx, totpID)
+		if err != nil {
+			return err
+		}
+		if t == nil || t.UserID != userID || t.OrbitID != orbit.ID {
+			return ErrTOTPNotFound
+		}
+		if t.IsConfirmed {
+			return ErrTOTPAlreadyConfirmed
+		}
+		if err := s.accept(ctx, repo, t, code, cfg.TOTP.Skew); err != nil {
+			return err
+		}
+		changed, err = s.setMFAEnabled(ctx, tx, userID, true)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if changed != nil {
+		s.users.invalidateUser(ctx, changed)
+	}
+	s.logger.Info().Int64("user_id", userID).Int64("totp_id", totpID).Msg("totp authenticator confirmed")
+	return nil
+}
+
+// Verify checks a code against every confirmed authenticator of the user.
+// Each time step is accepted at most once per authenticator.
+func (s *TOTPService) Verify(ctx context.Context, orbit *models.Orbit, userID int64, code string) (*models.TOTP, error) {
+	ctx, span := s.tracer.Start(ctx, "Verify")
+	defer span.End()
+
+	cfg, err := ParseOrbitConfig(orbit)
+	if err != nil {
+		return nil, err
+	}
+	repo := repositories.NewTOTPRepository(s.db.Exec(), s.logger)
+	list, _, err := repo.ListByUser(ctx, userID, repositories.Page{Limit: maxTOTPAuthenticatorsScan})
+	if err != nil {
+		return nil, err
+	}
+	for _, t := range list {
+		if !t.IsConfirmed || t.OrbitID != orbit.ID {
+			continue
+		}
+		err := s.accept(ctx, repo, t, code, cfg.TOTP.Skew)
+		if err == nil {
+			return t, nil
+		}
+		if !errors.Is(err, ErrInvalidTOTPCode) {
+			return nil, err
+		}
+	}
+	return nil, ErrInvalidTOTPCode
+}
+
+func (s *TOTPService) accept(ctx context.Context, repo *repositories.TOTPRepository, t *models.TOTP, code string, skew int) error {
+	newHash, err := totpHash(t.Algorithm)
+	if err != nil {
+		return err
+	}
+	secret, err := s.cipher.Open(t.SecretCipher, totpAssociatedData(t.UserID))
+	if err != nil {
+		return err
+	}
+	step, ok := matchTOTPStep(newHash, secret, strings.TrimSpace(code), t.Digits, t.Period, skew, t.LastUsedStep, s.now())
+	if !ok {
+		return ErrInvalidTOTPCode
+	}
+	used, err := repo.UseStep(ctx, t.ID, step)
+	if err != nil {
+		return err
+	}
+	if !used {
+		// Another request accepted the same or a later step first.
+		return ErrInvalidTOTPCode
+	}
+	t.LastUsedStep = step
+	t.IsConfirmed = true
+	return nil
+}
+
+func (s *TOTPService) List(ctx context.Context, userID int64) ([]*models.TOTP, error) {
+	ctx, span := s.tracer.Start(ctx, "List")
+	defer span.End()
+
+	list, _, err := repositories.NewTOTPRepository(s.db.Exec(), s.logger).ListByUser(ctx, userID, repositories.Page{Limit: maxTOTPAuthenticatorsScan})
+	return list, err
+}
+
+// Delete removes an authenticator. MFA is switched off with the last
+// confirmed one.
+func (s *TOTPService) Delete(ctx context.Context, userID, totpID int64) error {
+	ctx, span := s.tracer.Start(ctx, "Delete")
+	defer span.End()
+
+	var changed *models.User
+	err := s.db.WithTx(ctx, func(tx pgx.Tx) error {
+		repo := repositories.NewTOTPRepository(tx, s.logger)
+		t, err := repo.GetByID(ctx, totpID)
+		if err != nil {
+			return err
+		}
+		if t == nil || t.UserID != userID {
+			return ErrTOTPNotFound
+		}
+		if err := repo.Delete(ctx, totpID); err != nil {
+			return err
+		}
+		remaining, _, err := repo.ListByUser(ctx, userID, repositories.Page{Limit: maxTOTPAuthenticatorsScan})
+		if err != nil {
+			return err
+		}
+		for _, r := range remaining {
+			if r.IsConfirmed {
+				return nil
+			}
+		}
+		changed, err = s.setMFAEnabled(ctx, tx, userID, false)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if changed != nil {
+		s.users.invalidateUser(ctx, changed)
+	}
+	return nil
+}
+
+// setMFAEnabled returns the user when the flag changed so the caller can drop
+// cached copies once the transaction has committed.
+func (s *TOTPService) setMFAEnabled(ctx context.Context, tx pgx.Tx, userID int64, enabled bool) (*models.User, error) {
+	repo := repositories.NewUserRepository(tx, s.logger)
+	user, err := repo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+	if user.MFAEnabled == enabled {
+		return nil, nil
+	}
+	user.MFAEnabled = enabled
+	return repo.Update(ctx, user)
+}
@@ -0,0 +1,136 @@
+package services_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/testutil"
+)
+
+// RFC 6238 Appendix B seeds and the 8-digit codes at 59 s and 1111111109 s.
+var totpVectors = []struct {
+	algorithm string
+	seed      string
+	first     string
+	second    string
+}{
+	{services.TOTPAlgorithmSHA1, "12345678901234567890", "94287082", "07081804"},
+	{services.TOTPAlgorithmSHA256, "12345678901234567890123456789012", "46119246", "68084774"},
+	{services.TOTPAlgorithmSHA512, "1234567890123456789012345678901234567890123456789012345678901234", "90693936", "25091201"},
+}
+
+func TestTOTPServiceRFC6238(t *testing.T) {
+	for _, v := range totpVectors {
+		t.Run(v.algorithm, func(t *testing.T) {
+			dbConn, fx := setup(t)
+			ctx := t.Context()
+			cacheManager, _ := testutil.Redis(t)
+			secretCipher, err := services.NewAESGCMCipher(testutil.EncryptionKey)
+			if err != nil {
+				t.Fatal(err)
+			}
+			users := newUserService(dbConn, cacheManager)
+			totp := services.NewTOTPService(dbConn, secretCipher, users, nop)
+			orbit := fx.Orbit()
+			user := fx.User(orbit)
+
+			// The seed of the vectors is stored the way Enroll stores one.
+			sealed, err := secretCipher.Seal([]byte(v.seed), services.TOTPAssociatedData(user.ID))
+			if err != nil {
+				t.Fatal(err)
+			}
+			enrolled, err := repositories.NewTOTPRepository(dbConn.Exec(), nop).Create(ctx, &models.TOTP{
+				UserID:       user.ID,
+				OrbitID:      orbit.ID,
+				SecretCipher: sealed,
+				Algorithm:    v.algorithm,
+				Digits:       8,
+				Period:       30,
+				Issuer:       orbit.Name,
+				Label:        user.Email,
+				Name:         "rfc6238",
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			now := time.Unix(59, 0)
+			totp.SetClock(func() time.Time { return now })
+			if _, err := totp.Verify(ctx, orbit, user.ID, v.first); !errors.Is(err, services.ErrInvalidTOTPCode) {
+				t.Fatalf("Verify before Confirm error = %v, want ErrInvalidTOTPCode", err)
+			}
+			if err := totp.Confirm(ctx, orbit, user.ID, enrolled.ID, v.second); !errors.Is(err, services.ErrInvalidTOTPCode) {
+				t.Fatalf("Confirm with the code of another time error = %v, want ErrInvalidTOTPCode", err)
+			}
+			if err := totp.Confirm(ctx, orbit, user.ID, enrolled.ID, v.first); err != nil {
+				t.Fatal(err)
+			}
+			if got, err := users.GetByID(ctx, user.ID); err != nil || got == nil || !got.MFAEnabled {
+				t.Fatalf("user after Confirm = %+v, %v, want MFA enabled", got, err)
+			}
+
+			// The confirming code used its step up.
+			if _, err := totp.Verify(ctx, orbit, user.ID, v.first); !errors.Is(err, services.ErrInvalidTOTPCode) {
+				t.Fatalf("replayed Verify error = %v, want ErrInvalidTOTPCode", err)
+			}
+
+			// One period of lag is tolerated, once.
+			now = time.Unix(1111111109+30, 0)
+			if got, err := totp.Verify(ctx, orbit, user.ID, v.second); err != nil || got.ID != enrolled.ID {
+				t.Fatalf("Verify one period late = %+v, %v", got, err)
+			}
+			if _, err := totp.Verify(ctx, orbit, user.ID, v.second); !errors.Is(err, services.ErrInvalidTOTPCode) {
+				t.Fatalf("replayed Verify error = %v, want ErrInvalidTOTPCode", err)
+			}
+		})
+	}
+}
+
+func TestTOTPServiceRejectsCodesOutsideSkew(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	cacheManager, _ := testutil.Redis(t)
+	secretCipher, err := services.NewAESGCMCipher(testutil.EncryptionKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	totp := services.NewTOTPService(dbConn, secretCipher, newUserService(dbConn, cacheManager), nop)
+	orbit := fx.Orbit()
+	user := fx.User(orbit)
+
+	v := totpVectors[0]
+	sealed, err := secretCipher.Seal([]byte(v.seed), services.TOTPAssociatedData(user.ID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	enrolled, err := repositories.NewTOTPRepository(dbConn.Exec(), nop).Create(ctx, &models.TOTP{
+		UserID:       user.ID,
+		OrbitID:      orbit.ID,
+		SecretCipher: sealed,
+		Algorithm:    v.algorithm,
+		Digits:       8,
+		Period:       30,
+		Issuer:       orbit.Name,
+		Label:        user.Email,
+		Name:         "rfc6238",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The default skew is one period each way.
+	for _, at := range []int64{1111111109 - 60, 1111111109 + 60} {
+		totp.SetClock(func() time.Time { return time.Unix(at, 0) })
+		if err := totp.Confirm(ctx, orbit, user.ID, enrolled.ID, v.second); !errors.Is(err, services.ErrInvalidTOTPCode) {
+			t.Errorf("Confirm two periods off at %d error = %v, want ErrInvalidTOTPCode", at, err)
+		}
+	}
+	totp.SetClock(func() time.Time { return time.Unix(1111111109-30, 0) })
+	if err := totp.Confirm(ctx, orbit, user.ID, enrolled.ID, v.second); err != nil {
+		t.Fatalf("Confirm one period early = %v", err)
+	}
+}
@@ -0,0 +1,96 @@
+package services
+
+import (
+	"hash"
+	"testing"
+	"time"
+)
+
+// RFC 6238 Appendix B: 8-digit codes for a 30 second period, with the seed of
+// each HMAC variant.
+var rfc6238Seeds = map[string][]byte{
+	TOTPAlgorithmSHA1:   []byte("12345678901234567890"),
+	TOTPAlgorithmSHA256: []byte("12345678901234567890123456789012"),
+	TOTPAlgorithmSHA512: []byte("1234567890123456789012345678901234567890123456789012345678901234"),
+}
+
+var rfc6238Vectors = []struct {
+	unix  int64
+	codes map[string]string
+}{
+	{59, map[string]string{TOTPAlgorithmSHA1: "94287082", TOTPAlgorithmSHA256: "46119246", TOTPAlgorithmSHA512: "90693936"}},
+	{1111111109, map[string]string{TOTPAlgorithmSHA1: "07081804", TOTPAlgorithmSHA256: "68084774", TOTPAlgorithmSHA512: "25091201"}},
+	{1111111111, map[string]string{TOTPAlgorithmSHA1: "14050471", TOTPAlgorithmSHA256: "67062674", TOTPAlgorithmSHA512: "99943326"}},
+	{1234567890, map[string]string{TOTPAlgorithmSHA1: "89005924", TOTPAlgorithmSHA256: "91819424", TOTPAlgorithmSHA512: "93441116"}},
+	{2000000000, map[string]string{TOTPAlgorithmSHA1: "69279037", TOTPAlgorithmSHA256: "90698825", TOTPAlgorithmSHA512: "38618901"}},
+	{20000000000, map[string]string{TOTPAlgorithmSHA1: "65353130", TOTPAlgorithmSHA256: "77737706", TOTPAlgorithmSHA512: "47863826"}},
+}
+
+func rfc6238Hash(t *testing.T, algorithm string) func() hash.Hash {
+	t.Helper()
+	newHash, err := totpHash(algorithm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return newHash
+}
+
+func TestHOTPCodeRFC6238Vectors(t *testing.T) {
+	for _, v := range rfc6238Vectors {
+		for algorithm, want := range v.codes {
+			newHash := rfc6238Hash(t, algorithm)
+			step := totpStep(time.Unix(v.unix, 0), 30)
+			if got := hotpCode(newHash, rfc6238Seeds[algorithm], step, 8); got != want {
+				t.Errorf("%s at %d = %s, want %s", algorithm, v.unix, got, want)
+			}
+			got, ok := matchTOTPStep(newHash, rfc6238Seeds[algorithm], want, 8, 30, 0, 0, time.Unix(v.unix, 0))
+			if !ok || got != step {
+				t.Errorf("matchTOTPStep(%s at %d) = %d, %v, want step %d", algorithm, v.unix, got, ok, step)
+			}
+		}
+	}
+}
+
+func TestMatchTOTPStepSkew(t *testing.T) {
+	newHash := rfc6238Hash(t, TOTPAlgorithmSHA1)
+	key := rfc6238Seeds[TOTPAlgorithmSHA1]
+	issued := time.Unix(1111111109, 0)
+	code := "07081804"
+	step := totpStep(issued, 30)
+
+	for _, c := range []struct {
+		offset time.Duration
+		ok     bool
+	}{
+		{-60 * time.Second, false},
+		{-30 * time.Second, true},
+		{0, true},
+		{30 * time.Second, true},
+		{60 * time.Second, false},
+	} {
+		got, ok := matchTOTPStep(newHash, key, code, 8, 30, 1, 0, issued.Add(c.offset))
+		if ok != c.ok || ok && got != step {
+			t.Errorf("code checked %v after issue = %d, %v, want %v", c.offset, got, ok, c.ok)
+		}
+	}
+}
+
+func TestMatchTOTPStepRejectsUsedSteps(t *testing.T) {
+	newHash := rfc6238Hash(t, TOTPAlgorithmSHA1)
+	key := rfc6238Seeds[TOTPAlgorithmSHA1]
+	now := time.Unix(1111111109, 0)
+	step := totpStep(now, 30)
+
+	if _, ok := matchTOTPStep(newHash, key, "07081804", 8, 30, 1, step, now); ok {
+		t.Error("code of the last used step accepted again")
+	}
+	if _, ok := matchTOTPStep(newHash, key, "07081804", 8, 30, 1, step+1, now); ok {
+		t.Error("code older than the last used step accepted")
+	}
+	if got, ok := matchTOTPStep(newHash, key, "07081804", 8, 30, 1, step-1, now); !ok || got != step {
+		t.Errorf("code after the last used step = %d, %v, want step %d", got, ok, step)
+	}
+	if _, ok := matchTOTPStep(newHash, key, "7081804", 8, 30, 1, 0, now); ok {
+		t.Error("code with a missing digit accepted")
+	}
+}
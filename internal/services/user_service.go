@@ -5,41 +5,54 @@ import (
 	"errors"
 	"fmt"
 	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+	"sync"
 	"time"
 
 	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
 	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories/db"
 	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services/cache"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services/hashing"
 	"github.com/jackc/pgx/v5"
 	"github.com/rs/zerolog"
 	"go.opentelemetry.io/otel"
 	"go.opentelemetry.io/otel/trace"
 )
 
-var ErrUserAlreadyExists = errors.New("user with given identity already exists")
+var (
+	ErrUserAlreadyExists  = errors.New("user with given identity already exists")
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	ErrUserLocked         = errors.New("user account is locked")
+	ErrUserNotFound       = errors.New("user not found")
+)
 
 type userRepoRead interface {
 	GetByID(ctx context.Context, id int64) (*models.User, error)
 	GetByUsername(ctx context.Context, orbitID int64, username string) (*models.User, error)
 	GetByEmail(ctx context.Context, orbitID int64, email string) (*models.User, error)
-	ListByOrbit(ctx context.Context, orbitID int64, limit, offset int) ([]*models.User, error)
+	ListByOrbit(ctx context.Context, orbitID int64, filter repositories.UserFilter, page repositories.Page) ([]*models.User, string, error)
 }
 
 type UserService struct {
 	db        *db.DB
 	readRepo  userRepoRead
 	cacheMan  cache.Manager
+	breached  BreachedPasswordChecker
 	logger    zerolog.Logger
 	tracer    trace.Tracer
 	cacheTTL  time.Duration
 	cacheName string
+
+	// noCorpus warns once that a policy asks for a check nothing performs.
+	noCorpus sync.Once
 }
 
-func NewUserService(dbConn *db.DB, readRepo userRepoRead, cacheManager cache.Manager, logger zerolog.Logger) *UserService {
+// breached may be nil when no breached password corpus is available.
+func NewUserService(dbConn *db.DB, readRepo userRepoRead, cacheManager cache.Manager, breached BreachedPasswordChecker, logger zerolog.Logger) *UserService {
 	return &UserService{
 		db:        dbConn,
 		readRepo:  readRepo,
 		cacheMan:  cacheManager,
+		breached:  breached,
 		logger:    logger,
 		tracer:    otel.Tracer("service.user"),
 		cacheTTL:  5 * time.Minute,
@@ -71,12 +84,15 @@ func (s *UserService) Create(ctx context.Context, user *models.User) (*models.Us
 			return ErrUserAlreadyExists
 		}
 
-		existingByEmail, err := txRepo.GetByEmail(ctx, user.OrbitID, user.Email)
-		if err != nil {
-			return err
-		}
-		if existingByEmail != nil {
-			return ErrUserAlreadyExists
+		// Users without an email address do not collide with each other.
+		if user.Email != "" {
+			existingByEmail, err := txRepo.GetByEmail(ctx, user.OrbitID, user.Email)
+			if err != nil {
+				return err
+			}
+			if existingByEmail != nil {
+				return ErrUserAlreadyExists
+			}
 		}
 
 		created, err = txRepo.Create(ctx, user)
@@ -86,8 +102,13 @@ func (s *UserService) Create(ctx context.Context, user *models.User) (*models.Us
 
 		return nil
 	})
+	if isUniqueViolation(err) {
+		err = ErrUserAlreadyExists
+	}
 	if err != nil {
-		s.logger.Error().Err(err).Str("username", user.Username).Str("email", user.Email).Msg("user create failed")
+		if !errors.Is(err, ErrUserAlreadyExists) {
+			s.logger.Error().Err(err).Str("username", user.Username).Str("email", user.Email).Msg("user create failed")
+		}
 		return nil, err
 	}
 
@@ -154,9 +175,39 @@ func (s *UserService) Update(ctx context.Context, user *models.User) (*models.Us
 	ctx, span := s.tracer.Start(ctx, "Update")
 	defer span.End()
 
-	var updated *models.User
+	return s.update(ctx, user, false)
+}
+
+// UpdateIfUnmodified updates user only if the stored user was last updated at
+// user.UpdatedAt. Otherwise it fails with ErrVersionMismatch; a username or
+// email taken by another user fails with ErrUserAlreadyExists.
+func (s *UserService) UpdateIfUnmodified(ctx context.Context, user *models.User) (*models.User, error) {
+	ctx, span := s.tracer.Start(ctx, "UpdateIfUnmodified")
+	defer span.End()
+
+	return s.update(ctx, user, true)
+}
+
+func (s *UserService) update(ctx context.Context, user *models.User, ifUnmodified bool) (*models.User, error) {
+	var previous, updated *models.User
 	err := s.db.WithTx(ctx, func(tx pgx.Tx) error {
 		txRepo := repositories.NewUserRepository(tx, s.logger)
+		if ifUnmodified {
+			version, err := txRepo.LockVersion(ctx, user.ID)
+			if err != nil {
+				return err
+			}
+			if err := checkVersion(version, user.UpdatedAt); err != nil {
+				return err
+			}
+		}
+		// The stored identities are needed to drop cache entries of a
+		// username or email the update replaces.
+		p, err := txRepo.GetByID(ctx, user.ID)
+		if err != nil {
+			return err
+		}
+		previous = p
 		u, err := txRepo.Update(ctx, user)
 		if err != nil {
 			return err
@@ -164,14 +215,21 @@ func (s *UserService) Update(ctx context.Context, user *models.User) (*models.Us
 		updated = u
 		return nil
 	})
+	if isUniqueViolation(err) {
+		err = ErrUserAlreadyExists
+	}
 	if err != nil {
-		s.logger.Error().Err(err).Int64("user_id", user.ID).Msg("user update failed")
+		if !errors.Is(err, ErrUserAlreadyExists) && !errors.Is(err, ErrVersionMismatch) {
+			s.logger.Error().Err(err).Int64("user_id", user.ID).Msg("user update failed")
+		}
 		return nil, err
 	}
 
 	c := s.cacheMan.Cache(s.cacheName)
-	_ = c.Delete(ctx, s.cacheKeyByID(user.ID))
-	_ = c.Delete(ctx, s.cacheKeyByIdentity(user.OrbitID, user.Username))
+	if previous != nil {
+		s.invalidateUser(ctx, previous)
+	}
+	s.invalidateUser(ctx, user)
 	if updated != nil {
 		_ = c.Set(ctx, s.cacheKeyByID(updated.ID), updated, s.cacheTTL)
 		_ = c.Set(ctx, s.cacheKeyByIdentity(updated.OrbitID, updated.Username), updated, s.cacheTTL)
@@ -184,7 +242,7 @@ func (s *UserService) Delete(ctx context.Context, id int64) error {
 	ctx, span := s.tracer.Start(ctx, "Delete")
 	defer span.End()
 
-	var orbitID int64
+	var deleted *models.User
 	err := s.db.WithTx(ctx, func(tx pgx.Tx) error {
 		txRepo := repositories.NewUserRepository(tx, s.logger)
 		u, err := txRepo.GetByID(ctx, id)
@@ -194,7 +252,7 @@ func (s *UserService) Delete(ctx context.Context, id int64) error {
 		if u == nil {
 			return nil
 		}
-		orbitID = u.OrbitID
+		deleted = u
 		return txRepo.Delete(ctx, id)
 	})
 	if err != nil {
@@ -202,21 +260,283 @@ func (s *UserService) Delete(ctx context.Context, id int64) error {
 		return err
 	}
 
-	c := s.cacheMan.Cache(s.cacheName)
-	_ = c.Delete(ctx, s.cacheKeyByID(id))
-	_ = c.Delete(ctx, s.cacheKeyByIdentity(orbitID, ""))
+	if deleted != nil {
+		s.invalidateUser(ctx, deleted)
+	} else {
+		_ = s.cacheMan.Cache(s.cacheName).Delete(ctx, s.cacheKeyByID(id))
+	}
 	s.logger.Info().Int64("user_id", id).Msg("user soft-deleted and cache invalidated")
 	return nil
 }
 
-func (s *UserService) ListByOrbit(ctx context.Context, orbitID int64, limit, offset int) ([]*models.User, error) {
-	ctx, span := s.tracer.Start(ctx, "ListByOrbit")
+// PasswordHasher returns the hasher registry configured for an orbit.
+func (s *UserService) PasswordHasher(orbit *models.Orbit) (*hashing.Registry, error) {
+	cfg, err := ParseOrbitConfig(orbit)
+	if err != nil {
+		return nil, err
+	}
+	return hashing.NewRegistry(cfg.Passwords)
+}
+
+// SetPassword hashes password with the orbit's preferred algorithm and stores
+// it on user. The caller persists the user; policy is not checked here.
+func (s *UserService) SetPassword(orbit *models.Orbit, user *models.User, password string) error {
+	hasher, err := s.PasswordHasher(orbit)
+	if err != nil {
+		return err
+	}
+	return s.setPassword(hasher, user, password)
+}
+
+func (s *UserService) setPassword(hasher *hashing.Registry, user *models.User, password string) error {
+	hash, algo, err := hasher.Hash(password)
+	if err != nil {
+		return err
+	}
+	now := time.Now().UTC()
+	user.PasswordHash = hash
+	user.PasswordAlgo = algo
+	user.LastPasswordChange = &now
+	return nil
+}
+
+// CreateWithPassword creates a user after checking password against the
+// orbit's password policy.
+func (s *UserService) CreateWithPassword(ctx context.Context, orbit *models.Orbit, user *models.User, password string) (*models.User, error) {
+	ctx, span := s.tracer.Start(ctx, "CreateWithPassword")
+	defer span.End()
+
+	cfg, err := ParseOrbitConfig(orbit)
+	if err != nil {
+		return nil, err
+	}
+	hasher, err := hashing.NewRegistry(cfg.Passwords)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.checkPasswordPolicy(ctx, cfg.PasswordPolicy, user, password); err != nil {
+		return nil, err
+	}
+	if err := s.setPassword(hasher, user, password); err != nil {
+		return nil, err
+	}
+
+	created, err := s.Create(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.PasswordPolicy.HistoryDepth > 0 {
+		history := repositories.NewPasswordHistoryRepository(s.db.Exec(), s.logger)
+		_, _ = history.Create(ctx, &models.PasswordHistory{UserID: created.ID, PasswordHash: created.PasswordHash, PasswordAlgo: created.PasswordAlgo})
+	}
+	return created, nil
+}
+
+// ChangePassword replaces a user's password after checking it against the
+// orbit's password policy, including reuse of the last HistoryDepth passwords.
+func (s *UserService) ChangePassword(ctx context.Context, orbit *models.Orbit, userID int64, password string) (*models.User, error) {
+	ctx, span := s.tracer.Start(ctx, "ChangePassword")
 	defer span.End()
 
-	users, err := s.readRepo.ListByOrbit(ctx, orbitID, limit, offset)
+	var updated *models.User
+	err := s.db.WithTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		updated, err = s.changePassword(ctx, tx, orbit, userID, password)
+		return err
+	})
+	if err != nil {
+		if !errors.Is(err, ErrPasswordPolicy) && !errors.Is(err, ErrUserNotFound) {
+			s.logger.Error().Err(err).Int64("user_id", userID).Msg("password change failed")
+		}
+		return nil, err
+	}
+
+	s.invalidateUser(ctx, updated)
+	s.logger.Info().Int64("user_id", userID).Msg("password changed")
+	return updated, nil
+}
+
+// changePassword does the work of ChangePassword inside tx, so callers can
+// combine it with other changes that must only apply if the password does.
+// The cache is left for the caller to invalidate after commit.
+func (s *UserService) changePassword(ctx context.Context, tx pgx.Tx, orbit *models.Orbit, userID int64, password string) (*models.User, error) {
+	cfg, err := ParseOrbitConfig(orbit)
+	if err != nil {
+		return nil, err
+	}
+	policy := cfg.PasswordPolicy
+	hasher, err := hashing.NewRegistry(cfg.Passwords)
 	if err != nil {
-		s.logger.Error().Err(err).Int64("orbit_id", orbitID).Msg("list users by orbit failed")
 		return nil, err
 	}
-	return users, nil
+
+	userRepo := repositories.NewUserRepository(tx, s.logger)
+	historyRepo := repositories.NewPasswordHistoryRepository(tx, s.logger)
+
+	user, err := userRepo.GetByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil || user.OrbitID != orbit.ID {
+		return nil, ErrUserNotFound
+	}
+	if err := s.checkPasswordPolicy(ctx, policy, user, password); err != nil {
+		return nil, err
+	}
+
+	if policy.HistoryDepth > 0 {
+		previous := []*models.PasswordHistory{{PasswordHash: user.PasswordHash, PasswordAlgo: user.PasswordAlgo}}
+		recent, err := historyRepo.ListRecent(ctx, user.ID, policy.HistoryDepth)
+		if err != nil {
+			return nil, err
+		}
+		for _, ph := range append(previous, recent...) {
+			if ok, _, _ := hasher.Verify(ph.PasswordAlgo, ph.PasswordHash, password); ok {
+				return nil, &PasswordPolicyError{Violations: []string{PasswordViolationReused}}
+			}
+		}
+	}
+
+	if err := s.setPassword(hasher, user, password); err != nil {
+		return nil, err
+	}
+	updated, err := userRepo.Update(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	if updated == nil {
+		return nil, ErrUserNotFound
+	}
+	if policy.HistoryDepth > 0 {
+		if _, err := historyRepo.Create(ctx, &models.PasswordHistory{UserID: user.ID, PasswordHash: user.PasswordHash, PasswordAlgo: user.PasswordAlgo}); err != nil {
+			return nil, err
+		}
+		if err := historyRepo.Trim(ctx, user.ID, policy.HistoryDepth); err != nil {
+			return nil, err
+		}
+	}
+	return updated, nil
+}
+
+// PasswordExpired reports whether the orbit's max password age has passed.
+func (s *UserService) PasswordExpired(orbit *models.Orbit, user *models.User) bool {
+	cfg, err := ParseOrbitConfig(orbit)
+	if err != nil {
+		return false
+	}
+	return cfg.PasswordPolicy.Expired(user, time.Now().UTC())
+}
+
+func (s *UserService) checkPasswordPolicy(ctx context.Context, policy PasswordPolicy, user *models.User, password string) error {
+	violations := policy.staticViolations(password, user)
+	if policy.CheckBreached && s.breached == nil {
+		s.noCorpus.Do(func() {
+			s.logger.Warn().Msg("password policy asks for the breached password check but no corpus is configured")
+		})
+	}
+	if policy.CheckBreached && s.breached != nil {
+		breached, err := s.breached.IsBreached(ctx, password)
+		if err != nil {
+			// An unreadable corpus must not lock users out of changing passwords.
+			s.logger.Error().Err(err).Msg("breached password check failed")
+		} else if breached {
+			violations = append(violations, PasswordViolationBreached)
+		}
+	}
+	if len(violations) > 0 {
+		return &PasswordPolicyError{Violations: violations}
+	}
+	return nil
+}
+
+// SetLocked locks or unlocks an account and reports whether its state
+// changed.
+func (s *UserService) SetLocked(ctx context.Context, user *models.User, locked bool) (bool, error) {
+	ctx, span := s.tracer.Start(ctx, "SetLocked")
+	defer span.End()
+
+	changed, err := repositories.NewUserRepository(s.db.Exec(), s.logger).SetLocked(ctx, user.ID, locked)
+	if err != nil {
+		return false, err
+	}
+	s.invalidateUser(ctx, user)
+	user.IsLocked = locked
+	return changed, nil
+}
+
+func (s *UserService) invalidateUser(ctx context.Context, user *models.User) {
+	c := s.cacheMan.Cache(s.cacheName)
+	_ = c.Delete(ctx, s.cacheKeyByID(user.ID))
+	_ = c.Delete(ctx, s.cacheKeyByIdentity(user.OrbitID, user.Username))
+	_ = c.Delete(ctx, s.cacheKeyByIdentity(user.OrbitID, user.Email))
+}
+
+// Authenticate verifies a password login. Hashes produced by a legacy
+// algorithm or with outdated parameters are upgraded transparently once the
+// password has been proven correct.
+func (s *UserService) Authenticate(ctx context.Context, orbit *models.Orbit, identity, password string) (*models.User, error) {
+	ctx, span := s.tracer.Start(ctx, "Authenticate")
+	defer span.End()
+
+	hasher, err := s.PasswordHasher(orbit)
+	if err != nil {
+		return nil, err
+	}
+	user, err := s.GetByIdentity(ctx, orbit.ID, identity)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil || user.PasswordHash == "" {
+		// Spend comparable time on unknown identities so they cannot be told
+		// apart from wrong passwords.
+		_, _, _ = hasher.Hash(password)
+		return nil, ErrInvalidCredentials
+	}
+
+	ok, rehash, err := hasher.Verify(user.PasswordAlgo, user.PasswordHash, password)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("user_id", user.ID).Str("algo", user.PasswordAlgo).Msg("password verification failed")
+		return nil, ErrInvalidCredentials
+	}
+	if !ok || !user.IsActive {
+		return nil, ErrInvalidCredentials
+	}
+	if user.IsLocked {
+		return nil, ErrUserLocked
+	}
+
+	if rehash {
+		s.rehashPassword(ctx, hasher, user, password)
+	}
+	return user, nil
+}
+
+func (s *UserService) rehashPassword(ctx context.Context, hasher *hashing.Registry, user *models.User, password string) {
+	hash, algo, err := hasher.Hash(password)
+	if err != nil {
+		s.logger.Error().Err(err).Int64("user_id", user.ID).Msg("password rehash failed")
+		return
+	}
+	err = repositories.NewUserRepository(s.db.Exec(), s.logger).UpdatePasswordHash(ctx, user.ID, hash, algo, user.PasswordHash)
+	if err != nil {
+		return
+	}
+	s.logger.Info().Int64("user_id", user.ID).Str("from", user.PasswordAlgo).Str("to", algo).Msg("password hash upgraded")
+	user.PasswordHash = hash
+	user.PasswordAlgo = algo
+	s.invalidateUser(ctx, user)
+}
+
+func (s *UserService) ListByOrbit(ctx context.Context, orbitID int64, filter repositories.UserFilter, page repositories.Page) ([]*models.User, string, error) {
+	ctx, span := s.tracer.Start(ctx, "ListByOrbit")
+	defer span.End()
+
+	users, next, err := s.readRepo.ListByOrbit(ctx, orbitID, filter, page)
+	if err != nil {
+		if !errors.Is(err, repositories.ErrInvalidCursor) {
+			s.logger.Error().Err(err).Int64("orbit_id", orbitID).Msg("list users by orbit failed")
+		}
+		return nil, "", err
+	}
+	return users, next, nil
 }
@@ -0,0 +1,141 @@
+package services_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services/hashing"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/testutil"
+)
+
+func TestUserServiceUpdateInvalidatesReplacedIdentities(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	cacheManager, _ := testutil.Redis(t)
+	svc := newUserService(dbConn, cacheManager)
+	orbit := fx.Orbit()
+	user := fx.User(orbit)
+
+	// Warm every cache entry of the user.
+	for _, identity := range []string{user.Username, user.Email} {
+		if got, err := svc.GetByIdentity(ctx, orbit.ID, identity); err != nil || got == nil {
+			t.Fatalf("GetByIdentity(%s) = %+v, %v", identity, got, err)
+		}
+	}
+
+	oldUsername, oldEmail := user.Username, user.Email
+	user.Username = "renamed-" + oldUsername
+	user.Email = "renamed-" + oldEmail
+	if _, err := svc.Update(ctx, user); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, identity := range []string{oldUsername, oldEmail} {
+		if got, err := svc.GetByIdentity(ctx, orbit.ID, identity); err != nil || got != nil {
+			t.Errorf("GetByIdentity(%s) after rename = %+v, %v, want nil", identity, got, err)
+		}
+	}
+	got, err := svc.GetByIdentity(ctx, orbit.ID, user.Email)
+	if err != nil || got == nil || got.ID != user.ID {
+		t.Fatalf("GetByIdentity(new email) = %+v, %v", got, err)
+	}
+}
+
+func TestUserServiceDeleteInvalidatesCache(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	svc := newUserService(dbConn, testutil.LocalCache(t))
+	orbit := fx.Orbit()
+	user := fx.User(orbit)
+
+	for _, identity := range []string{user.Username, user.Email} {
+		if _, err := svc.GetByIdentity(ctx, orbit.ID, identity); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := svc.Delete(ctx, user.ID); err != nil {
+		t.Fatal(err)
+	}
+
+	if got, err := svc.GetByID(ctx, user.ID); err != nil || got != nil {
+		t.Errorf("GetByID after delete = %+v, %v, want nil", got, err)
+	}
+	for _, identity := range []string{user.Username, user.Email} {
+		if got, err := svc.GetByIdentity(ctx, orbit.ID, identity); err != nil || got != nil {
+			t.Errorf("GetByIdentity(%s) after delete = %+v, %v, want nil", identity, got, err)
+		}
+	}
+}
+
+func TestUserServiceAuthenticate(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	svc := newUserService(dbConn, testutil.LocalCache(t))
+	orbit := fx.Orbit()
+	user := fx.User(orbit)
+
+	got, err := svc.Authenticate(ctx, orbit, user.Email, testutil.Password)
+	if err != nil || got == nil || got.ID != user.ID {
+		t.Fatalf("Authenticate = %+v, %v", got, err)
+	}
+	if _, err := svc.Authenticate(ctx, orbit, user.Username, "wrong"); !errors.Is(err, services.ErrInvalidCredentials) {
+		t.Errorf("Authenticate with a wrong password = %v, want ErrInvalidCredentials", err)
+	}
+	if _, err := svc.Authenticate(ctx, orbit, "nobody", testutil.Password); !errors.Is(err, services.ErrInvalidCredentials) {
+		t.Errorf("Authenticate of an unknown user = %v, want ErrInvalidCredentials", err)
+	}
+
+	if _, err := svc.SetLocked(ctx, user, true); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.Authenticate(ctx, orbit, user.Username, testutil.Password); !errors.Is(err, services.ErrUserLocked) {
+		t.Errorf("Authenticate of a locked user = %v, want ErrUserLocked", err)
+	}
+}
+
+// Signing in with a hash of a non-preferred algorithm, as imported users
+// have, replaces it with one of the orbit's preferred algorithm.
+func TestUserServiceAuthenticateRehashes(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	svc := newUserService(dbConn, testutil.LocalCache(t))
+	orbit := fx.Orbit()
+
+	legacy := map[string]hashing.Hasher{
+		hashing.AlgoPBKDF2SHA256: hashing.NewPBKDF2SHA256(hashing.PBKDF2Params{Iterations: 1000, SaltLength: 16, KeyLength: 32}),
+		hashing.AlgoBcrypt:       hashing.NewBcrypt(hashing.BcryptParams{Cost: 4}),
+	}
+	for algo, h := range legacy {
+		t.Run(algo, func(t *testing.T) {
+			encoded, err := h.Hash(testutil.Password)
+			if err != nil {
+				t.Fatal(err)
+			}
+			user := fx.User(orbit, func(u *models.User) {
+				u.PasswordHash = encoded
+				u.PasswordAlgo = algo
+			})
+
+			if _, err := svc.Authenticate(ctx, orbit, user.Username, "wrong"); !errors.Is(err, services.ErrInvalidCredentials) {
+				t.Fatalf("Authenticate with a wrong password = %v", err)
+			}
+			if got, err := svc.GetByID(ctx, user.ID); err != nil || got.PasswordAlgo != algo {
+				t.Fatalf("user after a failed sign-in = %+v, %v, want the %s hash kept", got, err, algo)
+			}
+
+			if _, err := svc.Authenticate(ctx, orbit, user.Username, testutil.Password); err != nil {
+				t.Fatalf("Authenticate: %v", err)
+			}
+			stored, err := repositories.NewUserRepository(dbConn.Exec(), nop).GetByID(ctx, user.ID)
+			if err != nil || stored.PasswordAlgo != hashing.AlgoArgon2id || stored.PasswordHash == encoded {
+				t.Fatalf("stored hash after sign-in = %s, %v, want argon2id", stored.PasswordAlgo, err)
+			}
+			if _, err := svc.Authenticate(ctx, orbit, user.Email, testutil.Password); err != nil {
+				t.Fatalf("Authenticate with the upgraded hash: %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,380 @@
+package services
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories/db"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services/mail"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	ErrInvalidUserToken   = errors.New("token is invalid, expired or already used")
+	ErrEmailRateLimited   = errors.New("too many emails requested, try again later")
+	ErrNoEmailAddress     = errors.New("user has no email address")
+	ErrEmailNotConfigured = errors.New("no sender address is configured")
+)
+
+// Pages the mailed links lead to.
+const (
+	emailVerificationPath = "/email/verify"
+	passwordResetPath     = "/password/reset"
+)
+
+// passwordResetSendTimeout bounds a reset mail sent after the request that
+// asked for it has been answered.
+const passwordResetSendTimeout = 30 * time.Second
+
+// UserTokenService mails single-use tokens that prove control of an email
+// address: for verifying it and for resetting a forgotten password. A token
+// is a random value signed for its purpose; only its hash is stored.
+type UserTokenService struct {
+	db         *db.DB
+	users      *UserService
+	events     *SecurityEventService
+	mailer     mail.Mailer
+	signingKey []byte
+	from       string
+	logger     zerolog.Logger
+	tracer     trace.Tracer
+
+	// sending tracks reset mails still in flight.
+	sending sync.WaitGroup
+}
+
+// signingKey must be shared by every instance; from is the sender used by
+// orbits that do not configure their own.
+func NewUserTokenService(dbConn *db.DB, users *UserService, events *SecurityEventService, mailer mail.Mailer, signingKey []byte, from string, logger zerolog.Logger) *UserTokenService {
+	return &UserTokenService{
+		db:         dbConn,
+		users:      users,
+		events:     events,
+		mailer:     mailer,
+		signingKey: signingKey,
+		from:       from,
+		logger:     logger,
+		tracer:     otel.Tracer("service.user_token"),
+	}
+}
+
+func (s *UserTokenService) sign(purpose, value string) string {
+	mac := hmac.New(sha256.New, s.signingKey)
+	mac.Write([]byte(purpose + "." + value))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// tokenHash checks the signature and returns the hash the token is stored
+// under. Forged or mangled tokens never reach the database.
+func (s *UserTokenService) tokenHash(purpose, token string) (string, bool) {
+	value, sig, ok := strings.Cut(token, ".")
+	if !ok || !hmac.Equal([]byte(sig), []byte(s.sign(purpose, value))) {
+		return "", false
+	}
+	return hashToken(value), true
+}
+
+// SendEmailVerification mails a link confirming the user's current address.
+// Users whose address is already verified get nothing.
+func (s *UserTokenService) SendEmailVerification(ctx context.Context, orbit *models.Orbit, user *models.User) error {
+	ctx, span := s.tracer.Start(ctx, "SendEmailVerification")
+	defer span.End()
+
+	if user.Email == "" {
+		return ErrNoEmailAddress
+	}
+	if user.EmailVerified {
+		return nil
+	}
+	return s.issue(ctx, orbit, user, models.UserTokenPurposeEmailVerification, emailVerificationPath)
+}
+
+// VerifyEmail spends a verification token. It fails when the user has changed
+// the address since the mail was sent.
+func (s *UserTokenService) VerifyEmail(ctx context.Context, orbit *models.Orbit, token string) (*models.User, error) {
+	ctx, span := s.tracer.Start(ctx, "VerifyEmail")
+	defer span.End()
+
+	hash, ok := s.tokenHash(models.UserTokenPurposeEmailVerification, token)
+	if !ok {
+		return nil, ErrInvalidUserToken
+	}
+	var consumed *models.UserToken
+	err := s.db.WithTx(ctx, func(tx pgx.Tx) error {
+		var err error
+		consumed, err = repositories.NewUserTokenRepository(tx, s.logger).Consume(ctx, hash, models.UserTokenPurposeEmailVerification, time.Now().UTC())
+		if err != nil {
+			return err
+		}
+		if consumed == nil || consumed.OrbitID != orbit.ID {
+			return ErrInvalidUserToken
+		}
+		marked, err := repositories.NewUserRepository(tx, s.logger).MarkEmailVerified(ctx, consumed.UserID, consumed.Email)
+		if err != nil {
+			return err
+		}
+		if !marked {
+			return ErrInvalidUserToken
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	user, err := s.users.GetByID(ctx, consumed.UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrUserNotFound
+	}
+	s.users.invalidateUser(ctx, user)
+	user.EmailVerified = true
+	s.events.Record(ctx, orbit.ID, &user.ID, models.SecurityEventEmailVerified, models.SecuritySeverityInfo, map[string]any{
+		"email": consumed.Email,
+	})
+	s.logger.Info().Int64("user_id", user.ID).Msg("email verified")
+	return user, nil
+}
+
+// RequestPasswordReset mails a reset link to the account identity refers to.
+// It reports success for unknown identities too, so the response does not
+// tell which accounts exist. The token is stored and mailed in the
+// background: doing it before answering would make known identities
+// measurably slower. Failures are logged, never returned.
+func (s *UserTokenService) RequestPasswordReset(ctx context.Context, orbit *models.Orbit, identity string) error {
+	ctx, span := s.tracer.Start(ctx, "RequestPasswordReset")
+	defer span.End()
+
+	user, err := s.users.GetByIdentity(ctx, orbit.ID, strings.TrimSpace(identity))
+	if err != nil {
+		return err
+	}
+	if user == nil || user.Email == "" || !user.IsActive || user.DeletedAt != nil {
+		s.logger.Debug().Int64("orbit_id", orbit.ID).Msg("password reset requested for unknown identity")
+		return nil
+	}
+
+	s.sending.Add(1)
+	go func() {
+		defer s.sending.Done()
+		ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), passwordResetSendTimeout)
+		defer cancel()
+
+		err := s.issue(ctx, orbit, user, models.UserTokenPurposePasswordReset, passwordResetPath)
+		if errors.Is(err, ErrEmailRateLimited) {
+			s.logger.Warn().Int64("user_id", user.ID).Msg("password reset mail rate limited")
+			return
+		}
+		if err != nil {
+			s.logger.Error().Err(err).Int64("user_id", user.ID).Msg("password reset mail failed")
+			return
+		}
+		s.events.Record(ctx, orbit.ID, &user.ID, models.SecurityEventPasswordResetRequested, models.SecuritySeverityInfo, nil)
+	}()
+	return nil
+}
+
+// Shutdown waits for reset mails still being sent, or until ctx is done.
+func (s *UserTokenService) Shutdown(ctx context.Context) error {
+	done := make(chan struct{})
+	go func() {
+		s.sending.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// CheckPasswordReset tells whether a reset token can still be used, without
+// spending it.
+func (s *UserTokenService) CheckPasswordReset(ctx context.Context, orbit *models.Orbit, token string) error {
+	ctx, span := s.tracer.Start(ctx, "CheckPasswordReset")
+	defer span.End()
+
+	hash, ok := s.tokenHash(models.UserTokenPurposePasswordReset, token)
+	if !ok {
+		return ErrInvalidUserToken
+	}
+	t, err := repositories.NewUserTokenRepository(s.db.Exec(), s.logger).GetByHash(ctx, hash)
+	if err != nil {
+		return err
+	}
+	if t == nil || t.OrbitID != orbit.ID || t.Purpose != models.UserTokenPurposePasswordReset ||
+		t.ConsumedAt != nil || !time.Now().Before(t.ExpiresAt) {
+		return ErrInvalidUserToken
+	}
+	return nil
+}
+
+// ResetPassword spends a reset token on a new password and signs the user out
+// everywhere: sessions and refresh tokens are revoked in the same
+// transaction. A password the policy rejects leaves the token usable.
+func (s *UserTokenService) ResetPassword(ctx context.Context, orbit *models.Orbit, token, password string) (*models.User, error) {
+	ctx, span := s.tracer.Start(ctx, "ResetPassword")
+	defer span.End()
+
+	hash, ok := s.tokenHash(models.UserTokenPurposePasswordReset, token)
+	if !ok {
+		return nil, ErrInvalidUserToken
+	}
+	var (
+		updated  *models.User
+		sessions int64
+		refresh  int64
+	)
+	err := s.db.WithTx(ctx, func(tx pgx.Tx) error {
+		tokenRepo := repositories.NewUserTokenRepository(tx, s.logger)
+		consumed, err := tokenRepo.Consume(ctx, hash, models.UserTokenPurposePasswordReset, time.Now().UTC())
+		if err != nil {
+			return err
+		}
+		if consumed == nil || consumed.OrbitID != orbit.ID {
+			return ErrInvalidUserToken
+		}
+		updated, err = s.users.changePassword(ctx, tx, orbit, consumed.UserID, password)
+		if err != nil {
+			return err
+		}
+		if err := tokenRepo.ConsumeAllByUser(ctx, consumed.UserID, models.UserTokenPurposePasswordReset); err != nil {
+			return err
+		}
+		if sessions, err = repositories.NewSessionRepository(tx, s.logger).RevokeByUser(ctx, consumed.UserID); err != nil {
+			return err
+		}
+		refresh, err = repositories.NewRefreshTokenRepository(tx, s.logger).RevokeByUser(ctx, consumed.UserID)
+		return err
+	})
+	if err != nil {
+		if !errors.Is(err, ErrInvalidUserToken) && !errors.Is(err, ErrPasswordPolicy) {
+			s.logger.Error().Err(err).Msg("password reset failed")
+		}
+		return nil, err
+	}
+
+	s.users.invalidateUser(ctx, updated)
+	s.events.Record(ctx, orbit.ID, &updated.ID, models.SecurityEventPasswordReset, models.SecuritySeverityWarning, map[string]any{
+		"sessions_revoked":       sessions,
+		"refresh_tokens_revoked": refresh,
+	})
+	s.logger.Info().Int64("user_id", updated.ID).Int64("sessions_revoked", sessions).Msg("password reset")
+	return updated, nil
+}
+
+// issue stores a new token for purpose, retiring earlier ones, and mails the
+// link to it.
+func (s *UserTokenService) issue(ctx context.Context, orbit *models.Orbit, user *models.User, purpose, path string) error {
+	cfg, err := ParseOrbitConfig(orbit)
+	if err != nil {
+		return err
+	}
+	emailCfg := cfg.Email
+	from := emailCfg.From
+	if from == "" {
+		from = s.from
+	}
+	if from == "" {
+		return ErrEmailNotConfigured
+	}
+
+	now := time.Now().UTC()
+	ttl := emailCfg.TTL(purpose)
+	value, err := newOpaqueToken(32)
+	if err != nil {
+		return err
+	}
+	token := value + "." + s.sign(purpose, value)
+
+	err = s.db.WithTx(ctx, func(tx pgx.Tx) error {
+		repo := repositories.NewUserTokenRepository(tx, s.logger)
+		if emailCfg.MaxPerHour > 0 {
+			sent, err := repo.CountSince(ctx, user.ID, purpose, now.Add(-time.Hour))
+			if err != nil {
+				return err
+			}
+			if sent >= emailCfg.MaxPerHour {
+				return ErrEmailRateLimited
+			}
+		}
+		if err := repo.ConsumeAllByUser(ctx, user.ID, purpose); err != nil {
+			return err
+		}
+		_, err := repo.Create(ctx, &models.UserToken{
+			OrbitID:   orbit.ID,
+			UserID:    user.ID,
+			Purpose:   purpose,
+			TokenHash: hashToken(value),
+			Email:     user.Email,
+			ExpiresAt: now.Add(ttl),
+		})
+		return err
+	})
+	if err != nil {
+		return err
+	}
+
+	base := emailCfg.LinkBaseURL
+	if base == "" {
+		base = "https://" + orbit.Domain
+	}
+	name := user.DisplayName
+	if name == "" {
+		name = user.Username
+	}
+	orbitName := orbit.DisplayName
+	if orbitName == "" {
+		orbitName = orbit.Name
+	}
+	msg, err := renderEmail(emailTemplate(emailCfg, purpose), emailData{
+		Orbit:     orbitName,
+		Name:      name,
+		Email:     user.Email,
+		Link:      strings.TrimRight(base, "/") + path + "?token=" + url.QueryEscape(token),
+		ExpiresAt: now.Add(ttl),
+		ValidFor:  humanDuration(ttl),
+	})
+	if err != nil {
+		return fmt.Errorf("email template %s: %w", purpose, err)
+	}
+	msg.From = from
+	msg.To = user.Email
+	if err := s.mailer.Send(ctx, msg); err != nil {
+		s.logger.Error().Err(err).Int64("user_id", user.ID).Str("purpose", purpose).Msg("send mail failed")
+		return err
+	}
+	s.logger.Info().Int64("user_id", user.ID).Str("purpose", purpose).Msg("token mailed")
+	return nil
+}
+
+// humanDuration renders whole hours or minutes for mail bodies.
+func humanDuration(d time.Duration) string {
+	plural := func(n int64, unit string) string {
+		if n == 1 {
+			return "1 " + unit
+		}
+		return fmt.Sprintf("%d %ss", n, unit)
+	}
+	if d >= time.Hour && d%time.Hour == 0 {
+		return plural(int64(d/time.Hour), "hour")
+	}
+	return plural(int64((d+time.Minute-1)/time.Minute), "minute")
+}
@@ -0,0 +1,247 @@
+package services_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"regexp"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories/db"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services/mail"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/testutil"
+)
+
+// outbox records mail instead of sending it.
+type outbox struct {
+	mu   sync.Mutex
+	sent []mail.Message
+}
+
+func (o *outbox) Send(_ context.Context, msg mail.Message) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.sent = append(o.sent, msg)
+	return nil
+}
+
+func (o *outbox) messages() []mail.Message {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return append([]mail.Message(nil), o.sent...)
+}
+
+var tokenLink = regexp.MustCompile(`token=([^\s"&<]+)`)
+
+// lastToken takes the token out of the link in the latest mail.
+func (o *outbox) lastToken(t *testing.T) string {
+	t.Helper()
+	sent := o.messages()
+	if len(sent) == 0 {
+		t.Fatal("no mail sent")
+	}
+	m := tokenLink.FindStringSubmatch(sent[len(sent)-1].Text)
+	if m == nil {
+		t.Fatalf("no token link in %q", sent[len(sent)-1].Text)
+	}
+	token, err := url.QueryUnescape(m[1])
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func newUserTokenService(t *testing.T, dbConn *db.DB) (*services.UserTokenService, *services.UserService, *outbox) {
+	t.Helper()
+	cacheManager, _ := testutil.Redis(t)
+	users := newUserService(dbConn, cacheManager)
+	box := &outbox{}
+	tokens := services.NewUserTokenService(dbConn, users, services.NewSecurityEventService(dbConn, nop), box, []byte("user-token-test-key"), "Orbitum <no-reply@example.test>", nop)
+	return tokens, users, box
+}
+
+// requestReset asks for a reset and waits for the mail to go out.
+func requestReset(t *testing.T, tokens *services.UserTokenService, orbit *models.Orbit, identity string) {
+	t.Helper()
+	if err := tokens.RequestPasswordReset(t.Context(), orbit, identity); err != nil {
+		t.Fatalf("RequestPasswordReset: %v", err)
+	}
+	if err := tokens.Shutdown(t.Context()); err != nil {
+		t.Fatalf("Shutdown: %v", err)
+	}
+}
+
+func TestUserTokenServiceVerifyEmail(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	tokens, users, box := newUserTokenService(t, dbConn)
+	orbit := fx.Orbit()
+	other := fx.Orbit()
+	user := fx.User(orbit)
+
+	if err := tokens.SendEmailVerification(ctx, orbit, user); err != nil {
+		t.Fatal(err)
+	}
+	if sent := box.messages(); len(sent) != 1 || sent[0].To != user.Email {
+		t.Fatalf("sent = %+v, want one mail to %s", sent, user.Email)
+	}
+	token := box.lastToken(t)
+
+	if _, err := tokens.VerifyEmail(ctx, other, token); !errors.Is(err, services.ErrInvalidUserToken) {
+		t.Fatalf("VerifyEmail in another orbit error = %v, want ErrInvalidUserToken", err)
+	}
+	if _, err := tokens.VerifyEmail(ctx, orbit, token+"x"); !errors.Is(err, services.ErrInvalidUserToken) {
+		t.Fatalf("VerifyEmail with a mangled token error = %v, want ErrInvalidUserToken", err)
+	}
+	verified, err := tokens.VerifyEmail(ctx, orbit, token)
+	if err != nil || !verified.EmailVerified {
+		t.Fatalf("VerifyEmail = %+v, %v", verified, err)
+	}
+	if got, err := users.GetByID(ctx, user.ID); err != nil || !got.EmailVerified {
+		t.Fatalf("user after VerifyEmail = %+v, %v, want verified", got, err)
+	}
+
+	// Single use, and nothing is mailed to an address that is verified.
+	if _, err := tokens.VerifyEmail(ctx, orbit, token); !errors.Is(err, services.ErrInvalidUserToken) {
+		t.Fatalf("second VerifyEmail error = %v, want ErrInvalidUserToken", err)
+	}
+	if err := tokens.SendEmailVerification(ctx, orbit, verified); err != nil || len(box.messages()) != 1 {
+		t.Fatalf("SendEmailVerification to a verified address = %v, %d mails", err, len(box.messages()))
+	}
+}
+
+func TestUserTokenServiceVerifyEmailAfterAddressChange(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	tokens, users, box := newUserTokenService(t, dbConn)
+	orbit := fx.Orbit()
+	user := fx.User(orbit)
+
+	if err := tokens.SendEmailVerification(ctx, orbit, user); err != nil {
+		t.Fatal(err)
+	}
+	token := box.lastToken(t)
+
+	user.Email = "changed-" + user.Email
+	if _, err := users.Update(ctx, user); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := tokens.VerifyEmail(ctx, orbit, token); !errors.Is(err, services.ErrInvalidUserToken) {
+		t.Fatalf("VerifyEmail after the address changed error = %v, want ErrInvalidUserToken", err)
+	}
+	if got, err := users.GetByID(ctx, user.ID); err != nil || got.EmailVerified {
+		t.Fatalf("user after a stale VerifyEmail = %+v, %v, want unverified", got, err)
+	}
+}
+
+func TestUserTokenServiceExpiry(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	tokens, _, box := newUserTokenService(t, dbConn)
+	orbit := fx.Orbit()
+	user := fx.User(orbit)
+
+	requestReset(t, tokens, orbit, user.Username)
+	token := box.lastToken(t)
+	if err := tokens.CheckPasswordReset(ctx, orbit, token); err != nil {
+		t.Fatalf("CheckPasswordReset of a fresh token: %v", err)
+	}
+
+	if _, err := dbConn.Exec().Exec(ctx, `UPDATE user_tokens SET expires_at = now() - interval '1 second' WHERE user_id = $1`, user.ID); err != nil {
+		t.Fatal(err)
+	}
+	if err := tokens.CheckPasswordReset(ctx, orbit, token); !errors.Is(err, services.ErrInvalidUserToken) {
+		t.Fatalf("CheckPasswordReset of an expired token error = %v, want ErrInvalidUserToken", err)
+	}
+	if _, err := tokens.ResetPassword(ctx, orbit, token, "a brand new passphrase"); !errors.Is(err, services.ErrInvalidUserToken) {
+		t.Fatalf("ResetPassword with an expired token error = %v, want ErrInvalidUserToken", err)
+	}
+}
+
+func TestUserTokenServiceResetPassword(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	tokens, users, box := newUserTokenService(t, dbConn)
+	sessions := repositories.NewSessionRepository(dbConn.Exec(), nop)
+	refreshTokens := repositories.NewRefreshTokenRepository(dbConn.Exec(), nop)
+	orbit := fx.Orbit()
+	client := fx.Client(orbit)
+	user := fx.User(orbit)
+
+	now := time.Now().UTC()
+	expires := now.Add(time.Hour)
+	session, err := sessions.Create(ctx, &models.Session{
+		OrbitID:      orbit.ID,
+		UserID:       user.ID,
+		ClientID:     &client.ID,
+		StartedAt:    now,
+		LastActiveAt: now,
+		ExpiresAt:    &expires,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := refreshTokens.Create(ctx, &models.RefreshToken{
+		ExpiresAt: now.Add(time.Hour),
+		JTI:       "rt-reset",
+		OrbitID:   orbit.ID,
+		ClientID:  client.ID,
+		UserID:    &user.ID,
+		SessionID: &session.ID,
+		Scopes:    json.RawMessage(`["openid"]`),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	requestReset(t, tokens, orbit, user.Email)
+	first := box.lastToken(t)
+	requestReset(t, tokens, orbit, user.Username)
+	token := box.lastToken(t)
+	if err := tokens.CheckPasswordReset(ctx, orbit, first); !errors.Is(err, services.ErrInvalidUserToken) {
+		t.Fatalf("CheckPasswordReset of a superseded token error = %v, want ErrInvalidUserToken", err)
+	}
+
+	// A password the policy rejects leaves the token usable.
+	if _, err := tokens.ResetPassword(ctx, orbit, token, "short"); !errors.Is(err, services.ErrPasswordPolicy) {
+		t.Fatalf("ResetPassword with a weak password error = %v, want ErrPasswordPolicy", err)
+	}
+	const password = "a brand new passphrase"
+	if _, err := tokens.ResetPassword(ctx, orbit, token, password); err != nil {
+		t.Fatalf("ResetPassword: %v", err)
+	}
+	if _, err := tokens.ResetPassword(ctx, orbit, token, "another new passphrase"); !errors.Is(err, services.ErrInvalidUserToken) {
+		t.Fatalf("second ResetPassword error = %v, want ErrInvalidUserToken", err)
+	}
+	if _, err := users.Authenticate(ctx, orbit, user.Username, password); err != nil {
+		t.Fatalf("Authenticate with the new password: %v", err)
+	}
+
+	if got, err := sessions.GetByID(ctx, session.ID); err != nil || got == nil || !got.Revoked {
+		t.Fatalf("session after reset = %+v, %v, want it revoked", got, err)
+	}
+	if rt, err := refreshTokens.GetByJTI(ctx, "rt-reset"); err != nil || rt == nil || !rt.Revoked {
+		t.Fatalf("refresh token after reset = %+v, %v, want it revoked", rt, err)
+	}
+}
+
+func TestUserTokenServiceResetUnknownIdentity(t *testing.T) {
+	dbConn, fx := setup(t)
+	tokens, _, box := newUserTokenService(t, dbConn)
+	orbit := fx.Orbit()
+	other := fx.Orbit()
+	stranger := fx.User(other)
+	inactive := fx.User(orbit, func(u *models.User) { u.IsActive = false })
+
+	for _, identity := range []string{"nobody@example.test", stranger.Email, inactive.Username} {
+		requestReset(t, tokens, orbit, identity)
+	}
+	if sent := box.messages(); len(sent) != 0 {
+		t.Fatalf("sent %d mails for identities with no account in the orbit", len(sent))
+	}
+}
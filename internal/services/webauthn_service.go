@@ -0,0 +1,602 @@
+package services
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories/db"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services/cache"
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/webauthn"
+	"github.com/jackc/pgx/v5"
+	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	ErrWebAuthnUnavailable          = errors.New("passkeys are not available for this orbit")
+	ErrWebAuthnCeremonyNotFound     = errors.New("passkey ceremony not found or expired")
+	ErrWebAuthnCredentialNotFound   = errors.New("passkey not found")
+	ErrWebAuthnNameTaken            = errors.New("passkey name is already in use")
+	ErrWebAuthnLimitReached         = errors.New("maximum number of passkeys reached")
+	ErrInvalidWebAuthnRegistration  = errors.New("invalid passkey registration")
+	ErrInvalidWebAuthnAssertion     = errors.New("invalid passkey assertion")
+	ErrWebAuthnCredentialCloned     = errors.New("passkey sign counter did not increase")
+	ErrUnsupportedAttestationFormat = errors.New("unsupported attestation format")
+)
+
+const (
+	// maxWebAuthnCredentialsScan bounds how many rows are read per user;
+	// orbits cannot configure more passkeys than this.
+	maxWebAuthnCredentialsScan = 100
+	// The specification caps user handles at 64 bytes; all of them are used.
+	webAuthnUserHandleSize = 64
+)
+
+// webAuthnAttestationFormats are the attestation statements accepted at
+// registration. Anything else is refused before it is verified.
+var webAuthnAttestationFormats = []protocol.AttestationFormat{
+	protocol.AttestationFormatNone,
+	protocol.AttestationFormatPacked,
+}
+
+// webAuthnCeremony is the server side state of a registration or login
+// between the options being handed out and the authenticator response.
+type webAuthnCeremony struct {
+	OrbitID int64
+	// UserID is zero for a discoverable (usernameless) login.
+	UserID  int64
+	Name    string
+	Session webauthn.SessionData
+}
+
+// webAuthnUser adapts a user and its stored credentials to the library.
+type webAuthnUser struct {
+	user        *models.User
+	handle      []byte
+	credentials []*models.WebAuthnCredential
+}
+
+func (u *webAuthnUser) WebAuthnID() []byte {
+	return u.handle
+}
+
+func (u *webAuthnUser) WebAuthnName() string {
+	if u.user.Username != "" {
+		return u.user.Username
+	}
+	return u.user.Email
+}
+
+func (u *webAuthnUser) WebAuthnDisplayName() string {
+	if u.user.DisplayName != "" {
+		return u.user.DisplayName
+	}
+	return u.WebAuthnName()
+}
+
+func (u *webAuthnUser) WebAuthnCredentials() []webauthn.Credential {
+	out := make([]webauthn.Credential, 0, len(u.credentials))
+	for _, c := range u.credentials {
+		transports := make([]protocol.AuthenticatorTransport, 0, len(c.Transports))
+		for _, t := range c.Transports {
+			transports = append(transports, protocol.AuthenticatorTransport(t))
+		}
+		out = append(out, webauthn.Credential{
+			ID:              c.CredentialID,
+			PublicKey:       c.PublicKey,
+			AttestationType: c.AttestationFormat,
+			Transport:       transports,
+			Flags: webauthn.CredentialFlags{
+				UserVerified:   c.UserVerified,
+				BackupEligible: c.BackupEligible,
+				BackupState:    c.BackupState,
+			},
+			Authenticator: webauthn.Authenticator{
+				AAGUID:    c.AAGUID,
+				SignCount: uint32(c.SignCount),
+			},
+		})
+	}
+	return out
+}
+
+func (u *webAuthnUser) credential(id []byte) *models.WebAuthnCredential {
+	for _, c := range u.credentials {
+		if bytes.Equal(c.CredentialID, id) {
+			return c
+		}
+	}
+	return nil
+}
+
+type WebAuthnService struct {
+	db       *db.DB
+	cacheMan cache.Manager
+	users    *UserService
+	events   *SecurityEventService
+	logger   zerolog.Logger
+	tracer   trace.Tracer
+}
+
+func NewWebAuthnService(dbConn *db.DB, cacheManager cache.Manager, users *UserService, events *SecurityEventService, logger zerolog.Logger) *WebAuthnService {
+	return &WebAuthnService{
+		db:       dbConn,
+		cacheMan: cacheManager,
+		users:    users,
+		events:   events,
+		logger:   logger,
+		tracer:   otel.Tracer("service.webauthn"),
+	}
+}
+
+func webAuthnCeremonyKey(kind, id string) string {
+	return "webauthn_" + kind + ":" + id
+}
+
+// relyingParty builds the relying party for an orbit. The RP ID is the orbit
+// domain, so passkeys are scoped to it and its subdomains.
+func relyingParty(orbit *models.Orbit, cfg WebAuthnConfig) (*webauthn.WebAuthn, error) {
+	rpID := strings.ToLower(strings.TrimSpace(orbit.Domain))
+	if rpID == "" {
+		return nil, ErrWebAuthnUnavailable
+	}
+	origins := cfg.Origins
+	if len(origins) == 0 {
+		origins = []string{"https://" + rpID}
+	}
+	name := cfg.RPName
+	if name == "" {
+		name = orbit.DisplayName
+	}
+	if name == "" {
+		name = orbit.Name
+	}
+
+	residentKey := protocol.ResidentKeyRequirement(cfg.ResidentKey)
+	requireResidentKey := residentKey == protocol.ResidentKeyRequirementRequired
+	timeout := webauthn.TimeoutConfig{Enforce: true, Timeout: cfg.Timeout(), TimeoutUVD: cfg.Timeout()}
+	return webauthn.New(&webauthn.Config{
+		RPID:                  rpID,
+		RPDisplayName:         name,
+		RPOrigins:             origins,
+		AttestationPreference: protocol.ConveyancePreference(cfg.Attestation),
+		AuthenticatorSelection: protocol.AuthenticatorSelection{
+			RequireResidentKey: &requireResidentKey,
+			ResidentKey:        residentKey,
+			UserVerification:   protocol.UserVerificationRequirement(cfg.UserVerification),
+		},
+		Timeouts: webauthn.TimeoutsConfig{Login: timeout, Registration: timeout},
+	})
+}
+
+// Available reports whether passkeys can be used at the orbit, which needs a
+// domain to act as the relying party ID.
+func (s *WebAuthnService) Available(orbit *models.Orbit) bool {
+	return strings.TrimSpace(orbit.Domain) != ""
+}
+
+func (s *WebAuthnService) setup(orbit *models.Orbit) (WebAuthnConfig, *webauthn.WebAuthn, error) {
+	cfg, err := ParseOrbitConfig(orbit)
+	if err != nil {
+		return WebAuthnConfig{}, nil, err
+	}
+	rp, err := relyingParty(orbit, cfg.WebAuthn)
+	if err != nil {
+		return WebAuthnConfig{}, nil, err
+	}
+	return cfg.WebAuthn, rp, nil
+}
+
+func (s *WebAuthnService) saveCeremony(ctx context.Context, kind string, cfg WebAuthnConfig, ceremony *webAuthnCeremony) (string, error) {
+	id, err := newOpaqueToken(32)
+	if err != nil {
+		return "", err
+	}
+	if err := s.cacheMan.Cache("volatile").Set(ctx, webAuthnCeremonyKey(kind, id), ceremony, cfg.Timeout()); err != nil {
+		s.logger.Error().Err(err).Msg("save webauthn ceremony failed")
+		return "", err
+	}
+	return id, nil
+}
+
+// takeCeremony loads a ceremony and removes it, so every challenge is
+// answered at most once.
+func (s *WebAuthnService) takeCeremony(ctx context.Context, kind string, orbitID int64, id string) (*webAuthnCeremony, error) {
+	c := s.cacheMan.Cache("volatile")
+	var ceremony webAuthnCeremony
+	if err := c.Get(ctx, webAuthnCeremonyKey(kind, id), &ceremony); err != nil {
+		if errors.Is(err, cache.ErrCacheMiss) {
+			return nil, ErrWebAuthnCeremonyNotFound
+		}
+		return nil, err
+	}
+	_ = c.Delete(ctx, webAuthnCeremonyKey(kind, id))
+	if ceremony.OrbitID != orbitID {
+		return nil, ErrWebAuthnCeremonyNotFound
+	}
+	return &ceremony, nil
+}
+
+// loadUser returns the library view of a user. Users without passkeys get a
+// fresh random handle that is stored with their first credential.
+func (s *WebAuthnService) loadUser(ctx context.Context, user *models.User) (*webAuthnUser, error) {
+	list, _, err := repositories.NewWebAuthnCredentialRepository(s.db.Exec(), s.logger).ListByUser(ctx, user.ID, repositories.Page{Limit: maxWebAuthnCredentialsScan})
+	if err != nil {
+		return nil, err
+	}
+	u := &webAuthnUser{user: user, credentials: list}
+	if len(list) > 0 {
+		u.handle = list[0].UserHandle
+		return u, nil
+	}
+	u.handle = make([]byte, webAuthnUserHandleSize)
+	if _, err := rand.Read(u.handle); err != nil {
+		return nil, err
+	}
+	return u, nil
+}
+
+// BeginRegistration starts registering a passkey for a signed-in user and
+// returns the ceremony id together with the options for
+// navigator.credentials.create.
+func (s *WebAuthnService) BeginRegistration(ctx context.Context, orbit *models.Orbit, user *models.User, name string) (string, *protocol.CredentialCreation, error) {
+	ctx, span := s.tracer.Start(ctx, "BeginRegistration")
+	defer span.End()
+
+	cfg, rp, err := s.setup(orbit)
+	if err != nil {
+		return "", nil, err
+	}
+	name = strings.TrimSpace(name)
+	if name == "" || len(name) > 100 {
+		return "", nil, fmt.Errorf("%w: name must be between 1 and 100 characters", ErrInvalidWebAuthnRegistration)
+	}
+	u, err := s.loadUser(ctx, user)
+	if err != nil {
+		return "", nil, err
+	}
+	if err := checkWebAuthnName(cfg, u.credentials, name); err != nil {
+		return "", nil, err
+	}
+
+	creation, session, err := rp.BeginRegistration(u,
+		webauthn.WithExclusions(webauthn.Credentials(u.WebAuthnCredentials()).CredentialDescriptors()),
+		webauthn.WithAttestationFormats(webAuthnAttestationFormats),
+		webauthn.WithExtensions(protocol.AuthenticationExtensions{"credProps": true}),
+	)
+	if err != nil {
+		return "", nil, err
+	}
+	id, err := s.saveCeremony(ctx, "registration", cfg, &webAuthnCeremony{
+		OrbitID: orbit.ID,
+		UserID:  user.ID,
+		Name:    name,
+		Session: *session,
+	})
+	if err != nil {
+		return "", nil, err
+	}
+	return id, creation, nil
+}
+
+// FinishRegistration verifies the authenticator response to a registration
+// ceremony and stores the new credential.
+func (s *WebAuthnService) FinishRegistration(ctx context.Context, orbit *models.Orbit, user *models.User, ceremonyID string, response []byte) (*models.WebAuthnCredential, error) {
+	ctx, span := s.tracer.Start(ctx, "FinishRegistration")
+	defer span.End()
+
+	cfg, rp, err := s.setup(orbit)
+	if err != nil {
+		return nil, err
+	}
+	ceremony, err := s.takeCeremony(ctx, "registration", orbit.ID, ceremonyID)
+	if err != nil {
+		return nil, err
+	}
+	if ceremony.UserID != user.ID {
+		return nil, ErrWebAuthnCeremonyNotFound
+	}
+
+	parsed, err := protocol.ParseCredentialCreationResponseBytes(response)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidWebAuthnRegistration, webAuthnErrorDetail(err))
+	}
+	format := protocol.AttestationFormat(parsed.Response.AttestationObject.Format)
+	if !containsAttestationFormat(webAuthnAttestationFormats, format) {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedAttestationFormat, format)
+	}
+
+	u := &webAuthnUser{user: user, handle: ceremony.Session.UserID}
+	cred, err := rp.CreateCredential(u, ceremony.Session, parsed)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidWebAuthnRegistration, webAuthnErrorDetail(err))
+	}
+
+	transports := make([]string, 0, len(cred.Transport))
+	for _, t := range cred.Transport {
+		transports = append(transports, string(t))
+	}
+	record := &models.WebAuthnCredential{
+		OrbitID:           orbit.ID,
+		UserID:            user.ID,
+		UserHandle:        ceremony.Session.UserID,
+		CredentialID:      cred.ID,
+		PublicKey:         cred.PublicKey,
+		AttestationFormat: cred.AttestationType,
+		AAGUID:            cred.Authenticator.AAGUID,
+		SignCount:         int64(cred.Authenticator.SignCount),
+		Transports:        transports,
+		UserVerified:      cred.Flags.UserVerified,
+		BackupEligible:    cred.Flags.BackupEligible,
+		BackupState:       cred.Flags.BackupState,
+		Discoverable:      residentKeyCreated(parsed, cfg),
+		Name:              ceremony.Name,
+	}
+
+	var created *models.WebAuthnCredential
+	err = s.db.WithTx(ctx, func(tx pgx.Tx) error {
+		repo := repositories.NewWebAuthnCredentialRepository(tx, s.logger)
+		existing, _, err := repo.ListByUser(ctx, user.ID, repositories.Page{Limit: maxWebAuthnCredentialsScan})
+		if err != nil {
+			return err
+		}
+		if err := checkWebAuthnName(cfg, existing, record.Name); err != nil {
+			return err
+		}
+		taken, err := repo.GetByCredentialID(ctx, orbit.ID, record.CredentialID)
+		if err != nil {
+			return err
+		}
+		if taken != nil {
+			return fmt.Errorf("%w: credential is already registered", ErrInvalidWebAuthnRegistration)
+		}
+		created, err = repo.Create(ctx, record)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	s.events.Record(ctx, orbit.ID, &user.ID, models.SecurityEventWebAuthnCredentialRegistered, models.SecuritySeverityInfo, map[string]any{
+		"credential_id": created.ID,
+		"attestation":   created.AttestationFormat,
+		"discoverable":  created.Discoverable,
+	})
+	return created, nil
+}
+
+// BeginLogin starts an assertion ceremony. Without an identity the ceremony
+// is discoverable: the authenticator picks the credential and the user is
+// identified from its user handle. Unknown identities and users without
+// passkeys fall back to the same, but a user with passkeys is sent their
+// allowCredentials, which tells the caller the identity exists. Login pages
+// that must not reveal accounts should omit the identity; it stays optional
+// because credentials the authenticator did not make discoverable can only
+// be used through allowCredentials.
+func (s *WebAuthnService) BeginLogin(ctx context.Context, orbit *models.Orbit, identity string) (string, *protocol.CredentialAssertion, error) {
+	ctx, span := s.tracer.Start(ctx, "BeginLogin")
+	defer span.End()
+
+	cfg, rp, err := s.setup(orbit)
+	if err != nil {
+		return "", nil, err
+	}
+	var u *webAuthnUser
+	if identity = strings.TrimSpace(identity); identity != "" {
+		user, err := s.users.GetByIdentity(ctx, orbit.ID, identity)
+		if err != nil {
+			return "", nil, err
+		}
+		if user != nil {
+			if u, err = s.loadUser(ctx, user); err != nil {
+				return "", nil, err
+			}
+		}
+	}
+
+	uv := webauthn.WithUserVerification(protocol.UserVerificationRequirement(cfg.UserVerification))
+	ceremony := &webAuthnCeremony{OrbitID: orbit.ID}
+	var (
+		assertion *protocol.CredentialAssertion
+		session   *webauthn.SessionData
+	)
+	if u != nil && len(u.credentials) > 0 {
+		ceremony.UserID = u.user.ID
+		assertion, session, err = rp.BeginLogin(u, uv)
+	} else {
+		assertion, session, err = rp.BeginDiscoverableLogin(uv)
+	}
+	if err != nil {
+		return "", nil, err
+	}
+	ceremony.Session = *session
+
+	id, err := s.saveCeremony(ctx, "login", cfg, ceremony)
+	if err != nil {
+		return "", nil, err
+	}
+	return id, assertion, nil
+}
+
+// FinishLogin verifies an assertion and returns the authenticated user. A sign
+// counter that fails to move forward rejects the login and is reported as a
+// possibly cloned authenticator.
+func (s *WebAuthnService) FinishLogin(ctx context.Context, orbit *models.Orbit, ceremonyID string, response []byte) (*models.User, *models.WebAuthnCredential, error) {
+	ctx, span := s.tracer.Start(ctx, "FinishLogin")
+	defer span.End()
+
+	_, rp, err := s.setup(orbit)
+	if err != nil {
+		return nil, nil, err
+	}
+	ceremony, err := s.takeCeremony(ctx, "login", orbit.ID, ceremonyID)
+	if err != nil {
+		return nil, nil, err
+	}
+	parsed, err := protocol.ParseCredentialRequestResponseBytes(response)
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %s", ErrInvalidWebAuthnAssertion, webAuthnErrorDetail(err))
+	}
+
+	var (
+		u    *webAuthnUser
+		cred *webauthn.Credential
+	)
+	if ceremony.UserID == 0 {
+		var found webauthn.User
+		found, cred, err = rp.ValidatePasskeyLogin(func(_, userHandle []byte) (webauthn.User, error) {
+			return s.userByHandle(ctx, orbit.ID, userHandle)
+		}, ceremony.Session, parsed)
+		if err == nil {
+			u = found.(*webAuthnUser)
+		}
+	} else {
+		user, gerr := s.users.GetByID(ctx, ceremony.UserID)
+		if gerr != nil {
+			return nil, nil, gerr
+		}
+		if user == nil {
+			return nil, nil, ErrInvalidWebAuthnAssertion
+		}
+		if u, err = s.loadUser(ctx, user); err != nil {
+			return nil, nil, err
+		}
+		cred, err = rp.ValidateLogin(u, ceremony.Session, parsed)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("%w: %s", ErrInvalidWebAuthnAssertion, webAuthnErrorDetail(err))
+	}
+
+	record := u.credential(cred.ID)
+	if record == nil {
+		return nil, nil, ErrInvalidWebAuthnAssertion
+	}
+	if u.user.OrbitID != orbit.ID || !u.user.IsActive || u.user.DeletedAt != nil {
+		return nil, nil, ErrInvalidCredentials
+	}
+	if u.user.IsLocked {
+		return nil, nil, ErrUserLocked
+	}
+
+	if cred.Authenticator.CloneWarning {
+		s.reportClone(ctx, orbit, record, parsed.Response.AuthenticatorData.Counter)
+		return nil, nil, ErrWebAuthnCredentialCloned
+	}
+	record.SignCount = int64(cred.Authenticator.SignCount)
+	record.UserVerified = cred.Flags.UserVerified
+	record.BackupState = cred.Flags.BackupState
+	recorded, err := repositories.NewWebAuthnCredentialRepository(s.db.Exec(), s.logger).RecordAssertion(ctx, record)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !recorded {
+		// A concurrent assertion already moved the counter past this one.
+		s.reportClone(ctx, orbit, record, parsed.Response.AuthenticatorData.Counter)
+		return nil, nil, ErrWebAuthnCredentialCloned
+	}
+	return u.user, record, nil
+}
+
+func (s *WebAuthnService) userByHandle(ctx context.Context, orbitID int64, handle []byte) (*webAuthnUser, error) {
+	list, _, err := repositories.NewWebAuthnCredentialRepository(s.db.Exec(), s.logger).ListByUserHandle(ctx, orbitID, handle, repositories.Page{Limit: maxWebAuthnCredentialsScan})
+	if err != nil {
+		return nil, err
+	}
+	if len(list) == 0 {
+		return nil, ErrWebAuthnCredentialNotFound
+	}
+	user, err := s.users.GetByID(ctx, list[0].UserID)
+	if err != nil {
+		return nil, err
+	}
+	if user == nil {
+		return nil, ErrWebAuthnCredentialNotFound
+	}
+	return &webAuthnUser{user: user, handle: handle, credentials: list}, nil
+}
+
+func (s *WebAuthnService) reportClone(ctx context.Context, orbit *models.Orbit, record *models.WebAuthnCredential, counter uint32) {
+	s.logger.Warn().Int64("user_id", record.UserID).Int64("webauthn_credential_id", record.ID).Msg("webauthn sign counter regression")
+	s.events.Record(ctx, orbit.ID, &record.UserID, models.SecurityEventWebAuthnCloneSuspected, models.SecuritySeverityCritical, map[string]any{
+		"credential_id":  record.ID,
+		"stored_count":   record.SignCount,
+		"received_count": counter,
+	})
+}
+
+func (s *WebAuthnService) List(ctx context.Context, userID int64) ([]*models.WebAuthnCredential, error) {
+	ctx, span := s.tracer.Start(ctx, "List")
+	defer span.End()
+
+	list, _, err := repositories.NewWebAuthnCredentialRepository(s.db.Exec(), s.logger).ListByUser(ctx, userID, repositories.Page{Limit: maxWebAuthnCredentialsScan})
+	return list, err
+}
+
+func (s *WebAuthnService) Delete(ctx context.Context, userID, credentialID int64) error {
+	ctx, span := s.tracer.Start(ctx, "Delete")
+	defer span.End()
+
+	return s.db.WithTx(ctx, func(tx pgx.Tx) error {
+		repo := repositories.NewWebAuthnCredentialRepository(tx, s.logger)
+		c, err := repo.GetByID(ctx, credentialID)
+		if err != nil {
+			return err
+		}
+		if c == nil || c.UserID != userID {
+			return ErrWebAuthnCredentialNotFound
+		}
+		_, err = repo.Delete(ctx, credentialID)
+		return err
+	})
+}
+
+func checkWebAuthnName(cfg WebAuthnConfig, existing []*models.WebAuthnCredential, name string) error {
+	if cfg.MaxCredentials > 0 && len(existing) >= cfg.MaxCredentials {
+		return ErrWebAuthnLimitReached
+	}
+	for _, c := range existing {
+		if strings.EqualFold(c.Name, name) {
+			return ErrWebAuthnNameTaken
+		}
+	}
+	return nil
+}
+
+func containsAttestationFormat(formats []protocol.AttestationFormat, format protocol.AttestationFormat) bool {
+	for _, f := range formats {
+		if f == format {
+			return true
+		}
+	}
+	return false
+}
+
+// residentKeyCreated reports whether the authenticator created a discoverable
+// credential. Browsers say so through the credProps extension; without it only
+// a required resident key is certain.
+func residentKeyCreated(parsed *protocol.ParsedCredentialCreationData, cfg WebAuthnConfig) bool {
+	if props, ok := parsed.ClientExtensionResults["credProps"].(map[string]any); ok {
+		if rk, ok := props["rk"].(bool); ok {
+			return rk
+		}
+	}
+	return protocol.ResidentKeyRequirement(cfg.ResidentKey) == protocol.ResidentKeyRequirementRequired
+}
+
+// webAuthnErrorDetail surfaces the library's client-safe detail instead of
+// its generic error type.
+func webAuthnErrorDetail(err error) string {
+	var perr *protocol.Error
+	if errors.As(err, &perr) && perr.Details != "" {
+		return perr.Details
+	}
+	return err.Error()
+}
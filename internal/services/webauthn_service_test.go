@@ -0,0 +1,84 @@
+package services_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/testutil"
+)
+
+func TestWebAuthnServiceCeremonies(t *testing.T) {
+	dbConn, fx := setup(t)
+	ctx := t.Context()
+	cacheManager, _ := testutil.Redis(t)
+	webauthn := services.NewWebAuthnService(dbConn, cacheManager, newUserService(dbConn, cacheManager), services.NewSecurityEventService(dbConn, nop), nop)
+	orbit := fx.Orbit()
+	user := fx.User(orbit)
+
+	register := func(a *testutil.Authenticator, name, format string) error {
+		t.Helper()
+		id, creation, err := webauthn.BeginRegistration(ctx, orbit, user, name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		_, err = webauthn.FinishRegistration(ctx, orbit, user, id, a.Register(creation, format))
+		return err
+	}
+	login := func(a *testutil.Authenticator, identity string) (int64, error) {
+		t.Helper()
+		id, assertion, err := webauthn.BeginLogin(ctx, orbit, identity)
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, _, err := webauthn.FinishLogin(ctx, orbit, id, a.Assert(assertion))
+		if err != nil {
+			return 0, err
+		}
+		return got.ID, nil
+	}
+
+	phone := testutil.NewAuthenticator(t, orbit.Domain)
+	if err := register(phone, "Phone", "none"); err != nil {
+		t.Fatalf("none attestation: %v", err)
+	}
+	key := testutil.NewAuthenticator(t, orbit.Domain)
+	if err := register(key, "Security key", "packed"); err != nil {
+		t.Fatalf("packed attestation: %v", err)
+	}
+	for _, format := range []string{"fido-u2f", "tpm", "android-key"} {
+		if err := register(testutil.NewAuthenticator(t, orbit.Domain), "Other "+format, format); !errors.Is(err, services.ErrUnsupportedAttestationFormat) {
+			t.Errorf("%s attestation error = %v, want ErrUnsupportedAttestationFormat", format, err)
+		}
+	}
+	list, err := webauthn.List(ctx, user.ID)
+	if err != nil || len(list) != 2 {
+		t.Fatalf("List = %d passkeys, %v, want 2", len(list), err)
+	}
+
+	if got, err := login(phone, user.Username); err != nil || got != user.ID {
+		t.Fatalf("login by identity = %d, %v", got, err)
+	}
+	// Without an identity the user is found from the handle the
+	// authenticator returns.
+	if got, err := login(key, ""); err != nil || got != user.ID {
+		t.Fatalf("discoverable login = %d, %v", got, err)
+	}
+
+	// Another relying party's assertion is refused whatever it signs.
+	key.RPID = "evil.example.test"
+	if _, err := login(key, ""); !errors.Is(err, services.ErrInvalidWebAuthnAssertion) {
+		t.Errorf("login with a foreign RP ID error = %v, want ErrInvalidWebAuthnAssertion", err)
+	}
+	key.RPID, key.Origin = orbit.Domain, "https://evil.example.test"
+	if _, err := login(key, ""); !errors.Is(err, services.ErrInvalidWebAuthnAssertion) {
+		t.Errorf("login from a foreign origin error = %v, want ErrInvalidWebAuthnAssertion", err)
+	}
+
+	// A copy of the phone's key that has signed fewer times than the
+	// original gives itself away through the counter.
+	phone.SignCount = 0
+	if _, err := login(phone, ""); !errors.Is(err, services.ErrWebAuthnCredentialCloned) {
+		t.Fatalf("login with a regressed counter error = %v, want ErrWebAuthnCredentialCloned", err)
+	}
+}
@@ -0,0 +1,186 @@
+package testutil
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/models"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/repositories/db"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services/hashing"
+	"github.com/rs/zerolog"
+)
+
+// Password is the password of every user created by Fixtures.User.
+const Password = "correct-horse-battery-staple"
+
+// EncryptionKey is the data encryption key tests use for secret ciphers.
+var EncryptionKey = []byte("0123456789abcdef0123456789abcdef")
+
+// Fixtures creates rows straight through the repositories, so tests of a
+// service do not depend on the service under test to set up their data.
+type Fixtures struct {
+	t  testing.TB
+	db *db.DB
+}
+
+func NewFixtures(t testing.TB, dbConn *db.DB) *Fixtures {
+	return &Fixtures{t: t, db: dbConn}
+}
+
+// Orbit creates an orbit with a unique name and domain. Options adjust the
+// orbit before it is stored.
+func (f *Fixtures) Orbit(opts ...func(*models.Orbit)) *models.Orbit {
+	f.t.Helper()
+
+	name := "orbit-" + randomHex(4)
+	orbit := &models.Orbit{
+		Name:          name,
+		DisplayName:   "Orbit " + name,
+		Issuer:        "https://" + name + ".example.test",
+		Domain:        name + ".example.test",
+		DefaultScopes: json.RawMessage(`["openid"]`),
+	}
+	for _, opt := range opts {
+		opt(orbit)
+	}
+	created, err := repositories.NewOrbitRepository(f.db.Exec(), zerolog.Nop()).Create(context.Background(), orbit)
+	if err != nil {
+		f.t.Fatalf("create orbit: %v", err)
+	}
+	return created
+}
+
+// Client creates an active confidential client of orbit with the
+// authorization code grant.
+func (f *Fixtures) Client(orbit *models.Orbit, opts ...func(*models.Client)) *models.Client {
+	f.t.Helper()
+
+	id := "client-" + randomHex(4)
+	client := &models.Client{
+		OrbitID:                 orbit.ID,
+		ClientID:                id,
+		Name:                    "Client " + id,
+		RedirectURIs:            json.RawMessage(`["https://app.example.test/callback"]`),
+		PostLogoutRedirectURIs:  json.RawMessage(`[]`),
+		GrantTypes:              json.RawMessage(`["authorization_code","refresh_token"]`),
+		ResponseTypes:           json.RawMessage(`["code"]`),
+		TokenEndpointAuthMethod: "client_secret_basic",
+		Contacts:                json.RawMessage(`[]`),
+		AppType:                 "web",
+		IsActive:                true,
+		AllowedCORSOrigins:      json.RawMessage(`[]`),
+		AllowedScopes:           json.RawMessage(`["openid","profile","email"]`),
+	}
+	for _, opt := range opts {
+		opt(client)
+	}
+	created, err := repositories.NewClientRepository(f.db.Exec(), zerolog.Nop()).Create(context.Background(), client)
+	if err != nil {
+		f.t.Fatalf("create client: %v", err)
+	}
+	return created
+}
+
+// User creates an active user of orbit whose password is Password.
+func (f *Fixtures) User(orbit *models.Orbit, opts ...func(*models.User)) *models.User {
+	f.t.Helper()
+
+	cfg, err := services.ParseOrbitConfig(orbit)
+	if err != nil {
+		f.t.Fatalf("parse orbit config: %v", err)
+	}
+	hasher, err := hashing.NewRegistry(cfg.Passwords)
+	if err != nil {
+		f.t.Fatalf("password hasher: %v", err)
+	}
+	hash, algo, err := hasher.Hash(Password)
+	if err != nil {
+		f.t.Fatalf("hash password: %v", err)
+	}
+
+	name := "user-" + randomHex(4)
+	now := time.Now().UTC()
+	user := &models.User{
+		OrbitID:            orbit.ID,
+		Username:           name,
+		Email:              name + "@example.test",
+		PasswordHash:       hash,
+		PasswordAlgo:       algo,
+		LastPasswordChange: &now,
+		DisplayName:        "User " + name,
+		IsActive:           true,
+	}
+	for _, opt := range opts {
+		opt(user)
+	}
+	created, err := repositories.NewUserRepository(f.db.Exec(), zerolog.Nop()).Create(context.Background(), user)
+	if err != nil {
+		f.t.Fatalf("create user: %v", err)
+	}
+	return created
+}
+
+// Key creates an active ES256 signing key of orbit. The private key is sealed
+// with EncryptionKey.
+func (f *Fixtures) Key(orbit *models.Orbit, opts ...func(*models.JWKey)) *models.JWKey {
+	f.t.Helper()
+
+	priv, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		f.t.Fatalf("generate key: %v", err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(priv)
+	if err != nil {
+		f.t.Fatalf("marshal key: %v", err)
+	}
+	secretCipher, err := services.NewAESGCMCipher(EncryptionKey)
+	if err != nil {
+		f.t.Fatalf("cipher: %v", err)
+	}
+
+	kid := "key-" + randomHex(4)
+	sealed, err := secretCipher.Seal(der, []byte(kid))
+	if err != nil {
+		f.t.Fatalf("seal key: %v", err)
+	}
+	pub, err := json.Marshal(map[string]string{
+		"kty": "EC",
+		"crv": "P-256",
+		"kid": kid,
+		"use": "sig",
+		"alg": "ES256",
+		"x":   base64.RawURLEncoding.EncodeToString(priv.X.FillBytes(make([]byte, 32))),
+		"y":   base64.RawURLEncoding.EncodeToString(priv.Y.FillBytes(make([]byte, 32))),
+	})
+	if err != nil {
+		f.t.Fatalf("marshal jwk: %v", err)
+	}
+
+	key := &models.JWKey{
+		OrbitID:          orbit.ID,
+		Kid:              kid,
+		Use:              "sig",
+		Alg:              "ES256",
+		Kty:              "EC",
+		PublicKeyJWK:     pub,
+		PrivateKeyCipher: sealed,
+		IsActive:         true,
+	}
+	for _, opt := range opts {
+		opt(key)
+	}
+	created, err := repositories.NewJWKRepository(f.db.Exec(), zerolog.Nop()).Create(context.Background(), key)
+	if err != nil {
+		f.t.Fatalf("create key: %v", err)
+	}
+	return created
+}
@@ -0,0 +1,32 @@
+package testutil
+
+import (
+	"context"
+	"testing"
+
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services/cache"
+	"github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services/cache/local"
+	rediscache "github.com/BetelgeuseTb/betelgeuse-orbitum/internal/services/cache/redis"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/redis/go-redis/v9"
+)
+
+// Redis returns a cache manager backed by an in-process Redis. The server is
+// returned too, so tests can inspect keys or move its clock.
+func Redis(t testing.TB) (cache.Manager, *miniredis.Miniredis) {
+	t.Helper()
+
+	srv := miniredis.RunT(t)
+	manager := rediscache.NewManager(redis.NewClient(&redis.Options{Addr: srv.Addr()}))
+	t.Cleanup(func() { _ = manager.Shutdown(context.Background()) })
+	return manager, srv
+}
+
+// LocalCache returns an in-memory cache manager.
+func LocalCache(t testing.TB) cache.Manager {
+	t.Helper()
+
+	manager := local.NewManager()
+	t.Cleanup(func() { _ = manager.Shutdown(context.Background()) })
+	return manager
+}
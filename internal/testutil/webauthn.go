@@ -0,0 +1,182 @@
+package testutil
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+
+	"github.com/go-webauthn/webauthn/protocol"
+	"github.com/go-webauthn/webauthn/protocol/webauthncbor"
+	"github.com/go-webauthn/webauthn/protocol/webauthncose"
+)
+
+// Authenticator flags of the authenticator data, WebAuthn section 6.1.
+const (
+	flagUserPresent  = 0x01
+	flagUserVerified = 0x04
+	flagAttested     = 0x40
+)
+
+// Authenticator is a software passkey holding one ES256 credential. It answers
+// ceremonies the way a browser would hand the authenticator response to the
+// server, so tests can drive WebAuthnService without a device. RPID and Origin
+// may be changed to forge responses meant for another relying party.
+type Authenticator struct {
+	t            testing.TB
+	key          *ecdsa.PrivateKey
+	CredentialID []byte
+	UserHandle   []byte
+	SignCount    uint32
+	RPID         string
+	Origin       string
+}
+
+// NewAuthenticator creates an authenticator for the relying party rpID at
+// https://rpID.
+func NewAuthenticator(t testing.TB, rpID string) *Authenticator {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("generate credential key: %v", err)
+	}
+	id := make([]byte, 32)
+	if _, err := rand.Read(id); err != nil {
+		t.Fatalf("credential id: %v", err)
+	}
+	return &Authenticator{t: t, key: key, CredentialID: id, RPID: rpID, Origin: "https://" + rpID}
+}
+
+// Register answers a registration ceremony with an attestation statement of
+// format: "none", "packed" for packed self attestation, or any other name for
+// a statement the server must refuse before looking at it.
+func (a *Authenticator) Register(creation *protocol.CredentialCreation, format string) []byte {
+	a.t.Helper()
+
+	switch id := creation.Response.User.ID.(type) {
+	case protocol.URLEncodedBase64:
+		a.UserHandle = id
+	case []byte:
+		a.UserHandle = id
+	}
+	clientData := a.clientData(protocol.CreateCeremony, creation.Response.Challenge)
+
+	publicKey, err := webauthncbor.Marshal(webauthncose.EC2PublicKeyData{
+		PublicKeyData: webauthncose.PublicKeyData{
+			KeyType:   int64(webauthncose.EllipticKey),
+			Algorithm: int64(webauthncose.AlgES256),
+		},
+		Curve:  int64(webauthncose.P256),
+		XCoord: a.key.X.FillBytes(make([]byte, 32)),
+		YCoord: a.key.Y.FillBytes(make([]byte, 32)),
+	})
+	if err != nil {
+		a.t.Fatalf("encode credential key: %v", err)
+	}
+	authData := a.authData(flagUserPresent | flagUserVerified | flagAttested)
+	authData = append(authData, make([]byte, 16)...) // AAGUID
+	authData = binary.BigEndian.AppendUint16(authData, uint16(len(a.CredentialID)))
+	authData = append(authData, a.CredentialID...)
+	authData = append(authData, publicKey...)
+
+	statement := map[string]any{}
+	switch format {
+	case "none":
+	case "packed":
+		statement["alg"] = int64(webauthncose.AlgES256)
+		statement["sig"] = a.sign(authData, clientData)
+	default:
+		statement["sig"] = []byte("not checked")
+	}
+	attestation, err := webauthncbor.Marshal(map[string]any{
+		"fmt":      format,
+		"attStmt":  statement,
+		"authData": authData,
+	})
+	if err != nil {
+		a.t.Fatalf("encode attestation: %v", err)
+	}
+
+	return a.marshal(map[string]any{
+		"id":    base64.RawURLEncoding.EncodeToString(a.CredentialID),
+		"rawId": base64.RawURLEncoding.EncodeToString(a.CredentialID),
+		"type":  "public-key",
+		"response": map[string]any{
+			"clientDataJSON":    base64.RawURLEncoding.EncodeToString(clientData),
+			"attestationObject": base64.RawURLEncoding.EncodeToString(attestation),
+			"transports":        []string{"internal"},
+		},
+		"clientExtensionResults": map[string]any{"credProps": map[string]any{"rk": true}},
+	})
+}
+
+// Assert answers a login ceremony. The signature counter is advanced first;
+// setting SignCount back imitates a cloned authenticator.
+func (a *Authenticator) Assert(assertion *protocol.CredentialAssertion) []byte {
+	a.t.Helper()
+
+	clientData := a.clientData(protocol.AssertCeremony, assertion.Response.Challenge)
+	authData := a.authData(flagUserPresent | flagUserVerified)
+	return a.marshal(map[string]any{
+		"id":    base64.RawURLEncoding.EncodeToString(a.CredentialID),
+		"rawId": base64.RawURLEncoding.EncodeToString(a.CredentialID),
+		"type":  "public-key",
+		"response": map[string]any{
+			"clientDataJSON":    base64.RawURLEncoding.EncodeToString(clientData),
+			"authenticatorData": base64.RawURLEncoding.EncodeToString(authData),
+			"signature":         base64.RawURLEncoding.EncodeToString(a.sign(authData, clientData)),
+			"userHandle":        base64.RawURLEncoding.EncodeToString(a.UserHandle),
+		},
+	})
+}
+
+func (a *Authenticator) clientData(ceremony protocol.CeremonyType, challenge protocol.URLEncodedBase64) []byte {
+	a.t.Helper()
+
+	raw, err := json.Marshal(map[string]any{
+		"type":        ceremony,
+		"challenge":   challenge.String(),
+		"origin":      a.Origin,
+		"crossOrigin": false,
+	})
+	if err != nil {
+		a.t.Fatalf("encode client data: %v", err)
+	}
+	return raw
+}
+
+// authData starts the authenticator data: the RP ID hash, flags and the
+// signature counter, which is advanced first.
+func (a *Authenticator) authData(flags byte) []byte {
+	a.SignCount++
+	rpIDHash := sha256.Sum256([]byte(a.RPID))
+	out := append(rpIDHash[:], flags)
+	return binary.BigEndian.AppendUint32(out, a.SignCount)
+}
+
+func (a *Authenticator) sign(authData, clientData []byte) []byte {
+	a.t.Helper()
+
+	clientDataHash := sha256.Sum256(clientData)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	sig, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	if err != nil {
+		a.t.Fatalf("sign: %v", err)
+	}
+	return sig
+}
+
+func (a *Authenticator) marshal(v any) []byte {
+	a.t.Helper()
+
+	raw, err := json.Marshal(v)
+	if err != nil {
+		a.t.Fatalf("encode response: %v", err)
+	}
+	return raw
+}
@@ -0,0 +1,20417 @@
+// Package api provides primitives to interact with the openapi HTTP API.
+//
+// Code generated by github.com/oapi-codegen/oapi-codegen/v2 version v2.5.1 DO NOT EDIT.
+package api
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/labstack/echo/v4"
+	"github.com/oapi-codegen/runtime"
+)
+
+const (
+	AdminAuthScopes    = "adminAuth.Scopes"
+	AdminSessionScopes = "adminSession.Scopes"
+	BearerAuthScopes   = "bearerAuth.Scopes"
+	ClientAuthScopes   = "clientAuth.Scopes"
+)
+
+// Defines values for AuthzDecisionDecision.
+const (
+	AuthzDecisionDecisionAllow AuthzDecisionDecision = "allow"
+	AuthzDecisionDecisionDeny  AuthzDecisionDecision = "deny"
+)
+
+// Defines values for ClaimMapperSource.
+const (
+	Permissions ClaimMapperSource = "permissions"
+	Roles       ClaimMapperSource = "roles"
+)
+
+// Defines values for ClaimMapperTokens.
+const (
+	AccessToken ClaimMapperTokens = "access_token"
+	IdToken     ClaimMapperTokens = "id_token"
+)
+
+// Defines values for ClientInformationApplicationType.
+const (
+	ClientInformationApplicationTypeNative ClientInformationApplicationType = "native"
+	ClientInformationApplicationTypeWeb    ClientInformationApplicationType = "web"
+)
+
+// Defines values for ClientInformationTokenEndpointAuthMethod.
+const (
+	ClientInformationTokenEndpointAuthMethodClientSecretBasic ClientInformationTokenEndpointAuthMethod = "client_secret_basic"
+	ClientInformationTokenEndpointAuthMethodClientSecretPost  ClientInformationTokenEndpointAuthMethod = "client_secret_post"
+	ClientInformationTokenEndpointAuthMethodNone              ClientInformationTokenEndpointAuthMethod = "none"
+	ClientInformationTokenEndpointAuthMethodPrivateKeyJwt     ClientInformationTokenEndpointAuthMethod = "private_key_jwt"
+)
+
+// Defines values for ClientMetadataApplicationType.
+const (
+	ClientMetadataApplicationTypeNative ClientMetadataApplicationType = "native"
+	ClientMetadataApplicationTypeWeb    ClientMetadataApplicationType = "web"
+)
+
+// Defines values for ClientMetadataTokenEndpointAuthMethod.
+const (
+	ClientMetadataTokenEndpointAuthMethodClientSecretBasic ClientMetadataTokenEndpointAuthMethod = "client_secret_basic"
+	ClientMetadataTokenEndpointAuthMethodClientSecretPost  ClientMetadataTokenEndpointAuthMethod = "client_secret_post"
+	ClientMetadataTokenEndpointAuthMethodNone              ClientMetadataTokenEndpointAuthMethod = "none"
+	ClientMetadataTokenEndpointAuthMethodPrivateKeyJwt     ClientMetadataTokenEndpointAuthMethod = "private_key_jwt"
+)
+
+// Defines values for OAuthClientInputApplicationType.
+const (
+	Native OAuthClientInputApplicationType = "native"
+	Web    OAuthClientInputApplicationType = "web"
+)
+
+// Defines values for OAuthClientInputTokenEndpointAuthMethod.
+const (
+	ClientSecretBasic OAuthClientInputTokenEndpointAuthMethod = "client_secret_basic"
+	ClientSecretPost  OAuthClientInputTokenEndpointAuthMethod = "client_secret_post"
+	None              OAuthClientInputTokenEndpointAuthMethod = "none"
+	PrivateKeyJwt     OAuthClientInputTokenEndpointAuthMethod = "private_key_jwt"
+)
+
+// Defines values for PolicyEffect.
+const (
+	PolicyEffectAllow PolicyEffect = "allow"
+	PolicyEffectDeny  PolicyEffect = "deny"
+)
+
+// Defines values for PolicyDecisionDecision.
+const (
+	PolicyDecisionDecisionAllow PolicyDecisionDecision = "allow"
+	PolicyDecisionDecisionDeny  PolicyDecisionDecision = "deny"
+)
+
+// Defines values for PolicyInputEffect.
+const (
+	PolicyInputEffectAllow PolicyInputEffect = "allow"
+	PolicyInputEffectDeny  PolicyInputEffect = "deny"
+)
+
+// Defines values for SigningKeyInputAlg.
+const (
+	ES256 SigningKeyInputAlg = "ES256"
+	RS256 SigningKeyInputAlg = "RS256"
+)
+
+// Defines values for GetAdminV1OrbitsOrbitIdPolicyDecisionsParamsDecision.
+const (
+	GetAdminV1OrbitsOrbitIdPolicyDecisionsParamsDecisionAllow GetAdminV1OrbitsOrbitIdPolicyDecisionsParamsDecision = "allow"
+	GetAdminV1OrbitsOrbitIdPolicyDecisionsParamsDecisionDeny  GetAdminV1OrbitsOrbitIdPolicyDecisionsParamsDecision = "deny"
+)
+
+// Defines values for GetAuthorizeParamsResponseType.
+const (
+	Code GetAuthorizeParamsResponseType = "code"
+)
+
+// Defines values for GetAuthorizeParamsCodeChallengeMethod.
+const (
+	Plain GetAuthorizeParamsCodeChallengeMethod = "plain"
+	S256  GetAuthorizeParamsCodeChallengeMethod = "S256"
+)
+
+// Defines values for PostConsentFormdataBodyDecision.
+const (
+	PostConsentFormdataBodyDecisionAllow PostConsentFormdataBodyDecision = "allow"
+	PostConsentFormdataBodyDecisionDeny  PostConsentFormdataBodyDecision = "deny"
+)
+
+// Defines values for PostLoginMfaFormdataBodyMethod.
+const (
+	RecoveryCode PostLoginMfaFormdataBodyMethod = "recovery_code"
+	Totp         PostLoginMfaFormdataBodyMethod = "totp"
+)
+
+// Defines values for PostTokenFormdataBodyGrantType.
+const (
+	AuthorizationCode PostTokenFormdataBodyGrantType = "authorization_code"
+	ClientCredentials PostTokenFormdataBodyGrantType = "client_credentials"
+	RefreshToken      PostTokenFormdataBodyGrantType = "refresh_token"
+)
+
+// AuditLog defines model for AuditLog.
+type AuditLog struct {
+	Action string `json:"action"`
+
+	// ActorId User who made the change, absent for the admin API token
+	ActorId   *int64                  `json:"actor_id,omitempty"`
+	CreatedAt time.Time               `json:"created_at"`
+	Id        int64                   `json:"id"`
+	Metadata  *map[string]interface{} `json:"metadata,omitempty"`
+	Target    string                  `json:"target"`
+}
+
+// AuditLogList defines model for AuditLogList.
+type AuditLogList struct {
+	Items []AuditLog `json:"items"`
+
+	// NextCursor Cursor of the next page, absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// AuthzDecision defines model for AuthzDecision.
+type AuthzDecision struct {
+	Decision AuthzDecisionDecision `json:"decision"`
+
+	// DecisionId Entry of the decision in the decision log
+	DecisionId *int64 `json:"decision_id,omitempty"`
+
+	// Obligations Obligations of those policies, which the caller must fulfil when it acts on the decision
+	Obligations []map[string]interface{} `json:"obligations"`
+
+	// Policies Names of the policies that decided, empty when none applied
+	Policies []string `json:"policies"`
+}
+
+// AuthzDecisionDecision defines model for AuthzDecision.Decision.
+type AuthzDecisionDecision string
+
+// AuthzRequest defines model for AuthzRequest.
+type AuthzRequest struct {
+	Action string `json:"action"`
+
+	// Context Attributes of the request, read by conditions as context
+	Context *map[string]interface{} `json:"context,omitempty"`
+
+	// Resource Attributes of the resource acted on, read by conditions as resource
+	Resource *map[string]interface{} `json:"resource,omitempty"`
+
+	// UserId User of the orbit the decision is about, absent for decisions about no user
+	UserId *int64 `json:"user_id,omitempty"`
+}
+
+// ClaimMapper defines model for ClaimMapper.
+type ClaimMapper struct {
+	// Claim Name of the claim; registered JWT and OIDC claims cannot be used
+	Claim string `json:"claim"`
+
+	// Prefix Prepended to every value, such as ROLE_ for Spring Security
+	Prefix *string `json:"prefix,omitempty"`
+
+	// Source Roles puts the names of the user's roles in the claim. Permissions puts the names of the permissions the user holds through roles and whose metadata lists one of the token's audiences under "audiences".
+	Source ClaimMapperSource `json:"source"`
+
+	// Tokens Tokens the claim is added to, all of them when omitted
+	Tokens *[]ClaimMapperTokens `json:"tokens,omitempty"`
+}
+
+// ClaimMapperSource Roles puts the names of the user's roles in the claim. Permissions puts the names of the permissions the user holds through roles and whose metadata lists one of the token's audiences under "audiences".
+type ClaimMapperSource string
+
+// ClaimMapperTokens defines model for ClaimMapper.Tokens.
+type ClaimMapperTokens string
+
+// ClientInformation defines model for ClientInformation.
+type ClientInformation struct {
+	ApplicationType         *ClientInformationApplicationType `json:"application_type,omitempty"`
+	ClientId                string                            `json:"client_id"`
+	ClientIdIssuedAt        *int64                            `json:"client_id_issued_at,omitempty"`
+	ClientName              *string                           `json:"client_name,omitempty"`
+	ClientSecret            *string                           `json:"client_secret,omitempty"`
+	ClientSecretExpiresAt   *int64                            `json:"client_secret_expires_at,omitempty"`
+	ClientUri               *string                           `json:"client_uri,omitempty"`
+	Contacts                *[]string                         `json:"contacts,omitempty"`
+	GrantTypes              *[]string                         `json:"grant_types,omitempty"`
+	Jwks                    *map[string]interface{}           `json:"jwks,omitempty"`
+	JwksUri                 *string                           `json:"jwks_uri,omitempty"`
+	LogoUri                 *string                           `json:"logo_uri,omitempty"`
+	PostLogoutRedirectUris  *[]string                         `json:"post_logout_redirect_uris,omitempty"`
+	RedirectUris            *[]string                         `json:"redirect_uris,omitempty"`
+	RegistrationAccessToken *string                           `json:"registration_access_token,omitempty"`
+	RegistrationClientUri   *string                           `json:"registration_client_uri,omitempty"`
+	ResponseTypes           *[]string                         `json:"response_types,omitempty"`
+	Scope                   *string                           `json:"scope,omitempty"`
+	SoftwareId              *string                           `json:"software_id,omitempty"`
+
+	// SoftwareStatement Signed JWT asserting client metadata, issued by a trusted directory
+	SoftwareStatement       *string                                   `json:"software_statement,omitempty"`
+	SoftwareVersion         *string                                   `json:"software_version,omitempty"`
+	TokenEndpointAuthMethod *ClientInformationTokenEndpointAuthMethod `json:"token_endpoint_auth_method,omitempty"`
+}
+
+// ClientInformationApplicationType defines model for ClientInformation.ApplicationType.
+type ClientInformationApplicationType string
+
+// ClientInformationTokenEndpointAuthMethod defines model for ClientInformation.TokenEndpointAuthMethod.
+type ClientInformationTokenEndpointAuthMethod string
+
+// ClientMetadata defines model for ClientMetadata.
+type ClientMetadata struct {
+	ApplicationType        *ClientMetadataApplicationType `json:"application_type,omitempty"`
+	ClientId               *string                        `json:"client_id,omitempty"`
+	ClientName             *string                        `json:"client_name,omitempty"`
+	ClientUri              *string                        `json:"client_uri,omitempty"`
+	Contacts               *[]string                      `json:"contacts,omitempty"`
+	GrantTypes             *[]string                      `json:"grant_types,omitempty"`
+	Jwks                   *map[string]interface{}        `json:"jwks,omitempty"`
+	JwksUri                *string                        `json:"jwks_uri,omitempty"`
+	LogoUri                *string                        `json:"logo_uri,omitempty"`
+	PostLogoutRedirectUris *[]string                      `json:"post_logout_redirect_uris,omitempty"`
+	RedirectUris           *[]string                      `json:"redirect_uris,omitempty"`
+	ResponseTypes          *[]string                      `json:"response_types,omitempty"`
+	Scope                  *string                        `json:"scope,omitempty"`
+	SoftwareId             *string                        `json:"software_id,omitempty"`
+
+	// SoftwareStatement Signed JWT asserting client metadata, issued by a trusted directory
+	SoftwareStatement       *string                                `json:"software_statement,omitempty"`
+	SoftwareVersion         *string                                `json:"software_version,omitempty"`
+	TokenEndpointAuthMethod *ClientMetadataTokenEndpointAuthMethod `json:"token_endpoint_auth_method,omitempty"`
+}
+
+// ClientMetadataApplicationType defines model for ClientMetadata.ApplicationType.
+type ClientMetadataApplicationType string
+
+// ClientMetadataTokenEndpointAuthMethod defines model for ClientMetadata.TokenEndpointAuthMethod.
+type ClientMetadataTokenEndpointAuthMethod string
+
+// ClientSecret defines model for ClientSecret.
+type ClientSecret struct {
+	ClientSecret          string     `json:"client_secret"`
+	ClientSecretExpiresAt *time.Time `json:"client_secret_expires_at,omitempty"`
+}
+
+// Consent defines model for Consent.
+type Consent struct {
+	ClientId   string     `json:"client_id"`
+	ClientName *string    `json:"client_name,omitempty"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	GrantedAt  time.Time  `json:"granted_at"`
+	Id         int64      `json:"id"`
+	Scopes     []string   `json:"scopes"`
+}
+
+// Error defines model for Error.
+type Error struct {
+	Error            string  `json:"error"`
+	ErrorDescription *string `json:"error_description,omitempty"`
+}
+
+// Group defines model for Group.
+type Group struct {
+	CreatedAt time.Time               `json:"created_at"`
+	Id        int64                   `json:"id"`
+	Metadata  *map[string]interface{} `json:"metadata,omitempty"`
+	Name      string                  `json:"name"`
+}
+
+// GroupInput defines model for GroupInput.
+type GroupInput struct {
+	Metadata *map[string]interface{} `json:"metadata,omitempty"`
+	Name     string                  `json:"name"`
+}
+
+// GroupList defines model for GroupList.
+type GroupList struct {
+	Items []Group `json:"items"`
+
+	// NextCursor Cursor of the next page, absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// Introspection defines model for Introspection.
+type Introspection struct {
+	Active    *bool     `json:"active,omitempty"`
+	Aud       *[]string `json:"aud,omitempty"`
+	ClientId  *string   `json:"client_id,omitempty"`
+	Exp       *int      `json:"exp,omitempty"`
+	Iat       *int      `json:"iat,omitempty"`
+	Iss       *string   `json:"iss,omitempty"`
+	Jti       *string   `json:"jti,omitempty"`
+	Scope     *string   `json:"scope,omitempty"`
+	Sub       *string   `json:"sub,omitempty"`
+	TokenType *string   `json:"token_type,omitempty"`
+	Username  *string   `json:"username,omitempty"`
+}
+
+// JWKSet defines model for JWKSet.
+type JWKSet struct {
+	Keys []struct {
+		Alg *string `json:"alg,omitempty"`
+		Crv *string `json:"crv,omitempty"`
+		E   *string `json:"e,omitempty"`
+		Kid *string `json:"kid,omitempty"`
+		Kty *string `json:"kty,omitempty"`
+		N   *string `json:"n,omitempty"`
+		Use *string `json:"use,omitempty"`
+		X   *string `json:"x,omitempty"`
+		Y   *string `json:"y,omitempty"`
+	} `json:"keys"`
+}
+
+// OAuthClient defines model for OAuthClient.
+type OAuthClient struct {
+	AllowedCorsOrigins []string `json:"allowed_cors_origins"`
+	AllowedScopes      []string `json:"allowed_scopes"`
+	ApplicationType    string   `json:"application_type"`
+
+	// ClaimMappers Claims carrying the user's roles and permissions in tokens issued to the client
+	ClaimMappers *[]ClaimMapper `json:"claim_mappers,omitempty"`
+	ClientId     string         `json:"client_id"`
+	ClientName   *string        `json:"client_name,omitempty"`
+
+	// ClientSecret Only returned when a secret is issued
+	ClientSecret            *string                 `json:"client_secret,omitempty"`
+	ClientSecretExpiresAt   *time.Time              `json:"client_secret_expires_at,omitempty"`
+	ClientUri               *string                 `json:"client_uri,omitempty"`
+	Contacts                *[]string               `json:"contacts,omitempty"`
+	CreatedAt               time.Time               `json:"created_at"`
+	Description             *string                 `json:"description,omitempty"`
+	GrantTypes              []string                `json:"grant_types"`
+	IsActive                bool                    `json:"is_active"`
+	IsPublic                bool                    `json:"is_public"`
+	Jwks                    *map[string]interface{} `json:"jwks,omitempty"`
+	JwksUri                 *string                 `json:"jwks_uri,omitempty"`
+	LogoUri                 *string                 `json:"logo_uri,omitempty"`
+	PostLogoutRedirectUris  *[]string               `json:"post_logout_redirect_uris,omitempty"`
+	RedirectUris            []string                `json:"redirect_uris"`
+	ResponseTypes           []string                `json:"response_types"`
+	TokenEndpointAuthMethod string                  `json:"token_endpoint_auth_method"`
+	UpdatedAt               time.Time               `json:"updated_at"`
+}
+
+// OAuthClientInput defines model for OAuthClientInput.
+type OAuthClientInput struct {
+	AllowedCorsOrigins *[]string                        `json:"allowed_cors_origins,omitempty"`
+	AllowedScopes      *[]string                        `json:"allowed_scopes,omitempty"`
+	ApplicationType    *OAuthClientInputApplicationType `json:"application_type,omitempty"`
+
+	// ClaimMappers Claims carrying the user's roles and permissions in tokens issued to the client
+	ClaimMappers *[]ClaimMapper `json:"claim_mappers,omitempty"`
+
+	// ClientId Generated when omitted on creation; cannot be changed
+	ClientId                *string                                  `json:"client_id,omitempty"`
+	ClientName              *string                                  `json:"client_name,omitempty"`
+	ClientUri               *string                                  `json:"client_uri,omitempty"`
+	Contacts                *[]string                                `json:"contacts,omitempty"`
+	Description             *string                                  `json:"description,omitempty"`
+	GrantTypes              *[]string                                `json:"grant_types,omitempty"`
+	IsActive                *bool                                    `json:"is_active,omitempty"`
+	Jwks                    *map[string]interface{}                  `json:"jwks,omitempty"`
+	JwksUri                 *string                                  `json:"jwks_uri,omitempty"`
+	LogoUri                 *string                                  `json:"logo_uri,omitempty"`
+	PostLogoutRedirectUris  *[]string                                `json:"post_logout_redirect_uris,omitempty"`
+	RedirectUris            *[]string                                `json:"redirect_uris,omitempty"`
+	ResponseTypes           *[]string                                `json:"response_types,omitempty"`
+	TokenEndpointAuthMethod *OAuthClientInputTokenEndpointAuthMethod `json:"token_endpoint_auth_method,omitempty"`
+}
+
+// OAuthClientInputApplicationType defines model for OAuthClientInput.ApplicationType.
+type OAuthClientInputApplicationType string
+
+// OAuthClientInputTokenEndpointAuthMethod defines model for OAuthClientInput.TokenEndpointAuthMethod.
+type OAuthClientInputTokenEndpointAuthMethod string
+
+// OAuthClientList defines model for OAuthClientList.
+type OAuthClientList struct {
+	Items []OAuthClient `json:"items"`
+
+	// NextCursor Cursor of the next page, absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// Orbit defines model for Orbit.
+type Orbit struct {
+	// Config Per-orbit settings such as password, lockout and registration policies
+	Config        map[string]interface{} `json:"config"`
+	CreatedAt     time.Time              `json:"created_at"`
+	DefaultScopes []string               `json:"default_scopes"`
+	Description   *string                `json:"description,omitempty"`
+	DisplayName   *string                `json:"display_name,omitempty"`
+
+	// Domain Host name the orbit's endpoints are served on
+	Domain    string    `json:"domain"`
+	Id        int64     `json:"id"`
+	Issuer    string    `json:"issuer"`
+	Name      string    `json:"name"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// OrbitInput defines model for OrbitInput.
+type OrbitInput struct {
+	Config        *map[string]interface{} `json:"config,omitempty"`
+	DefaultScopes *[]string               `json:"default_scopes,omitempty"`
+	Description   *string                 `json:"description,omitempty"`
+	DisplayName   *string                 `json:"display_name,omitempty"`
+	Domain        string                  `json:"domain"`
+	Issuer        string                  `json:"issuer"`
+	Name          string                  `json:"name"`
+}
+
+// OrbitList defines model for OrbitList.
+type OrbitList struct {
+	Items []Orbit `json:"items"`
+
+	// NextCursor Cursor of the next page, absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// Passkey defines model for Passkey.
+type Passkey struct {
+	// BackedUp Whether the authenticator reported the passkey as synced or backed up
+	BackedUp  bool      `json:"backed_up"`
+	CreatedAt time.Time `json:"created_at"`
+
+	// Discoverable Whether the passkey can be used without entering a username
+	Discoverable bool       `json:"discoverable"`
+	Id           int64      `json:"id"`
+	LastUsedAt   *time.Time `json:"last_used_at,omitempty"`
+	Name         string     `json:"name"`
+}
+
+// PasskeyOptions defines model for PasskeyOptions.
+type PasskeyOptions struct {
+	// CeremonyId Identifies the ceremony when the authenticator response is submitted
+	CeremonyId string `json:"ceremony_id"`
+
+	// Options Options for navigator.credentials.create or navigator.credentials.get, with base64url encoded buffers
+	Options json.RawMessage `json:"options"`
+}
+
+// Permission defines model for Permission.
+type Permission struct {
+	CreatedAt time.Time               `json:"created_at"`
+	Id        int64                   `json:"id"`
+	Metadata  *map[string]interface{} `json:"metadata,omitempty"`
+	Name      string                  `json:"name"`
+}
+
+// PermissionInput defines model for PermissionInput.
+type PermissionInput struct {
+	// Metadata An "audiences" list names the audiences the permission is put in tokens for by claim mappers of the permissions source.
+	Metadata *map[string]interface{} `json:"metadata,omitempty"`
+	Name     string                  `json:"name"`
+}
+
+// PermissionList defines model for PermissionList.
+type PermissionList struct {
+	Items []Permission `json:"items"`
+
+	// NextCursor Cursor of the next page, absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// Policy defines model for Policy.
+type Policy struct {
+	Actions     []string                 `json:"actions"`
+	Condition   string                   `json:"condition"`
+	CreatedAt   time.Time                `json:"created_at"`
+	Description *string                  `json:"description,omitempty"`
+	Effect      PolicyEffect             `json:"effect"`
+	Id          int64                    `json:"id"`
+	IsActive    bool                     `json:"is_active"`
+	Name        string                   `json:"name"`
+	Obligations []map[string]interface{} `json:"obligations"`
+	UpdatedAt   time.Time                `json:"updated_at"`
+}
+
+// PolicyEffect defines model for Policy.Effect.
+type PolicyEffect string
+
+// PolicyDecision defines model for PolicyDecision.
+type PolicyDecision struct {
+	Action string `json:"action"`
+
+	// ClientId Internal id of the client that asked, absent once it is deleted
+	ClientId  *int64                 `json:"client_id,omitempty"`
+	CreatedAt time.Time              `json:"created_at"`
+	Decision  PolicyDecisionDecision `json:"decision"`
+
+	// Errors Conditions that could not be evaluated
+	Errors *[]string `json:"errors,omitempty"`
+	Id     int64     `json:"id"`
+
+	// Policies Names of the policies that decided
+	Policies []string `json:"policies"`
+
+	// Request The resource and context attributes the decision was made on
+	Request *map[string]interface{} `json:"request,omitempty"`
+	UserId  *int64                  `json:"user_id,omitempty"`
+}
+
+// PolicyDecisionDecision defines model for PolicyDecision.Decision.
+type PolicyDecisionDecision string
+
+// PolicyDecisionList defines model for PolicyDecisionList.
+type PolicyDecisionList struct {
+	Items []PolicyDecision `json:"items"`
+
+	// NextCursor Cursor of the next page, absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// PolicyInput defines model for PolicyInput.
+type PolicyInput struct {
+	// Actions Actions the policy covers, such as invoice:approve. A trailing * matches every action it prefixes.
+	Actions []string `json:"actions"`
+
+	// Condition Expression that must hold for the policy to apply, over the attributes user, client, action, resource, context and now, for example user.profile.department == resource.department && "approver" in user.roles. An empty condition always holds.
+	Condition   *string `json:"condition,omitempty"`
+	Description *string `json:"description,omitempty"`
+
+	// Effect Deny wins over allow; actions no policy allows are denied
+	Effect   PolicyInputEffect `json:"effect"`
+	IsActive *bool             `json:"is_active,omitempty"`
+	Name     string            `json:"name"`
+
+	// Obligations Returned with the decisions the policy makes, for the caller to fulfil. Each names its kind under type, such as {"type": "notify", "to": "audit"}.
+	Obligations *[]map[string]interface{} `json:"obligations,omitempty"`
+}
+
+// PolicyInputEffect Deny wins over allow; actions no policy allows are denied
+type PolicyInputEffect string
+
+// PolicyList defines model for PolicyList.
+type PolicyList struct {
+	Items []Policy `json:"items"`
+
+	// NextCursor Cursor of the next page, absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// RecoveryCodeStatus defines model for RecoveryCodeStatus.
+type RecoveryCodeStatus struct {
+	Remaining int `json:"remaining"`
+}
+
+// RecoveryCodes defines model for RecoveryCodes.
+type RecoveryCodes struct {
+	Codes []string `json:"codes"`
+}
+
+// Role defines model for Role.
+type Role struct {
+	CreatedAt time.Time               `json:"created_at"`
+	Id        int64                   `json:"id"`
+	Metadata  *map[string]interface{} `json:"metadata,omitempty"`
+	Name      string                  `json:"name"`
+}
+
+// RoleInput defines model for RoleInput.
+type RoleInput struct {
+	Metadata *map[string]interface{} `json:"metadata,omitempty"`
+	Name     string                  `json:"name"`
+}
+
+// RoleList defines model for RoleList.
+type RoleList struct {
+	Items []Role `json:"items"`
+
+	// NextCursor Cursor of the next page, absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// Scope defines model for Scope.
+type Scope struct {
+	CreatedAt   time.Time `json:"created_at"`
+	Description *string   `json:"description,omitempty"`
+	Id          int64     `json:"id"`
+	IsActive    bool      `json:"is_active"`
+	IsDefault   bool      `json:"is_default"`
+	Name        string    `json:"name"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
+
+// ScopeInput defines model for ScopeInput.
+type ScopeInput struct {
+	Description *string `json:"description,omitempty"`
+	IsActive    *bool   `json:"is_active,omitempty"`
+	IsDefault   *bool   `json:"is_default,omitempty"`
+
+	// Name Cannot be changed once created
+	Name string `json:"name"`
+}
+
+// ScopeList defines model for ScopeList.
+type ScopeList struct {
+	Items []Scope `json:"items"`
+
+	// NextCursor Cursor of the next page, absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// Session defines model for Session.
+type Session struct {
+	// ClientId The client the user signed in through
+	ClientId   *string `json:"client_id,omitempty"`
+	ClientName *string `json:"client_name,omitempty"`
+
+	// Current Whether this is the session the request was made with
+	Current bool `json:"current"`
+
+	// DeviceInfo User agent of the browser that signed in
+	DeviceInfo *string    `json:"device_info,omitempty"`
+	ExpiresAt  *time.Time `json:"expires_at,omitempty"`
+	Id         int64      `json:"id"`
+
+	// Ip Address the user signed in from
+	Ip *string `json:"ip,omitempty"`
+
+	// LastActiveAt Last use, recorded at most once per the orbit's touch interval
+	LastActiveAt time.Time `json:"last_active_at"`
+	StartedAt    time.Time `json:"started_at"`
+}
+
+// SigningKey defines model for SigningKey.
+type SigningKey struct {
+	Alg       string                 `json:"alg"`
+	CreatedAt time.Time              `json:"created_at"`
+	ExpiresAt *time.Time             `json:"expires_at,omitempty"`
+	Id        int64                  `json:"id"`
+	IsActive  bool                   `json:"is_active"`
+	Kid       string                 `json:"kid"`
+	NotBefore *time.Time             `json:"not_before,omitempty"`
+	PublicJwk map[string]interface{} `json:"public_jwk"`
+	UpdatedAt time.Time              `json:"updated_at"`
+	Use       string                 `json:"use"`
+}
+
+// SigningKeyInput defines model for SigningKeyInput.
+type SigningKeyInput struct {
+	// Alg Only used on creation
+	Alg       *SigningKeyInputAlg `json:"alg,omitempty"`
+	ExpiresAt *time.Time          `json:"expires_at,omitempty"`
+	IsActive  *bool               `json:"is_active,omitempty"`
+	NotBefore *time.Time          `json:"not_before,omitempty"`
+}
+
+// SigningKeyInputAlg Only used on creation
+type SigningKeyInputAlg string
+
+// SigningKeyList defines model for SigningKeyList.
+type SigningKeyList struct {
+	Items []SigningKey `json:"items"`
+
+	// NextCursor Cursor of the next page, absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// TOTPAuthenticator defines model for TOTPAuthenticator.
+type TOTPAuthenticator struct {
+	Confirmed bool      `json:"confirmed"`
+	CreatedAt time.Time `json:"created_at"`
+	Id        int64     `json:"id"`
+	Name      string    `json:"name"`
+}
+
+// TOTPEnrollment defines model for TOTPEnrollment.
+type TOTPEnrollment struct {
+	Id   int64  `json:"id"`
+	Name string `json:"name"`
+
+	// OtpauthUri Key URI for authenticator apps, also the QR code payload
+	OtpauthUri string `json:"otpauth_uri"`
+
+	// Secret Base32 encoded shared secret, only returned once
+	Secret string `json:"secret"`
+}
+
+// TokenResponse defines model for TokenResponse.
+type TokenResponse struct {
+	AccessToken  string  `json:"access_token"`
+	ExpiresIn    int     `json:"expires_in"`
+	IdToken      *string `json:"id_token,omitempty"`
+	RefreshToken *string `json:"refresh_token,omitempty"`
+	Scope        *string `json:"scope,omitempty"`
+	TokenType    string  `json:"token_type"`
+}
+
+// User defines model for User.
+type User struct {
+	CreatedAt          time.Time               `json:"created_at"`
+	DisplayName        *string                 `json:"display_name,omitempty"`
+	Email              *string                 `json:"email,omitempty"`
+	EmailVerified      bool                    `json:"email_verified"`
+	Id                 int64                   `json:"id"`
+	IsActive           bool                    `json:"is_active"`
+	IsLocked           bool                    `json:"is_locked"`
+	LastPasswordChange *time.Time              `json:"last_password_change,omitempty"`
+	MfaEnabled         bool                    `json:"mfa_enabled"`
+	Profile            *map[string]interface{} `json:"profile,omitempty"`
+	UpdatedAt          time.Time               `json:"updated_at"`
+	Username           string                  `json:"username"`
+}
+
+// UserInput defines model for UserInput.
+type UserInput struct {
+	DisplayName   *string `json:"display_name,omitempty"`
+	Email         *string `json:"email,omitempty"`
+	EmailVerified *bool   `json:"email_verified,omitempty"`
+	IsActive      *bool   `json:"is_active,omitempty"`
+
+	// Password Checked against the orbit's password policy. Only accepted on creation; users without one sign in by other means.
+	Password *string                 `json:"password,omitempty"`
+	Profile  *map[string]interface{} `json:"profile,omitempty"`
+	Username string                  `json:"username"`
+}
+
+// UserList defines model for UserList.
+type UserList struct {
+	Items []User `json:"items"`
+
+	// NextCursor Cursor of the next page, absent on the last page
+	NextCursor *string `json:"next_cursor,omitempty"`
+}
+
+// Cursor defines model for Cursor.
+type Cursor = string
+
+// IfMatch defines model for IfMatch.
+type IfMatch = string
+
+// Limit defines model for Limit.
+type Limit = int
+
+// OrbitId defines model for OrbitId.
+type OrbitId = int64
+
+// PostAccountPasskeysRegistrationsJSONBody defines parameters for PostAccountPasskeysRegistrations.
+type PostAccountPasskeysRegistrationsJSONBody struct {
+	Name string `json:"name"`
+}
+
+// PostAccountPasskeysRegistrationsCeremonyIdJSONBody defines parameters for PostAccountPasskeysRegistrationsCeremonyId.
+type PostAccountPasskeysRegistrationsCeremonyIdJSONBody = json.RawMessage
+
+// PostAccountTotpJSONBody defines parameters for PostAccountTotp.
+type PostAccountTotpJSONBody struct {
+	Name string `json:"name"`
+}
+
+// PostAccountTotpTotpIdConfirmJSONBody defines parameters for PostAccountTotpTotpIdConfirm.
+type PostAccountTotpTotpIdConfirmJSONBody struct {
+	Code string `json:"code"`
+}
+
+// GetAdminV1OrbitsParams defines parameters for GetAdminV1Orbits.
+type GetAdminV1OrbitsParams struct {
+	// Limit Page size, 50 by default and at most 500
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor next_cursor of the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// PutAdminV1OrbitsOrbitIdParams defines parameters for PutAdminV1OrbitsOrbitId.
+type PutAdminV1OrbitsOrbitIdParams struct {
+	// IfMatch ETag of the representation the change is based on
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetAdminV1OrbitsOrbitIdAuditLogsParams defines parameters for GetAdminV1OrbitsOrbitIdAuditLogs.
+type GetAdminV1OrbitsOrbitIdAuditLogsParams struct {
+	Action  *string    `form:"action,omitempty" json:"action,omitempty"`
+	ActorId *int64     `form:"actor_id,omitempty" json:"actor_id,omitempty"`
+	Since   *time.Time `form:"since,omitempty" json:"since,omitempty"`
+	Until   *time.Time `form:"until,omitempty" json:"until,omitempty"`
+
+	// Limit Page size, 50 by default and at most 500
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor next_cursor of the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetAdminV1OrbitsOrbitIdClientsParams defines parameters for GetAdminV1OrbitsOrbitIdClients.
+type GetAdminV1OrbitsOrbitIdClientsParams struct {
+	ApplicationType *string `form:"application_type,omitempty" json:"application_type,omitempty"`
+	IsActive        *bool   `form:"is_active,omitempty" json:"is_active,omitempty"`
+
+	// Limit Page size, 50 by default and at most 500
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor next_cursor of the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// PutAdminV1OrbitsOrbitIdClientsClientIdParams defines parameters for PutAdminV1OrbitsOrbitIdClientsClientId.
+type PutAdminV1OrbitsOrbitIdClientsClientIdParams struct {
+	// IfMatch ETag of the representation the change is based on
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetAdminV1OrbitsOrbitIdGroupsParams defines parameters for GetAdminV1OrbitsOrbitIdGroups.
+type GetAdminV1OrbitsOrbitIdGroupsParams struct {
+	// Limit Page size, 50 by default and at most 500
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor next_cursor of the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetAdminV1OrbitsOrbitIdGroupsGroupIdMembersParams defines parameters for GetAdminV1OrbitsOrbitIdGroupsGroupIdMembers.
+type GetAdminV1OrbitsOrbitIdGroupsGroupIdMembersParams struct {
+	// Limit Page size, 50 by default and at most 500
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor next_cursor of the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetAdminV1OrbitsOrbitIdKeysParams defines parameters for GetAdminV1OrbitsOrbitIdKeys.
+type GetAdminV1OrbitsOrbitIdKeysParams struct {
+	// Limit Page size, 50 by default and at most 500
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor next_cursor of the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// PutAdminV1OrbitsOrbitIdKeysKeyIdParams defines parameters for PutAdminV1OrbitsOrbitIdKeysKeyId.
+type PutAdminV1OrbitsOrbitIdKeysKeyIdParams struct {
+	// IfMatch ETag of the representation the change is based on
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetAdminV1OrbitsOrbitIdPermissionsParams defines parameters for GetAdminV1OrbitsOrbitIdPermissions.
+type GetAdminV1OrbitsOrbitIdPermissionsParams struct {
+	// Limit Page size, 50 by default and at most 500
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor next_cursor of the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetAdminV1OrbitsOrbitIdPoliciesParams defines parameters for GetAdminV1OrbitsOrbitIdPolicies.
+type GetAdminV1OrbitsOrbitIdPoliciesParams struct {
+	// Limit Page size, 50 by default and at most 500
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor next_cursor of the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// PutAdminV1OrbitsOrbitIdPoliciesPolicyIdParams defines parameters for PutAdminV1OrbitsOrbitIdPoliciesPolicyId.
+type PutAdminV1OrbitsOrbitIdPoliciesPolicyIdParams struct {
+	// IfMatch ETag of the representation the change is based on
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetAdminV1OrbitsOrbitIdPolicyDecisionsParams defines parameters for GetAdminV1OrbitsOrbitIdPolicyDecisions.
+type GetAdminV1OrbitsOrbitIdPolicyDecisionsParams struct {
+	Action   *string                                               `form:"action,omitempty" json:"action,omitempty"`
+	Decision *GetAdminV1OrbitsOrbitIdPolicyDecisionsParamsDecision `form:"decision,omitempty" json:"decision,omitempty"`
+	UserId   *int64                                                `form:"user_id,omitempty" json:"user_id,omitempty"`
+	Since    *time.Time                                            `form:"since,omitempty" json:"since,omitempty"`
+	Until    *time.Time                                            `form:"until,omitempty" json:"until,omitempty"`
+
+	// Limit Page size, 50 by default and at most 500
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor next_cursor of the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetAdminV1OrbitsOrbitIdPolicyDecisionsParamsDecision defines parameters for GetAdminV1OrbitsOrbitIdPolicyDecisions.
+type GetAdminV1OrbitsOrbitIdPolicyDecisionsParamsDecision string
+
+// GetAdminV1OrbitsOrbitIdRolesParams defines parameters for GetAdminV1OrbitsOrbitIdRoles.
+type GetAdminV1OrbitsOrbitIdRolesParams struct {
+	// Limit Page size, 50 by default and at most 500
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor next_cursor of the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// GetAdminV1OrbitsOrbitIdScopesParams defines parameters for GetAdminV1OrbitsOrbitIdScopes.
+type GetAdminV1OrbitsOrbitIdScopesParams struct {
+	// Limit Page size, 50 by default and at most 500
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor next_cursor of the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// PutAdminV1OrbitsOrbitIdScopesScopeIdParams defines parameters for PutAdminV1OrbitsOrbitIdScopesScopeId.
+type PutAdminV1OrbitsOrbitIdScopesScopeIdParams struct {
+	// IfMatch ETag of the representation the change is based on
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetAdminV1OrbitsOrbitIdUsersParams defines parameters for GetAdminV1OrbitsOrbitIdUsers.
+type GetAdminV1OrbitsOrbitIdUsersParams struct {
+	// EmailPrefix Start of the email address, ignoring case
+	EmailPrefix   *string    `form:"email_prefix,omitempty" json:"email_prefix,omitempty"`
+	IsActive      *bool      `form:"is_active,omitempty" json:"is_active,omitempty"`
+	CreatedAfter  *time.Time `form:"created_after,omitempty" json:"created_after,omitempty"`
+	CreatedBefore *time.Time `form:"created_before,omitempty" json:"created_before,omitempty"`
+
+	// Limit Page size, 50 by default and at most 500
+	Limit *Limit `form:"limit,omitempty" json:"limit,omitempty"`
+
+	// Cursor next_cursor of the previous page
+	Cursor *Cursor `form:"cursor,omitempty" json:"cursor,omitempty"`
+}
+
+// PutAdminV1OrbitsOrbitIdUsersUserIdParams defines parameters for PutAdminV1OrbitsOrbitIdUsersUserId.
+type PutAdminV1OrbitsOrbitIdUsersUserIdParams struct {
+	// IfMatch ETag of the representation the change is based on
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetAuthorizeParams defines parameters for GetAuthorize.
+type GetAuthorizeParams struct {
+	ResponseType GetAuthorizeParamsResponseType `form:"response_type" json:"response_type"`
+	ClientId     string                         `form:"client_id" json:"client_id"`
+	RedirectUri  string                         `form:"redirect_uri" json:"redirect_uri"`
+	Scope        *string                        `form:"scope,omitempty" json:"scope,omitempty"`
+	State        *string                        `form:"state,omitempty" json:"state,omitempty"`
+
+	// Nonce Value the ID token repeats, binding it to the client session
+	Nonce               *string                                `form:"nonce,omitempty" json:"nonce,omitempty"`
+	CodeChallenge       *string                                `form:"code_challenge,omitempty" json:"code_challenge,omitempty"`
+	CodeChallengeMethod *GetAuthorizeParamsCodeChallengeMethod `form:"code_challenge_method,omitempty" json:"code_challenge_method,omitempty"`
+	Resource            *[]string                              `form:"resource,omitempty" json:"resource,omitempty"`
+
+	// Prompt Space separated list of none, login, consent and select_account
+	Prompt    *string `form:"prompt,omitempty" json:"prompt,omitempty"`
+	MaxAge    *int    `form:"max_age,omitempty" json:"max_age,omitempty"`
+	LoginHint *string `form:"login_hint,omitempty" json:"login_hint,omitempty"`
+
+	// AcrValues Space separated authentication context classes in order of preference
+	AcrValues *string `form:"acr_values,omitempty" json:"acr_values,omitempty"`
+}
+
+// GetAuthorizeParamsResponseType defines parameters for GetAuthorize.
+type GetAuthorizeParamsResponseType string
+
+// GetAuthorizeParamsCodeChallengeMethod defines parameters for GetAuthorize.
+type GetAuthorizeParamsCodeChallengeMethod string
+
+// GetConsentParams defines parameters for GetConsent.
+type GetConsentParams struct {
+	RequestId string `form:"request_id" json:"request_id"`
+}
+
+// PostConsentFormdataBody defines parameters for PostConsent.
+type PostConsentFormdataBody struct {
+	Decision  PostConsentFormdataBodyDecision `form:"decision" json:"decision"`
+	RequestId string                          `form:"request_id" json:"request_id"`
+}
+
+// PostConsentFormdataBodyDecision defines parameters for PostConsent.
+type PostConsentFormdataBodyDecision string
+
+// GetEmailVerifyParams defines parameters for GetEmailVerify.
+type GetEmailVerifyParams struct {
+	Token string `form:"token" json:"token"`
+}
+
+// PostIntrospectFormdataBody defines parameters for PostIntrospect.
+type PostIntrospectFormdataBody struct {
+	Resource      *string `form:"resource,omitempty" json:"resource,omitempty"`
+	Token         string  `form:"token" json:"token"`
+	TokenTypeHint *string `form:"token_type_hint,omitempty" json:"token_type_hint,omitempty"`
+}
+
+// GetLoginParams defines parameters for GetLogin.
+type GetLoginParams struct {
+	RequestId string `form:"request_id" json:"request_id"`
+}
+
+// PostLoginFormdataBody defines parameters for PostLogin.
+type PostLoginFormdataBody struct {
+	Identity  string `form:"identity" json:"identity"`
+	Password  string `form:"password" json:"password"`
+	RequestId string `form:"request_id" json:"request_id"`
+}
+
+// GetLoginMfaParams defines parameters for GetLoginMfa.
+type GetLoginMfaParams struct {
+	RequestId string `form:"request_id" json:"request_id"`
+}
+
+// PostLoginMfaFormdataBody defines parameters for PostLoginMfa.
+type PostLoginMfaFormdataBody struct {
+	Code      string                         `form:"code" json:"code"`
+	Method    PostLoginMfaFormdataBodyMethod `form:"method" json:"method"`
+	RequestId string                         `form:"request_id" json:"request_id"`
+}
+
+// PostLoginMfaFormdataBodyMethod defines parameters for PostLoginMfa.
+type PostLoginMfaFormdataBodyMethod string
+
+// PostLoginPasskeyFormdataBody defines parameters for PostLoginPasskey.
+type PostLoginPasskeyFormdataBody struct {
+	CeremonyId string `form:"ceremony_id" json:"ceremony_id"`
+
+	// Credential PublicKeyCredential returned by navigator.credentials.get, serialised as JSON
+	Credential string `form:"credential" json:"credential"`
+	RequestId  string `form:"request_id" json:"request_id"`
+}
+
+// PostLoginPasskeyOptionsJSONBody defines parameters for PostLoginPasskeyOptions.
+type PostLoginPasskeyOptionsJSONBody struct {
+	// Identity Username or email; omit for a usernameless login with a discoverable credential
+	Identity  *string `json:"identity,omitempty"`
+	RequestId string  `json:"request_id"`
+}
+
+// PostLogoutJSONBody defines parameters for PostLogout.
+type PostLogoutJSONBody struct {
+	ClientId *string `json:"client_id,omitempty"`
+
+	// IdTokenHint An ID token the orbit issued to the client, identifying the user whose session ends
+	IdTokenHint           *string `json:"id_token_hint,omitempty"`
+	PostLogoutRedirectUri *string `json:"post_logout_redirect_uri,omitempty"`
+	State                 *string `json:"state,omitempty"`
+}
+
+// PostPasswordForgotFormdataBody defines parameters for PostPasswordForgot.
+type PostPasswordForgotFormdataBody struct {
+	Identity string `form:"identity" json:"identity"`
+}
+
+// GetPasswordResetParams defines parameters for GetPasswordReset.
+type GetPasswordResetParams struct {
+	Token string `form:"token" json:"token"`
+}
+
+// PostPasswordResetFormdataBody defines parameters for PostPasswordReset.
+type PostPasswordResetFormdataBody struct {
+	Password string `form:"password" json:"password"`
+	Token    string `form:"token" json:"token"`
+}
+
+// PostRevokeFormdataBody defines parameters for PostRevoke.
+type PostRevokeFormdataBody struct {
+	Token         string  `form:"token" json:"token"`
+	TokenTypeHint *string `form:"token_type_hint,omitempty" json:"token_type_hint,omitempty"`
+}
+
+// PostTokenFormdataBody defines parameters for PostToken.
+type PostTokenFormdataBody struct {
+	// ClientId Identifies public clients and clients using client_secret_post
+	ClientId *string `form:"client_id,omitempty" json:"client_id,omitempty"`
+
+	// ClientSecret Secret of clients using client_secret_post
+	ClientSecret *string                        `form:"client_secret,omitempty" json:"client_secret,omitempty"`
+	Code         *string                        `form:"code,omitempty" json:"code,omitempty"`
+	CodeVerifier *string                        `form:"code_verifier,omitempty" json:"code_verifier,omitempty"`
+	GrantType    PostTokenFormdataBodyGrantType `form:"grant_type" json:"grant_type"`
+	RedirectUri  *string                        `form:"redirect_uri,omitempty" json:"redirect_uri,omitempty"`
+	RefreshToken *string                        `form:"refresh_token,omitempty" json:"refresh_token,omitempty"`
+	Resource     *[]string                      `form:"resource,omitempty" json:"resource,omitempty"`
+	Scope        *string                        `form:"scope,omitempty" json:"scope,omitempty"`
+}
+
+// PostTokenFormdataBodyGrantType defines parameters for PostToken.
+type PostTokenFormdataBodyGrantType string
+
+// PostAccountPasskeysRegistrationsJSONRequestBody defines body for PostAccountPasskeysRegistrations for application/json ContentType.
+type PostAccountPasskeysRegistrationsJSONRequestBody PostAccountPasskeysRegistrationsJSONBody
+
+// PostAccountPasskeysRegistrationsCeremonyIdJSONRequestBody defines body for PostAccountPasskeysRegistrationsCeremonyId for application/json ContentType.
+type PostAccountPasskeysRegistrationsCeremonyIdJSONRequestBody = PostAccountPasskeysRegistrationsCeremonyIdJSONBody
+
+// PostAccountTotpJSONRequestBody defines body for PostAccountTotp for application/json ContentType.
+type PostAccountTotpJSONRequestBody PostAccountTotpJSONBody
+
+// PostAccountTotpTotpIdConfirmJSONRequestBody defines body for PostAccountTotpTotpIdConfirm for application/json ContentType.
+type PostAccountTotpTotpIdConfirmJSONRequestBody PostAccountTotpTotpIdConfirmJSONBody
+
+// PostAdminV1OrbitsJSONRequestBody defines body for PostAdminV1Orbits for application/json ContentType.
+type PostAdminV1OrbitsJSONRequestBody = OrbitInput
+
+// PutAdminV1OrbitsOrbitIdJSONRequestBody defines body for PutAdminV1OrbitsOrbitId for application/json ContentType.
+type PutAdminV1OrbitsOrbitIdJSONRequestBody = OrbitInput
+
+// PostAdminV1OrbitsOrbitIdClientsJSONRequestBody defines body for PostAdminV1OrbitsOrbitIdClients for application/json ContentType.
+type PostAdminV1OrbitsOrbitIdClientsJSONRequestBody = OAuthClientInput
+
+// PutAdminV1OrbitsOrbitIdClientsClientIdJSONRequestBody defines body for PutAdminV1OrbitsOrbitIdClientsClientId for application/json ContentType.
+type PutAdminV1OrbitsOrbitIdClientsClientIdJSONRequestBody = OAuthClientInput
+
+// PostAdminV1OrbitsOrbitIdGroupsJSONRequestBody defines body for PostAdminV1OrbitsOrbitIdGroups for application/json ContentType.
+type PostAdminV1OrbitsOrbitIdGroupsJSONRequestBody = GroupInput
+
+// PostAdminV1OrbitsOrbitIdKeysJSONRequestBody defines body for PostAdminV1OrbitsOrbitIdKeys for application/json ContentType.
+type PostAdminV1OrbitsOrbitIdKeysJSONRequestBody = SigningKeyInput
+
+// PutAdminV1OrbitsOrbitIdKeysKeyIdJSONRequestBody defines body for PutAdminV1OrbitsOrbitIdKeysKeyId for application/json ContentType.
+type PutAdminV1OrbitsOrbitIdKeysKeyIdJSONRequestBody = SigningKeyInput
+
+// PostAdminV1OrbitsOrbitIdPermissionsJSONRequestBody defines body for PostAdminV1OrbitsOrbitIdPermissions for application/json ContentType.
+type PostAdminV1OrbitsOrbitIdPermissionsJSONRequestBody = PermissionInput
+
+// PostAdminV1OrbitsOrbitIdPoliciesJSONRequestBody defines body for PostAdminV1OrbitsOrbitIdPolicies for application/json ContentType.
+type PostAdminV1OrbitsOrbitIdPoliciesJSONRequestBody = PolicyInput
+
+// PutAdminV1OrbitsOrbitIdPoliciesPolicyIdJSONRequestBody defines body for PutAdminV1OrbitsOrbitIdPoliciesPolicyId for application/json ContentType.
+type PutAdminV1OrbitsOrbitIdPoliciesPolicyIdJSONRequestBody = PolicyInput
+
+// PostAdminV1OrbitsOrbitIdRolesJSONRequestBody defines body for PostAdminV1OrbitsOrbitIdRoles for application/json ContentType.
+type PostAdminV1OrbitsOrbitIdRolesJSONRequestBody = RoleInput
+
+// PostAdminV1OrbitsOrbitIdScopesJSONRequestBody defines body for PostAdminV1OrbitsOrbitIdScopes for application/json ContentType.
+type PostAdminV1OrbitsOrbitIdScopesJSONRequestBody = ScopeInput
+
+// PutAdminV1OrbitsOrbitIdScopesScopeIdJSONRequestBody defines body for PutAdminV1OrbitsOrbitIdScopesScopeId for application/json ContentType.
+type PutAdminV1OrbitsOrbitIdScopesScopeIdJSONRequestBody = ScopeInput
+
+// PostAdminV1OrbitsOrbitIdUsersJSONRequestBody defines body for PostAdminV1OrbitsOrbitIdUsers for application/json ContentType.
+type PostAdminV1OrbitsOrbitIdUsersJSONRequestBody = UserInput
+
+// PutAdminV1OrbitsOrbitIdUsersUserIdJSONRequestBody defines body for PutAdminV1OrbitsOrbitIdUsersUserId for application/json ContentType.
+type PutAdminV1OrbitsOrbitIdUsersUserIdJSONRequestBody = UserInput
+
+// PostAuthzEvaluateJSONRequestBody defines body for PostAuthzEvaluate for application/json ContentType.
+type PostAuthzEvaluateJSONRequestBody = AuthzRequest
+
+// PostConsentFormdataRequestBody defines body for PostConsent for application/x-www-form-urlencoded ContentType.
+type PostConsentFormdataRequestBody PostConsentFormdataBody
+
+// PostIntrospectFormdataRequestBody defines body for PostIntrospect for application/x-www-form-urlencoded ContentType.
+type PostIntrospectFormdataRequestBody PostIntrospectFormdataBody
+
+// PostLoginFormdataRequestBody defines body for PostLogin for application/x-www-form-urlencoded ContentType.
+type PostLoginFormdataRequestBody PostLoginFormdataBody
+
+// PostLoginMfaFormdataRequestBody defines body for PostLoginMfa for application/x-www-form-urlencoded ContentType.
+type PostLoginMfaFormdataRequestBody PostLoginMfaFormdataBody
+
+// PostLoginPasskeyFormdataRequestBody defines body for PostLoginPasskey for application/x-www-form-urlencoded ContentType.
+type PostLoginPasskeyFormdataRequestBody PostLoginPasskeyFormdataBody
+
+// PostLoginPasskeyOptionsJSONRequestBody defines body for PostLoginPasskeyOptions for application/json ContentType.
+type PostLoginPasskeyOptionsJSONRequestBody PostLoginPasskeyOptionsJSONBody
+
+// PostLogoutJSONRequestBody defines body for PostLogout for application/json ContentType.
+type PostLogoutJSONRequestBody PostLogoutJSONBody
+
+// PostPasswordForgotFormdataRequestBody defines body for PostPasswordForgot for application/x-www-form-urlencoded ContentType.
+type PostPasswordForgotFormdataRequestBody PostPasswordForgotFormdataBody
+
+// PostPasswordResetFormdataRequestBody defines body for PostPasswordReset for application/x-www-form-urlencoded ContentType.
+type PostPasswordResetFormdataRequestBody PostPasswordResetFormdataBody
+
+// PostRegisterJSONRequestBody defines body for PostRegister for application/json ContentType.
+type PostRegisterJSONRequestBody = ClientMetadata
+
+// PutRegisterClientIdJSONRequestBody defines body for PutRegisterClientId for application/json ContentType.
+type PutRegisterClientIdJSONRequestBody = ClientMetadata
+
+// PostRevokeFormdataRequestBody defines body for PostRevoke for application/x-www-form-urlencoded ContentType.
+type PostRevokeFormdataRequestBody PostRevokeFormdataBody
+
+// PostTokenFormdataRequestBody defines body for PostToken for application/x-www-form-urlencoded ContentType.
+type PostTokenFormdataRequestBody PostTokenFormdataBody
+
+// RequestEditorFn  is the function signature for the RequestEditor callback function
+type RequestEditorFn func(ctx context.Context, req *http.Request) error
+
+// Doer performs HTTP requests.
+//
+// The standard http.Client implements this interface.
+type HttpRequestDoer interface {
+	Do(req *http.Request) (*http.Response, error)
+}
+
+// Client which conforms to the OpenAPI3 specification for this service.
+type Client struct {
+	// The endpoint of the server conforming to this interface, with scheme,
+	// https://api.deepmap.com for example. This can contain a path relative
+	// to the server, such as https://api.deepmap.com/dev-test, and all the
+	// paths in the swagger spec will be appended to the server.
+	Server string
+
+	// Doer for performing requests, typically a *http.Client with any
+	// customized settings, such as certificate chains.
+	Client HttpRequestDoer
+
+	// A list of callbacks for modifying requests which are generated before sending over
+	// the network.
+	RequestEditors []RequestEditorFn
+}
+
+// ClientOption allows setting custom parameters during construction
+type ClientOption func(*Client) error
+
+// Creates a new Client, with reasonable defaults
+func NewClient(server string, opts ...ClientOption) (*Client, error) {
+	// create a client with sane default values
+	client := Client{
+		Server: server,
+	}
+	// mutate client and add all optional params
+	for _, o := range opts {
+		if err := o(&client); err != nil {
+			return nil, err
+		}
+	}
+	// ensure the server URL always has a trailing slash
+	if !strings.HasSuffix(client.Server, "/") {
+		client.Server += "/"
+	}
+	// create httpClient, if not already present
+	if client.Client == nil {
+		client.Client = &http.Client{}
+	}
+	return &client, nil
+}
+
+// WithHTTPClient allows overriding the default Doer, which is
+// automatically created using http.Client. This is useful for tests.
+func WithHTTPClient(doer HttpRequestDoer) ClientOption {
+	return func(c *Client) error {
+		c.Client = doer
+		return nil
+	}
+}
+
+// WithRequestEditorFn allows setting up a callback function, which will be
+// called right before sending the request. This can be used to mutate the request.
+func WithRequestEditorFn(fn RequestEditorFn) ClientOption {
+	return func(c *Client) error {
+		c.RequestEditors = append(c.RequestEditors, fn)
+		return nil
+	}
+}
+
+// The interface specification for the client above.
+type ClientInterface interface {
+	// GetWellKnownJwksJson request
+	GetWellKnownJwksJson(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetWellKnownOpenidConfiguration request
+	GetWellKnownOpenidConfiguration(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAccountConsents request
+	GetAccountConsents(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAccountConsentsConsentId request
+	DeleteAccountConsentsConsentId(ctx context.Context, consentId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAccountEmailVerification request
+	PostAccountEmailVerification(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAccountPasskeys request
+	GetAccountPasskeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAccountPasskeysRegistrationsWithBody request with any body
+	PostAccountPasskeysRegistrationsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAccountPasskeysRegistrations(ctx context.Context, body PostAccountPasskeysRegistrationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAccountPasskeysRegistrationsCeremonyIdWithBody request with any body
+	PostAccountPasskeysRegistrationsCeremonyIdWithBody(ctx context.Context, ceremonyId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAccountPasskeysRegistrationsCeremonyId(ctx context.Context, ceremonyId string, body PostAccountPasskeysRegistrationsCeremonyIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAccountPasskeysPasskeyId request
+	DeleteAccountPasskeysPasskeyId(ctx context.Context, passkeyId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAccountRecoveryCodes request
+	GetAccountRecoveryCodes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAccountRecoveryCodes request
+	PostAccountRecoveryCodes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAccountSessions request
+	DeleteAccountSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAccountSessions request
+	GetAccountSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAccountSessionsSessionId request
+	DeleteAccountSessionsSessionId(ctx context.Context, sessionId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAccountTotp request
+	GetAccountTotp(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAccountTotpWithBody request with any body
+	PostAccountTotpWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAccountTotp(ctx context.Context, body PostAccountTotpJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAccountTotpTotpId request
+	DeleteAccountTotpTotpId(ctx context.Context, totpId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAccountTotpTotpIdConfirmWithBody request with any body
+	PostAccountTotpTotpIdConfirmWithBody(ctx context.Context, totpId int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAccountTotpTotpIdConfirm(ctx context.Context, totpId int64, body PostAccountTotpTotpIdConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminV1Orbits request
+	GetAdminV1Orbits(ctx context.Context, params *GetAdminV1OrbitsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAdminV1OrbitsWithBody request with any body
+	PostAdminV1OrbitsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAdminV1Orbits(ctx context.Context, body PostAdminV1OrbitsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAdminV1OrbitsOrbitId request
+	DeleteAdminV1OrbitsOrbitId(ctx context.Context, orbitId OrbitId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminV1OrbitsOrbitId request
+	GetAdminV1OrbitsOrbitId(ctx context.Context, orbitId OrbitId, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutAdminV1OrbitsOrbitIdWithBody request with any body
+	PutAdminV1OrbitsOrbitIdWithBody(ctx context.Context, orbitId OrbitId, params *PutAdminV1OrbitsOrbitIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutAdminV1OrbitsOrbitId(ctx context.Context, orbitId OrbitId, params *PutAdminV1OrbitsOrbitIdParams, body PutAdminV1OrbitsOrbitIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminV1OrbitsOrbitIdAuditLogs request
+	GetAdminV1OrbitsOrbitIdAuditLogs(ctx context.Context, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdAuditLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminV1OrbitsOrbitIdClients request
+	GetAdminV1OrbitsOrbitIdClients(ctx context.Context, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdClientsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAdminV1OrbitsOrbitIdClientsWithBody request with any body
+	PostAdminV1OrbitsOrbitIdClientsWithBody(ctx context.Context, orbitId OrbitId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAdminV1OrbitsOrbitIdClients(ctx context.Context, orbitId OrbitId, body PostAdminV1OrbitsOrbitIdClientsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAdminV1OrbitsOrbitIdClientsClientId request
+	DeleteAdminV1OrbitsOrbitIdClientsClientId(ctx context.Context, orbitId OrbitId, clientId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminV1OrbitsOrbitIdClientsClientId request
+	GetAdminV1OrbitsOrbitIdClientsClientId(ctx context.Context, orbitId OrbitId, clientId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutAdminV1OrbitsOrbitIdClientsClientIdWithBody request with any body
+	PutAdminV1OrbitsOrbitIdClientsClientIdWithBody(ctx context.Context, orbitId OrbitId, clientId string, params *PutAdminV1OrbitsOrbitIdClientsClientIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutAdminV1OrbitsOrbitIdClientsClientId(ctx context.Context, orbitId OrbitId, clientId string, params *PutAdminV1OrbitsOrbitIdClientsClientIdParams, body PutAdminV1OrbitsOrbitIdClientsClientIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAdminV1OrbitsOrbitIdClientsClientIdSecret request
+	PostAdminV1OrbitsOrbitIdClientsClientIdSecret(ctx context.Context, orbitId OrbitId, clientId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminV1OrbitsOrbitIdGroups request
+	GetAdminV1OrbitsOrbitIdGroups(ctx context.Context, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdGroupsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAdminV1OrbitsOrbitIdGroupsWithBody request with any body
+	PostAdminV1OrbitsOrbitIdGroupsWithBody(ctx context.Context, orbitId OrbitId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAdminV1OrbitsOrbitIdGroups(ctx context.Context, orbitId OrbitId, body PostAdminV1OrbitsOrbitIdGroupsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAdminV1OrbitsOrbitIdGroupsGroupId request
+	DeleteAdminV1OrbitsOrbitIdGroupsGroupId(ctx context.Context, orbitId OrbitId, groupId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminV1OrbitsOrbitIdGroupsGroupId request
+	GetAdminV1OrbitsOrbitIdGroupsGroupId(ctx context.Context, orbitId OrbitId, groupId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminV1OrbitsOrbitIdGroupsGroupIdMembers request
+	GetAdminV1OrbitsOrbitIdGroupsGroupIdMembers(ctx context.Context, orbitId OrbitId, groupId int64, params *GetAdminV1OrbitsOrbitIdGroupsGroupIdMembersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserId request
+	DeleteAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserId(ctx context.Context, orbitId OrbitId, groupId int64, userId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserId request
+	PutAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserId(ctx context.Context, orbitId OrbitId, groupId int64, userId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminV1OrbitsOrbitIdGroupsGroupIdRoles request
+	GetAdminV1OrbitsOrbitIdGroupsGroupIdRoles(ctx context.Context, orbitId OrbitId, groupId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleId request
+	DeleteAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleId(ctx context.Context, orbitId OrbitId, groupId int64, roleId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleId request
+	PutAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleId(ctx context.Context, orbitId OrbitId, groupId int64, roleId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminV1OrbitsOrbitIdKeys request
+	GetAdminV1OrbitsOrbitIdKeys(ctx context.Context, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdKeysParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAdminV1OrbitsOrbitIdKeysWithBody request with any body
+	PostAdminV1OrbitsOrbitIdKeysWithBody(ctx context.Context, orbitId OrbitId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAdminV1OrbitsOrbitIdKeys(ctx context.Context, orbitId OrbitId, body PostAdminV1OrbitsOrbitIdKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAdminV1OrbitsOrbitIdKeysKeyId request
+	DeleteAdminV1OrbitsOrbitIdKeysKeyId(ctx context.Context, orbitId OrbitId, keyId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminV1OrbitsOrbitIdKeysKeyId request
+	GetAdminV1OrbitsOrbitIdKeysKeyId(ctx context.Context, orbitId OrbitId, keyId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutAdminV1OrbitsOrbitIdKeysKeyIdWithBody request with any body
+	PutAdminV1OrbitsOrbitIdKeysKeyIdWithBody(ctx context.Context, orbitId OrbitId, keyId int64, params *PutAdminV1OrbitsOrbitIdKeysKeyIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutAdminV1OrbitsOrbitIdKeysKeyId(ctx context.Context, orbitId OrbitId, keyId int64, params *PutAdminV1OrbitsOrbitIdKeysKeyIdParams, body PutAdminV1OrbitsOrbitIdKeysKeyIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminV1OrbitsOrbitIdPermissions request
+	GetAdminV1OrbitsOrbitIdPermissions(ctx context.Context, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdPermissionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAdminV1OrbitsOrbitIdPermissionsWithBody request with any body
+	PostAdminV1OrbitsOrbitIdPermissionsWithBody(ctx context.Context, orbitId OrbitId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAdminV1OrbitsOrbitIdPermissions(ctx context.Context, orbitId OrbitId, body PostAdminV1OrbitsOrbitIdPermissionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAdminV1OrbitsOrbitIdPermissionsPermissionId request
+	DeleteAdminV1OrbitsOrbitIdPermissionsPermissionId(ctx context.Context, orbitId OrbitId, permissionId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminV1OrbitsOrbitIdPermissionsPermissionId request
+	GetAdminV1OrbitsOrbitIdPermissionsPermissionId(ctx context.Context, orbitId OrbitId, permissionId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminV1OrbitsOrbitIdPolicies request
+	GetAdminV1OrbitsOrbitIdPolicies(ctx context.Context, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdPoliciesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAdminV1OrbitsOrbitIdPoliciesWithBody request with any body
+	PostAdminV1OrbitsOrbitIdPoliciesWithBody(ctx context.Context, orbitId OrbitId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAdminV1OrbitsOrbitIdPolicies(ctx context.Context, orbitId OrbitId, body PostAdminV1OrbitsOrbitIdPoliciesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAdminV1OrbitsOrbitIdPoliciesPolicyId request
+	DeleteAdminV1OrbitsOrbitIdPoliciesPolicyId(ctx context.Context, orbitId OrbitId, policyId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminV1OrbitsOrbitIdPoliciesPolicyId request
+	GetAdminV1OrbitsOrbitIdPoliciesPolicyId(ctx context.Context, orbitId OrbitId, policyId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutAdminV1OrbitsOrbitIdPoliciesPolicyIdWithBody request with any body
+	PutAdminV1OrbitsOrbitIdPoliciesPolicyIdWithBody(ctx context.Context, orbitId OrbitId, policyId int64, params *PutAdminV1OrbitsOrbitIdPoliciesPolicyIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutAdminV1OrbitsOrbitIdPoliciesPolicyId(ctx context.Context, orbitId OrbitId, policyId int64, params *PutAdminV1OrbitsOrbitIdPoliciesPolicyIdParams, body PutAdminV1OrbitsOrbitIdPoliciesPolicyIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminV1OrbitsOrbitIdPolicyDecisions request
+	GetAdminV1OrbitsOrbitIdPolicyDecisions(ctx context.Context, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdPolicyDecisionsParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminV1OrbitsOrbitIdRoles request
+	GetAdminV1OrbitsOrbitIdRoles(ctx context.Context, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdRolesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAdminV1OrbitsOrbitIdRolesWithBody request with any body
+	PostAdminV1OrbitsOrbitIdRolesWithBody(ctx context.Context, orbitId OrbitId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAdminV1OrbitsOrbitIdRoles(ctx context.Context, orbitId OrbitId, body PostAdminV1OrbitsOrbitIdRolesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAdminV1OrbitsOrbitIdRolesRoleId request
+	DeleteAdminV1OrbitsOrbitIdRolesRoleId(ctx context.Context, orbitId OrbitId, roleId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminV1OrbitsOrbitIdRolesRoleId request
+	GetAdminV1OrbitsOrbitIdRolesRoleId(ctx context.Context, orbitId OrbitId, roleId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminV1OrbitsOrbitIdRolesRoleIdIncludes request
+	GetAdminV1OrbitsOrbitIdRolesRoleIdIncludes(ctx context.Context, orbitId OrbitId, roleId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleId request
+	DeleteAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleId(ctx context.Context, orbitId OrbitId, roleId int64, includedRoleId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleId request
+	PutAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleId(ctx context.Context, orbitId OrbitId, roleId int64, includedRoleId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminV1OrbitsOrbitIdRolesRoleIdPermissions request
+	GetAdminV1OrbitsOrbitIdRolesRoleIdPermissions(ctx context.Context, orbitId OrbitId, roleId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionId request
+	DeleteAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionId(ctx context.Context, orbitId OrbitId, roleId int64, permissionId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionId request
+	PutAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionId(ctx context.Context, orbitId OrbitId, roleId int64, permissionId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminV1OrbitsOrbitIdScopes request
+	GetAdminV1OrbitsOrbitIdScopes(ctx context.Context, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdScopesParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAdminV1OrbitsOrbitIdScopesWithBody request with any body
+	PostAdminV1OrbitsOrbitIdScopesWithBody(ctx context.Context, orbitId OrbitId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAdminV1OrbitsOrbitIdScopes(ctx context.Context, orbitId OrbitId, body PostAdminV1OrbitsOrbitIdScopesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAdminV1OrbitsOrbitIdScopesScopeId request
+	DeleteAdminV1OrbitsOrbitIdScopesScopeId(ctx context.Context, orbitId OrbitId, scopeId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminV1OrbitsOrbitIdScopesScopeId request
+	GetAdminV1OrbitsOrbitIdScopesScopeId(ctx context.Context, orbitId OrbitId, scopeId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutAdminV1OrbitsOrbitIdScopesScopeIdWithBody request with any body
+	PutAdminV1OrbitsOrbitIdScopesScopeIdWithBody(ctx context.Context, orbitId OrbitId, scopeId int64, params *PutAdminV1OrbitsOrbitIdScopesScopeIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutAdminV1OrbitsOrbitIdScopesScopeId(ctx context.Context, orbitId OrbitId, scopeId int64, params *PutAdminV1OrbitsOrbitIdScopesScopeIdParams, body PutAdminV1OrbitsOrbitIdScopesScopeIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminV1OrbitsOrbitIdUsers request
+	GetAdminV1OrbitsOrbitIdUsers(ctx context.Context, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAdminV1OrbitsOrbitIdUsersWithBody request with any body
+	PostAdminV1OrbitsOrbitIdUsersWithBody(ctx context.Context, orbitId OrbitId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAdminV1OrbitsOrbitIdUsers(ctx context.Context, orbitId OrbitId, body PostAdminV1OrbitsOrbitIdUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAdminV1OrbitsOrbitIdUsersUserId request
+	DeleteAdminV1OrbitsOrbitIdUsersUserId(ctx context.Context, orbitId OrbitId, userId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminV1OrbitsOrbitIdUsersUserId request
+	GetAdminV1OrbitsOrbitIdUsersUserId(ctx context.Context, orbitId OrbitId, userId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutAdminV1OrbitsOrbitIdUsersUserIdWithBody request with any body
+	PutAdminV1OrbitsOrbitIdUsersUserIdWithBody(ctx context.Context, orbitId OrbitId, userId int64, params *PutAdminV1OrbitsOrbitIdUsersUserIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutAdminV1OrbitsOrbitIdUsersUserId(ctx context.Context, orbitId OrbitId, userId int64, params *PutAdminV1OrbitsOrbitIdUsersUserIdParams, body PutAdminV1OrbitsOrbitIdUsersUserIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminV1OrbitsOrbitIdUsersUserIdPermissions request
+	GetAdminV1OrbitsOrbitIdUsersUserIdPermissions(ctx context.Context, orbitId OrbitId, userId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAdminV1OrbitsOrbitIdUsersUserIdRoles request
+	GetAdminV1OrbitsOrbitIdUsersUserIdRoles(ctx context.Context, orbitId OrbitId, userId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteAdminV1OrbitsOrbitIdUsersUserIdRolesRoleId request
+	DeleteAdminV1OrbitsOrbitIdUsersUserIdRolesRoleId(ctx context.Context, orbitId OrbitId, userId int64, roleId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutAdminV1OrbitsOrbitIdUsersUserIdRolesRoleId request
+	PutAdminV1OrbitsOrbitIdUsersUserIdRolesRoleId(ctx context.Context, orbitId OrbitId, userId int64, roleId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAdminV1OrbitsOrbitIdUsersUserIdUnlock request
+	PostAdminV1OrbitsOrbitIdUsersUserIdUnlock(ctx context.Context, orbitId OrbitId, userId int64, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetAuthorize request
+	GetAuthorize(ctx context.Context, params *GetAuthorizeParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostAuthzEvaluateWithBody request with any body
+	PostAuthzEvaluateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostAuthzEvaluate(ctx context.Context, body PostAuthzEvaluateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetConsent request
+	GetConsent(ctx context.Context, params *GetConsentParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostConsentWithBody request with any body
+	PostConsentWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostConsentWithFormdataBody(ctx context.Context, body PostConsentFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetEmailVerify request
+	GetEmailVerify(ctx context.Context, params *GetEmailVerifyParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostIntrospectWithBody request with any body
+	PostIntrospectWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostIntrospectWithFormdataBody(ctx context.Context, body PostIntrospectFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLogin request
+	GetLogin(ctx context.Context, params *GetLoginParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostLoginWithBody request with any body
+	PostLoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostLoginWithFormdataBody(ctx context.Context, body PostLoginFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetLoginMfa request
+	GetLoginMfa(ctx context.Context, params *GetLoginMfaParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostLoginMfaWithBody request with any body
+	PostLoginMfaWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostLoginMfaWithFormdataBody(ctx context.Context, body PostLoginMfaFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostLoginPasskeyWithBody request with any body
+	PostLoginPasskeyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostLoginPasskeyWithFormdataBody(ctx context.Context, body PostLoginPasskeyFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostLoginPasskeyOptionsWithBody request with any body
+	PostLoginPasskeyOptionsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostLoginPasskeyOptions(ctx context.Context, body PostLoginPasskeyOptionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostLogoutWithBody request with any body
+	PostLogoutWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostLogout(ctx context.Context, body PostLogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPasswordForgot request
+	GetPasswordForgot(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostPasswordForgotWithBody request with any body
+	PostPasswordForgotWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostPasswordForgotWithFormdataBody(ctx context.Context, body PostPasswordForgotFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetPasswordReset request
+	GetPasswordReset(ctx context.Context, params *GetPasswordResetParams, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostPasswordResetWithBody request with any body
+	PostPasswordResetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostPasswordResetWithFormdataBody(ctx context.Context, body PostPasswordResetFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRegisterWithBody request with any body
+	PostRegisterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRegister(ctx context.Context, body PostRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// DeleteRegisterClientId request
+	DeleteRegisterClientId(ctx context.Context, clientId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetRegisterClientId request
+	GetRegisterClientId(ctx context.Context, clientId string, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PutRegisterClientIdWithBody request with any body
+	PutRegisterClientIdWithBody(ctx context.Context, clientId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PutRegisterClientId(ctx context.Context, clientId string, body PutRegisterClientIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostRevokeWithBody request with any body
+	PostRevokeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostRevokeWithFormdataBody(ctx context.Context, body PostRevokeFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// PostTokenWithBody request with any body
+	PostTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	PostTokenWithFormdataBody(ctx context.Context, body PostTokenFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error)
+
+	// GetUserinfo request
+	GetUserinfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error)
+}
+
+func (c *Client) GetWellKnownJwksJson(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWellKnownJwksJsonRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetWellKnownOpenidConfiguration(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetWellKnownOpenidConfigurationRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAccountConsents(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAccountConsentsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAccountConsentsConsentId(ctx context.Context, consentId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAccountConsentsConsentIdRequest(c.Server, consentId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAccountEmailVerification(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAccountEmailVerificationRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAccountPasskeys(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAccountPasskeysRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAccountPasskeysRegistrationsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAccountPasskeysRegistrationsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAccountPasskeysRegistrations(ctx context.Context, body PostAccountPasskeysRegistrationsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAccountPasskeysRegistrationsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAccountPasskeysRegistrationsCeremonyIdWithBody(ctx context.Context, ceremonyId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAccountPasskeysRegistrationsCeremonyIdRequestWithBody(c.Server, ceremonyId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAccountPasskeysRegistrationsCeremonyId(ctx context.Context, ceremonyId string, body PostAccountPasskeysRegistrationsCeremonyIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAccountPasskeysRegistrationsCeremonyIdRequest(c.Server, ceremonyId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAccountPasskeysPasskeyId(ctx context.Context, passkeyId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAccountPasskeysPasskeyIdRequest(c.Server, passkeyId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAccountRecoveryCodes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAccountRecoveryCodesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAccountRecoveryCodes(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAccountRecoveryCodesRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAccountSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAccountSessionsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAccountSessions(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAccountSessionsRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAccountSessionsSessionId(ctx context.Context, sessionId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAccountSessionsSessionIdRequest(c.Server, sessionId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAccountTotp(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAccountTotpRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAccountTotpWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAccountTotpRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAccountTotp(ctx context.Context, body PostAccountTotpJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAccountTotpRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAccountTotpTotpId(ctx context.Context, totpId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAccountTotpTotpIdRequest(c.Server, totpId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAccountTotpTotpIdConfirmWithBody(ctx context.Context, totpId int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAccountTotpTotpIdConfirmRequestWithBody(c.Server, totpId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAccountTotpTotpIdConfirm(ctx context.Context, totpId int64, body PostAccountTotpTotpIdConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAccountTotpTotpIdConfirmRequest(c.Server, totpId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAdminV1Orbits(ctx context.Context, params *GetAdminV1OrbitsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminV1OrbitsRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAdminV1OrbitsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminV1OrbitsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAdminV1Orbits(ctx context.Context, body PostAdminV1OrbitsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminV1OrbitsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAdminV1OrbitsOrbitId(ctx context.Context, orbitId OrbitId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAdminV1OrbitsOrbitIdRequest(c.Server, orbitId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAdminV1OrbitsOrbitId(ctx context.Context, orbitId OrbitId, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminV1OrbitsOrbitIdRequest(c.Server, orbitId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAdminV1OrbitsOrbitIdWithBody(ctx context.Context, orbitId OrbitId, params *PutAdminV1OrbitsOrbitIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminV1OrbitsOrbitIdRequestWithBody(c.Server, orbitId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAdminV1OrbitsOrbitId(ctx context.Context, orbitId OrbitId, params *PutAdminV1OrbitsOrbitIdParams, body PutAdminV1OrbitsOrbitIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminV1OrbitsOrbitIdRequest(c.Server, orbitId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAdminV1OrbitsOrbitIdAuditLogs(ctx context.Context, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdAuditLogsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminV1OrbitsOrbitIdAuditLogsRequest(c.Server, orbitId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAdminV1OrbitsOrbitIdClients(ctx context.Context, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdClientsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminV1OrbitsOrbitIdClientsRequest(c.Server, orbitId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAdminV1OrbitsOrbitIdClientsWithBody(ctx context.Context, orbitId OrbitId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminV1OrbitsOrbitIdClientsRequestWithBody(c.Server, orbitId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAdminV1OrbitsOrbitIdClients(ctx context.Context, orbitId OrbitId, body PostAdminV1OrbitsOrbitIdClientsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminV1OrbitsOrbitIdClientsRequest(c.Server, orbitId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAdminV1OrbitsOrbitIdClientsClientId(ctx context.Context, orbitId OrbitId, clientId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAdminV1OrbitsOrbitIdClientsClientIdRequest(c.Server, orbitId, clientId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAdminV1OrbitsOrbitIdClientsClientId(ctx context.Context, orbitId OrbitId, clientId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminV1OrbitsOrbitIdClientsClientIdRequest(c.Server, orbitId, clientId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAdminV1OrbitsOrbitIdClientsClientIdWithBody(ctx context.Context, orbitId OrbitId, clientId string, params *PutAdminV1OrbitsOrbitIdClientsClientIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminV1OrbitsOrbitIdClientsClientIdRequestWithBody(c.Server, orbitId, clientId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAdminV1OrbitsOrbitIdClientsClientId(ctx context.Context, orbitId OrbitId, clientId string, params *PutAdminV1OrbitsOrbitIdClientsClientIdParams, body PutAdminV1OrbitsOrbitIdClientsClientIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminV1OrbitsOrbitIdClientsClientIdRequest(c.Server, orbitId, clientId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAdminV1OrbitsOrbitIdClientsClientIdSecret(ctx context.Context, orbitId OrbitId, clientId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminV1OrbitsOrbitIdClientsClientIdSecretRequest(c.Server, orbitId, clientId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAdminV1OrbitsOrbitIdGroups(ctx context.Context, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdGroupsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminV1OrbitsOrbitIdGroupsRequest(c.Server, orbitId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAdminV1OrbitsOrbitIdGroupsWithBody(ctx context.Context, orbitId OrbitId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminV1OrbitsOrbitIdGroupsRequestWithBody(c.Server, orbitId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAdminV1OrbitsOrbitIdGroups(ctx context.Context, orbitId OrbitId, body PostAdminV1OrbitsOrbitIdGroupsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminV1OrbitsOrbitIdGroupsRequest(c.Server, orbitId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAdminV1OrbitsOrbitIdGroupsGroupId(ctx context.Context, orbitId OrbitId, groupId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAdminV1OrbitsOrbitIdGroupsGroupIdRequest(c.Server, orbitId, groupId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAdminV1OrbitsOrbitIdGroupsGroupId(ctx context.Context, orbitId OrbitId, groupId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminV1OrbitsOrbitIdGroupsGroupIdRequest(c.Server, orbitId, groupId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAdminV1OrbitsOrbitIdGroupsGroupIdMembers(ctx context.Context, orbitId OrbitId, groupId int64, params *GetAdminV1OrbitsOrbitIdGroupsGroupIdMembersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminV1OrbitsOrbitIdGroupsGroupIdMembersRequest(c.Server, orbitId, groupId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserId(ctx context.Context, orbitId OrbitId, groupId int64, userId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserIdRequest(c.Server, orbitId, groupId, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserId(ctx context.Context, orbitId OrbitId, groupId int64, userId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserIdRequest(c.Server, orbitId, groupId, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAdminV1OrbitsOrbitIdGroupsGroupIdRoles(ctx context.Context, orbitId OrbitId, groupId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminV1OrbitsOrbitIdGroupsGroupIdRolesRequest(c.Server, orbitId, groupId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleId(ctx context.Context, orbitId OrbitId, groupId int64, roleId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleIdRequest(c.Server, orbitId, groupId, roleId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleId(ctx context.Context, orbitId OrbitId, groupId int64, roleId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleIdRequest(c.Server, orbitId, groupId, roleId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAdminV1OrbitsOrbitIdKeys(ctx context.Context, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdKeysParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminV1OrbitsOrbitIdKeysRequest(c.Server, orbitId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAdminV1OrbitsOrbitIdKeysWithBody(ctx context.Context, orbitId OrbitId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminV1OrbitsOrbitIdKeysRequestWithBody(c.Server, orbitId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAdminV1OrbitsOrbitIdKeys(ctx context.Context, orbitId OrbitId, body PostAdminV1OrbitsOrbitIdKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminV1OrbitsOrbitIdKeysRequest(c.Server, orbitId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAdminV1OrbitsOrbitIdKeysKeyId(ctx context.Context, orbitId OrbitId, keyId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAdminV1OrbitsOrbitIdKeysKeyIdRequest(c.Server, orbitId, keyId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAdminV1OrbitsOrbitIdKeysKeyId(ctx context.Context, orbitId OrbitId, keyId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminV1OrbitsOrbitIdKeysKeyIdRequest(c.Server, orbitId, keyId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAdminV1OrbitsOrbitIdKeysKeyIdWithBody(ctx context.Context, orbitId OrbitId, keyId int64, params *PutAdminV1OrbitsOrbitIdKeysKeyIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminV1OrbitsOrbitIdKeysKeyIdRequestWithBody(c.Server, orbitId, keyId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAdminV1OrbitsOrbitIdKeysKeyId(ctx context.Context, orbitId OrbitId, keyId int64, params *PutAdminV1OrbitsOrbitIdKeysKeyIdParams, body PutAdminV1OrbitsOrbitIdKeysKeyIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminV1OrbitsOrbitIdKeysKeyIdRequest(c.Server, orbitId, keyId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAdminV1OrbitsOrbitIdPermissions(ctx context.Context, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdPermissionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminV1OrbitsOrbitIdPermissionsRequest(c.Server, orbitId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAdminV1OrbitsOrbitIdPermissionsWithBody(ctx context.Context, orbitId OrbitId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminV1OrbitsOrbitIdPermissionsRequestWithBody(c.Server, orbitId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAdminV1OrbitsOrbitIdPermissions(ctx context.Context, orbitId OrbitId, body PostAdminV1OrbitsOrbitIdPermissionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminV1OrbitsOrbitIdPermissionsRequest(c.Server, orbitId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAdminV1OrbitsOrbitIdPermissionsPermissionId(ctx context.Context, orbitId OrbitId, permissionId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAdminV1OrbitsOrbitIdPermissionsPermissionIdRequest(c.Server, orbitId, permissionId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAdminV1OrbitsOrbitIdPermissionsPermissionId(ctx context.Context, orbitId OrbitId, permissionId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminV1OrbitsOrbitIdPermissionsPermissionIdRequest(c.Server, orbitId, permissionId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAdminV1OrbitsOrbitIdPolicies(ctx context.Context, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdPoliciesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminV1OrbitsOrbitIdPoliciesRequest(c.Server, orbitId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAdminV1OrbitsOrbitIdPoliciesWithBody(ctx context.Context, orbitId OrbitId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminV1OrbitsOrbitIdPoliciesRequestWithBody(c.Server, orbitId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAdminV1OrbitsOrbitIdPolicies(ctx context.Context, orbitId OrbitId, body PostAdminV1OrbitsOrbitIdPoliciesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminV1OrbitsOrbitIdPoliciesRequest(c.Server, orbitId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAdminV1OrbitsOrbitIdPoliciesPolicyId(ctx context.Context, orbitId OrbitId, policyId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAdminV1OrbitsOrbitIdPoliciesPolicyIdRequest(c.Server, orbitId, policyId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAdminV1OrbitsOrbitIdPoliciesPolicyId(ctx context.Context, orbitId OrbitId, policyId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminV1OrbitsOrbitIdPoliciesPolicyIdRequest(c.Server, orbitId, policyId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAdminV1OrbitsOrbitIdPoliciesPolicyIdWithBody(ctx context.Context, orbitId OrbitId, policyId int64, params *PutAdminV1OrbitsOrbitIdPoliciesPolicyIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminV1OrbitsOrbitIdPoliciesPolicyIdRequestWithBody(c.Server, orbitId, policyId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAdminV1OrbitsOrbitIdPoliciesPolicyId(ctx context.Context, orbitId OrbitId, policyId int64, params *PutAdminV1OrbitsOrbitIdPoliciesPolicyIdParams, body PutAdminV1OrbitsOrbitIdPoliciesPolicyIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminV1OrbitsOrbitIdPoliciesPolicyIdRequest(c.Server, orbitId, policyId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAdminV1OrbitsOrbitIdPolicyDecisions(ctx context.Context, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdPolicyDecisionsParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminV1OrbitsOrbitIdPolicyDecisionsRequest(c.Server, orbitId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAdminV1OrbitsOrbitIdRoles(ctx context.Context, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdRolesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminV1OrbitsOrbitIdRolesRequest(c.Server, orbitId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAdminV1OrbitsOrbitIdRolesWithBody(ctx context.Context, orbitId OrbitId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminV1OrbitsOrbitIdRolesRequestWithBody(c.Server, orbitId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAdminV1OrbitsOrbitIdRoles(ctx context.Context, orbitId OrbitId, body PostAdminV1OrbitsOrbitIdRolesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminV1OrbitsOrbitIdRolesRequest(c.Server, orbitId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAdminV1OrbitsOrbitIdRolesRoleId(ctx context.Context, orbitId OrbitId, roleId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAdminV1OrbitsOrbitIdRolesRoleIdRequest(c.Server, orbitId, roleId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAdminV1OrbitsOrbitIdRolesRoleId(ctx context.Context, orbitId OrbitId, roleId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminV1OrbitsOrbitIdRolesRoleIdRequest(c.Server, orbitId, roleId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAdminV1OrbitsOrbitIdRolesRoleIdIncludes(ctx context.Context, orbitId OrbitId, roleId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminV1OrbitsOrbitIdRolesRoleIdIncludesRequest(c.Server, orbitId, roleId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleId(ctx context.Context, orbitId OrbitId, roleId int64, includedRoleId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleIdRequest(c.Server, orbitId, roleId, includedRoleId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleId(ctx context.Context, orbitId OrbitId, roleId int64, includedRoleId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleIdRequest(c.Server, orbitId, roleId, includedRoleId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAdminV1OrbitsOrbitIdRolesRoleIdPermissions(ctx context.Context, orbitId OrbitId, roleId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminV1OrbitsOrbitIdRolesRoleIdPermissionsRequest(c.Server, orbitId, roleId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionId(ctx context.Context, orbitId OrbitId, roleId int64, permissionId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionIdRequest(c.Server, orbitId, roleId, permissionId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionId(ctx context.Context, orbitId OrbitId, roleId int64, permissionId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionIdRequest(c.Server, orbitId, roleId, permissionId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAdminV1OrbitsOrbitIdScopes(ctx context.Context, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdScopesParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminV1OrbitsOrbitIdScopesRequest(c.Server, orbitId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAdminV1OrbitsOrbitIdScopesWithBody(ctx context.Context, orbitId OrbitId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminV1OrbitsOrbitIdScopesRequestWithBody(c.Server, orbitId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAdminV1OrbitsOrbitIdScopes(ctx context.Context, orbitId OrbitId, body PostAdminV1OrbitsOrbitIdScopesJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminV1OrbitsOrbitIdScopesRequest(c.Server, orbitId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAdminV1OrbitsOrbitIdScopesScopeId(ctx context.Context, orbitId OrbitId, scopeId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAdminV1OrbitsOrbitIdScopesScopeIdRequest(c.Server, orbitId, scopeId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAdminV1OrbitsOrbitIdScopesScopeId(ctx context.Context, orbitId OrbitId, scopeId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminV1OrbitsOrbitIdScopesScopeIdRequest(c.Server, orbitId, scopeId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAdminV1OrbitsOrbitIdScopesScopeIdWithBody(ctx context.Context, orbitId OrbitId, scopeId int64, params *PutAdminV1OrbitsOrbitIdScopesScopeIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminV1OrbitsOrbitIdScopesScopeIdRequestWithBody(c.Server, orbitId, scopeId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAdminV1OrbitsOrbitIdScopesScopeId(ctx context.Context, orbitId OrbitId, scopeId int64, params *PutAdminV1OrbitsOrbitIdScopesScopeIdParams, body PutAdminV1OrbitsOrbitIdScopesScopeIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminV1OrbitsOrbitIdScopesScopeIdRequest(c.Server, orbitId, scopeId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAdminV1OrbitsOrbitIdUsers(ctx context.Context, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdUsersParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminV1OrbitsOrbitIdUsersRequest(c.Server, orbitId, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAdminV1OrbitsOrbitIdUsersWithBody(ctx context.Context, orbitId OrbitId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminV1OrbitsOrbitIdUsersRequestWithBody(c.Server, orbitId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAdminV1OrbitsOrbitIdUsers(ctx context.Context, orbitId OrbitId, body PostAdminV1OrbitsOrbitIdUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminV1OrbitsOrbitIdUsersRequest(c.Server, orbitId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAdminV1OrbitsOrbitIdUsersUserId(ctx context.Context, orbitId OrbitId, userId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAdminV1OrbitsOrbitIdUsersUserIdRequest(c.Server, orbitId, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAdminV1OrbitsOrbitIdUsersUserId(ctx context.Context, orbitId OrbitId, userId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminV1OrbitsOrbitIdUsersUserIdRequest(c.Server, orbitId, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAdminV1OrbitsOrbitIdUsersUserIdWithBody(ctx context.Context, orbitId OrbitId, userId int64, params *PutAdminV1OrbitsOrbitIdUsersUserIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminV1OrbitsOrbitIdUsersUserIdRequestWithBody(c.Server, orbitId, userId, params, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAdminV1OrbitsOrbitIdUsersUserId(ctx context.Context, orbitId OrbitId, userId int64, params *PutAdminV1OrbitsOrbitIdUsersUserIdParams, body PutAdminV1OrbitsOrbitIdUsersUserIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminV1OrbitsOrbitIdUsersUserIdRequest(c.Server, orbitId, userId, params, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAdminV1OrbitsOrbitIdUsersUserIdPermissions(ctx context.Context, orbitId OrbitId, userId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminV1OrbitsOrbitIdUsersUserIdPermissionsRequest(c.Server, orbitId, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAdminV1OrbitsOrbitIdUsersUserIdRoles(ctx context.Context, orbitId OrbitId, userId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAdminV1OrbitsOrbitIdUsersUserIdRolesRequest(c.Server, orbitId, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteAdminV1OrbitsOrbitIdUsersUserIdRolesRoleId(ctx context.Context, orbitId OrbitId, userId int64, roleId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteAdminV1OrbitsOrbitIdUsersUserIdRolesRoleIdRequest(c.Server, orbitId, userId, roleId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutAdminV1OrbitsOrbitIdUsersUserIdRolesRoleId(ctx context.Context, orbitId OrbitId, userId int64, roleId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutAdminV1OrbitsOrbitIdUsersUserIdRolesRoleIdRequest(c.Server, orbitId, userId, roleId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAdminV1OrbitsOrbitIdUsersUserIdUnlock(ctx context.Context, orbitId OrbitId, userId int64, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAdminV1OrbitsOrbitIdUsersUserIdUnlockRequest(c.Server, orbitId, userId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetAuthorize(ctx context.Context, params *GetAuthorizeParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetAuthorizeRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAuthzEvaluateWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAuthzEvaluateRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostAuthzEvaluate(ctx context.Context, body PostAuthzEvaluateJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostAuthzEvaluateRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetConsent(ctx context.Context, params *GetConsentParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetConsentRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostConsentWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostConsentRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostConsentWithFormdataBody(ctx context.Context, body PostConsentFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostConsentRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetEmailVerify(ctx context.Context, params *GetEmailVerifyParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetEmailVerifyRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostIntrospectWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostIntrospectRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostIntrospectWithFormdataBody(ctx context.Context, body PostIntrospectFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostIntrospectRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetLogin(ctx context.Context, params *GetLoginParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLoginRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostLoginWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostLoginRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostLoginWithFormdataBody(ctx context.Context, body PostLoginFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostLoginRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetLoginMfa(ctx context.Context, params *GetLoginMfaParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetLoginMfaRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostLoginMfaWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostLoginMfaRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostLoginMfaWithFormdataBody(ctx context.Context, body PostLoginMfaFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostLoginMfaRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostLoginPasskeyWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostLoginPasskeyRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostLoginPasskeyWithFormdataBody(ctx context.Context, body PostLoginPasskeyFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostLoginPasskeyRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostLoginPasskeyOptionsWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostLoginPasskeyOptionsRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostLoginPasskeyOptions(ctx context.Context, body PostLoginPasskeyOptionsJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostLoginPasskeyOptionsRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostLogoutWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostLogoutRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostLogout(ctx context.Context, body PostLogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostLogoutRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPasswordForgot(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPasswordForgotRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPasswordForgotWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPasswordForgotRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPasswordForgotWithFormdataBody(ctx context.Context, body PostPasswordForgotFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPasswordForgotRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetPasswordReset(ctx context.Context, params *GetPasswordResetParams, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetPasswordResetRequest(c.Server, params)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPasswordResetWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPasswordResetRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostPasswordResetWithFormdataBody(ctx context.Context, body PostPasswordResetFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostPasswordResetRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRegisterWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRegisterRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRegister(ctx context.Context, body PostRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRegisterRequest(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) DeleteRegisterClientId(ctx context.Context, clientId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewDeleteRegisterClientIdRequest(c.Server, clientId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetRegisterClientId(ctx context.Context, clientId string, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetRegisterClientIdRequest(c.Server, clientId)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutRegisterClientIdWithBody(ctx context.Context, clientId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutRegisterClientIdRequestWithBody(c.Server, clientId, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PutRegisterClientId(ctx context.Context, clientId string, body PutRegisterClientIdJSONRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPutRegisterClientIdRequest(c.Server, clientId, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRevokeWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRevokeRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostRevokeWithFormdataBody(ctx context.Context, body PostRevokeFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostRevokeRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostTokenWithBody(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTokenRequestWithBody(c.Server, contentType, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) PostTokenWithFormdataBody(ctx context.Context, body PostTokenFormdataRequestBody, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewPostTokenRequestWithFormdataBody(c.Server, body)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+func (c *Client) GetUserinfo(ctx context.Context, reqEditors ...RequestEditorFn) (*http.Response, error) {
+	req, err := NewGetUserinfoRequest(c.Server)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	if err := c.applyEditors(ctx, req, reqEditors); err != nil {
+		return nil, err
+	}
+	return c.Client.Do(req)
+}
+
+// NewGetWellKnownJwksJsonRequest generates requests for GetWellKnownJwksJson
+func NewGetWellKnownJwksJsonRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/.well-known/jwks.json")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetWellKnownOpenidConfigurationRequest generates requests for GetWellKnownOpenidConfiguration
+func NewGetWellKnownOpenidConfigurationRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/.well-known/openid-configuration")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAccountConsentsRequest generates requests for GetAccountConsents
+func NewGetAccountConsentsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/account/consents")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteAccountConsentsConsentIdRequest generates requests for DeleteAccountConsentsConsentId
+func NewDeleteAccountConsentsConsentIdRequest(server string, consentId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "consent_id", runtime.ParamLocationPath, consentId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/account/consents/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAccountEmailVerificationRequest generates requests for PostAccountEmailVerification
+func NewPostAccountEmailVerificationRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/account/email/verification")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAccountPasskeysRequest generates requests for GetAccountPasskeys
+func NewGetAccountPasskeysRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/account/passkeys")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAccountPasskeysRegistrationsRequest calls the generic PostAccountPasskeysRegistrations builder with application/json body
+func NewPostAccountPasskeysRegistrationsRequest(server string, body PostAccountPasskeysRegistrationsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAccountPasskeysRegistrationsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAccountPasskeysRegistrationsRequestWithBody generates requests for PostAccountPasskeysRegistrations with any type of body
+func NewPostAccountPasskeysRegistrationsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/account/passkeys/registrations")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostAccountPasskeysRegistrationsCeremonyIdRequest calls the generic PostAccountPasskeysRegistrationsCeremonyId builder with application/json body
+func NewPostAccountPasskeysRegistrationsCeremonyIdRequest(server string, ceremonyId string, body PostAccountPasskeysRegistrationsCeremonyIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAccountPasskeysRegistrationsCeremonyIdRequestWithBody(server, ceremonyId, "application/json", bodyReader)
+}
+
+// NewPostAccountPasskeysRegistrationsCeremonyIdRequestWithBody generates requests for PostAccountPasskeysRegistrationsCeremonyId with any type of body
+func NewPostAccountPasskeysRegistrationsCeremonyIdRequestWithBody(server string, ceremonyId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "ceremony_id", runtime.ParamLocationPath, ceremonyId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/account/passkeys/registrations/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteAccountPasskeysPasskeyIdRequest generates requests for DeleteAccountPasskeysPasskeyId
+func NewDeleteAccountPasskeysPasskeyIdRequest(server string, passkeyId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "passkey_id", runtime.ParamLocationPath, passkeyId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/account/passkeys/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAccountRecoveryCodesRequest generates requests for GetAccountRecoveryCodes
+func NewGetAccountRecoveryCodesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/account/recovery-codes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAccountRecoveryCodesRequest generates requests for PostAccountRecoveryCodes
+func NewPostAccountRecoveryCodesRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/account/recovery-codes")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteAccountSessionsRequest generates requests for DeleteAccountSessions
+func NewDeleteAccountSessionsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/account/sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAccountSessionsRequest generates requests for GetAccountSessions
+func NewGetAccountSessionsRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/account/sessions")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteAccountSessionsSessionIdRequest generates requests for DeleteAccountSessionsSessionId
+func NewDeleteAccountSessionsSessionIdRequest(server string, sessionId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "session_id", runtime.ParamLocationPath, sessionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/account/sessions/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAccountTotpRequest generates requests for GetAccountTotp
+func NewGetAccountTotpRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/account/totp")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAccountTotpRequest calls the generic PostAccountTotp builder with application/json body
+func NewPostAccountTotpRequest(server string, body PostAccountTotpJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAccountTotpRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAccountTotpRequestWithBody generates requests for PostAccountTotp with any type of body
+func NewPostAccountTotpRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/account/totp")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteAccountTotpTotpIdRequest generates requests for DeleteAccountTotpTotpId
+func NewDeleteAccountTotpTotpIdRequest(server string, totpId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "totp_id", runtime.ParamLocationPath, totpId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/account/totp/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAccountTotpTotpIdConfirmRequest calls the generic PostAccountTotpTotpIdConfirm builder with application/json body
+func NewPostAccountTotpTotpIdConfirmRequest(server string, totpId int64, body PostAccountTotpTotpIdConfirmJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAccountTotpTotpIdConfirmRequestWithBody(server, totpId, "application/json", bodyReader)
+}
+
+// NewPostAccountTotpTotpIdConfirmRequestWithBody generates requests for PostAccountTotpTotpIdConfirm with any type of body
+func NewPostAccountTotpTotpIdConfirmRequestWithBody(server string, totpId int64, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "totp_id", runtime.ParamLocationPath, totpId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/account/totp/%s/confirm", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetAdminV1OrbitsRequest generates requests for GetAdminV1Orbits
+func NewGetAdminV1OrbitsRequest(server string, params *GetAdminV1OrbitsParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAdminV1OrbitsRequest calls the generic PostAdminV1Orbits builder with application/json body
+func NewPostAdminV1OrbitsRequest(server string, body PostAdminV1OrbitsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAdminV1OrbitsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAdminV1OrbitsRequestWithBody generates requests for PostAdminV1Orbits with any type of body
+func NewPostAdminV1OrbitsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteAdminV1OrbitsOrbitIdRequest generates requests for DeleteAdminV1OrbitsOrbitId
+func NewDeleteAdminV1OrbitsOrbitIdRequest(server string, orbitId OrbitId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAdminV1OrbitsOrbitIdRequest generates requests for GetAdminV1OrbitsOrbitId
+func NewGetAdminV1OrbitsOrbitIdRequest(server string, orbitId OrbitId) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutAdminV1OrbitsOrbitIdRequest calls the generic PutAdminV1OrbitsOrbitId builder with application/json body
+func NewPutAdminV1OrbitsOrbitIdRequest(server string, orbitId OrbitId, params *PutAdminV1OrbitsOrbitIdParams, body PutAdminV1OrbitsOrbitIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutAdminV1OrbitsOrbitIdRequestWithBody(server, orbitId, params, "application/json", bodyReader)
+}
+
+// NewPutAdminV1OrbitsOrbitIdRequestWithBody generates requests for PutAdminV1OrbitsOrbitId with any type of body
+func NewPutAdminV1OrbitsOrbitIdRequestWithBody(server string, orbitId OrbitId, params *PutAdminV1OrbitsOrbitIdParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetAdminV1OrbitsOrbitIdAuditLogsRequest generates requests for GetAdminV1OrbitsOrbitIdAuditLogs
+func NewGetAdminV1OrbitsOrbitIdAuditLogsRequest(server string, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdAuditLogsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/audit-logs", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Action != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "action", runtime.ParamLocationQuery, *params.Action); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.ActorId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "actor_id", runtime.ParamLocationQuery, *params.ActorId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Since != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Until != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "until", runtime.ParamLocationQuery, *params.Until); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAdminV1OrbitsOrbitIdClientsRequest generates requests for GetAdminV1OrbitsOrbitIdClients
+func NewGetAdminV1OrbitsOrbitIdClientsRequest(server string, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdClientsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/clients", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.ApplicationType != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "application_type", runtime.ParamLocationQuery, *params.ApplicationType); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IsActive != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "is_active", runtime.ParamLocationQuery, *params.IsActive); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAdminV1OrbitsOrbitIdClientsRequest calls the generic PostAdminV1OrbitsOrbitIdClients builder with application/json body
+func NewPostAdminV1OrbitsOrbitIdClientsRequest(server string, orbitId OrbitId, body PostAdminV1OrbitsOrbitIdClientsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAdminV1OrbitsOrbitIdClientsRequestWithBody(server, orbitId, "application/json", bodyReader)
+}
+
+// NewPostAdminV1OrbitsOrbitIdClientsRequestWithBody generates requests for PostAdminV1OrbitsOrbitIdClients with any type of body
+func NewPostAdminV1OrbitsOrbitIdClientsRequestWithBody(server string, orbitId OrbitId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/clients", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteAdminV1OrbitsOrbitIdClientsClientIdRequest generates requests for DeleteAdminV1OrbitsOrbitIdClientsClientId
+func NewDeleteAdminV1OrbitsOrbitIdClientsClientIdRequest(server string, orbitId OrbitId, clientId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "client_id", runtime.ParamLocationPath, clientId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/clients/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAdminV1OrbitsOrbitIdClientsClientIdRequest generates requests for GetAdminV1OrbitsOrbitIdClientsClientId
+func NewGetAdminV1OrbitsOrbitIdClientsClientIdRequest(server string, orbitId OrbitId, clientId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "client_id", runtime.ParamLocationPath, clientId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/clients/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutAdminV1OrbitsOrbitIdClientsClientIdRequest calls the generic PutAdminV1OrbitsOrbitIdClientsClientId builder with application/json body
+func NewPutAdminV1OrbitsOrbitIdClientsClientIdRequest(server string, orbitId OrbitId, clientId string, params *PutAdminV1OrbitsOrbitIdClientsClientIdParams, body PutAdminV1OrbitsOrbitIdClientsClientIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutAdminV1OrbitsOrbitIdClientsClientIdRequestWithBody(server, orbitId, clientId, params, "application/json", bodyReader)
+}
+
+// NewPutAdminV1OrbitsOrbitIdClientsClientIdRequestWithBody generates requests for PutAdminV1OrbitsOrbitIdClientsClientId with any type of body
+func NewPutAdminV1OrbitsOrbitIdClientsClientIdRequestWithBody(server string, orbitId OrbitId, clientId string, params *PutAdminV1OrbitsOrbitIdClientsClientIdParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "client_id", runtime.ParamLocationPath, clientId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/clients/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewPostAdminV1OrbitsOrbitIdClientsClientIdSecretRequest generates requests for PostAdminV1OrbitsOrbitIdClientsClientIdSecret
+func NewPostAdminV1OrbitsOrbitIdClientsClientIdSecretRequest(server string, orbitId OrbitId, clientId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "client_id", runtime.ParamLocationPath, clientId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/clients/%s/secret", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAdminV1OrbitsOrbitIdGroupsRequest generates requests for GetAdminV1OrbitsOrbitIdGroups
+func NewGetAdminV1OrbitsOrbitIdGroupsRequest(server string, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdGroupsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/groups", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAdminV1OrbitsOrbitIdGroupsRequest calls the generic PostAdminV1OrbitsOrbitIdGroups builder with application/json body
+func NewPostAdminV1OrbitsOrbitIdGroupsRequest(server string, orbitId OrbitId, body PostAdminV1OrbitsOrbitIdGroupsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAdminV1OrbitsOrbitIdGroupsRequestWithBody(server, orbitId, "application/json", bodyReader)
+}
+
+// NewPostAdminV1OrbitsOrbitIdGroupsRequestWithBody generates requests for PostAdminV1OrbitsOrbitIdGroups with any type of body
+func NewPostAdminV1OrbitsOrbitIdGroupsRequestWithBody(server string, orbitId OrbitId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/groups", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteAdminV1OrbitsOrbitIdGroupsGroupIdRequest generates requests for DeleteAdminV1OrbitsOrbitIdGroupsGroupId
+func NewDeleteAdminV1OrbitsOrbitIdGroupsGroupIdRequest(server string, orbitId OrbitId, groupId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "group_id", runtime.ParamLocationPath, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/groups/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAdminV1OrbitsOrbitIdGroupsGroupIdRequest generates requests for GetAdminV1OrbitsOrbitIdGroupsGroupId
+func NewGetAdminV1OrbitsOrbitIdGroupsGroupIdRequest(server string, orbitId OrbitId, groupId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "group_id", runtime.ParamLocationPath, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/groups/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAdminV1OrbitsOrbitIdGroupsGroupIdMembersRequest generates requests for GetAdminV1OrbitsOrbitIdGroupsGroupIdMembers
+func NewGetAdminV1OrbitsOrbitIdGroupsGroupIdMembersRequest(server string, orbitId OrbitId, groupId int64, params *GetAdminV1OrbitsOrbitIdGroupsGroupIdMembersParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "group_id", runtime.ParamLocationPath, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/groups/%s/members", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserIdRequest generates requests for DeleteAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserId
+func NewDeleteAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserIdRequest(server string, orbitId OrbitId, groupId int64, userId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "group_id", runtime.ParamLocationPath, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/groups/%s/members/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserIdRequest generates requests for PutAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserId
+func NewPutAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserIdRequest(server string, orbitId OrbitId, groupId int64, userId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "group_id", runtime.ParamLocationPath, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/groups/%s/members/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAdminV1OrbitsOrbitIdGroupsGroupIdRolesRequest generates requests for GetAdminV1OrbitsOrbitIdGroupsGroupIdRoles
+func NewGetAdminV1OrbitsOrbitIdGroupsGroupIdRolesRequest(server string, orbitId OrbitId, groupId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "group_id", runtime.ParamLocationPath, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/groups/%s/roles", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleIdRequest generates requests for DeleteAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleId
+func NewDeleteAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleIdRequest(server string, orbitId OrbitId, groupId int64, roleId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "group_id", runtime.ParamLocationPath, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "role_id", runtime.ParamLocationPath, roleId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/groups/%s/roles/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleIdRequest generates requests for PutAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleId
+func NewPutAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleIdRequest(server string, orbitId OrbitId, groupId int64, roleId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "group_id", runtime.ParamLocationPath, groupId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "role_id", runtime.ParamLocationPath, roleId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/groups/%s/roles/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAdminV1OrbitsOrbitIdKeysRequest generates requests for GetAdminV1OrbitsOrbitIdKeys
+func NewGetAdminV1OrbitsOrbitIdKeysRequest(server string, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdKeysParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/keys", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAdminV1OrbitsOrbitIdKeysRequest calls the generic PostAdminV1OrbitsOrbitIdKeys builder with application/json body
+func NewPostAdminV1OrbitsOrbitIdKeysRequest(server string, orbitId OrbitId, body PostAdminV1OrbitsOrbitIdKeysJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAdminV1OrbitsOrbitIdKeysRequestWithBody(server, orbitId, "application/json", bodyReader)
+}
+
+// NewPostAdminV1OrbitsOrbitIdKeysRequestWithBody generates requests for PostAdminV1OrbitsOrbitIdKeys with any type of body
+func NewPostAdminV1OrbitsOrbitIdKeysRequestWithBody(server string, orbitId OrbitId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/keys", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteAdminV1OrbitsOrbitIdKeysKeyIdRequest generates requests for DeleteAdminV1OrbitsOrbitIdKeysKeyId
+func NewDeleteAdminV1OrbitsOrbitIdKeysKeyIdRequest(server string, orbitId OrbitId, keyId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "key_id", runtime.ParamLocationPath, keyId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/keys/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAdminV1OrbitsOrbitIdKeysKeyIdRequest generates requests for GetAdminV1OrbitsOrbitIdKeysKeyId
+func NewGetAdminV1OrbitsOrbitIdKeysKeyIdRequest(server string, orbitId OrbitId, keyId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "key_id", runtime.ParamLocationPath, keyId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/keys/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutAdminV1OrbitsOrbitIdKeysKeyIdRequest calls the generic PutAdminV1OrbitsOrbitIdKeysKeyId builder with application/json body
+func NewPutAdminV1OrbitsOrbitIdKeysKeyIdRequest(server string, orbitId OrbitId, keyId int64, params *PutAdminV1OrbitsOrbitIdKeysKeyIdParams, body PutAdminV1OrbitsOrbitIdKeysKeyIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutAdminV1OrbitsOrbitIdKeysKeyIdRequestWithBody(server, orbitId, keyId, params, "application/json", bodyReader)
+}
+
+// NewPutAdminV1OrbitsOrbitIdKeysKeyIdRequestWithBody generates requests for PutAdminV1OrbitsOrbitIdKeysKeyId with any type of body
+func NewPutAdminV1OrbitsOrbitIdKeysKeyIdRequestWithBody(server string, orbitId OrbitId, keyId int64, params *PutAdminV1OrbitsOrbitIdKeysKeyIdParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "key_id", runtime.ParamLocationPath, keyId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/keys/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetAdminV1OrbitsOrbitIdPermissionsRequest generates requests for GetAdminV1OrbitsOrbitIdPermissions
+func NewGetAdminV1OrbitsOrbitIdPermissionsRequest(server string, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdPermissionsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/permissions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAdminV1OrbitsOrbitIdPermissionsRequest calls the generic PostAdminV1OrbitsOrbitIdPermissions builder with application/json body
+func NewPostAdminV1OrbitsOrbitIdPermissionsRequest(server string, orbitId OrbitId, body PostAdminV1OrbitsOrbitIdPermissionsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAdminV1OrbitsOrbitIdPermissionsRequestWithBody(server, orbitId, "application/json", bodyReader)
+}
+
+// NewPostAdminV1OrbitsOrbitIdPermissionsRequestWithBody generates requests for PostAdminV1OrbitsOrbitIdPermissions with any type of body
+func NewPostAdminV1OrbitsOrbitIdPermissionsRequestWithBody(server string, orbitId OrbitId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/permissions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteAdminV1OrbitsOrbitIdPermissionsPermissionIdRequest generates requests for DeleteAdminV1OrbitsOrbitIdPermissionsPermissionId
+func NewDeleteAdminV1OrbitsOrbitIdPermissionsPermissionIdRequest(server string, orbitId OrbitId, permissionId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "permission_id", runtime.ParamLocationPath, permissionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/permissions/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAdminV1OrbitsOrbitIdPermissionsPermissionIdRequest generates requests for GetAdminV1OrbitsOrbitIdPermissionsPermissionId
+func NewGetAdminV1OrbitsOrbitIdPermissionsPermissionIdRequest(server string, orbitId OrbitId, permissionId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "permission_id", runtime.ParamLocationPath, permissionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/permissions/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAdminV1OrbitsOrbitIdPoliciesRequest generates requests for GetAdminV1OrbitsOrbitIdPolicies
+func NewGetAdminV1OrbitsOrbitIdPoliciesRequest(server string, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdPoliciesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/policies", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAdminV1OrbitsOrbitIdPoliciesRequest calls the generic PostAdminV1OrbitsOrbitIdPolicies builder with application/json body
+func NewPostAdminV1OrbitsOrbitIdPoliciesRequest(server string, orbitId OrbitId, body PostAdminV1OrbitsOrbitIdPoliciesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAdminV1OrbitsOrbitIdPoliciesRequestWithBody(server, orbitId, "application/json", bodyReader)
+}
+
+// NewPostAdminV1OrbitsOrbitIdPoliciesRequestWithBody generates requests for PostAdminV1OrbitsOrbitIdPolicies with any type of body
+func NewPostAdminV1OrbitsOrbitIdPoliciesRequestWithBody(server string, orbitId OrbitId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/policies", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteAdminV1OrbitsOrbitIdPoliciesPolicyIdRequest generates requests for DeleteAdminV1OrbitsOrbitIdPoliciesPolicyId
+func NewDeleteAdminV1OrbitsOrbitIdPoliciesPolicyIdRequest(server string, orbitId OrbitId, policyId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "policy_id", runtime.ParamLocationPath, policyId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/policies/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAdminV1OrbitsOrbitIdPoliciesPolicyIdRequest generates requests for GetAdminV1OrbitsOrbitIdPoliciesPolicyId
+func NewGetAdminV1OrbitsOrbitIdPoliciesPolicyIdRequest(server string, orbitId OrbitId, policyId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "policy_id", runtime.ParamLocationPath, policyId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/policies/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutAdminV1OrbitsOrbitIdPoliciesPolicyIdRequest calls the generic PutAdminV1OrbitsOrbitIdPoliciesPolicyId builder with application/json body
+func NewPutAdminV1OrbitsOrbitIdPoliciesPolicyIdRequest(server string, orbitId OrbitId, policyId int64, params *PutAdminV1OrbitsOrbitIdPoliciesPolicyIdParams, body PutAdminV1OrbitsOrbitIdPoliciesPolicyIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutAdminV1OrbitsOrbitIdPoliciesPolicyIdRequestWithBody(server, orbitId, policyId, params, "application/json", bodyReader)
+}
+
+// NewPutAdminV1OrbitsOrbitIdPoliciesPolicyIdRequestWithBody generates requests for PutAdminV1OrbitsOrbitIdPoliciesPolicyId with any type of body
+func NewPutAdminV1OrbitsOrbitIdPoliciesPolicyIdRequestWithBody(server string, orbitId OrbitId, policyId int64, params *PutAdminV1OrbitsOrbitIdPoliciesPolicyIdParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "policy_id", runtime.ParamLocationPath, policyId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/policies/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetAdminV1OrbitsOrbitIdPolicyDecisionsRequest generates requests for GetAdminV1OrbitsOrbitIdPolicyDecisions
+func NewGetAdminV1OrbitsOrbitIdPolicyDecisionsRequest(server string, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdPolicyDecisionsParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/policy-decisions", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Action != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "action", runtime.ParamLocationQuery, *params.Action); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Decision != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "decision", runtime.ParamLocationQuery, *params.Decision); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.UserId != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "user_id", runtime.ParamLocationQuery, *params.UserId); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Since != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "since", runtime.ParamLocationQuery, *params.Since); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Until != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "until", runtime.ParamLocationQuery, *params.Until); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAdminV1OrbitsOrbitIdRolesRequest generates requests for GetAdminV1OrbitsOrbitIdRoles
+func NewGetAdminV1OrbitsOrbitIdRolesRequest(server string, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdRolesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/roles", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAdminV1OrbitsOrbitIdRolesRequest calls the generic PostAdminV1OrbitsOrbitIdRoles builder with application/json body
+func NewPostAdminV1OrbitsOrbitIdRolesRequest(server string, orbitId OrbitId, body PostAdminV1OrbitsOrbitIdRolesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAdminV1OrbitsOrbitIdRolesRequestWithBody(server, orbitId, "application/json", bodyReader)
+}
+
+// NewPostAdminV1OrbitsOrbitIdRolesRequestWithBody generates requests for PostAdminV1OrbitsOrbitIdRoles with any type of body
+func NewPostAdminV1OrbitsOrbitIdRolesRequestWithBody(server string, orbitId OrbitId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/roles", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteAdminV1OrbitsOrbitIdRolesRoleIdRequest generates requests for DeleteAdminV1OrbitsOrbitIdRolesRoleId
+func NewDeleteAdminV1OrbitsOrbitIdRolesRoleIdRequest(server string, orbitId OrbitId, roleId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "role_id", runtime.ParamLocationPath, roleId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/roles/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAdminV1OrbitsOrbitIdRolesRoleIdRequest generates requests for GetAdminV1OrbitsOrbitIdRolesRoleId
+func NewGetAdminV1OrbitsOrbitIdRolesRoleIdRequest(server string, orbitId OrbitId, roleId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "role_id", runtime.ParamLocationPath, roleId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/roles/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAdminV1OrbitsOrbitIdRolesRoleIdIncludesRequest generates requests for GetAdminV1OrbitsOrbitIdRolesRoleIdIncludes
+func NewGetAdminV1OrbitsOrbitIdRolesRoleIdIncludesRequest(server string, orbitId OrbitId, roleId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "role_id", runtime.ParamLocationPath, roleId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/roles/%s/includes", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleIdRequest generates requests for DeleteAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleId
+func NewDeleteAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleIdRequest(server string, orbitId OrbitId, roleId int64, includedRoleId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "role_id", runtime.ParamLocationPath, roleId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "included_role_id", runtime.ParamLocationPath, includedRoleId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/roles/%s/includes/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleIdRequest generates requests for PutAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleId
+func NewPutAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleIdRequest(server string, orbitId OrbitId, roleId int64, includedRoleId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "role_id", runtime.ParamLocationPath, roleId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "included_role_id", runtime.ParamLocationPath, includedRoleId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/roles/%s/includes/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAdminV1OrbitsOrbitIdRolesRoleIdPermissionsRequest generates requests for GetAdminV1OrbitsOrbitIdRolesRoleIdPermissions
+func NewGetAdminV1OrbitsOrbitIdRolesRoleIdPermissionsRequest(server string, orbitId OrbitId, roleId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "role_id", runtime.ParamLocationPath, roleId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/roles/%s/permissions", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionIdRequest generates requests for DeleteAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionId
+func NewDeleteAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionIdRequest(server string, orbitId OrbitId, roleId int64, permissionId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "role_id", runtime.ParamLocationPath, roleId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "permission_id", runtime.ParamLocationPath, permissionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/roles/%s/permissions/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionIdRequest generates requests for PutAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionId
+func NewPutAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionIdRequest(server string, orbitId OrbitId, roleId int64, permissionId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "role_id", runtime.ParamLocationPath, roleId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "permission_id", runtime.ParamLocationPath, permissionId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/roles/%s/permissions/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAdminV1OrbitsOrbitIdScopesRequest generates requests for GetAdminV1OrbitsOrbitIdScopes
+func NewGetAdminV1OrbitsOrbitIdScopesRequest(server string, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdScopesParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/scopes", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAdminV1OrbitsOrbitIdScopesRequest calls the generic PostAdminV1OrbitsOrbitIdScopes builder with application/json body
+func NewPostAdminV1OrbitsOrbitIdScopesRequest(server string, orbitId OrbitId, body PostAdminV1OrbitsOrbitIdScopesJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAdminV1OrbitsOrbitIdScopesRequestWithBody(server, orbitId, "application/json", bodyReader)
+}
+
+// NewPostAdminV1OrbitsOrbitIdScopesRequestWithBody generates requests for PostAdminV1OrbitsOrbitIdScopes with any type of body
+func NewPostAdminV1OrbitsOrbitIdScopesRequestWithBody(server string, orbitId OrbitId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/scopes", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteAdminV1OrbitsOrbitIdScopesScopeIdRequest generates requests for DeleteAdminV1OrbitsOrbitIdScopesScopeId
+func NewDeleteAdminV1OrbitsOrbitIdScopesScopeIdRequest(server string, orbitId OrbitId, scopeId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "scope_id", runtime.ParamLocationPath, scopeId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/scopes/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAdminV1OrbitsOrbitIdScopesScopeIdRequest generates requests for GetAdminV1OrbitsOrbitIdScopesScopeId
+func NewGetAdminV1OrbitsOrbitIdScopesScopeIdRequest(server string, orbitId OrbitId, scopeId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "scope_id", runtime.ParamLocationPath, scopeId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/scopes/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutAdminV1OrbitsOrbitIdScopesScopeIdRequest calls the generic PutAdminV1OrbitsOrbitIdScopesScopeId builder with application/json body
+func NewPutAdminV1OrbitsOrbitIdScopesScopeIdRequest(server string, orbitId OrbitId, scopeId int64, params *PutAdminV1OrbitsOrbitIdScopesScopeIdParams, body PutAdminV1OrbitsOrbitIdScopesScopeIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutAdminV1OrbitsOrbitIdScopesScopeIdRequestWithBody(server, orbitId, scopeId, params, "application/json", bodyReader)
+}
+
+// NewPutAdminV1OrbitsOrbitIdScopesScopeIdRequestWithBody generates requests for PutAdminV1OrbitsOrbitIdScopesScopeId with any type of body
+func NewPutAdminV1OrbitsOrbitIdScopesScopeIdRequestWithBody(server string, orbitId OrbitId, scopeId int64, params *PutAdminV1OrbitsOrbitIdScopesScopeIdParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "scope_id", runtime.ParamLocationPath, scopeId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/scopes/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetAdminV1OrbitsOrbitIdUsersRequest generates requests for GetAdminV1OrbitsOrbitIdUsers
+func NewGetAdminV1OrbitsOrbitIdUsersRequest(server string, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdUsersParams) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/users", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if params.EmailPrefix != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "email_prefix", runtime.ParamLocationQuery, *params.EmailPrefix); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.IsActive != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "is_active", runtime.ParamLocationQuery, *params.IsActive); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreatedAfter != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "created_after", runtime.ParamLocationQuery, *params.CreatedAfter); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CreatedBefore != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "created_before", runtime.ParamLocationQuery, *params.CreatedBefore); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Limit != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "limit", runtime.ParamLocationQuery, *params.Limit); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Cursor != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "cursor", runtime.ParamLocationQuery, *params.Cursor); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAdminV1OrbitsOrbitIdUsersRequest calls the generic PostAdminV1OrbitsOrbitIdUsers builder with application/json body
+func NewPostAdminV1OrbitsOrbitIdUsersRequest(server string, orbitId OrbitId, body PostAdminV1OrbitsOrbitIdUsersJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAdminV1OrbitsOrbitIdUsersRequestWithBody(server, orbitId, "application/json", bodyReader)
+}
+
+// NewPostAdminV1OrbitsOrbitIdUsersRequestWithBody generates requests for PostAdminV1OrbitsOrbitIdUsers with any type of body
+func NewPostAdminV1OrbitsOrbitIdUsersRequestWithBody(server string, orbitId OrbitId, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/users", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteAdminV1OrbitsOrbitIdUsersUserIdRequest generates requests for DeleteAdminV1OrbitsOrbitIdUsersUserId
+func NewDeleteAdminV1OrbitsOrbitIdUsersUserIdRequest(server string, orbitId OrbitId, userId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/users/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAdminV1OrbitsOrbitIdUsersUserIdRequest generates requests for GetAdminV1OrbitsOrbitIdUsersUserId
+func NewGetAdminV1OrbitsOrbitIdUsersUserIdRequest(server string, orbitId OrbitId, userId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/users/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutAdminV1OrbitsOrbitIdUsersUserIdRequest calls the generic PutAdminV1OrbitsOrbitIdUsersUserId builder with application/json body
+func NewPutAdminV1OrbitsOrbitIdUsersUserIdRequest(server string, orbitId OrbitId, userId int64, params *PutAdminV1OrbitsOrbitIdUsersUserIdParams, body PutAdminV1OrbitsOrbitIdUsersUserIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutAdminV1OrbitsOrbitIdUsersUserIdRequestWithBody(server, orbitId, userId, params, "application/json", bodyReader)
+}
+
+// NewPutAdminV1OrbitsOrbitIdUsersUserIdRequestWithBody generates requests for PutAdminV1OrbitsOrbitIdUsersUserId with any type of body
+func NewPutAdminV1OrbitsOrbitIdUsersUserIdRequestWithBody(server string, orbitId OrbitId, userId int64, params *PutAdminV1OrbitsOrbitIdUsersUserIdParams, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/users/%s", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	if params != nil {
+
+		if params.IfMatch != nil {
+			var headerParam0 string
+
+			headerParam0, err = runtime.StyleParamWithLocation("simple", false, "If-Match", runtime.ParamLocationHeader, *params.IfMatch)
+			if err != nil {
+				return nil, err
+			}
+
+			req.Header.Set("If-Match", headerParam0)
+		}
+
+	}
+
+	return req, nil
+}
+
+// NewGetAdminV1OrbitsOrbitIdUsersUserIdPermissionsRequest generates requests for GetAdminV1OrbitsOrbitIdUsersUserIdPermissions
+func NewGetAdminV1OrbitsOrbitIdUsersUserIdPermissionsRequest(server string, orbitId OrbitId, userId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/users/%s/permissions", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAdminV1OrbitsOrbitIdUsersUserIdRolesRequest generates requests for GetAdminV1OrbitsOrbitIdUsersUserIdRoles
+func NewGetAdminV1OrbitsOrbitIdUsersUserIdRolesRequest(server string, orbitId OrbitId, userId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/users/%s/roles", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewDeleteAdminV1OrbitsOrbitIdUsersUserIdRolesRoleIdRequest generates requests for DeleteAdminV1OrbitsOrbitIdUsersUserIdRolesRoleId
+func NewDeleteAdminV1OrbitsOrbitIdUsersUserIdRolesRoleIdRequest(server string, orbitId OrbitId, userId int64, roleId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "role_id", runtime.ParamLocationPath, roleId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/users/%s/roles/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutAdminV1OrbitsOrbitIdUsersUserIdRolesRoleIdRequest generates requests for PutAdminV1OrbitsOrbitIdUsersUserIdRolesRoleId
+func NewPutAdminV1OrbitsOrbitIdUsersUserIdRolesRoleIdRequest(server string, orbitId OrbitId, userId int64, roleId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam2 string
+
+	pathParam2, err = runtime.StyleParamWithLocation("simple", false, "role_id", runtime.ParamLocationPath, roleId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/users/%s/roles/%s", pathParam0, pathParam1, pathParam2)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAdminV1OrbitsOrbitIdUsersUserIdUnlockRequest generates requests for PostAdminV1OrbitsOrbitIdUsersUserIdUnlock
+func NewPostAdminV1OrbitsOrbitIdUsersUserIdUnlockRequest(server string, orbitId OrbitId, userId int64) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "orbit_id", runtime.ParamLocationPath, orbitId)
+	if err != nil {
+		return nil, err
+	}
+
+	var pathParam1 string
+
+	pathParam1, err = runtime.StyleParamWithLocation("simple", false, "user_id", runtime.ParamLocationPath, userId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/admin/v1/orbits/%s/users/%s/unlock", pathParam0, pathParam1)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetAuthorizeRequest generates requests for GetAuthorize
+func NewGetAuthorizeRequest(server string, params *GetAuthorizeParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/authorize")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "response_type", runtime.ParamLocationQuery, params.ResponseType); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "client_id", runtime.ParamLocationQuery, params.ClientId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "redirect_uri", runtime.ParamLocationQuery, params.RedirectUri); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		if params.Scope != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "scope", runtime.ParamLocationQuery, *params.Scope); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.State != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "state", runtime.ParamLocationQuery, *params.State); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Nonce != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "nonce", runtime.ParamLocationQuery, *params.Nonce); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CodeChallenge != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "code_challenge", runtime.ParamLocationQuery, *params.CodeChallenge); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.CodeChallengeMethod != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "code_challenge_method", runtime.ParamLocationQuery, *params.CodeChallengeMethod); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Resource != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "resource", runtime.ParamLocationQuery, *params.Resource); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.Prompt != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "prompt", runtime.ParamLocationQuery, *params.Prompt); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.MaxAge != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "max_age", runtime.ParamLocationQuery, *params.MaxAge); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.LoginHint != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "login_hint", runtime.ParamLocationQuery, *params.LoginHint); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		if params.AcrValues != nil {
+
+			if queryFrag, err := runtime.StyleParamWithLocation("form", true, "acr_values", runtime.ParamLocationQuery, *params.AcrValues); err != nil {
+				return nil, err
+			} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+				return nil, err
+			} else {
+				for k, v := range parsed {
+					for _, v2 := range v {
+						queryValues.Add(k, v2)
+					}
+				}
+			}
+
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostAuthzEvaluateRequest calls the generic PostAuthzEvaluate builder with application/json body
+func NewPostAuthzEvaluateRequest(server string, body PostAuthzEvaluateJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostAuthzEvaluateRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostAuthzEvaluateRequestWithBody generates requests for PostAuthzEvaluate with any type of body
+func NewPostAuthzEvaluateRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/authz/evaluate")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetConsentRequest generates requests for GetConsent
+func NewGetConsentRequest(server string, params *GetConsentParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/consent")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "request_id", runtime.ParamLocationQuery, params.RequestId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostConsentRequestWithFormdataBody calls the generic PostConsent builder with application/x-www-form-urlencoded body
+func NewPostConsentRequestWithFormdataBody(server string, body PostConsentFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewPostConsentRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewPostConsentRequestWithBody generates requests for PostConsent with any type of body
+func NewPostConsentRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/consent")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetEmailVerifyRequest generates requests for GetEmailVerify
+func NewGetEmailVerifyRequest(server string, params *GetEmailVerifyParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/email/verify")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "token", runtime.ParamLocationQuery, params.Token); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostIntrospectRequestWithFormdataBody calls the generic PostIntrospect builder with application/x-www-form-urlencoded body
+func NewPostIntrospectRequestWithFormdataBody(server string, body PostIntrospectFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewPostIntrospectRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewPostIntrospectRequestWithBody generates requests for PostIntrospect with any type of body
+func NewPostIntrospectRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/introspect")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetLoginRequest generates requests for GetLogin
+func NewGetLoginRequest(server string, params *GetLoginParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/login")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "request_id", runtime.ParamLocationQuery, params.RequestId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostLoginRequestWithFormdataBody calls the generic PostLogin builder with application/x-www-form-urlencoded body
+func NewPostLoginRequestWithFormdataBody(server string, body PostLoginFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewPostLoginRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewPostLoginRequestWithBody generates requests for PostLogin with any type of body
+func NewPostLoginRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/login")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetLoginMfaRequest generates requests for GetLoginMfa
+func NewGetLoginMfaRequest(server string, params *GetLoginMfaParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/login/mfa")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "request_id", runtime.ParamLocationQuery, params.RequestId); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostLoginMfaRequestWithFormdataBody calls the generic PostLoginMfa builder with application/x-www-form-urlencoded body
+func NewPostLoginMfaRequestWithFormdataBody(server string, body PostLoginMfaFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewPostLoginMfaRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewPostLoginMfaRequestWithBody generates requests for PostLoginMfa with any type of body
+func NewPostLoginMfaRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/login/mfa")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostLoginPasskeyRequestWithFormdataBody calls the generic PostLoginPasskey builder with application/x-www-form-urlencoded body
+func NewPostLoginPasskeyRequestWithFormdataBody(server string, body PostLoginPasskeyFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewPostLoginPasskeyRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewPostLoginPasskeyRequestWithBody generates requests for PostLoginPasskey with any type of body
+func NewPostLoginPasskeyRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/login/passkey")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostLoginPasskeyOptionsRequest calls the generic PostLoginPasskeyOptions builder with application/json body
+func NewPostLoginPasskeyOptionsRequest(server string, body PostLoginPasskeyOptionsJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostLoginPasskeyOptionsRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostLoginPasskeyOptionsRequestWithBody generates requests for PostLoginPasskeyOptions with any type of body
+func NewPostLoginPasskeyOptionsRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/login/passkey/options")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostLogoutRequest calls the generic PostLogout builder with application/json body
+func NewPostLogoutRequest(server string, body PostLogoutJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostLogoutRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostLogoutRequestWithBody generates requests for PostLogout with any type of body
+func NewPostLogoutRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/logout")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetPasswordForgotRequest generates requests for GetPasswordForgot
+func NewGetPasswordForgotRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/password/forgot")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostPasswordForgotRequestWithFormdataBody calls the generic PostPasswordForgot builder with application/x-www-form-urlencoded body
+func NewPostPasswordForgotRequestWithFormdataBody(server string, body PostPasswordForgotFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewPostPasswordForgotRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewPostPasswordForgotRequestWithBody generates requests for PostPasswordForgot with any type of body
+func NewPostPasswordForgotRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/password/forgot")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetPasswordResetRequest generates requests for GetPasswordReset
+func NewGetPasswordResetRequest(server string, params *GetPasswordResetParams) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/password/reset")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	if params != nil {
+		queryValues := queryURL.Query()
+
+		if queryFrag, err := runtime.StyleParamWithLocation("form", true, "token", runtime.ParamLocationQuery, params.Token); err != nil {
+			return nil, err
+		} else if parsed, err := url.ParseQuery(queryFrag); err != nil {
+			return nil, err
+		} else {
+			for k, v := range parsed {
+				for _, v2 := range v {
+					queryValues.Add(k, v2)
+				}
+			}
+		}
+
+		queryURL.RawQuery = queryValues.Encode()
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPostPasswordResetRequestWithFormdataBody calls the generic PostPasswordReset builder with application/x-www-form-urlencoded body
+func NewPostPasswordResetRequestWithFormdataBody(server string, body PostPasswordResetFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewPostPasswordResetRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewPostPasswordResetRequestWithBody generates requests for PostPasswordReset with any type of body
+func NewPostPasswordResetRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/password/reset")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostRegisterRequest calls the generic PostRegister builder with application/json body
+func NewPostRegisterRequest(server string, body PostRegisterJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPostRegisterRequestWithBody(server, "application/json", bodyReader)
+}
+
+// NewPostRegisterRequestWithBody generates requests for PostRegister with any type of body
+func NewPostRegisterRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/register")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewDeleteRegisterClientIdRequest generates requests for DeleteRegisterClientId
+func NewDeleteRegisterClientIdRequest(server string, clientId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "client_id", runtime.ParamLocationPath, clientId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/register/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("DELETE", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewGetRegisterClientIdRequest generates requests for GetRegisterClientId
+func NewGetRegisterClientIdRequest(server string, clientId string) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "client_id", runtime.ParamLocationPath, clientId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/register/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// NewPutRegisterClientIdRequest calls the generic PutRegisterClientId builder with application/json body
+func NewPutRegisterClientIdRequest(server string, clientId string, body PutRegisterClientIdJSONRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	buf, err := json.Marshal(body)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = bytes.NewReader(buf)
+	return NewPutRegisterClientIdRequestWithBody(server, clientId, "application/json", bodyReader)
+}
+
+// NewPutRegisterClientIdRequestWithBody generates requests for PutRegisterClientId with any type of body
+func NewPutRegisterClientIdRequestWithBody(server string, clientId string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	var pathParam0 string
+
+	pathParam0, err = runtime.StyleParamWithLocation("simple", false, "client_id", runtime.ParamLocationPath, clientId)
+	if err != nil {
+		return nil, err
+	}
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/register/%s", pathParam0)
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("PUT", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostRevokeRequestWithFormdataBody calls the generic PostRevoke builder with application/x-www-form-urlencoded body
+func NewPostRevokeRequestWithFormdataBody(server string, body PostRevokeFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewPostRevokeRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewPostRevokeRequestWithBody generates requests for PostRevoke with any type of body
+func NewPostRevokeRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/revoke")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewPostTokenRequestWithFormdataBody calls the generic PostToken builder with application/x-www-form-urlencoded body
+func NewPostTokenRequestWithFormdataBody(server string, body PostTokenFormdataRequestBody) (*http.Request, error) {
+	var bodyReader io.Reader
+	bodyStr, err := runtime.MarshalForm(body, nil)
+	if err != nil {
+		return nil, err
+	}
+	bodyReader = strings.NewReader(bodyStr.Encode())
+	return NewPostTokenRequestWithBody(server, "application/x-www-form-urlencoded", bodyReader)
+}
+
+// NewPostTokenRequestWithBody generates requests for PostToken with any type of body
+func NewPostTokenRequestWithBody(server string, contentType string, body io.Reader) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/token")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("POST", queryURL.String(), body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Add("Content-Type", contentType)
+
+	return req, nil
+}
+
+// NewGetUserinfoRequest generates requests for GetUserinfo
+func NewGetUserinfoRequest(server string) (*http.Request, error) {
+	var err error
+
+	serverURL, err := url.Parse(server)
+	if err != nil {
+		return nil, err
+	}
+
+	operationPath := fmt.Sprintf("/userinfo")
+	if operationPath[0] == '/' {
+		operationPath = "." + operationPath
+	}
+
+	queryURL, err := serverURL.Parse(operationPath)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequest("GET", queryURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (c *Client) applyEditors(ctx context.Context, req *http.Request, additionalEditors []RequestEditorFn) error {
+	for _, r := range c.RequestEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	for _, r := range additionalEditors {
+		if err := r(ctx, req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ClientWithResponses builds on ClientInterface to offer response payloads
+type ClientWithResponses struct {
+	ClientInterface
+}
+
+// NewClientWithResponses creates a new ClientWithResponses, which wraps
+// Client with return type handling
+func NewClientWithResponses(server string, opts ...ClientOption) (*ClientWithResponses, error) {
+	client, err := NewClient(server, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &ClientWithResponses{client}, nil
+}
+
+// WithBaseURL overrides the baseURL.
+func WithBaseURL(baseURL string) ClientOption {
+	return func(c *Client) error {
+		newBaseURL, err := url.Parse(baseURL)
+		if err != nil {
+			return err
+		}
+		c.Server = newBaseURL.String()
+		return nil
+	}
+}
+
+// ClientWithResponsesInterface is the interface specification for the client with responses above.
+type ClientWithResponsesInterface interface {
+	// GetWellKnownJwksJsonWithResponse request
+	GetWellKnownJwksJsonWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWellKnownJwksJsonResponse, error)
+
+	// GetWellKnownOpenidConfigurationWithResponse request
+	GetWellKnownOpenidConfigurationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWellKnownOpenidConfigurationResponse, error)
+
+	// GetAccountConsentsWithResponse request
+	GetAccountConsentsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAccountConsentsResponse, error)
+
+	// DeleteAccountConsentsConsentIdWithResponse request
+	DeleteAccountConsentsConsentIdWithResponse(ctx context.Context, consentId int64, reqEditors ...RequestEditorFn) (*DeleteAccountConsentsConsentIdResponse, error)
+
+	// PostAccountEmailVerificationWithResponse request
+	PostAccountEmailVerificationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostAccountEmailVerificationResponse, error)
+
+	// GetAccountPasskeysWithResponse request
+	GetAccountPasskeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAccountPasskeysResponse, error)
+
+	// PostAccountPasskeysRegistrationsWithBodyWithResponse request with any body
+	PostAccountPasskeysRegistrationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAccountPasskeysRegistrationsResponse, error)
+
+	PostAccountPasskeysRegistrationsWithResponse(ctx context.Context, body PostAccountPasskeysRegistrationsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAccountPasskeysRegistrationsResponse, error)
+
+	// PostAccountPasskeysRegistrationsCeremonyIdWithBodyWithResponse request with any body
+	PostAccountPasskeysRegistrationsCeremonyIdWithBodyWithResponse(ctx context.Context, ceremonyId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAccountPasskeysRegistrationsCeremonyIdResponse, error)
+
+	PostAccountPasskeysRegistrationsCeremonyIdWithResponse(ctx context.Context, ceremonyId string, body PostAccountPasskeysRegistrationsCeremonyIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAccountPasskeysRegistrationsCeremonyIdResponse, error)
+
+	// DeleteAccountPasskeysPasskeyIdWithResponse request
+	DeleteAccountPasskeysPasskeyIdWithResponse(ctx context.Context, passkeyId int64, reqEditors ...RequestEditorFn) (*DeleteAccountPasskeysPasskeyIdResponse, error)
+
+	// GetAccountRecoveryCodesWithResponse request
+	GetAccountRecoveryCodesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAccountRecoveryCodesResponse, error)
+
+	// PostAccountRecoveryCodesWithResponse request
+	PostAccountRecoveryCodesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostAccountRecoveryCodesResponse, error)
+
+	// DeleteAccountSessionsWithResponse request
+	DeleteAccountSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteAccountSessionsResponse, error)
+
+	// GetAccountSessionsWithResponse request
+	GetAccountSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAccountSessionsResponse, error)
+
+	// DeleteAccountSessionsSessionIdWithResponse request
+	DeleteAccountSessionsSessionIdWithResponse(ctx context.Context, sessionId int64, reqEditors ...RequestEditorFn) (*DeleteAccountSessionsSessionIdResponse, error)
+
+	// GetAccountTotpWithResponse request
+	GetAccountTotpWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAccountTotpResponse, error)
+
+	// PostAccountTotpWithBodyWithResponse request with any body
+	PostAccountTotpWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAccountTotpResponse, error)
+
+	PostAccountTotpWithResponse(ctx context.Context, body PostAccountTotpJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAccountTotpResponse, error)
+
+	// DeleteAccountTotpTotpIdWithResponse request
+	DeleteAccountTotpTotpIdWithResponse(ctx context.Context, totpId int64, reqEditors ...RequestEditorFn) (*DeleteAccountTotpTotpIdResponse, error)
+
+	// PostAccountTotpTotpIdConfirmWithBodyWithResponse request with any body
+	PostAccountTotpTotpIdConfirmWithBodyWithResponse(ctx context.Context, totpId int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAccountTotpTotpIdConfirmResponse, error)
+
+	PostAccountTotpTotpIdConfirmWithResponse(ctx context.Context, totpId int64, body PostAccountTotpTotpIdConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAccountTotpTotpIdConfirmResponse, error)
+
+	// GetAdminV1OrbitsWithResponse request
+	GetAdminV1OrbitsWithResponse(ctx context.Context, params *GetAdminV1OrbitsParams, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsResponse, error)
+
+	// PostAdminV1OrbitsWithBodyWithResponse request with any body
+	PostAdminV1OrbitsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminV1OrbitsResponse, error)
+
+	PostAdminV1OrbitsWithResponse(ctx context.Context, body PostAdminV1OrbitsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminV1OrbitsResponse, error)
+
+	// DeleteAdminV1OrbitsOrbitIdWithResponse request
+	DeleteAdminV1OrbitsOrbitIdWithResponse(ctx context.Context, orbitId OrbitId, reqEditors ...RequestEditorFn) (*DeleteAdminV1OrbitsOrbitIdResponse, error)
+
+	// GetAdminV1OrbitsOrbitIdWithResponse request
+	GetAdminV1OrbitsOrbitIdWithResponse(ctx context.Context, orbitId OrbitId, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdResponse, error)
+
+	// PutAdminV1OrbitsOrbitIdWithBodyWithResponse request with any body
+	PutAdminV1OrbitsOrbitIdWithBodyWithResponse(ctx context.Context, orbitId OrbitId, params *PutAdminV1OrbitsOrbitIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminV1OrbitsOrbitIdResponse, error)
+
+	PutAdminV1OrbitsOrbitIdWithResponse(ctx context.Context, orbitId OrbitId, params *PutAdminV1OrbitsOrbitIdParams, body PutAdminV1OrbitsOrbitIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminV1OrbitsOrbitIdResponse, error)
+
+	// GetAdminV1OrbitsOrbitIdAuditLogsWithResponse request
+	GetAdminV1OrbitsOrbitIdAuditLogsWithResponse(ctx context.Context, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdAuditLogsParams, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdAuditLogsResponse, error)
+
+	// GetAdminV1OrbitsOrbitIdClientsWithResponse request
+	GetAdminV1OrbitsOrbitIdClientsWithResponse(ctx context.Context, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdClientsParams, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdClientsResponse, error)
+
+	// PostAdminV1OrbitsOrbitIdClientsWithBodyWithResponse request with any body
+	PostAdminV1OrbitsOrbitIdClientsWithBodyWithResponse(ctx context.Context, orbitId OrbitId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminV1OrbitsOrbitIdClientsResponse, error)
+
+	PostAdminV1OrbitsOrbitIdClientsWithResponse(ctx context.Context, orbitId OrbitId, body PostAdminV1OrbitsOrbitIdClientsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminV1OrbitsOrbitIdClientsResponse, error)
+
+	// DeleteAdminV1OrbitsOrbitIdClientsClientIdWithResponse request
+	DeleteAdminV1OrbitsOrbitIdClientsClientIdWithResponse(ctx context.Context, orbitId OrbitId, clientId string, reqEditors ...RequestEditorFn) (*DeleteAdminV1OrbitsOrbitIdClientsClientIdResponse, error)
+
+	// GetAdminV1OrbitsOrbitIdClientsClientIdWithResponse request
+	GetAdminV1OrbitsOrbitIdClientsClientIdWithResponse(ctx context.Context, orbitId OrbitId, clientId string, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdClientsClientIdResponse, error)
+
+	// PutAdminV1OrbitsOrbitIdClientsClientIdWithBodyWithResponse request with any body
+	PutAdminV1OrbitsOrbitIdClientsClientIdWithBodyWithResponse(ctx context.Context, orbitId OrbitId, clientId string, params *PutAdminV1OrbitsOrbitIdClientsClientIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminV1OrbitsOrbitIdClientsClientIdResponse, error)
+
+	PutAdminV1OrbitsOrbitIdClientsClientIdWithResponse(ctx context.Context, orbitId OrbitId, clientId string, params *PutAdminV1OrbitsOrbitIdClientsClientIdParams, body PutAdminV1OrbitsOrbitIdClientsClientIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminV1OrbitsOrbitIdClientsClientIdResponse, error)
+
+	// PostAdminV1OrbitsOrbitIdClientsClientIdSecretWithResponse request
+	PostAdminV1OrbitsOrbitIdClientsClientIdSecretWithResponse(ctx context.Context, orbitId OrbitId, clientId string, reqEditors ...RequestEditorFn) (*PostAdminV1OrbitsOrbitIdClientsClientIdSecretResponse, error)
+
+	// GetAdminV1OrbitsOrbitIdGroupsWithResponse request
+	GetAdminV1OrbitsOrbitIdGroupsWithResponse(ctx context.Context, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdGroupsParams, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdGroupsResponse, error)
+
+	// PostAdminV1OrbitsOrbitIdGroupsWithBodyWithResponse request with any body
+	PostAdminV1OrbitsOrbitIdGroupsWithBodyWithResponse(ctx context.Context, orbitId OrbitId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminV1OrbitsOrbitIdGroupsResponse, error)
+
+	PostAdminV1OrbitsOrbitIdGroupsWithResponse(ctx context.Context, orbitId OrbitId, body PostAdminV1OrbitsOrbitIdGroupsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminV1OrbitsOrbitIdGroupsResponse, error)
+
+	// DeleteAdminV1OrbitsOrbitIdGroupsGroupIdWithResponse request
+	DeleteAdminV1OrbitsOrbitIdGroupsGroupIdWithResponse(ctx context.Context, orbitId OrbitId, groupId int64, reqEditors ...RequestEditorFn) (*DeleteAdminV1OrbitsOrbitIdGroupsGroupIdResponse, error)
+
+	// GetAdminV1OrbitsOrbitIdGroupsGroupIdWithResponse request
+	GetAdminV1OrbitsOrbitIdGroupsGroupIdWithResponse(ctx context.Context, orbitId OrbitId, groupId int64, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdGroupsGroupIdResponse, error)
+
+	// GetAdminV1OrbitsOrbitIdGroupsGroupIdMembersWithResponse request
+	GetAdminV1OrbitsOrbitIdGroupsGroupIdMembersWithResponse(ctx context.Context, orbitId OrbitId, groupId int64, params *GetAdminV1OrbitsOrbitIdGroupsGroupIdMembersParams, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdGroupsGroupIdMembersResponse, error)
+
+	// DeleteAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserIdWithResponse request
+	DeleteAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserIdWithResponse(ctx context.Context, orbitId OrbitId, groupId int64, userId int64, reqEditors ...RequestEditorFn) (*DeleteAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserIdResponse, error)
+
+	// PutAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserIdWithResponse request
+	PutAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserIdWithResponse(ctx context.Context, orbitId OrbitId, groupId int64, userId int64, reqEditors ...RequestEditorFn) (*PutAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserIdResponse, error)
+
+	// GetAdminV1OrbitsOrbitIdGroupsGroupIdRolesWithResponse request
+	GetAdminV1OrbitsOrbitIdGroupsGroupIdRolesWithResponse(ctx context.Context, orbitId OrbitId, groupId int64, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdGroupsGroupIdRolesResponse, error)
+
+	// DeleteAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleIdWithResponse request
+	DeleteAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleIdWithResponse(ctx context.Context, orbitId OrbitId, groupId int64, roleId int64, reqEditors ...RequestEditorFn) (*DeleteAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleIdResponse, error)
+
+	// PutAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleIdWithResponse request
+	PutAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleIdWithResponse(ctx context.Context, orbitId OrbitId, groupId int64, roleId int64, reqEditors ...RequestEditorFn) (*PutAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleIdResponse, error)
+
+	// GetAdminV1OrbitsOrbitIdKeysWithResponse request
+	GetAdminV1OrbitsOrbitIdKeysWithResponse(ctx context.Context, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdKeysParams, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdKeysResponse, error)
+
+	// PostAdminV1OrbitsOrbitIdKeysWithBodyWithResponse request with any body
+	PostAdminV1OrbitsOrbitIdKeysWithBodyWithResponse(ctx context.Context, orbitId OrbitId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminV1OrbitsOrbitIdKeysResponse, error)
+
+	PostAdminV1OrbitsOrbitIdKeysWithResponse(ctx context.Context, orbitId OrbitId, body PostAdminV1OrbitsOrbitIdKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminV1OrbitsOrbitIdKeysResponse, error)
+
+	// DeleteAdminV1OrbitsOrbitIdKeysKeyIdWithResponse request
+	DeleteAdminV1OrbitsOrbitIdKeysKeyIdWithResponse(ctx context.Context, orbitId OrbitId, keyId int64, reqEditors ...RequestEditorFn) (*DeleteAdminV1OrbitsOrbitIdKeysKeyIdResponse, error)
+
+	// GetAdminV1OrbitsOrbitIdKeysKeyIdWithResponse request
+	GetAdminV1OrbitsOrbitIdKeysKeyIdWithResponse(ctx context.Context, orbitId OrbitId, keyId int64, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdKeysKeyIdResponse, error)
+
+	// PutAdminV1OrbitsOrbitIdKeysKeyIdWithBodyWithResponse request with any body
+	PutAdminV1OrbitsOrbitIdKeysKeyIdWithBodyWithResponse(ctx context.Context, orbitId OrbitId, keyId int64, params *PutAdminV1OrbitsOrbitIdKeysKeyIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminV1OrbitsOrbitIdKeysKeyIdResponse, error)
+
+	PutAdminV1OrbitsOrbitIdKeysKeyIdWithResponse(ctx context.Context, orbitId OrbitId, keyId int64, params *PutAdminV1OrbitsOrbitIdKeysKeyIdParams, body PutAdminV1OrbitsOrbitIdKeysKeyIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminV1OrbitsOrbitIdKeysKeyIdResponse, error)
+
+	// GetAdminV1OrbitsOrbitIdPermissionsWithResponse request
+	GetAdminV1OrbitsOrbitIdPermissionsWithResponse(ctx context.Context, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdPermissionsParams, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdPermissionsResponse, error)
+
+	// PostAdminV1OrbitsOrbitIdPermissionsWithBodyWithResponse request with any body
+	PostAdminV1OrbitsOrbitIdPermissionsWithBodyWithResponse(ctx context.Context, orbitId OrbitId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminV1OrbitsOrbitIdPermissionsResponse, error)
+
+	PostAdminV1OrbitsOrbitIdPermissionsWithResponse(ctx context.Context, orbitId OrbitId, body PostAdminV1OrbitsOrbitIdPermissionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminV1OrbitsOrbitIdPermissionsResponse, error)
+
+	// DeleteAdminV1OrbitsOrbitIdPermissionsPermissionIdWithResponse request
+	DeleteAdminV1OrbitsOrbitIdPermissionsPermissionIdWithResponse(ctx context.Context, orbitId OrbitId, permissionId int64, reqEditors ...RequestEditorFn) (*DeleteAdminV1OrbitsOrbitIdPermissionsPermissionIdResponse, error)
+
+	// GetAdminV1OrbitsOrbitIdPermissionsPermissionIdWithResponse request
+	GetAdminV1OrbitsOrbitIdPermissionsPermissionIdWithResponse(ctx context.Context, orbitId OrbitId, permissionId int64, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdPermissionsPermissionIdResponse, error)
+
+	// GetAdminV1OrbitsOrbitIdPoliciesWithResponse request
+	GetAdminV1OrbitsOrbitIdPoliciesWithResponse(ctx context.Context, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdPoliciesParams, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdPoliciesResponse, error)
+
+	// PostAdminV1OrbitsOrbitIdPoliciesWithBodyWithResponse request with any body
+	PostAdminV1OrbitsOrbitIdPoliciesWithBodyWithResponse(ctx context.Context, orbitId OrbitId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminV1OrbitsOrbitIdPoliciesResponse, error)
+
+	PostAdminV1OrbitsOrbitIdPoliciesWithResponse(ctx context.Context, orbitId OrbitId, body PostAdminV1OrbitsOrbitIdPoliciesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminV1OrbitsOrbitIdPoliciesResponse, error)
+
+	// DeleteAdminV1OrbitsOrbitIdPoliciesPolicyIdWithResponse request
+	DeleteAdminV1OrbitsOrbitIdPoliciesPolicyIdWithResponse(ctx context.Context, orbitId OrbitId, policyId int64, reqEditors ...RequestEditorFn) (*DeleteAdminV1OrbitsOrbitIdPoliciesPolicyIdResponse, error)
+
+	// GetAdminV1OrbitsOrbitIdPoliciesPolicyIdWithResponse request
+	GetAdminV1OrbitsOrbitIdPoliciesPolicyIdWithResponse(ctx context.Context, orbitId OrbitId, policyId int64, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdPoliciesPolicyIdResponse, error)
+
+	// PutAdminV1OrbitsOrbitIdPoliciesPolicyIdWithBodyWithResponse request with any body
+	PutAdminV1OrbitsOrbitIdPoliciesPolicyIdWithBodyWithResponse(ctx context.Context, orbitId OrbitId, policyId int64, params *PutAdminV1OrbitsOrbitIdPoliciesPolicyIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminV1OrbitsOrbitIdPoliciesPolicyIdResponse, error)
+
+	PutAdminV1OrbitsOrbitIdPoliciesPolicyIdWithResponse(ctx context.Context, orbitId OrbitId, policyId int64, params *PutAdminV1OrbitsOrbitIdPoliciesPolicyIdParams, body PutAdminV1OrbitsOrbitIdPoliciesPolicyIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminV1OrbitsOrbitIdPoliciesPolicyIdResponse, error)
+
+	// GetAdminV1OrbitsOrbitIdPolicyDecisionsWithResponse request
+	GetAdminV1OrbitsOrbitIdPolicyDecisionsWithResponse(ctx context.Context, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdPolicyDecisionsParams, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdPolicyDecisionsResponse, error)
+
+	// GetAdminV1OrbitsOrbitIdRolesWithResponse request
+	GetAdminV1OrbitsOrbitIdRolesWithResponse(ctx context.Context, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdRolesParams, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdRolesResponse, error)
+
+	// PostAdminV1OrbitsOrbitIdRolesWithBodyWithResponse request with any body
+	PostAdminV1OrbitsOrbitIdRolesWithBodyWithResponse(ctx context.Context, orbitId OrbitId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminV1OrbitsOrbitIdRolesResponse, error)
+
+	PostAdminV1OrbitsOrbitIdRolesWithResponse(ctx context.Context, orbitId OrbitId, body PostAdminV1OrbitsOrbitIdRolesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminV1OrbitsOrbitIdRolesResponse, error)
+
+	// DeleteAdminV1OrbitsOrbitIdRolesRoleIdWithResponse request
+	DeleteAdminV1OrbitsOrbitIdRolesRoleIdWithResponse(ctx context.Context, orbitId OrbitId, roleId int64, reqEditors ...RequestEditorFn) (*DeleteAdminV1OrbitsOrbitIdRolesRoleIdResponse, error)
+
+	// GetAdminV1OrbitsOrbitIdRolesRoleIdWithResponse request
+	GetAdminV1OrbitsOrbitIdRolesRoleIdWithResponse(ctx context.Context, orbitId OrbitId, roleId int64, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdRolesRoleIdResponse, error)
+
+	// GetAdminV1OrbitsOrbitIdRolesRoleIdIncludesWithResponse request
+	GetAdminV1OrbitsOrbitIdRolesRoleIdIncludesWithResponse(ctx context.Context, orbitId OrbitId, roleId int64, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdRolesRoleIdIncludesResponse, error)
+
+	// DeleteAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleIdWithResponse request
+	DeleteAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleIdWithResponse(ctx context.Context, orbitId OrbitId, roleId int64, includedRoleId int64, reqEditors ...RequestEditorFn) (*DeleteAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleIdResponse, error)
+
+	// PutAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleIdWithResponse request
+	PutAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleIdWithResponse(ctx context.Context, orbitId OrbitId, roleId int64, includedRoleId int64, reqEditors ...RequestEditorFn) (*PutAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleIdResponse, error)
+
+	// GetAdminV1OrbitsOrbitIdRolesRoleIdPermissionsWithResponse request
+	GetAdminV1OrbitsOrbitIdRolesRoleIdPermissionsWithResponse(ctx context.Context, orbitId OrbitId, roleId int64, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdRolesRoleIdPermissionsResponse, error)
+
+	// DeleteAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionIdWithResponse request
+	DeleteAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionIdWithResponse(ctx context.Context, orbitId OrbitId, roleId int64, permissionId int64, reqEditors ...RequestEditorFn) (*DeleteAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionIdResponse, error)
+
+	// PutAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionIdWithResponse request
+	PutAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionIdWithResponse(ctx context.Context, orbitId OrbitId, roleId int64, permissionId int64, reqEditors ...RequestEditorFn) (*PutAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionIdResponse, error)
+
+	// GetAdminV1OrbitsOrbitIdScopesWithResponse request
+	GetAdminV1OrbitsOrbitIdScopesWithResponse(ctx context.Context, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdScopesParams, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdScopesResponse, error)
+
+	// PostAdminV1OrbitsOrbitIdScopesWithBodyWithResponse request with any body
+	PostAdminV1OrbitsOrbitIdScopesWithBodyWithResponse(ctx context.Context, orbitId OrbitId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminV1OrbitsOrbitIdScopesResponse, error)
+
+	PostAdminV1OrbitsOrbitIdScopesWithResponse(ctx context.Context, orbitId OrbitId, body PostAdminV1OrbitsOrbitIdScopesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminV1OrbitsOrbitIdScopesResponse, error)
+
+	// DeleteAdminV1OrbitsOrbitIdScopesScopeIdWithResponse request
+	DeleteAdminV1OrbitsOrbitIdScopesScopeIdWithResponse(ctx context.Context, orbitId OrbitId, scopeId int64, reqEditors ...RequestEditorFn) (*DeleteAdminV1OrbitsOrbitIdScopesScopeIdResponse, error)
+
+	// GetAdminV1OrbitsOrbitIdScopesScopeIdWithResponse request
+	GetAdminV1OrbitsOrbitIdScopesScopeIdWithResponse(ctx context.Context, orbitId OrbitId, scopeId int64, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdScopesScopeIdResponse, error)
+
+	// PutAdminV1OrbitsOrbitIdScopesScopeIdWithBodyWithResponse request with any body
+	PutAdminV1OrbitsOrbitIdScopesScopeIdWithBodyWithResponse(ctx context.Context, orbitId OrbitId, scopeId int64, params *PutAdminV1OrbitsOrbitIdScopesScopeIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminV1OrbitsOrbitIdScopesScopeIdResponse, error)
+
+	PutAdminV1OrbitsOrbitIdScopesScopeIdWithResponse(ctx context.Context, orbitId OrbitId, scopeId int64, params *PutAdminV1OrbitsOrbitIdScopesScopeIdParams, body PutAdminV1OrbitsOrbitIdScopesScopeIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminV1OrbitsOrbitIdScopesScopeIdResponse, error)
+
+	// GetAdminV1OrbitsOrbitIdUsersWithResponse request
+	GetAdminV1OrbitsOrbitIdUsersWithResponse(ctx context.Context, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdUsersParams, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdUsersResponse, error)
+
+	// PostAdminV1OrbitsOrbitIdUsersWithBodyWithResponse request with any body
+	PostAdminV1OrbitsOrbitIdUsersWithBodyWithResponse(ctx context.Context, orbitId OrbitId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminV1OrbitsOrbitIdUsersResponse, error)
+
+	PostAdminV1OrbitsOrbitIdUsersWithResponse(ctx context.Context, orbitId OrbitId, body PostAdminV1OrbitsOrbitIdUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminV1OrbitsOrbitIdUsersResponse, error)
+
+	// DeleteAdminV1OrbitsOrbitIdUsersUserIdWithResponse request
+	DeleteAdminV1OrbitsOrbitIdUsersUserIdWithResponse(ctx context.Context, orbitId OrbitId, userId int64, reqEditors ...RequestEditorFn) (*DeleteAdminV1OrbitsOrbitIdUsersUserIdResponse, error)
+
+	// GetAdminV1OrbitsOrbitIdUsersUserIdWithResponse request
+	GetAdminV1OrbitsOrbitIdUsersUserIdWithResponse(ctx context.Context, orbitId OrbitId, userId int64, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdUsersUserIdResponse, error)
+
+	// PutAdminV1OrbitsOrbitIdUsersUserIdWithBodyWithResponse request with any body
+	PutAdminV1OrbitsOrbitIdUsersUserIdWithBodyWithResponse(ctx context.Context, orbitId OrbitId, userId int64, params *PutAdminV1OrbitsOrbitIdUsersUserIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminV1OrbitsOrbitIdUsersUserIdResponse, error)
+
+	PutAdminV1OrbitsOrbitIdUsersUserIdWithResponse(ctx context.Context, orbitId OrbitId, userId int64, params *PutAdminV1OrbitsOrbitIdUsersUserIdParams, body PutAdminV1OrbitsOrbitIdUsersUserIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminV1OrbitsOrbitIdUsersUserIdResponse, error)
+
+	// GetAdminV1OrbitsOrbitIdUsersUserIdPermissionsWithResponse request
+	GetAdminV1OrbitsOrbitIdUsersUserIdPermissionsWithResponse(ctx context.Context, orbitId OrbitId, userId int64, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdUsersUserIdPermissionsResponse, error)
+
+	// GetAdminV1OrbitsOrbitIdUsersUserIdRolesWithResponse request
+	GetAdminV1OrbitsOrbitIdUsersUserIdRolesWithResponse(ctx context.Context, orbitId OrbitId, userId int64, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdUsersUserIdRolesResponse, error)
+
+	// DeleteAdminV1OrbitsOrbitIdUsersUserIdRolesRoleIdWithResponse request
+	DeleteAdminV1OrbitsOrbitIdUsersUserIdRolesRoleIdWithResponse(ctx context.Context, orbitId OrbitId, userId int64, roleId int64, reqEditors ...RequestEditorFn) (*DeleteAdminV1OrbitsOrbitIdUsersUserIdRolesRoleIdResponse, error)
+
+	// PutAdminV1OrbitsOrbitIdUsersUserIdRolesRoleIdWithResponse request
+	PutAdminV1OrbitsOrbitIdUsersUserIdRolesRoleIdWithResponse(ctx context.Context, orbitId OrbitId, userId int64, roleId int64, reqEditors ...RequestEditorFn) (*PutAdminV1OrbitsOrbitIdUsersUserIdRolesRoleIdResponse, error)
+
+	// PostAdminV1OrbitsOrbitIdUsersUserIdUnlockWithResponse request
+	PostAdminV1OrbitsOrbitIdUsersUserIdUnlockWithResponse(ctx context.Context, orbitId OrbitId, userId int64, reqEditors ...RequestEditorFn) (*PostAdminV1OrbitsOrbitIdUsersUserIdUnlockResponse, error)
+
+	// GetAuthorizeWithResponse request
+	GetAuthorizeWithResponse(ctx context.Context, params *GetAuthorizeParams, reqEditors ...RequestEditorFn) (*GetAuthorizeResponse, error)
+
+	// PostAuthzEvaluateWithBodyWithResponse request with any body
+	PostAuthzEvaluateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthzEvaluateResponse, error)
+
+	PostAuthzEvaluateWithResponse(ctx context.Context, body PostAuthzEvaluateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthzEvaluateResponse, error)
+
+	// GetConsentWithResponse request
+	GetConsentWithResponse(ctx context.Context, params *GetConsentParams, reqEditors ...RequestEditorFn) (*GetConsentResponse, error)
+
+	// PostConsentWithBodyWithResponse request with any body
+	PostConsentWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostConsentResponse, error)
+
+	PostConsentWithFormdataBodyWithResponse(ctx context.Context, body PostConsentFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostConsentResponse, error)
+
+	// GetEmailVerifyWithResponse request
+	GetEmailVerifyWithResponse(ctx context.Context, params *GetEmailVerifyParams, reqEditors ...RequestEditorFn) (*GetEmailVerifyResponse, error)
+
+	// PostIntrospectWithBodyWithResponse request with any body
+	PostIntrospectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostIntrospectResponse, error)
+
+	PostIntrospectWithFormdataBodyWithResponse(ctx context.Context, body PostIntrospectFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostIntrospectResponse, error)
+
+	// GetLoginWithResponse request
+	GetLoginWithResponse(ctx context.Context, params *GetLoginParams, reqEditors ...RequestEditorFn) (*GetLoginResponse, error)
+
+	// PostLoginWithBodyWithResponse request with any body
+	PostLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLoginResponse, error)
+
+	PostLoginWithFormdataBodyWithResponse(ctx context.Context, body PostLoginFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostLoginResponse, error)
+
+	// GetLoginMfaWithResponse request
+	GetLoginMfaWithResponse(ctx context.Context, params *GetLoginMfaParams, reqEditors ...RequestEditorFn) (*GetLoginMfaResponse, error)
+
+	// PostLoginMfaWithBodyWithResponse request with any body
+	PostLoginMfaWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLoginMfaResponse, error)
+
+	PostLoginMfaWithFormdataBodyWithResponse(ctx context.Context, body PostLoginMfaFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostLoginMfaResponse, error)
+
+	// PostLoginPasskeyWithBodyWithResponse request with any body
+	PostLoginPasskeyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLoginPasskeyResponse, error)
+
+	PostLoginPasskeyWithFormdataBodyWithResponse(ctx context.Context, body PostLoginPasskeyFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostLoginPasskeyResponse, error)
+
+	// PostLoginPasskeyOptionsWithBodyWithResponse request with any body
+	PostLoginPasskeyOptionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLoginPasskeyOptionsResponse, error)
+
+	PostLoginPasskeyOptionsWithResponse(ctx context.Context, body PostLoginPasskeyOptionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostLoginPasskeyOptionsResponse, error)
+
+	// PostLogoutWithBodyWithResponse request with any body
+	PostLogoutWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLogoutResponse, error)
+
+	PostLogoutWithResponse(ctx context.Context, body PostLogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*PostLogoutResponse, error)
+
+	// GetPasswordForgotWithResponse request
+	GetPasswordForgotWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPasswordForgotResponse, error)
+
+	// PostPasswordForgotWithBodyWithResponse request with any body
+	PostPasswordForgotWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPasswordForgotResponse, error)
+
+	PostPasswordForgotWithFormdataBodyWithResponse(ctx context.Context, body PostPasswordForgotFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostPasswordForgotResponse, error)
+
+	// GetPasswordResetWithResponse request
+	GetPasswordResetWithResponse(ctx context.Context, params *GetPasswordResetParams, reqEditors ...RequestEditorFn) (*GetPasswordResetResponse, error)
+
+	// PostPasswordResetWithBodyWithResponse request with any body
+	PostPasswordResetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPasswordResetResponse, error)
+
+	PostPasswordResetWithFormdataBodyWithResponse(ctx context.Context, body PostPasswordResetFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostPasswordResetResponse, error)
+
+	// PostRegisterWithBodyWithResponse request with any body
+	PostRegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRegisterResponse, error)
+
+	PostRegisterWithResponse(ctx context.Context, body PostRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRegisterResponse, error)
+
+	// DeleteRegisterClientIdWithResponse request
+	DeleteRegisterClientIdWithResponse(ctx context.Context, clientId string, reqEditors ...RequestEditorFn) (*DeleteRegisterClientIdResponse, error)
+
+	// GetRegisterClientIdWithResponse request
+	GetRegisterClientIdWithResponse(ctx context.Context, clientId string, reqEditors ...RequestEditorFn) (*GetRegisterClientIdResponse, error)
+
+	// PutRegisterClientIdWithBodyWithResponse request with any body
+	PutRegisterClientIdWithBodyWithResponse(ctx context.Context, clientId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutRegisterClientIdResponse, error)
+
+	PutRegisterClientIdWithResponse(ctx context.Context, clientId string, body PutRegisterClientIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutRegisterClientIdResponse, error)
+
+	// PostRevokeWithBodyWithResponse request with any body
+	PostRevokeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRevokeResponse, error)
+
+	PostRevokeWithFormdataBodyWithResponse(ctx context.Context, body PostRevokeFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostRevokeResponse, error)
+
+	// PostTokenWithBodyWithResponse request with any body
+	PostTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTokenResponse, error)
+
+	PostTokenWithFormdataBodyWithResponse(ctx context.Context, body PostTokenFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostTokenResponse, error)
+
+	// GetUserinfoWithResponse request
+	GetUserinfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserinfoResponse, error)
+}
+
+type GetWellKnownJwksJsonResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *JWKSet
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWellKnownJwksJsonResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWellKnownJwksJsonResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetWellKnownOpenidConfigurationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		AcrValuesSupported               *[]string `json:"acr_values_supported,omitempty"`
+		AuthorizationEndpoint            *string   `json:"authorization_endpoint,omitempty"`
+		IdTokenSigningAlgValuesSupported *[]string `json:"id_token_signing_alg_values_supported,omitempty"`
+		Issuer                           *string   `json:"issuer,omitempty"`
+		JwksUri                          *string   `json:"jwks_uri,omitempty"`
+		ResponseTypesSupported           *[]string `json:"response_types_supported,omitempty"`
+		ScopesSupported                  *[]string `json:"scopes_supported,omitempty"`
+		SubjectTypesSupported            *[]string `json:"subject_types_supported,omitempty"`
+		TokenEndpoint                    *string   `json:"token_endpoint,omitempty"`
+		UserinfoEndpoint                 *string   `json:"userinfo_endpoint,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetWellKnownOpenidConfigurationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetWellKnownOpenidConfigurationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAccountConsentsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Consent
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAccountConsentsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAccountConsentsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAccountConsentsConsentIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAccountConsentsConsentIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAccountConsentsConsentIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAccountEmailVerificationResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON401      *Error
+	JSON429      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAccountEmailVerificationResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAccountEmailVerificationResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAccountPasskeysResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Passkey
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAccountPasskeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAccountPasskeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAccountPasskeysRegistrationsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PasskeyOptions
+	JSON400      *Error
+	JSON401      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAccountPasskeysRegistrationsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAccountPasskeysRegistrationsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAccountPasskeysRegistrationsCeremonyIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Passkey
+	JSON400      *Error
+	JSON401      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAccountPasskeysRegistrationsCeremonyIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAccountPasskeysRegistrationsCeremonyIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAccountPasskeysPasskeyIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAccountPasskeysPasskeyIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAccountPasskeysPasskeyIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAccountRecoveryCodesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RecoveryCodeStatus
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAccountRecoveryCodesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAccountRecoveryCodesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAccountRecoveryCodesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *RecoveryCodes
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAccountRecoveryCodesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAccountRecoveryCodesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAccountSessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAccountSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAccountSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAccountSessionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Session
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAccountSessionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAccountSessionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAccountSessionsSessionIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAccountSessionsSessionIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAccountSessionsSessionIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAccountTotpResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]TOTPAuthenticator
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAccountTotpResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAccountTotpResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAccountTotpResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *TOTPEnrollment
+	JSON400      *Error
+	JSON401      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAccountTotpResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAccountTotpResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAccountTotpTotpIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAccountTotpTotpIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAccountTotpTotpIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAccountTotpTotpIdConfirmResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON401      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAccountTotpTotpIdConfirmResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAccountTotpTotpIdConfirmResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminV1OrbitsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OrbitList
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminV1OrbitsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminV1OrbitsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAdminV1OrbitsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Orbit
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAdminV1OrbitsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAdminV1OrbitsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAdminV1OrbitsOrbitIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAdminV1OrbitsOrbitIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAdminV1OrbitsOrbitIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminV1OrbitsOrbitIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Orbit
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminV1OrbitsOrbitIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminV1OrbitsOrbitIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutAdminV1OrbitsOrbitIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Orbit
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSON412      *Error
+	JSON428      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutAdminV1OrbitsOrbitIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutAdminV1OrbitsOrbitIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminV1OrbitsOrbitIdAuditLogsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuditLogList
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminV1OrbitsOrbitIdAuditLogsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminV1OrbitsOrbitIdAuditLogsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminV1OrbitsOrbitIdClientsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OAuthClientList
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminV1OrbitsOrbitIdClientsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminV1OrbitsOrbitIdClientsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAdminV1OrbitsOrbitIdClientsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *OAuthClient
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAdminV1OrbitsOrbitIdClientsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAdminV1OrbitsOrbitIdClientsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAdminV1OrbitsOrbitIdClientsClientIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAdminV1OrbitsOrbitIdClientsClientIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAdminV1OrbitsOrbitIdClientsClientIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminV1OrbitsOrbitIdClientsClientIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OAuthClient
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminV1OrbitsOrbitIdClientsClientIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminV1OrbitsOrbitIdClientsClientIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutAdminV1OrbitsOrbitIdClientsClientIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *OAuthClient
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON412      *Error
+	JSON428      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutAdminV1OrbitsOrbitIdClientsClientIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutAdminV1OrbitsOrbitIdClientsClientIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAdminV1OrbitsOrbitIdClientsClientIdSecretResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ClientSecret
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAdminV1OrbitsOrbitIdClientsClientIdSecretResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAdminV1OrbitsOrbitIdClientsClientIdSecretResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminV1OrbitsOrbitIdGroupsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *GroupList
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminV1OrbitsOrbitIdGroupsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminV1OrbitsOrbitIdGroupsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAdminV1OrbitsOrbitIdGroupsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Group
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAdminV1OrbitsOrbitIdGroupsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAdminV1OrbitsOrbitIdGroupsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAdminV1OrbitsOrbitIdGroupsGroupIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAdminV1OrbitsOrbitIdGroupsGroupIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAdminV1OrbitsOrbitIdGroupsGroupIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminV1OrbitsOrbitIdGroupsGroupIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Group
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminV1OrbitsOrbitIdGroupsGroupIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminV1OrbitsOrbitIdGroupsGroupIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminV1OrbitsOrbitIdGroupsGroupIdMembersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserList
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminV1OrbitsOrbitIdGroupsGroupIdMembersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminV1OrbitsOrbitIdGroupsGroupIdMembersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminV1OrbitsOrbitIdGroupsGroupIdRolesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Role
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminV1OrbitsOrbitIdGroupsGroupIdRolesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminV1OrbitsOrbitIdGroupsGroupIdRolesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminV1OrbitsOrbitIdKeysResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SigningKeyList
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminV1OrbitsOrbitIdKeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminV1OrbitsOrbitIdKeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAdminV1OrbitsOrbitIdKeysResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *SigningKey
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAdminV1OrbitsOrbitIdKeysResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAdminV1OrbitsOrbitIdKeysResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAdminV1OrbitsOrbitIdKeysKeyIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAdminV1OrbitsOrbitIdKeysKeyIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAdminV1OrbitsOrbitIdKeysKeyIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminV1OrbitsOrbitIdKeysKeyIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SigningKey
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminV1OrbitsOrbitIdKeysKeyIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminV1OrbitsOrbitIdKeysKeyIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutAdminV1OrbitsOrbitIdKeysKeyIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *SigningKey
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON412      *Error
+	JSON428      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutAdminV1OrbitsOrbitIdKeysKeyIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutAdminV1OrbitsOrbitIdKeysKeyIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminV1OrbitsOrbitIdPermissionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PermissionList
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminV1OrbitsOrbitIdPermissionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminV1OrbitsOrbitIdPermissionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAdminV1OrbitsOrbitIdPermissionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Permission
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAdminV1OrbitsOrbitIdPermissionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAdminV1OrbitsOrbitIdPermissionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAdminV1OrbitsOrbitIdPermissionsPermissionIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAdminV1OrbitsOrbitIdPermissionsPermissionIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAdminV1OrbitsOrbitIdPermissionsPermissionIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminV1OrbitsOrbitIdPermissionsPermissionIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Permission
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminV1OrbitsOrbitIdPermissionsPermissionIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminV1OrbitsOrbitIdPermissionsPermissionIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminV1OrbitsOrbitIdPoliciesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PolicyList
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminV1OrbitsOrbitIdPoliciesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminV1OrbitsOrbitIdPoliciesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAdminV1OrbitsOrbitIdPoliciesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Policy
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAdminV1OrbitsOrbitIdPoliciesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAdminV1OrbitsOrbitIdPoliciesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAdminV1OrbitsOrbitIdPoliciesPolicyIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAdminV1OrbitsOrbitIdPoliciesPolicyIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAdminV1OrbitsOrbitIdPoliciesPolicyIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminV1OrbitsOrbitIdPoliciesPolicyIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Policy
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminV1OrbitsOrbitIdPoliciesPolicyIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminV1OrbitsOrbitIdPoliciesPolicyIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutAdminV1OrbitsOrbitIdPoliciesPolicyIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Policy
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSON412      *Error
+	JSON428      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutAdminV1OrbitsOrbitIdPoliciesPolicyIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutAdminV1OrbitsOrbitIdPoliciesPolicyIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminV1OrbitsOrbitIdPolicyDecisionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PolicyDecisionList
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminV1OrbitsOrbitIdPolicyDecisionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminV1OrbitsOrbitIdPolicyDecisionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminV1OrbitsOrbitIdRolesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *RoleList
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminV1OrbitsOrbitIdRolesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminV1OrbitsOrbitIdRolesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAdminV1OrbitsOrbitIdRolesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Role
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAdminV1OrbitsOrbitIdRolesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAdminV1OrbitsOrbitIdRolesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAdminV1OrbitsOrbitIdRolesRoleIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAdminV1OrbitsOrbitIdRolesRoleIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAdminV1OrbitsOrbitIdRolesRoleIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminV1OrbitsOrbitIdRolesRoleIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Role
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminV1OrbitsOrbitIdRolesRoleIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminV1OrbitsOrbitIdRolesRoleIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminV1OrbitsOrbitIdRolesRoleIdIncludesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Role
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminV1OrbitsOrbitIdRolesRoleIdIncludesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminV1OrbitsOrbitIdRolesRoleIdIncludesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminV1OrbitsOrbitIdRolesRoleIdPermissionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Permission
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminV1OrbitsOrbitIdRolesRoleIdPermissionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminV1OrbitsOrbitIdRolesRoleIdPermissionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminV1OrbitsOrbitIdScopesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ScopeList
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminV1OrbitsOrbitIdScopesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminV1OrbitsOrbitIdScopesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAdminV1OrbitsOrbitIdScopesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *Scope
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAdminV1OrbitsOrbitIdScopesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAdminV1OrbitsOrbitIdScopesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAdminV1OrbitsOrbitIdScopesScopeIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAdminV1OrbitsOrbitIdScopesScopeIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAdminV1OrbitsOrbitIdScopesScopeIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminV1OrbitsOrbitIdScopesScopeIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Scope
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminV1OrbitsOrbitIdScopesScopeIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminV1OrbitsOrbitIdScopesScopeIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutAdminV1OrbitsOrbitIdScopesScopeIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Scope
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON412      *Error
+	JSON428      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutAdminV1OrbitsOrbitIdScopesScopeIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutAdminV1OrbitsOrbitIdScopesScopeIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminV1OrbitsOrbitIdUsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *UserList
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminV1OrbitsOrbitIdUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminV1OrbitsOrbitIdUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAdminV1OrbitsOrbitIdUsersResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *User
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON409      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAdminV1OrbitsOrbitIdUsersResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAdminV1OrbitsOrbitIdUsersResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAdminV1OrbitsOrbitIdUsersUserIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAdminV1OrbitsOrbitIdUsersUserIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAdminV1OrbitsOrbitIdUsersUserIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminV1OrbitsOrbitIdUsersUserIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminV1OrbitsOrbitIdUsersUserIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminV1OrbitsOrbitIdUsersUserIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutAdminV1OrbitsOrbitIdUsersUserIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *User
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+	JSON409      *Error
+	JSON412      *Error
+	JSON428      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutAdminV1OrbitsOrbitIdUsersUserIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutAdminV1OrbitsOrbitIdUsersUserIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminV1OrbitsOrbitIdUsersUserIdPermissionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]string
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminV1OrbitsOrbitIdUsersUserIdPermissionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminV1OrbitsOrbitIdUsersUserIdPermissionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAdminV1OrbitsOrbitIdUsersUserIdRolesResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *[]Role
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAdminV1OrbitsOrbitIdUsersUserIdRolesResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAdminV1OrbitsOrbitIdUsersUserIdRolesResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteAdminV1OrbitsOrbitIdUsersUserIdRolesRoleIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteAdminV1OrbitsOrbitIdUsersUserIdRolesRoleIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteAdminV1OrbitsOrbitIdUsersUserIdRolesRoleIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutAdminV1OrbitsOrbitIdUsersUserIdRolesRoleIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutAdminV1OrbitsOrbitIdUsersUserIdRolesRoleIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutAdminV1OrbitsOrbitIdUsersUserIdRolesRoleIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAdminV1OrbitsOrbitIdUsersUserIdUnlockResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+	JSON403      *Error
+	JSON404      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAdminV1OrbitsOrbitIdUsersUserIdUnlockResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAdminV1OrbitsOrbitIdUsersUserIdUnlockResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetAuthorizeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetAuthorizeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetAuthorizeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostAuthzEvaluateResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *AuthzDecision
+	JSON400      *struct {
+		Error            string  `json:"error"`
+		ErrorDescription *string `json:"error_description,omitempty"`
+	}
+	JSON401 *struct {
+		Error            string  `json:"error"`
+		ErrorDescription *string `json:"error_description,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PostAuthzEvaluateResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostAuthzEvaluateResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetConsentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetConsentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetConsentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostConsentResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostConsentResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostConsentResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetEmailVerifyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetEmailVerifyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetEmailVerifyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostIntrospectResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *Introspection
+	JSON401      *struct {
+		Error            string  `json:"error"`
+		ErrorDescription *string `json:"error_description,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PostIntrospectResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostIntrospectResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLoginResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostLoginResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostLoginResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostLoginResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetLoginMfaResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetLoginMfaResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetLoginMfaResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostLoginMfaResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostLoginMfaResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostLoginMfaResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostLoginPasskeyResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostLoginPasskeyResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostLoginPasskeyResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostLoginPasskeyOptionsResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *PasskeyOptions
+	JSON400      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostLoginPasskeyOptionsResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostLoginPasskeyOptionsResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostLogoutResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON400      *Error
+	JSON403      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostLogoutResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostLogoutResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPasswordForgotResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPasswordForgotResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPasswordForgotResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPasswordForgotResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPasswordForgotResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPasswordForgotResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetPasswordResetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r GetPasswordResetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetPasswordResetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostPasswordResetResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostPasswordResetResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostPasswordResetResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostRegisterResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON201      *ClientInformation
+	JSON400      *Error
+	JSON401      *Error
+	JSON403      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PostRegisterResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRegisterResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type DeleteRegisterClientIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r DeleteRegisterClientIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r DeleteRegisterClientIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetRegisterClientIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClientInformation
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r GetRegisterClientIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetRegisterClientIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PutRegisterClientIdResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *ClientInformation
+	JSON400      *Error
+	JSON401      *Error
+}
+
+// Status returns HTTPResponse.Status
+func (r PutRegisterClientIdResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PutRegisterClientIdResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostRevokeResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+}
+
+// Status returns HTTPResponse.Status
+func (r PostRevokeResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostRevokeResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type PostTokenResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *TokenResponse
+	JSON400      *struct {
+		Error            string  `json:"error"`
+		ErrorDescription *string `json:"error_description,omitempty"`
+	}
+	JSON401 *struct {
+		Error            string  `json:"error"`
+		ErrorDescription *string `json:"error_description,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r PostTokenResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r PostTokenResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+type GetUserinfoResponse struct {
+	Body         []byte
+	HTTPResponse *http.Response
+	JSON200      *struct {
+		Email         *string `json:"email,omitempty"`
+		EmailVerified *bool   `json:"email_verified,omitempty"`
+		Name          *string `json:"name,omitempty"`
+		Sub           *string `json:"sub,omitempty"`
+	}
+}
+
+// Status returns HTTPResponse.Status
+func (r GetUserinfoResponse) Status() string {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.Status
+	}
+	return http.StatusText(0)
+}
+
+// StatusCode returns HTTPResponse.StatusCode
+func (r GetUserinfoResponse) StatusCode() int {
+	if r.HTTPResponse != nil {
+		return r.HTTPResponse.StatusCode
+	}
+	return 0
+}
+
+// GetWellKnownJwksJsonWithResponse request returning *GetWellKnownJwksJsonResponse
+func (c *ClientWithResponses) GetWellKnownJwksJsonWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWellKnownJwksJsonResponse, error) {
+	rsp, err := c.GetWellKnownJwksJson(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWellKnownJwksJsonResponse(rsp)
+}
+
+// GetWellKnownOpenidConfigurationWithResponse request returning *GetWellKnownOpenidConfigurationResponse
+func (c *ClientWithResponses) GetWellKnownOpenidConfigurationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetWellKnownOpenidConfigurationResponse, error) {
+	rsp, err := c.GetWellKnownOpenidConfiguration(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetWellKnownOpenidConfigurationResponse(rsp)
+}
+
+// GetAccountConsentsWithResponse request returning *GetAccountConsentsResponse
+func (c *ClientWithResponses) GetAccountConsentsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAccountConsentsResponse, error) {
+	rsp, err := c.GetAccountConsents(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAccountConsentsResponse(rsp)
+}
+
+// DeleteAccountConsentsConsentIdWithResponse request returning *DeleteAccountConsentsConsentIdResponse
+func (c *ClientWithResponses) DeleteAccountConsentsConsentIdWithResponse(ctx context.Context, consentId int64, reqEditors ...RequestEditorFn) (*DeleteAccountConsentsConsentIdResponse, error) {
+	rsp, err := c.DeleteAccountConsentsConsentId(ctx, consentId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAccountConsentsConsentIdResponse(rsp)
+}
+
+// PostAccountEmailVerificationWithResponse request returning *PostAccountEmailVerificationResponse
+func (c *ClientWithResponses) PostAccountEmailVerificationWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostAccountEmailVerificationResponse, error) {
+	rsp, err := c.PostAccountEmailVerification(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAccountEmailVerificationResponse(rsp)
+}
+
+// GetAccountPasskeysWithResponse request returning *GetAccountPasskeysResponse
+func (c *ClientWithResponses) GetAccountPasskeysWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAccountPasskeysResponse, error) {
+	rsp, err := c.GetAccountPasskeys(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAccountPasskeysResponse(rsp)
+}
+
+// PostAccountPasskeysRegistrationsWithBodyWithResponse request with arbitrary body returning *PostAccountPasskeysRegistrationsResponse
+func (c *ClientWithResponses) PostAccountPasskeysRegistrationsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAccountPasskeysRegistrationsResponse, error) {
+	rsp, err := c.PostAccountPasskeysRegistrationsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAccountPasskeysRegistrationsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAccountPasskeysRegistrationsWithResponse(ctx context.Context, body PostAccountPasskeysRegistrationsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAccountPasskeysRegistrationsResponse, error) {
+	rsp, err := c.PostAccountPasskeysRegistrations(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAccountPasskeysRegistrationsResponse(rsp)
+}
+
+// PostAccountPasskeysRegistrationsCeremonyIdWithBodyWithResponse request with arbitrary body returning *PostAccountPasskeysRegistrationsCeremonyIdResponse
+func (c *ClientWithResponses) PostAccountPasskeysRegistrationsCeremonyIdWithBodyWithResponse(ctx context.Context, ceremonyId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAccountPasskeysRegistrationsCeremonyIdResponse, error) {
+	rsp, err := c.PostAccountPasskeysRegistrationsCeremonyIdWithBody(ctx, ceremonyId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAccountPasskeysRegistrationsCeremonyIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAccountPasskeysRegistrationsCeremonyIdWithResponse(ctx context.Context, ceremonyId string, body PostAccountPasskeysRegistrationsCeremonyIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAccountPasskeysRegistrationsCeremonyIdResponse, error) {
+	rsp, err := c.PostAccountPasskeysRegistrationsCeremonyId(ctx, ceremonyId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAccountPasskeysRegistrationsCeremonyIdResponse(rsp)
+}
+
+// DeleteAccountPasskeysPasskeyIdWithResponse request returning *DeleteAccountPasskeysPasskeyIdResponse
+func (c *ClientWithResponses) DeleteAccountPasskeysPasskeyIdWithResponse(ctx context.Context, passkeyId int64, reqEditors ...RequestEditorFn) (*DeleteAccountPasskeysPasskeyIdResponse, error) {
+	rsp, err := c.DeleteAccountPasskeysPasskeyId(ctx, passkeyId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAccountPasskeysPasskeyIdResponse(rsp)
+}
+
+// GetAccountRecoveryCodesWithResponse request returning *GetAccountRecoveryCodesResponse
+func (c *ClientWithResponses) GetAccountRecoveryCodesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAccountRecoveryCodesResponse, error) {
+	rsp, err := c.GetAccountRecoveryCodes(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAccountRecoveryCodesResponse(rsp)
+}
+
+// PostAccountRecoveryCodesWithResponse request returning *PostAccountRecoveryCodesResponse
+func (c *ClientWithResponses) PostAccountRecoveryCodesWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*PostAccountRecoveryCodesResponse, error) {
+	rsp, err := c.PostAccountRecoveryCodes(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAccountRecoveryCodesResponse(rsp)
+}
+
+// DeleteAccountSessionsWithResponse request returning *DeleteAccountSessionsResponse
+func (c *ClientWithResponses) DeleteAccountSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*DeleteAccountSessionsResponse, error) {
+	rsp, err := c.DeleteAccountSessions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAccountSessionsResponse(rsp)
+}
+
+// GetAccountSessionsWithResponse request returning *GetAccountSessionsResponse
+func (c *ClientWithResponses) GetAccountSessionsWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAccountSessionsResponse, error) {
+	rsp, err := c.GetAccountSessions(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAccountSessionsResponse(rsp)
+}
+
+// DeleteAccountSessionsSessionIdWithResponse request returning *DeleteAccountSessionsSessionIdResponse
+func (c *ClientWithResponses) DeleteAccountSessionsSessionIdWithResponse(ctx context.Context, sessionId int64, reqEditors ...RequestEditorFn) (*DeleteAccountSessionsSessionIdResponse, error) {
+	rsp, err := c.DeleteAccountSessionsSessionId(ctx, sessionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAccountSessionsSessionIdResponse(rsp)
+}
+
+// GetAccountTotpWithResponse request returning *GetAccountTotpResponse
+func (c *ClientWithResponses) GetAccountTotpWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetAccountTotpResponse, error) {
+	rsp, err := c.GetAccountTotp(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAccountTotpResponse(rsp)
+}
+
+// PostAccountTotpWithBodyWithResponse request with arbitrary body returning *PostAccountTotpResponse
+func (c *ClientWithResponses) PostAccountTotpWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAccountTotpResponse, error) {
+	rsp, err := c.PostAccountTotpWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAccountTotpResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAccountTotpWithResponse(ctx context.Context, body PostAccountTotpJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAccountTotpResponse, error) {
+	rsp, err := c.PostAccountTotp(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAccountTotpResponse(rsp)
+}
+
+// DeleteAccountTotpTotpIdWithResponse request returning *DeleteAccountTotpTotpIdResponse
+func (c *ClientWithResponses) DeleteAccountTotpTotpIdWithResponse(ctx context.Context, totpId int64, reqEditors ...RequestEditorFn) (*DeleteAccountTotpTotpIdResponse, error) {
+	rsp, err := c.DeleteAccountTotpTotpId(ctx, totpId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAccountTotpTotpIdResponse(rsp)
+}
+
+// PostAccountTotpTotpIdConfirmWithBodyWithResponse request with arbitrary body returning *PostAccountTotpTotpIdConfirmResponse
+func (c *ClientWithResponses) PostAccountTotpTotpIdConfirmWithBodyWithResponse(ctx context.Context, totpId int64, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAccountTotpTotpIdConfirmResponse, error) {
+	rsp, err := c.PostAccountTotpTotpIdConfirmWithBody(ctx, totpId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAccountTotpTotpIdConfirmResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAccountTotpTotpIdConfirmWithResponse(ctx context.Context, totpId int64, body PostAccountTotpTotpIdConfirmJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAccountTotpTotpIdConfirmResponse, error) {
+	rsp, err := c.PostAccountTotpTotpIdConfirm(ctx, totpId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAccountTotpTotpIdConfirmResponse(rsp)
+}
+
+// GetAdminV1OrbitsWithResponse request returning *GetAdminV1OrbitsResponse
+func (c *ClientWithResponses) GetAdminV1OrbitsWithResponse(ctx context.Context, params *GetAdminV1OrbitsParams, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsResponse, error) {
+	rsp, err := c.GetAdminV1Orbits(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminV1OrbitsResponse(rsp)
+}
+
+// PostAdminV1OrbitsWithBodyWithResponse request with arbitrary body returning *PostAdminV1OrbitsResponse
+func (c *ClientWithResponses) PostAdminV1OrbitsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminV1OrbitsResponse, error) {
+	rsp, err := c.PostAdminV1OrbitsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminV1OrbitsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAdminV1OrbitsWithResponse(ctx context.Context, body PostAdminV1OrbitsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminV1OrbitsResponse, error) {
+	rsp, err := c.PostAdminV1Orbits(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminV1OrbitsResponse(rsp)
+}
+
+// DeleteAdminV1OrbitsOrbitIdWithResponse request returning *DeleteAdminV1OrbitsOrbitIdResponse
+func (c *ClientWithResponses) DeleteAdminV1OrbitsOrbitIdWithResponse(ctx context.Context, orbitId OrbitId, reqEditors ...RequestEditorFn) (*DeleteAdminV1OrbitsOrbitIdResponse, error) {
+	rsp, err := c.DeleteAdminV1OrbitsOrbitId(ctx, orbitId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAdminV1OrbitsOrbitIdResponse(rsp)
+}
+
+// GetAdminV1OrbitsOrbitIdWithResponse request returning *GetAdminV1OrbitsOrbitIdResponse
+func (c *ClientWithResponses) GetAdminV1OrbitsOrbitIdWithResponse(ctx context.Context, orbitId OrbitId, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdResponse, error) {
+	rsp, err := c.GetAdminV1OrbitsOrbitId(ctx, orbitId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminV1OrbitsOrbitIdResponse(rsp)
+}
+
+// PutAdminV1OrbitsOrbitIdWithBodyWithResponse request with arbitrary body returning *PutAdminV1OrbitsOrbitIdResponse
+func (c *ClientWithResponses) PutAdminV1OrbitsOrbitIdWithBodyWithResponse(ctx context.Context, orbitId OrbitId, params *PutAdminV1OrbitsOrbitIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminV1OrbitsOrbitIdResponse, error) {
+	rsp, err := c.PutAdminV1OrbitsOrbitIdWithBody(ctx, orbitId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminV1OrbitsOrbitIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutAdminV1OrbitsOrbitIdWithResponse(ctx context.Context, orbitId OrbitId, params *PutAdminV1OrbitsOrbitIdParams, body PutAdminV1OrbitsOrbitIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminV1OrbitsOrbitIdResponse, error) {
+	rsp, err := c.PutAdminV1OrbitsOrbitId(ctx, orbitId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminV1OrbitsOrbitIdResponse(rsp)
+}
+
+// GetAdminV1OrbitsOrbitIdAuditLogsWithResponse request returning *GetAdminV1OrbitsOrbitIdAuditLogsResponse
+func (c *ClientWithResponses) GetAdminV1OrbitsOrbitIdAuditLogsWithResponse(ctx context.Context, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdAuditLogsParams, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdAuditLogsResponse, error) {
+	rsp, err := c.GetAdminV1OrbitsOrbitIdAuditLogs(ctx, orbitId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminV1OrbitsOrbitIdAuditLogsResponse(rsp)
+}
+
+// GetAdminV1OrbitsOrbitIdClientsWithResponse request returning *GetAdminV1OrbitsOrbitIdClientsResponse
+func (c *ClientWithResponses) GetAdminV1OrbitsOrbitIdClientsWithResponse(ctx context.Context, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdClientsParams, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdClientsResponse, error) {
+	rsp, err := c.GetAdminV1OrbitsOrbitIdClients(ctx, orbitId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminV1OrbitsOrbitIdClientsResponse(rsp)
+}
+
+// PostAdminV1OrbitsOrbitIdClientsWithBodyWithResponse request with arbitrary body returning *PostAdminV1OrbitsOrbitIdClientsResponse
+func (c *ClientWithResponses) PostAdminV1OrbitsOrbitIdClientsWithBodyWithResponse(ctx context.Context, orbitId OrbitId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminV1OrbitsOrbitIdClientsResponse, error) {
+	rsp, err := c.PostAdminV1OrbitsOrbitIdClientsWithBody(ctx, orbitId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminV1OrbitsOrbitIdClientsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAdminV1OrbitsOrbitIdClientsWithResponse(ctx context.Context, orbitId OrbitId, body PostAdminV1OrbitsOrbitIdClientsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminV1OrbitsOrbitIdClientsResponse, error) {
+	rsp, err := c.PostAdminV1OrbitsOrbitIdClients(ctx, orbitId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminV1OrbitsOrbitIdClientsResponse(rsp)
+}
+
+// DeleteAdminV1OrbitsOrbitIdClientsClientIdWithResponse request returning *DeleteAdminV1OrbitsOrbitIdClientsClientIdResponse
+func (c *ClientWithResponses) DeleteAdminV1OrbitsOrbitIdClientsClientIdWithResponse(ctx context.Context, orbitId OrbitId, clientId string, reqEditors ...RequestEditorFn) (*DeleteAdminV1OrbitsOrbitIdClientsClientIdResponse, error) {
+	rsp, err := c.DeleteAdminV1OrbitsOrbitIdClientsClientId(ctx, orbitId, clientId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAdminV1OrbitsOrbitIdClientsClientIdResponse(rsp)
+}
+
+// GetAdminV1OrbitsOrbitIdClientsClientIdWithResponse request returning *GetAdminV1OrbitsOrbitIdClientsClientIdResponse
+func (c *ClientWithResponses) GetAdminV1OrbitsOrbitIdClientsClientIdWithResponse(ctx context.Context, orbitId OrbitId, clientId string, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdClientsClientIdResponse, error) {
+	rsp, err := c.GetAdminV1OrbitsOrbitIdClientsClientId(ctx, orbitId, clientId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminV1OrbitsOrbitIdClientsClientIdResponse(rsp)
+}
+
+// PutAdminV1OrbitsOrbitIdClientsClientIdWithBodyWithResponse request with arbitrary body returning *PutAdminV1OrbitsOrbitIdClientsClientIdResponse
+func (c *ClientWithResponses) PutAdminV1OrbitsOrbitIdClientsClientIdWithBodyWithResponse(ctx context.Context, orbitId OrbitId, clientId string, params *PutAdminV1OrbitsOrbitIdClientsClientIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminV1OrbitsOrbitIdClientsClientIdResponse, error) {
+	rsp, err := c.PutAdminV1OrbitsOrbitIdClientsClientIdWithBody(ctx, orbitId, clientId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminV1OrbitsOrbitIdClientsClientIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutAdminV1OrbitsOrbitIdClientsClientIdWithResponse(ctx context.Context, orbitId OrbitId, clientId string, params *PutAdminV1OrbitsOrbitIdClientsClientIdParams, body PutAdminV1OrbitsOrbitIdClientsClientIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminV1OrbitsOrbitIdClientsClientIdResponse, error) {
+	rsp, err := c.PutAdminV1OrbitsOrbitIdClientsClientId(ctx, orbitId, clientId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminV1OrbitsOrbitIdClientsClientIdResponse(rsp)
+}
+
+// PostAdminV1OrbitsOrbitIdClientsClientIdSecretWithResponse request returning *PostAdminV1OrbitsOrbitIdClientsClientIdSecretResponse
+func (c *ClientWithResponses) PostAdminV1OrbitsOrbitIdClientsClientIdSecretWithResponse(ctx context.Context, orbitId OrbitId, clientId string, reqEditors ...RequestEditorFn) (*PostAdminV1OrbitsOrbitIdClientsClientIdSecretResponse, error) {
+	rsp, err := c.PostAdminV1OrbitsOrbitIdClientsClientIdSecret(ctx, orbitId, clientId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminV1OrbitsOrbitIdClientsClientIdSecretResponse(rsp)
+}
+
+// GetAdminV1OrbitsOrbitIdGroupsWithResponse request returning *GetAdminV1OrbitsOrbitIdGroupsResponse
+func (c *ClientWithResponses) GetAdminV1OrbitsOrbitIdGroupsWithResponse(ctx context.Context, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdGroupsParams, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdGroupsResponse, error) {
+	rsp, err := c.GetAdminV1OrbitsOrbitIdGroups(ctx, orbitId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminV1OrbitsOrbitIdGroupsResponse(rsp)
+}
+
+// PostAdminV1OrbitsOrbitIdGroupsWithBodyWithResponse request with arbitrary body returning *PostAdminV1OrbitsOrbitIdGroupsResponse
+func (c *ClientWithResponses) PostAdminV1OrbitsOrbitIdGroupsWithBodyWithResponse(ctx context.Context, orbitId OrbitId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminV1OrbitsOrbitIdGroupsResponse, error) {
+	rsp, err := c.PostAdminV1OrbitsOrbitIdGroupsWithBody(ctx, orbitId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminV1OrbitsOrbitIdGroupsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAdminV1OrbitsOrbitIdGroupsWithResponse(ctx context.Context, orbitId OrbitId, body PostAdminV1OrbitsOrbitIdGroupsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminV1OrbitsOrbitIdGroupsResponse, error) {
+	rsp, err := c.PostAdminV1OrbitsOrbitIdGroups(ctx, orbitId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminV1OrbitsOrbitIdGroupsResponse(rsp)
+}
+
+// DeleteAdminV1OrbitsOrbitIdGroupsGroupIdWithResponse request returning *DeleteAdminV1OrbitsOrbitIdGroupsGroupIdResponse
+func (c *ClientWithResponses) DeleteAdminV1OrbitsOrbitIdGroupsGroupIdWithResponse(ctx context.Context, orbitId OrbitId, groupId int64, reqEditors ...RequestEditorFn) (*DeleteAdminV1OrbitsOrbitIdGroupsGroupIdResponse, error) {
+	rsp, err := c.DeleteAdminV1OrbitsOrbitIdGroupsGroupId(ctx, orbitId, groupId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAdminV1OrbitsOrbitIdGroupsGroupIdResponse(rsp)
+}
+
+// GetAdminV1OrbitsOrbitIdGroupsGroupIdWithResponse request returning *GetAdminV1OrbitsOrbitIdGroupsGroupIdResponse
+func (c *ClientWithResponses) GetAdminV1OrbitsOrbitIdGroupsGroupIdWithResponse(ctx context.Context, orbitId OrbitId, groupId int64, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdGroupsGroupIdResponse, error) {
+	rsp, err := c.GetAdminV1OrbitsOrbitIdGroupsGroupId(ctx, orbitId, groupId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminV1OrbitsOrbitIdGroupsGroupIdResponse(rsp)
+}
+
+// GetAdminV1OrbitsOrbitIdGroupsGroupIdMembersWithResponse request returning *GetAdminV1OrbitsOrbitIdGroupsGroupIdMembersResponse
+func (c *ClientWithResponses) GetAdminV1OrbitsOrbitIdGroupsGroupIdMembersWithResponse(ctx context.Context, orbitId OrbitId, groupId int64, params *GetAdminV1OrbitsOrbitIdGroupsGroupIdMembersParams, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdGroupsGroupIdMembersResponse, error) {
+	rsp, err := c.GetAdminV1OrbitsOrbitIdGroupsGroupIdMembers(ctx, orbitId, groupId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminV1OrbitsOrbitIdGroupsGroupIdMembersResponse(rsp)
+}
+
+// DeleteAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserIdWithResponse request returning *DeleteAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserIdResponse
+func (c *ClientWithResponses) DeleteAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserIdWithResponse(ctx context.Context, orbitId OrbitId, groupId int64, userId int64, reqEditors ...RequestEditorFn) (*DeleteAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserIdResponse, error) {
+	rsp, err := c.DeleteAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserId(ctx, orbitId, groupId, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserIdResponse(rsp)
+}
+
+// PutAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserIdWithResponse request returning *PutAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserIdResponse
+func (c *ClientWithResponses) PutAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserIdWithResponse(ctx context.Context, orbitId OrbitId, groupId int64, userId int64, reqEditors ...RequestEditorFn) (*PutAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserIdResponse, error) {
+	rsp, err := c.PutAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserId(ctx, orbitId, groupId, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserIdResponse(rsp)
+}
+
+// GetAdminV1OrbitsOrbitIdGroupsGroupIdRolesWithResponse request returning *GetAdminV1OrbitsOrbitIdGroupsGroupIdRolesResponse
+func (c *ClientWithResponses) GetAdminV1OrbitsOrbitIdGroupsGroupIdRolesWithResponse(ctx context.Context, orbitId OrbitId, groupId int64, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdGroupsGroupIdRolesResponse, error) {
+	rsp, err := c.GetAdminV1OrbitsOrbitIdGroupsGroupIdRoles(ctx, orbitId, groupId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminV1OrbitsOrbitIdGroupsGroupIdRolesResponse(rsp)
+}
+
+// DeleteAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleIdWithResponse request returning *DeleteAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleIdResponse
+func (c *ClientWithResponses) DeleteAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleIdWithResponse(ctx context.Context, orbitId OrbitId, groupId int64, roleId int64, reqEditors ...RequestEditorFn) (*DeleteAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleIdResponse, error) {
+	rsp, err := c.DeleteAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleId(ctx, orbitId, groupId, roleId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleIdResponse(rsp)
+}
+
+// PutAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleIdWithResponse request returning *PutAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleIdResponse
+func (c *ClientWithResponses) PutAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleIdWithResponse(ctx context.Context, orbitId OrbitId, groupId int64, roleId int64, reqEditors ...RequestEditorFn) (*PutAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleIdResponse, error) {
+	rsp, err := c.PutAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleId(ctx, orbitId, groupId, roleId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleIdResponse(rsp)
+}
+
+// GetAdminV1OrbitsOrbitIdKeysWithResponse request returning *GetAdminV1OrbitsOrbitIdKeysResponse
+func (c *ClientWithResponses) GetAdminV1OrbitsOrbitIdKeysWithResponse(ctx context.Context, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdKeysParams, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdKeysResponse, error) {
+	rsp, err := c.GetAdminV1OrbitsOrbitIdKeys(ctx, orbitId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminV1OrbitsOrbitIdKeysResponse(rsp)
+}
+
+// PostAdminV1OrbitsOrbitIdKeysWithBodyWithResponse request with arbitrary body returning *PostAdminV1OrbitsOrbitIdKeysResponse
+func (c *ClientWithResponses) PostAdminV1OrbitsOrbitIdKeysWithBodyWithResponse(ctx context.Context, orbitId OrbitId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminV1OrbitsOrbitIdKeysResponse, error) {
+	rsp, err := c.PostAdminV1OrbitsOrbitIdKeysWithBody(ctx, orbitId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminV1OrbitsOrbitIdKeysResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAdminV1OrbitsOrbitIdKeysWithResponse(ctx context.Context, orbitId OrbitId, body PostAdminV1OrbitsOrbitIdKeysJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminV1OrbitsOrbitIdKeysResponse, error) {
+	rsp, err := c.PostAdminV1OrbitsOrbitIdKeys(ctx, orbitId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminV1OrbitsOrbitIdKeysResponse(rsp)
+}
+
+// DeleteAdminV1OrbitsOrbitIdKeysKeyIdWithResponse request returning *DeleteAdminV1OrbitsOrbitIdKeysKeyIdResponse
+func (c *ClientWithResponses) DeleteAdminV1OrbitsOrbitIdKeysKeyIdWithResponse(ctx context.Context, orbitId OrbitId, keyId int64, reqEditors ...RequestEditorFn) (*DeleteAdminV1OrbitsOrbitIdKeysKeyIdResponse, error) {
+	rsp, err := c.DeleteAdminV1OrbitsOrbitIdKeysKeyId(ctx, orbitId, keyId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAdminV1OrbitsOrbitIdKeysKeyIdResponse(rsp)
+}
+
+// GetAdminV1OrbitsOrbitIdKeysKeyIdWithResponse request returning *GetAdminV1OrbitsOrbitIdKeysKeyIdResponse
+func (c *ClientWithResponses) GetAdminV1OrbitsOrbitIdKeysKeyIdWithResponse(ctx context.Context, orbitId OrbitId, keyId int64, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdKeysKeyIdResponse, error) {
+	rsp, err := c.GetAdminV1OrbitsOrbitIdKeysKeyId(ctx, orbitId, keyId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminV1OrbitsOrbitIdKeysKeyIdResponse(rsp)
+}
+
+// PutAdminV1OrbitsOrbitIdKeysKeyIdWithBodyWithResponse request with arbitrary body returning *PutAdminV1OrbitsOrbitIdKeysKeyIdResponse
+func (c *ClientWithResponses) PutAdminV1OrbitsOrbitIdKeysKeyIdWithBodyWithResponse(ctx context.Context, orbitId OrbitId, keyId int64, params *PutAdminV1OrbitsOrbitIdKeysKeyIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminV1OrbitsOrbitIdKeysKeyIdResponse, error) {
+	rsp, err := c.PutAdminV1OrbitsOrbitIdKeysKeyIdWithBody(ctx, orbitId, keyId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminV1OrbitsOrbitIdKeysKeyIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutAdminV1OrbitsOrbitIdKeysKeyIdWithResponse(ctx context.Context, orbitId OrbitId, keyId int64, params *PutAdminV1OrbitsOrbitIdKeysKeyIdParams, body PutAdminV1OrbitsOrbitIdKeysKeyIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminV1OrbitsOrbitIdKeysKeyIdResponse, error) {
+	rsp, err := c.PutAdminV1OrbitsOrbitIdKeysKeyId(ctx, orbitId, keyId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminV1OrbitsOrbitIdKeysKeyIdResponse(rsp)
+}
+
+// GetAdminV1OrbitsOrbitIdPermissionsWithResponse request returning *GetAdminV1OrbitsOrbitIdPermissionsResponse
+func (c *ClientWithResponses) GetAdminV1OrbitsOrbitIdPermissionsWithResponse(ctx context.Context, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdPermissionsParams, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdPermissionsResponse, error) {
+	rsp, err := c.GetAdminV1OrbitsOrbitIdPermissions(ctx, orbitId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminV1OrbitsOrbitIdPermissionsResponse(rsp)
+}
+
+// PostAdminV1OrbitsOrbitIdPermissionsWithBodyWithResponse request with arbitrary body returning *PostAdminV1OrbitsOrbitIdPermissionsResponse
+func (c *ClientWithResponses) PostAdminV1OrbitsOrbitIdPermissionsWithBodyWithResponse(ctx context.Context, orbitId OrbitId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminV1OrbitsOrbitIdPermissionsResponse, error) {
+	rsp, err := c.PostAdminV1OrbitsOrbitIdPermissionsWithBody(ctx, orbitId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminV1OrbitsOrbitIdPermissionsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAdminV1OrbitsOrbitIdPermissionsWithResponse(ctx context.Context, orbitId OrbitId, body PostAdminV1OrbitsOrbitIdPermissionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminV1OrbitsOrbitIdPermissionsResponse, error) {
+	rsp, err := c.PostAdminV1OrbitsOrbitIdPermissions(ctx, orbitId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminV1OrbitsOrbitIdPermissionsResponse(rsp)
+}
+
+// DeleteAdminV1OrbitsOrbitIdPermissionsPermissionIdWithResponse request returning *DeleteAdminV1OrbitsOrbitIdPermissionsPermissionIdResponse
+func (c *ClientWithResponses) DeleteAdminV1OrbitsOrbitIdPermissionsPermissionIdWithResponse(ctx context.Context, orbitId OrbitId, permissionId int64, reqEditors ...RequestEditorFn) (*DeleteAdminV1OrbitsOrbitIdPermissionsPermissionIdResponse, error) {
+	rsp, err := c.DeleteAdminV1OrbitsOrbitIdPermissionsPermissionId(ctx, orbitId, permissionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAdminV1OrbitsOrbitIdPermissionsPermissionIdResponse(rsp)
+}
+
+// GetAdminV1OrbitsOrbitIdPermissionsPermissionIdWithResponse request returning *GetAdminV1OrbitsOrbitIdPermissionsPermissionIdResponse
+func (c *ClientWithResponses) GetAdminV1OrbitsOrbitIdPermissionsPermissionIdWithResponse(ctx context.Context, orbitId OrbitId, permissionId int64, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdPermissionsPermissionIdResponse, error) {
+	rsp, err := c.GetAdminV1OrbitsOrbitIdPermissionsPermissionId(ctx, orbitId, permissionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminV1OrbitsOrbitIdPermissionsPermissionIdResponse(rsp)
+}
+
+// GetAdminV1OrbitsOrbitIdPoliciesWithResponse request returning *GetAdminV1OrbitsOrbitIdPoliciesResponse
+func (c *ClientWithResponses) GetAdminV1OrbitsOrbitIdPoliciesWithResponse(ctx context.Context, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdPoliciesParams, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdPoliciesResponse, error) {
+	rsp, err := c.GetAdminV1OrbitsOrbitIdPolicies(ctx, orbitId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminV1OrbitsOrbitIdPoliciesResponse(rsp)
+}
+
+// PostAdminV1OrbitsOrbitIdPoliciesWithBodyWithResponse request with arbitrary body returning *PostAdminV1OrbitsOrbitIdPoliciesResponse
+func (c *ClientWithResponses) PostAdminV1OrbitsOrbitIdPoliciesWithBodyWithResponse(ctx context.Context, orbitId OrbitId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminV1OrbitsOrbitIdPoliciesResponse, error) {
+	rsp, err := c.PostAdminV1OrbitsOrbitIdPoliciesWithBody(ctx, orbitId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminV1OrbitsOrbitIdPoliciesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAdminV1OrbitsOrbitIdPoliciesWithResponse(ctx context.Context, orbitId OrbitId, body PostAdminV1OrbitsOrbitIdPoliciesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminV1OrbitsOrbitIdPoliciesResponse, error) {
+	rsp, err := c.PostAdminV1OrbitsOrbitIdPolicies(ctx, orbitId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminV1OrbitsOrbitIdPoliciesResponse(rsp)
+}
+
+// DeleteAdminV1OrbitsOrbitIdPoliciesPolicyIdWithResponse request returning *DeleteAdminV1OrbitsOrbitIdPoliciesPolicyIdResponse
+func (c *ClientWithResponses) DeleteAdminV1OrbitsOrbitIdPoliciesPolicyIdWithResponse(ctx context.Context, orbitId OrbitId, policyId int64, reqEditors ...RequestEditorFn) (*DeleteAdminV1OrbitsOrbitIdPoliciesPolicyIdResponse, error) {
+	rsp, err := c.DeleteAdminV1OrbitsOrbitIdPoliciesPolicyId(ctx, orbitId, policyId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAdminV1OrbitsOrbitIdPoliciesPolicyIdResponse(rsp)
+}
+
+// GetAdminV1OrbitsOrbitIdPoliciesPolicyIdWithResponse request returning *GetAdminV1OrbitsOrbitIdPoliciesPolicyIdResponse
+func (c *ClientWithResponses) GetAdminV1OrbitsOrbitIdPoliciesPolicyIdWithResponse(ctx context.Context, orbitId OrbitId, policyId int64, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdPoliciesPolicyIdResponse, error) {
+	rsp, err := c.GetAdminV1OrbitsOrbitIdPoliciesPolicyId(ctx, orbitId, policyId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminV1OrbitsOrbitIdPoliciesPolicyIdResponse(rsp)
+}
+
+// PutAdminV1OrbitsOrbitIdPoliciesPolicyIdWithBodyWithResponse request with arbitrary body returning *PutAdminV1OrbitsOrbitIdPoliciesPolicyIdResponse
+func (c *ClientWithResponses) PutAdminV1OrbitsOrbitIdPoliciesPolicyIdWithBodyWithResponse(ctx context.Context, orbitId OrbitId, policyId int64, params *PutAdminV1OrbitsOrbitIdPoliciesPolicyIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminV1OrbitsOrbitIdPoliciesPolicyIdResponse, error) {
+	rsp, err := c.PutAdminV1OrbitsOrbitIdPoliciesPolicyIdWithBody(ctx, orbitId, policyId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminV1OrbitsOrbitIdPoliciesPolicyIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutAdminV1OrbitsOrbitIdPoliciesPolicyIdWithResponse(ctx context.Context, orbitId OrbitId, policyId int64, params *PutAdminV1OrbitsOrbitIdPoliciesPolicyIdParams, body PutAdminV1OrbitsOrbitIdPoliciesPolicyIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminV1OrbitsOrbitIdPoliciesPolicyIdResponse, error) {
+	rsp, err := c.PutAdminV1OrbitsOrbitIdPoliciesPolicyId(ctx, orbitId, policyId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminV1OrbitsOrbitIdPoliciesPolicyIdResponse(rsp)
+}
+
+// GetAdminV1OrbitsOrbitIdPolicyDecisionsWithResponse request returning *GetAdminV1OrbitsOrbitIdPolicyDecisionsResponse
+func (c *ClientWithResponses) GetAdminV1OrbitsOrbitIdPolicyDecisionsWithResponse(ctx context.Context, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdPolicyDecisionsParams, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdPolicyDecisionsResponse, error) {
+	rsp, err := c.GetAdminV1OrbitsOrbitIdPolicyDecisions(ctx, orbitId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminV1OrbitsOrbitIdPolicyDecisionsResponse(rsp)
+}
+
+// GetAdminV1OrbitsOrbitIdRolesWithResponse request returning *GetAdminV1OrbitsOrbitIdRolesResponse
+func (c *ClientWithResponses) GetAdminV1OrbitsOrbitIdRolesWithResponse(ctx context.Context, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdRolesParams, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdRolesResponse, error) {
+	rsp, err := c.GetAdminV1OrbitsOrbitIdRoles(ctx, orbitId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminV1OrbitsOrbitIdRolesResponse(rsp)
+}
+
+// PostAdminV1OrbitsOrbitIdRolesWithBodyWithResponse request with arbitrary body returning *PostAdminV1OrbitsOrbitIdRolesResponse
+func (c *ClientWithResponses) PostAdminV1OrbitsOrbitIdRolesWithBodyWithResponse(ctx context.Context, orbitId OrbitId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminV1OrbitsOrbitIdRolesResponse, error) {
+	rsp, err := c.PostAdminV1OrbitsOrbitIdRolesWithBody(ctx, orbitId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminV1OrbitsOrbitIdRolesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAdminV1OrbitsOrbitIdRolesWithResponse(ctx context.Context, orbitId OrbitId, body PostAdminV1OrbitsOrbitIdRolesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminV1OrbitsOrbitIdRolesResponse, error) {
+	rsp, err := c.PostAdminV1OrbitsOrbitIdRoles(ctx, orbitId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminV1OrbitsOrbitIdRolesResponse(rsp)
+}
+
+// DeleteAdminV1OrbitsOrbitIdRolesRoleIdWithResponse request returning *DeleteAdminV1OrbitsOrbitIdRolesRoleIdResponse
+func (c *ClientWithResponses) DeleteAdminV1OrbitsOrbitIdRolesRoleIdWithResponse(ctx context.Context, orbitId OrbitId, roleId int64, reqEditors ...RequestEditorFn) (*DeleteAdminV1OrbitsOrbitIdRolesRoleIdResponse, error) {
+	rsp, err := c.DeleteAdminV1OrbitsOrbitIdRolesRoleId(ctx, orbitId, roleId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAdminV1OrbitsOrbitIdRolesRoleIdResponse(rsp)
+}
+
+// GetAdminV1OrbitsOrbitIdRolesRoleIdWithResponse request returning *GetAdminV1OrbitsOrbitIdRolesRoleIdResponse
+func (c *ClientWithResponses) GetAdminV1OrbitsOrbitIdRolesRoleIdWithResponse(ctx context.Context, orbitId OrbitId, roleId int64, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdRolesRoleIdResponse, error) {
+	rsp, err := c.GetAdminV1OrbitsOrbitIdRolesRoleId(ctx, orbitId, roleId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminV1OrbitsOrbitIdRolesRoleIdResponse(rsp)
+}
+
+// GetAdminV1OrbitsOrbitIdRolesRoleIdIncludesWithResponse request returning *GetAdminV1OrbitsOrbitIdRolesRoleIdIncludesResponse
+func (c *ClientWithResponses) GetAdminV1OrbitsOrbitIdRolesRoleIdIncludesWithResponse(ctx context.Context, orbitId OrbitId, roleId int64, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdRolesRoleIdIncludesResponse, error) {
+	rsp, err := c.GetAdminV1OrbitsOrbitIdRolesRoleIdIncludes(ctx, orbitId, roleId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminV1OrbitsOrbitIdRolesRoleIdIncludesResponse(rsp)
+}
+
+// DeleteAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleIdWithResponse request returning *DeleteAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleIdResponse
+func (c *ClientWithResponses) DeleteAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleIdWithResponse(ctx context.Context, orbitId OrbitId, roleId int64, includedRoleId int64, reqEditors ...RequestEditorFn) (*DeleteAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleIdResponse, error) {
+	rsp, err := c.DeleteAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleId(ctx, orbitId, roleId, includedRoleId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleIdResponse(rsp)
+}
+
+// PutAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleIdWithResponse request returning *PutAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleIdResponse
+func (c *ClientWithResponses) PutAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleIdWithResponse(ctx context.Context, orbitId OrbitId, roleId int64, includedRoleId int64, reqEditors ...RequestEditorFn) (*PutAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleIdResponse, error) {
+	rsp, err := c.PutAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleId(ctx, orbitId, roleId, includedRoleId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleIdResponse(rsp)
+}
+
+// GetAdminV1OrbitsOrbitIdRolesRoleIdPermissionsWithResponse request returning *GetAdminV1OrbitsOrbitIdRolesRoleIdPermissionsResponse
+func (c *ClientWithResponses) GetAdminV1OrbitsOrbitIdRolesRoleIdPermissionsWithResponse(ctx context.Context, orbitId OrbitId, roleId int64, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdRolesRoleIdPermissionsResponse, error) {
+	rsp, err := c.GetAdminV1OrbitsOrbitIdRolesRoleIdPermissions(ctx, orbitId, roleId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminV1OrbitsOrbitIdRolesRoleIdPermissionsResponse(rsp)
+}
+
+// DeleteAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionIdWithResponse request returning *DeleteAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionIdResponse
+func (c *ClientWithResponses) DeleteAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionIdWithResponse(ctx context.Context, orbitId OrbitId, roleId int64, permissionId int64, reqEditors ...RequestEditorFn) (*DeleteAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionIdResponse, error) {
+	rsp, err := c.DeleteAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionId(ctx, orbitId, roleId, permissionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionIdResponse(rsp)
+}
+
+// PutAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionIdWithResponse request returning *PutAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionIdResponse
+func (c *ClientWithResponses) PutAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionIdWithResponse(ctx context.Context, orbitId OrbitId, roleId int64, permissionId int64, reqEditors ...RequestEditorFn) (*PutAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionIdResponse, error) {
+	rsp, err := c.PutAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionId(ctx, orbitId, roleId, permissionId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionIdResponse(rsp)
+}
+
+// GetAdminV1OrbitsOrbitIdScopesWithResponse request returning *GetAdminV1OrbitsOrbitIdScopesResponse
+func (c *ClientWithResponses) GetAdminV1OrbitsOrbitIdScopesWithResponse(ctx context.Context, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdScopesParams, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdScopesResponse, error) {
+	rsp, err := c.GetAdminV1OrbitsOrbitIdScopes(ctx, orbitId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminV1OrbitsOrbitIdScopesResponse(rsp)
+}
+
+// PostAdminV1OrbitsOrbitIdScopesWithBodyWithResponse request with arbitrary body returning *PostAdminV1OrbitsOrbitIdScopesResponse
+func (c *ClientWithResponses) PostAdminV1OrbitsOrbitIdScopesWithBodyWithResponse(ctx context.Context, orbitId OrbitId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminV1OrbitsOrbitIdScopesResponse, error) {
+	rsp, err := c.PostAdminV1OrbitsOrbitIdScopesWithBody(ctx, orbitId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminV1OrbitsOrbitIdScopesResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAdminV1OrbitsOrbitIdScopesWithResponse(ctx context.Context, orbitId OrbitId, body PostAdminV1OrbitsOrbitIdScopesJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminV1OrbitsOrbitIdScopesResponse, error) {
+	rsp, err := c.PostAdminV1OrbitsOrbitIdScopes(ctx, orbitId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminV1OrbitsOrbitIdScopesResponse(rsp)
+}
+
+// DeleteAdminV1OrbitsOrbitIdScopesScopeIdWithResponse request returning *DeleteAdminV1OrbitsOrbitIdScopesScopeIdResponse
+func (c *ClientWithResponses) DeleteAdminV1OrbitsOrbitIdScopesScopeIdWithResponse(ctx context.Context, orbitId OrbitId, scopeId int64, reqEditors ...RequestEditorFn) (*DeleteAdminV1OrbitsOrbitIdScopesScopeIdResponse, error) {
+	rsp, err := c.DeleteAdminV1OrbitsOrbitIdScopesScopeId(ctx, orbitId, scopeId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAdminV1OrbitsOrbitIdScopesScopeIdResponse(rsp)
+}
+
+// GetAdminV1OrbitsOrbitIdScopesScopeIdWithResponse request returning *GetAdminV1OrbitsOrbitIdScopesScopeIdResponse
+func (c *ClientWithResponses) GetAdminV1OrbitsOrbitIdScopesScopeIdWithResponse(ctx context.Context, orbitId OrbitId, scopeId int64, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdScopesScopeIdResponse, error) {
+	rsp, err := c.GetAdminV1OrbitsOrbitIdScopesScopeId(ctx, orbitId, scopeId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminV1OrbitsOrbitIdScopesScopeIdResponse(rsp)
+}
+
+// PutAdminV1OrbitsOrbitIdScopesScopeIdWithBodyWithResponse request with arbitrary body returning *PutAdminV1OrbitsOrbitIdScopesScopeIdResponse
+func (c *ClientWithResponses) PutAdminV1OrbitsOrbitIdScopesScopeIdWithBodyWithResponse(ctx context.Context, orbitId OrbitId, scopeId int64, params *PutAdminV1OrbitsOrbitIdScopesScopeIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminV1OrbitsOrbitIdScopesScopeIdResponse, error) {
+	rsp, err := c.PutAdminV1OrbitsOrbitIdScopesScopeIdWithBody(ctx, orbitId, scopeId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminV1OrbitsOrbitIdScopesScopeIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutAdminV1OrbitsOrbitIdScopesScopeIdWithResponse(ctx context.Context, orbitId OrbitId, scopeId int64, params *PutAdminV1OrbitsOrbitIdScopesScopeIdParams, body PutAdminV1OrbitsOrbitIdScopesScopeIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminV1OrbitsOrbitIdScopesScopeIdResponse, error) {
+	rsp, err := c.PutAdminV1OrbitsOrbitIdScopesScopeId(ctx, orbitId, scopeId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminV1OrbitsOrbitIdScopesScopeIdResponse(rsp)
+}
+
+// GetAdminV1OrbitsOrbitIdUsersWithResponse request returning *GetAdminV1OrbitsOrbitIdUsersResponse
+func (c *ClientWithResponses) GetAdminV1OrbitsOrbitIdUsersWithResponse(ctx context.Context, orbitId OrbitId, params *GetAdminV1OrbitsOrbitIdUsersParams, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdUsersResponse, error) {
+	rsp, err := c.GetAdminV1OrbitsOrbitIdUsers(ctx, orbitId, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminV1OrbitsOrbitIdUsersResponse(rsp)
+}
+
+// PostAdminV1OrbitsOrbitIdUsersWithBodyWithResponse request with arbitrary body returning *PostAdminV1OrbitsOrbitIdUsersResponse
+func (c *ClientWithResponses) PostAdminV1OrbitsOrbitIdUsersWithBodyWithResponse(ctx context.Context, orbitId OrbitId, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAdminV1OrbitsOrbitIdUsersResponse, error) {
+	rsp, err := c.PostAdminV1OrbitsOrbitIdUsersWithBody(ctx, orbitId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminV1OrbitsOrbitIdUsersResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAdminV1OrbitsOrbitIdUsersWithResponse(ctx context.Context, orbitId OrbitId, body PostAdminV1OrbitsOrbitIdUsersJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAdminV1OrbitsOrbitIdUsersResponse, error) {
+	rsp, err := c.PostAdminV1OrbitsOrbitIdUsers(ctx, orbitId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminV1OrbitsOrbitIdUsersResponse(rsp)
+}
+
+// DeleteAdminV1OrbitsOrbitIdUsersUserIdWithResponse request returning *DeleteAdminV1OrbitsOrbitIdUsersUserIdResponse
+func (c *ClientWithResponses) DeleteAdminV1OrbitsOrbitIdUsersUserIdWithResponse(ctx context.Context, orbitId OrbitId, userId int64, reqEditors ...RequestEditorFn) (*DeleteAdminV1OrbitsOrbitIdUsersUserIdResponse, error) {
+	rsp, err := c.DeleteAdminV1OrbitsOrbitIdUsersUserId(ctx, orbitId, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAdminV1OrbitsOrbitIdUsersUserIdResponse(rsp)
+}
+
+// GetAdminV1OrbitsOrbitIdUsersUserIdWithResponse request returning *GetAdminV1OrbitsOrbitIdUsersUserIdResponse
+func (c *ClientWithResponses) GetAdminV1OrbitsOrbitIdUsersUserIdWithResponse(ctx context.Context, orbitId OrbitId, userId int64, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdUsersUserIdResponse, error) {
+	rsp, err := c.GetAdminV1OrbitsOrbitIdUsersUserId(ctx, orbitId, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminV1OrbitsOrbitIdUsersUserIdResponse(rsp)
+}
+
+// PutAdminV1OrbitsOrbitIdUsersUserIdWithBodyWithResponse request with arbitrary body returning *PutAdminV1OrbitsOrbitIdUsersUserIdResponse
+func (c *ClientWithResponses) PutAdminV1OrbitsOrbitIdUsersUserIdWithBodyWithResponse(ctx context.Context, orbitId OrbitId, userId int64, params *PutAdminV1OrbitsOrbitIdUsersUserIdParams, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutAdminV1OrbitsOrbitIdUsersUserIdResponse, error) {
+	rsp, err := c.PutAdminV1OrbitsOrbitIdUsersUserIdWithBody(ctx, orbitId, userId, params, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminV1OrbitsOrbitIdUsersUserIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutAdminV1OrbitsOrbitIdUsersUserIdWithResponse(ctx context.Context, orbitId OrbitId, userId int64, params *PutAdminV1OrbitsOrbitIdUsersUserIdParams, body PutAdminV1OrbitsOrbitIdUsersUserIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutAdminV1OrbitsOrbitIdUsersUserIdResponse, error) {
+	rsp, err := c.PutAdminV1OrbitsOrbitIdUsersUserId(ctx, orbitId, userId, params, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminV1OrbitsOrbitIdUsersUserIdResponse(rsp)
+}
+
+// GetAdminV1OrbitsOrbitIdUsersUserIdPermissionsWithResponse request returning *GetAdminV1OrbitsOrbitIdUsersUserIdPermissionsResponse
+func (c *ClientWithResponses) GetAdminV1OrbitsOrbitIdUsersUserIdPermissionsWithResponse(ctx context.Context, orbitId OrbitId, userId int64, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdUsersUserIdPermissionsResponse, error) {
+	rsp, err := c.GetAdminV1OrbitsOrbitIdUsersUserIdPermissions(ctx, orbitId, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminV1OrbitsOrbitIdUsersUserIdPermissionsResponse(rsp)
+}
+
+// GetAdminV1OrbitsOrbitIdUsersUserIdRolesWithResponse request returning *GetAdminV1OrbitsOrbitIdUsersUserIdRolesResponse
+func (c *ClientWithResponses) GetAdminV1OrbitsOrbitIdUsersUserIdRolesWithResponse(ctx context.Context, orbitId OrbitId, userId int64, reqEditors ...RequestEditorFn) (*GetAdminV1OrbitsOrbitIdUsersUserIdRolesResponse, error) {
+	rsp, err := c.GetAdminV1OrbitsOrbitIdUsersUserIdRoles(ctx, orbitId, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAdminV1OrbitsOrbitIdUsersUserIdRolesResponse(rsp)
+}
+
+// DeleteAdminV1OrbitsOrbitIdUsersUserIdRolesRoleIdWithResponse request returning *DeleteAdminV1OrbitsOrbitIdUsersUserIdRolesRoleIdResponse
+func (c *ClientWithResponses) DeleteAdminV1OrbitsOrbitIdUsersUserIdRolesRoleIdWithResponse(ctx context.Context, orbitId OrbitId, userId int64, roleId int64, reqEditors ...RequestEditorFn) (*DeleteAdminV1OrbitsOrbitIdUsersUserIdRolesRoleIdResponse, error) {
+	rsp, err := c.DeleteAdminV1OrbitsOrbitIdUsersUserIdRolesRoleId(ctx, orbitId, userId, roleId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteAdminV1OrbitsOrbitIdUsersUserIdRolesRoleIdResponse(rsp)
+}
+
+// PutAdminV1OrbitsOrbitIdUsersUserIdRolesRoleIdWithResponse request returning *PutAdminV1OrbitsOrbitIdUsersUserIdRolesRoleIdResponse
+func (c *ClientWithResponses) PutAdminV1OrbitsOrbitIdUsersUserIdRolesRoleIdWithResponse(ctx context.Context, orbitId OrbitId, userId int64, roleId int64, reqEditors ...RequestEditorFn) (*PutAdminV1OrbitsOrbitIdUsersUserIdRolesRoleIdResponse, error) {
+	rsp, err := c.PutAdminV1OrbitsOrbitIdUsersUserIdRolesRoleId(ctx, orbitId, userId, roleId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutAdminV1OrbitsOrbitIdUsersUserIdRolesRoleIdResponse(rsp)
+}
+
+// PostAdminV1OrbitsOrbitIdUsersUserIdUnlockWithResponse request returning *PostAdminV1OrbitsOrbitIdUsersUserIdUnlockResponse
+func (c *ClientWithResponses) PostAdminV1OrbitsOrbitIdUsersUserIdUnlockWithResponse(ctx context.Context, orbitId OrbitId, userId int64, reqEditors ...RequestEditorFn) (*PostAdminV1OrbitsOrbitIdUsersUserIdUnlockResponse, error) {
+	rsp, err := c.PostAdminV1OrbitsOrbitIdUsersUserIdUnlock(ctx, orbitId, userId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAdminV1OrbitsOrbitIdUsersUserIdUnlockResponse(rsp)
+}
+
+// GetAuthorizeWithResponse request returning *GetAuthorizeResponse
+func (c *ClientWithResponses) GetAuthorizeWithResponse(ctx context.Context, params *GetAuthorizeParams, reqEditors ...RequestEditorFn) (*GetAuthorizeResponse, error) {
+	rsp, err := c.GetAuthorize(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetAuthorizeResponse(rsp)
+}
+
+// PostAuthzEvaluateWithBodyWithResponse request with arbitrary body returning *PostAuthzEvaluateResponse
+func (c *ClientWithResponses) PostAuthzEvaluateWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostAuthzEvaluateResponse, error) {
+	rsp, err := c.PostAuthzEvaluateWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthzEvaluateResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostAuthzEvaluateWithResponse(ctx context.Context, body PostAuthzEvaluateJSONRequestBody, reqEditors ...RequestEditorFn) (*PostAuthzEvaluateResponse, error) {
+	rsp, err := c.PostAuthzEvaluate(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostAuthzEvaluateResponse(rsp)
+}
+
+// GetConsentWithResponse request returning *GetConsentResponse
+func (c *ClientWithResponses) GetConsentWithResponse(ctx context.Context, params *GetConsentParams, reqEditors ...RequestEditorFn) (*GetConsentResponse, error) {
+	rsp, err := c.GetConsent(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetConsentResponse(rsp)
+}
+
+// PostConsentWithBodyWithResponse request with arbitrary body returning *PostConsentResponse
+func (c *ClientWithResponses) PostConsentWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostConsentResponse, error) {
+	rsp, err := c.PostConsentWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostConsentResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostConsentWithFormdataBodyWithResponse(ctx context.Context, body PostConsentFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostConsentResponse, error) {
+	rsp, err := c.PostConsentWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostConsentResponse(rsp)
+}
+
+// GetEmailVerifyWithResponse request returning *GetEmailVerifyResponse
+func (c *ClientWithResponses) GetEmailVerifyWithResponse(ctx context.Context, params *GetEmailVerifyParams, reqEditors ...RequestEditorFn) (*GetEmailVerifyResponse, error) {
+	rsp, err := c.GetEmailVerify(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetEmailVerifyResponse(rsp)
+}
+
+// PostIntrospectWithBodyWithResponse request with arbitrary body returning *PostIntrospectResponse
+func (c *ClientWithResponses) PostIntrospectWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostIntrospectResponse, error) {
+	rsp, err := c.PostIntrospectWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostIntrospectResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostIntrospectWithFormdataBodyWithResponse(ctx context.Context, body PostIntrospectFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostIntrospectResponse, error) {
+	rsp, err := c.PostIntrospectWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostIntrospectResponse(rsp)
+}
+
+// GetLoginWithResponse request returning *GetLoginResponse
+func (c *ClientWithResponses) GetLoginWithResponse(ctx context.Context, params *GetLoginParams, reqEditors ...RequestEditorFn) (*GetLoginResponse, error) {
+	rsp, err := c.GetLogin(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLoginResponse(rsp)
+}
+
+// PostLoginWithBodyWithResponse request with arbitrary body returning *PostLoginResponse
+func (c *ClientWithResponses) PostLoginWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLoginResponse, error) {
+	rsp, err := c.PostLoginWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostLoginResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostLoginWithFormdataBodyWithResponse(ctx context.Context, body PostLoginFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostLoginResponse, error) {
+	rsp, err := c.PostLoginWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostLoginResponse(rsp)
+}
+
+// GetLoginMfaWithResponse request returning *GetLoginMfaResponse
+func (c *ClientWithResponses) GetLoginMfaWithResponse(ctx context.Context, params *GetLoginMfaParams, reqEditors ...RequestEditorFn) (*GetLoginMfaResponse, error) {
+	rsp, err := c.GetLoginMfa(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetLoginMfaResponse(rsp)
+}
+
+// PostLoginMfaWithBodyWithResponse request with arbitrary body returning *PostLoginMfaResponse
+func (c *ClientWithResponses) PostLoginMfaWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLoginMfaResponse, error) {
+	rsp, err := c.PostLoginMfaWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostLoginMfaResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostLoginMfaWithFormdataBodyWithResponse(ctx context.Context, body PostLoginMfaFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostLoginMfaResponse, error) {
+	rsp, err := c.PostLoginMfaWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostLoginMfaResponse(rsp)
+}
+
+// PostLoginPasskeyWithBodyWithResponse request with arbitrary body returning *PostLoginPasskeyResponse
+func (c *ClientWithResponses) PostLoginPasskeyWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLoginPasskeyResponse, error) {
+	rsp, err := c.PostLoginPasskeyWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostLoginPasskeyResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostLoginPasskeyWithFormdataBodyWithResponse(ctx context.Context, body PostLoginPasskeyFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostLoginPasskeyResponse, error) {
+	rsp, err := c.PostLoginPasskeyWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostLoginPasskeyResponse(rsp)
+}
+
+// PostLoginPasskeyOptionsWithBodyWithResponse request with arbitrary body returning *PostLoginPasskeyOptionsResponse
+func (c *ClientWithResponses) PostLoginPasskeyOptionsWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLoginPasskeyOptionsResponse, error) {
+	rsp, err := c.PostLoginPasskeyOptionsWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostLoginPasskeyOptionsResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostLoginPasskeyOptionsWithResponse(ctx context.Context, body PostLoginPasskeyOptionsJSONRequestBody, reqEditors ...RequestEditorFn) (*PostLoginPasskeyOptionsResponse, error) {
+	rsp, err := c.PostLoginPasskeyOptions(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostLoginPasskeyOptionsResponse(rsp)
+}
+
+// PostLogoutWithBodyWithResponse request with arbitrary body returning *PostLogoutResponse
+func (c *ClientWithResponses) PostLogoutWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostLogoutResponse, error) {
+	rsp, err := c.PostLogoutWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostLogoutResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostLogoutWithResponse(ctx context.Context, body PostLogoutJSONRequestBody, reqEditors ...RequestEditorFn) (*PostLogoutResponse, error) {
+	rsp, err := c.PostLogout(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostLogoutResponse(rsp)
+}
+
+// GetPasswordForgotWithResponse request returning *GetPasswordForgotResponse
+func (c *ClientWithResponses) GetPasswordForgotWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetPasswordForgotResponse, error) {
+	rsp, err := c.GetPasswordForgot(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPasswordForgotResponse(rsp)
+}
+
+// PostPasswordForgotWithBodyWithResponse request with arbitrary body returning *PostPasswordForgotResponse
+func (c *ClientWithResponses) PostPasswordForgotWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPasswordForgotResponse, error) {
+	rsp, err := c.PostPasswordForgotWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPasswordForgotResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPasswordForgotWithFormdataBodyWithResponse(ctx context.Context, body PostPasswordForgotFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostPasswordForgotResponse, error) {
+	rsp, err := c.PostPasswordForgotWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPasswordForgotResponse(rsp)
+}
+
+// GetPasswordResetWithResponse request returning *GetPasswordResetResponse
+func (c *ClientWithResponses) GetPasswordResetWithResponse(ctx context.Context, params *GetPasswordResetParams, reqEditors ...RequestEditorFn) (*GetPasswordResetResponse, error) {
+	rsp, err := c.GetPasswordReset(ctx, params, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetPasswordResetResponse(rsp)
+}
+
+// PostPasswordResetWithBodyWithResponse request with arbitrary body returning *PostPasswordResetResponse
+func (c *ClientWithResponses) PostPasswordResetWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostPasswordResetResponse, error) {
+	rsp, err := c.PostPasswordResetWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPasswordResetResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostPasswordResetWithFormdataBodyWithResponse(ctx context.Context, body PostPasswordResetFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostPasswordResetResponse, error) {
+	rsp, err := c.PostPasswordResetWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostPasswordResetResponse(rsp)
+}
+
+// PostRegisterWithBodyWithResponse request with arbitrary body returning *PostRegisterResponse
+func (c *ClientWithResponses) PostRegisterWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRegisterResponse, error) {
+	rsp, err := c.PostRegisterWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRegisterResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostRegisterWithResponse(ctx context.Context, body PostRegisterJSONRequestBody, reqEditors ...RequestEditorFn) (*PostRegisterResponse, error) {
+	rsp, err := c.PostRegister(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRegisterResponse(rsp)
+}
+
+// DeleteRegisterClientIdWithResponse request returning *DeleteRegisterClientIdResponse
+func (c *ClientWithResponses) DeleteRegisterClientIdWithResponse(ctx context.Context, clientId string, reqEditors ...RequestEditorFn) (*DeleteRegisterClientIdResponse, error) {
+	rsp, err := c.DeleteRegisterClientId(ctx, clientId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseDeleteRegisterClientIdResponse(rsp)
+}
+
+// GetRegisterClientIdWithResponse request returning *GetRegisterClientIdResponse
+func (c *ClientWithResponses) GetRegisterClientIdWithResponse(ctx context.Context, clientId string, reqEditors ...RequestEditorFn) (*GetRegisterClientIdResponse, error) {
+	rsp, err := c.GetRegisterClientId(ctx, clientId, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetRegisterClientIdResponse(rsp)
+}
+
+// PutRegisterClientIdWithBodyWithResponse request with arbitrary body returning *PutRegisterClientIdResponse
+func (c *ClientWithResponses) PutRegisterClientIdWithBodyWithResponse(ctx context.Context, clientId string, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PutRegisterClientIdResponse, error) {
+	rsp, err := c.PutRegisterClientIdWithBody(ctx, clientId, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutRegisterClientIdResponse(rsp)
+}
+
+func (c *ClientWithResponses) PutRegisterClientIdWithResponse(ctx context.Context, clientId string, body PutRegisterClientIdJSONRequestBody, reqEditors ...RequestEditorFn) (*PutRegisterClientIdResponse, error) {
+	rsp, err := c.PutRegisterClientId(ctx, clientId, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePutRegisterClientIdResponse(rsp)
+}
+
+// PostRevokeWithBodyWithResponse request with arbitrary body returning *PostRevokeResponse
+func (c *ClientWithResponses) PostRevokeWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostRevokeResponse, error) {
+	rsp, err := c.PostRevokeWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRevokeResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostRevokeWithFormdataBodyWithResponse(ctx context.Context, body PostRevokeFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostRevokeResponse, error) {
+	rsp, err := c.PostRevokeWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostRevokeResponse(rsp)
+}
+
+// PostTokenWithBodyWithResponse request with arbitrary body returning *PostTokenResponse
+func (c *ClientWithResponses) PostTokenWithBodyWithResponse(ctx context.Context, contentType string, body io.Reader, reqEditors ...RequestEditorFn) (*PostTokenResponse, error) {
+	rsp, err := c.PostTokenWithBody(ctx, contentType, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTokenResponse(rsp)
+}
+
+func (c *ClientWithResponses) PostTokenWithFormdataBodyWithResponse(ctx context.Context, body PostTokenFormdataRequestBody, reqEditors ...RequestEditorFn) (*PostTokenResponse, error) {
+	rsp, err := c.PostTokenWithFormdataBody(ctx, body, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParsePostTokenResponse(rsp)
+}
+
+// GetUserinfoWithResponse request returning *GetUserinfoResponse
+func (c *ClientWithResponses) GetUserinfoWithResponse(ctx context.Context, reqEditors ...RequestEditorFn) (*GetUserinfoResponse, error) {
+	rsp, err := c.GetUserinfo(ctx, reqEditors...)
+	if err != nil {
+		return nil, err
+	}
+	return ParseGetUserinfoResponse(rsp)
+}
+
+// ParseGetWellKnownJwksJsonResponse parses an HTTP response from a GetWellKnownJwksJsonWithResponse call
+func ParseGetWellKnownJwksJsonResponse(rsp *http.Response) (*GetWellKnownJwksJsonResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWellKnownJwksJsonResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest JWKSet
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetWellKnownOpenidConfigurationResponse parses an HTTP response from a GetWellKnownOpenidConfigurationWithResponse call
+func ParseGetWellKnownOpenidConfigurationResponse(rsp *http.Response) (*GetWellKnownOpenidConfigurationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetWellKnownOpenidConfigurationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			AcrValuesSupported               *[]string `json:"acr_values_supported,omitempty"`
+			AuthorizationEndpoint            *string   `json:"authorization_endpoint,omitempty"`
+			IdTokenSigningAlgValuesSupported *[]string `json:"id_token_signing_alg_values_supported,omitempty"`
+			Issuer                           *string   `json:"issuer,omitempty"`
+			JwksUri                          *string   `json:"jwks_uri,omitempty"`
+			ResponseTypesSupported           *[]string `json:"response_types_supported,omitempty"`
+			ScopesSupported                  *[]string `json:"scopes_supported,omitempty"`
+			SubjectTypesSupported            *[]string `json:"subject_types_supported,omitempty"`
+			TokenEndpoint                    *string   `json:"token_endpoint,omitempty"`
+			UserinfoEndpoint                 *string   `json:"userinfo_endpoint,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAccountConsentsResponse parses an HTTP response from a GetAccountConsentsWithResponse call
+func ParseGetAccountConsentsResponse(rsp *http.Response) (*GetAccountConsentsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAccountConsentsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Consent
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAccountConsentsConsentIdResponse parses an HTTP response from a DeleteAccountConsentsConsentIdWithResponse call
+func ParseDeleteAccountConsentsConsentIdResponse(rsp *http.Response) (*DeleteAccountConsentsConsentIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAccountConsentsConsentIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAccountEmailVerificationResponse parses an HTTP response from a PostAccountEmailVerificationWithResponse call
+func ParsePostAccountEmailVerificationResponse(rsp *http.Response) (*PostAccountEmailVerificationResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAccountEmailVerificationResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 429:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON429 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAccountPasskeysResponse parses an HTTP response from a GetAccountPasskeysWithResponse call
+func ParseGetAccountPasskeysResponse(rsp *http.Response) (*GetAccountPasskeysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAccountPasskeysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Passkey
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAccountPasskeysRegistrationsResponse parses an HTTP response from a PostAccountPasskeysRegistrationsWithResponse call
+func ParsePostAccountPasskeysRegistrationsResponse(rsp *http.Response) (*PostAccountPasskeysRegistrationsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAccountPasskeysRegistrationsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PasskeyOptions
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAccountPasskeysRegistrationsCeremonyIdResponse parses an HTTP response from a PostAccountPasskeysRegistrationsCeremonyIdWithResponse call
+func ParsePostAccountPasskeysRegistrationsCeremonyIdResponse(rsp *http.Response) (*PostAccountPasskeysRegistrationsCeremonyIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAccountPasskeysRegistrationsCeremonyIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Passkey
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAccountPasskeysPasskeyIdResponse parses an HTTP response from a DeleteAccountPasskeysPasskeyIdWithResponse call
+func ParseDeleteAccountPasskeysPasskeyIdResponse(rsp *http.Response) (*DeleteAccountPasskeysPasskeyIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAccountPasskeysPasskeyIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAccountRecoveryCodesResponse parses an HTTP response from a GetAccountRecoveryCodesWithResponse call
+func ParseGetAccountRecoveryCodesResponse(rsp *http.Response) (*GetAccountRecoveryCodesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAccountRecoveryCodesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RecoveryCodeStatus
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAccountRecoveryCodesResponse parses an HTTP response from a PostAccountRecoveryCodesWithResponse call
+func ParsePostAccountRecoveryCodesResponse(rsp *http.Response) (*PostAccountRecoveryCodesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAccountRecoveryCodesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest RecoveryCodes
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAccountSessionsResponse parses an HTTP response from a DeleteAccountSessionsWithResponse call
+func ParseDeleteAccountSessionsResponse(rsp *http.Response) (*DeleteAccountSessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAccountSessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAccountSessionsResponse parses an HTTP response from a GetAccountSessionsWithResponse call
+func ParseGetAccountSessionsResponse(rsp *http.Response) (*GetAccountSessionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAccountSessionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Session
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAccountSessionsSessionIdResponse parses an HTTP response from a DeleteAccountSessionsSessionIdWithResponse call
+func ParseDeleteAccountSessionsSessionIdResponse(rsp *http.Response) (*DeleteAccountSessionsSessionIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAccountSessionsSessionIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAccountTotpResponse parses an HTTP response from a GetAccountTotpWithResponse call
+func ParseGetAccountTotpResponse(rsp *http.Response) (*GetAccountTotpResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAccountTotpResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []TOTPAuthenticator
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAccountTotpResponse parses an HTTP response from a PostAccountTotpWithResponse call
+func ParsePostAccountTotpResponse(rsp *http.Response) (*PostAccountTotpResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAccountTotpResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest TOTPEnrollment
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAccountTotpTotpIdResponse parses an HTTP response from a DeleteAccountTotpTotpIdWithResponse call
+func ParseDeleteAccountTotpTotpIdResponse(rsp *http.Response) (*DeleteAccountTotpTotpIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAccountTotpTotpIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAccountTotpTotpIdConfirmResponse parses an HTTP response from a PostAccountTotpTotpIdConfirmWithResponse call
+func ParsePostAccountTotpTotpIdConfirmResponse(rsp *http.Response) (*PostAccountTotpTotpIdConfirmResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAccountTotpTotpIdConfirmResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminV1OrbitsResponse parses an HTTP response from a GetAdminV1OrbitsWithResponse call
+func ParseGetAdminV1OrbitsResponse(rsp *http.Response) (*GetAdminV1OrbitsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminV1OrbitsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OrbitList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAdminV1OrbitsResponse parses an HTTP response from a PostAdminV1OrbitsWithResponse call
+func ParsePostAdminV1OrbitsResponse(rsp *http.Response) (*PostAdminV1OrbitsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAdminV1OrbitsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Orbit
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAdminV1OrbitsOrbitIdResponse parses an HTTP response from a DeleteAdminV1OrbitsOrbitIdWithResponse call
+func ParseDeleteAdminV1OrbitsOrbitIdResponse(rsp *http.Response) (*DeleteAdminV1OrbitsOrbitIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAdminV1OrbitsOrbitIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminV1OrbitsOrbitIdResponse parses an HTTP response from a GetAdminV1OrbitsOrbitIdWithResponse call
+func ParseGetAdminV1OrbitsOrbitIdResponse(rsp *http.Response) (*GetAdminV1OrbitsOrbitIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminV1OrbitsOrbitIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Orbit
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutAdminV1OrbitsOrbitIdResponse parses an HTTP response from a PutAdminV1OrbitsOrbitIdWithResponse call
+func ParsePutAdminV1OrbitsOrbitIdResponse(rsp *http.Response) (*PutAdminV1OrbitsOrbitIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutAdminV1OrbitsOrbitIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Orbit
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 428:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON428 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminV1OrbitsOrbitIdAuditLogsResponse parses an HTTP response from a GetAdminV1OrbitsOrbitIdAuditLogsWithResponse call
+func ParseGetAdminV1OrbitsOrbitIdAuditLogsResponse(rsp *http.Response) (*GetAdminV1OrbitsOrbitIdAuditLogsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminV1OrbitsOrbitIdAuditLogsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuditLogList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminV1OrbitsOrbitIdClientsResponse parses an HTTP response from a GetAdminV1OrbitsOrbitIdClientsWithResponse call
+func ParseGetAdminV1OrbitsOrbitIdClientsResponse(rsp *http.Response) (*GetAdminV1OrbitsOrbitIdClientsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminV1OrbitsOrbitIdClientsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OAuthClientList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAdminV1OrbitsOrbitIdClientsResponse parses an HTTP response from a PostAdminV1OrbitsOrbitIdClientsWithResponse call
+func ParsePostAdminV1OrbitsOrbitIdClientsResponse(rsp *http.Response) (*PostAdminV1OrbitsOrbitIdClientsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAdminV1OrbitsOrbitIdClientsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest OAuthClient
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAdminV1OrbitsOrbitIdClientsClientIdResponse parses an HTTP response from a DeleteAdminV1OrbitsOrbitIdClientsClientIdWithResponse call
+func ParseDeleteAdminV1OrbitsOrbitIdClientsClientIdResponse(rsp *http.Response) (*DeleteAdminV1OrbitsOrbitIdClientsClientIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAdminV1OrbitsOrbitIdClientsClientIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminV1OrbitsOrbitIdClientsClientIdResponse parses an HTTP response from a GetAdminV1OrbitsOrbitIdClientsClientIdWithResponse call
+func ParseGetAdminV1OrbitsOrbitIdClientsClientIdResponse(rsp *http.Response) (*GetAdminV1OrbitsOrbitIdClientsClientIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminV1OrbitsOrbitIdClientsClientIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OAuthClient
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutAdminV1OrbitsOrbitIdClientsClientIdResponse parses an HTTP response from a PutAdminV1OrbitsOrbitIdClientsClientIdWithResponse call
+func ParsePutAdminV1OrbitsOrbitIdClientsClientIdResponse(rsp *http.Response) (*PutAdminV1OrbitsOrbitIdClientsClientIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutAdminV1OrbitsOrbitIdClientsClientIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest OAuthClient
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 428:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON428 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAdminV1OrbitsOrbitIdClientsClientIdSecretResponse parses an HTTP response from a PostAdminV1OrbitsOrbitIdClientsClientIdSecretWithResponse call
+func ParsePostAdminV1OrbitsOrbitIdClientsClientIdSecretResponse(rsp *http.Response) (*PostAdminV1OrbitsOrbitIdClientsClientIdSecretResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAdminV1OrbitsOrbitIdClientsClientIdSecretResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ClientSecret
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminV1OrbitsOrbitIdGroupsResponse parses an HTTP response from a GetAdminV1OrbitsOrbitIdGroupsWithResponse call
+func ParseGetAdminV1OrbitsOrbitIdGroupsResponse(rsp *http.Response) (*GetAdminV1OrbitsOrbitIdGroupsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminV1OrbitsOrbitIdGroupsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest GroupList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAdminV1OrbitsOrbitIdGroupsResponse parses an HTTP response from a PostAdminV1OrbitsOrbitIdGroupsWithResponse call
+func ParsePostAdminV1OrbitsOrbitIdGroupsResponse(rsp *http.Response) (*PostAdminV1OrbitsOrbitIdGroupsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAdminV1OrbitsOrbitIdGroupsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Group
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAdminV1OrbitsOrbitIdGroupsGroupIdResponse parses an HTTP response from a DeleteAdminV1OrbitsOrbitIdGroupsGroupIdWithResponse call
+func ParseDeleteAdminV1OrbitsOrbitIdGroupsGroupIdResponse(rsp *http.Response) (*DeleteAdminV1OrbitsOrbitIdGroupsGroupIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAdminV1OrbitsOrbitIdGroupsGroupIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminV1OrbitsOrbitIdGroupsGroupIdResponse parses an HTTP response from a GetAdminV1OrbitsOrbitIdGroupsGroupIdWithResponse call
+func ParseGetAdminV1OrbitsOrbitIdGroupsGroupIdResponse(rsp *http.Response) (*GetAdminV1OrbitsOrbitIdGroupsGroupIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminV1OrbitsOrbitIdGroupsGroupIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Group
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminV1OrbitsOrbitIdGroupsGroupIdMembersResponse parses an HTTP response from a GetAdminV1OrbitsOrbitIdGroupsGroupIdMembersWithResponse call
+func ParseGetAdminV1OrbitsOrbitIdGroupsGroupIdMembersResponse(rsp *http.Response) (*GetAdminV1OrbitsOrbitIdGroupsGroupIdMembersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminV1OrbitsOrbitIdGroupsGroupIdMembersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserIdResponse parses an HTTP response from a DeleteAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserIdWithResponse call
+func ParseDeleteAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserIdResponse(rsp *http.Response) (*DeleteAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserIdResponse parses an HTTP response from a PutAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserIdWithResponse call
+func ParsePutAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserIdResponse(rsp *http.Response) (*PutAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminV1OrbitsOrbitIdGroupsGroupIdRolesResponse parses an HTTP response from a GetAdminV1OrbitsOrbitIdGroupsGroupIdRolesWithResponse call
+func ParseGetAdminV1OrbitsOrbitIdGroupsGroupIdRolesResponse(rsp *http.Response) (*GetAdminV1OrbitsOrbitIdGroupsGroupIdRolesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminV1OrbitsOrbitIdGroupsGroupIdRolesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Role
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleIdResponse parses an HTTP response from a DeleteAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleIdWithResponse call
+func ParseDeleteAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleIdResponse(rsp *http.Response) (*DeleteAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleIdResponse parses an HTTP response from a PutAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleIdWithResponse call
+func ParsePutAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleIdResponse(rsp *http.Response) (*PutAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminV1OrbitsOrbitIdKeysResponse parses an HTTP response from a GetAdminV1OrbitsOrbitIdKeysWithResponse call
+func ParseGetAdminV1OrbitsOrbitIdKeysResponse(rsp *http.Response) (*GetAdminV1OrbitsOrbitIdKeysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminV1OrbitsOrbitIdKeysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SigningKeyList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAdminV1OrbitsOrbitIdKeysResponse parses an HTTP response from a PostAdminV1OrbitsOrbitIdKeysWithResponse call
+func ParsePostAdminV1OrbitsOrbitIdKeysResponse(rsp *http.Response) (*PostAdminV1OrbitsOrbitIdKeysResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAdminV1OrbitsOrbitIdKeysResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest SigningKey
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAdminV1OrbitsOrbitIdKeysKeyIdResponse parses an HTTP response from a DeleteAdminV1OrbitsOrbitIdKeysKeyIdWithResponse call
+func ParseDeleteAdminV1OrbitsOrbitIdKeysKeyIdResponse(rsp *http.Response) (*DeleteAdminV1OrbitsOrbitIdKeysKeyIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAdminV1OrbitsOrbitIdKeysKeyIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminV1OrbitsOrbitIdKeysKeyIdResponse parses an HTTP response from a GetAdminV1OrbitsOrbitIdKeysKeyIdWithResponse call
+func ParseGetAdminV1OrbitsOrbitIdKeysKeyIdResponse(rsp *http.Response) (*GetAdminV1OrbitsOrbitIdKeysKeyIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminV1OrbitsOrbitIdKeysKeyIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SigningKey
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutAdminV1OrbitsOrbitIdKeysKeyIdResponse parses an HTTP response from a PutAdminV1OrbitsOrbitIdKeysKeyIdWithResponse call
+func ParsePutAdminV1OrbitsOrbitIdKeysKeyIdResponse(rsp *http.Response) (*PutAdminV1OrbitsOrbitIdKeysKeyIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutAdminV1OrbitsOrbitIdKeysKeyIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest SigningKey
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 428:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON428 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminV1OrbitsOrbitIdPermissionsResponse parses an HTTP response from a GetAdminV1OrbitsOrbitIdPermissionsWithResponse call
+func ParseGetAdminV1OrbitsOrbitIdPermissionsResponse(rsp *http.Response) (*GetAdminV1OrbitsOrbitIdPermissionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminV1OrbitsOrbitIdPermissionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PermissionList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAdminV1OrbitsOrbitIdPermissionsResponse parses an HTTP response from a PostAdminV1OrbitsOrbitIdPermissionsWithResponse call
+func ParsePostAdminV1OrbitsOrbitIdPermissionsResponse(rsp *http.Response) (*PostAdminV1OrbitsOrbitIdPermissionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAdminV1OrbitsOrbitIdPermissionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Permission
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAdminV1OrbitsOrbitIdPermissionsPermissionIdResponse parses an HTTP response from a DeleteAdminV1OrbitsOrbitIdPermissionsPermissionIdWithResponse call
+func ParseDeleteAdminV1OrbitsOrbitIdPermissionsPermissionIdResponse(rsp *http.Response) (*DeleteAdminV1OrbitsOrbitIdPermissionsPermissionIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAdminV1OrbitsOrbitIdPermissionsPermissionIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminV1OrbitsOrbitIdPermissionsPermissionIdResponse parses an HTTP response from a GetAdminV1OrbitsOrbitIdPermissionsPermissionIdWithResponse call
+func ParseGetAdminV1OrbitsOrbitIdPermissionsPermissionIdResponse(rsp *http.Response) (*GetAdminV1OrbitsOrbitIdPermissionsPermissionIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminV1OrbitsOrbitIdPermissionsPermissionIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Permission
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminV1OrbitsOrbitIdPoliciesResponse parses an HTTP response from a GetAdminV1OrbitsOrbitIdPoliciesWithResponse call
+func ParseGetAdminV1OrbitsOrbitIdPoliciesResponse(rsp *http.Response) (*GetAdminV1OrbitsOrbitIdPoliciesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminV1OrbitsOrbitIdPoliciesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PolicyList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAdminV1OrbitsOrbitIdPoliciesResponse parses an HTTP response from a PostAdminV1OrbitsOrbitIdPoliciesWithResponse call
+func ParsePostAdminV1OrbitsOrbitIdPoliciesResponse(rsp *http.Response) (*PostAdminV1OrbitsOrbitIdPoliciesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAdminV1OrbitsOrbitIdPoliciesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Policy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAdminV1OrbitsOrbitIdPoliciesPolicyIdResponse parses an HTTP response from a DeleteAdminV1OrbitsOrbitIdPoliciesPolicyIdWithResponse call
+func ParseDeleteAdminV1OrbitsOrbitIdPoliciesPolicyIdResponse(rsp *http.Response) (*DeleteAdminV1OrbitsOrbitIdPoliciesPolicyIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAdminV1OrbitsOrbitIdPoliciesPolicyIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminV1OrbitsOrbitIdPoliciesPolicyIdResponse parses an HTTP response from a GetAdminV1OrbitsOrbitIdPoliciesPolicyIdWithResponse call
+func ParseGetAdminV1OrbitsOrbitIdPoliciesPolicyIdResponse(rsp *http.Response) (*GetAdminV1OrbitsOrbitIdPoliciesPolicyIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminV1OrbitsOrbitIdPoliciesPolicyIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Policy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutAdminV1OrbitsOrbitIdPoliciesPolicyIdResponse parses an HTTP response from a PutAdminV1OrbitsOrbitIdPoliciesPolicyIdWithResponse call
+func ParsePutAdminV1OrbitsOrbitIdPoliciesPolicyIdResponse(rsp *http.Response) (*PutAdminV1OrbitsOrbitIdPoliciesPolicyIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutAdminV1OrbitsOrbitIdPoliciesPolicyIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Policy
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 428:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON428 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminV1OrbitsOrbitIdPolicyDecisionsResponse parses an HTTP response from a GetAdminV1OrbitsOrbitIdPolicyDecisionsWithResponse call
+func ParseGetAdminV1OrbitsOrbitIdPolicyDecisionsResponse(rsp *http.Response) (*GetAdminV1OrbitsOrbitIdPolicyDecisionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminV1OrbitsOrbitIdPolicyDecisionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PolicyDecisionList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminV1OrbitsOrbitIdRolesResponse parses an HTTP response from a GetAdminV1OrbitsOrbitIdRolesWithResponse call
+func ParseGetAdminV1OrbitsOrbitIdRolesResponse(rsp *http.Response) (*GetAdminV1OrbitsOrbitIdRolesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminV1OrbitsOrbitIdRolesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest RoleList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAdminV1OrbitsOrbitIdRolesResponse parses an HTTP response from a PostAdminV1OrbitsOrbitIdRolesWithResponse call
+func ParsePostAdminV1OrbitsOrbitIdRolesResponse(rsp *http.Response) (*PostAdminV1OrbitsOrbitIdRolesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAdminV1OrbitsOrbitIdRolesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Role
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAdminV1OrbitsOrbitIdRolesRoleIdResponse parses an HTTP response from a DeleteAdminV1OrbitsOrbitIdRolesRoleIdWithResponse call
+func ParseDeleteAdminV1OrbitsOrbitIdRolesRoleIdResponse(rsp *http.Response) (*DeleteAdminV1OrbitsOrbitIdRolesRoleIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAdminV1OrbitsOrbitIdRolesRoleIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminV1OrbitsOrbitIdRolesRoleIdResponse parses an HTTP response from a GetAdminV1OrbitsOrbitIdRolesRoleIdWithResponse call
+func ParseGetAdminV1OrbitsOrbitIdRolesRoleIdResponse(rsp *http.Response) (*GetAdminV1OrbitsOrbitIdRolesRoleIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminV1OrbitsOrbitIdRolesRoleIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Role
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminV1OrbitsOrbitIdRolesRoleIdIncludesResponse parses an HTTP response from a GetAdminV1OrbitsOrbitIdRolesRoleIdIncludesWithResponse call
+func ParseGetAdminV1OrbitsOrbitIdRolesRoleIdIncludesResponse(rsp *http.Response) (*GetAdminV1OrbitsOrbitIdRolesRoleIdIncludesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminV1OrbitsOrbitIdRolesRoleIdIncludesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Role
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleIdResponse parses an HTTP response from a DeleteAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleIdWithResponse call
+func ParseDeleteAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleIdResponse(rsp *http.Response) (*DeleteAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleIdResponse parses an HTTP response from a PutAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleIdWithResponse call
+func ParsePutAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleIdResponse(rsp *http.Response) (*PutAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminV1OrbitsOrbitIdRolesRoleIdPermissionsResponse parses an HTTP response from a GetAdminV1OrbitsOrbitIdRolesRoleIdPermissionsWithResponse call
+func ParseGetAdminV1OrbitsOrbitIdRolesRoleIdPermissionsResponse(rsp *http.Response) (*GetAdminV1OrbitsOrbitIdRolesRoleIdPermissionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminV1OrbitsOrbitIdRolesRoleIdPermissionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Permission
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionIdResponse parses an HTTP response from a DeleteAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionIdWithResponse call
+func ParseDeleteAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionIdResponse(rsp *http.Response) (*DeleteAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionIdResponse parses an HTTP response from a PutAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionIdWithResponse call
+func ParsePutAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionIdResponse(rsp *http.Response) (*PutAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminV1OrbitsOrbitIdScopesResponse parses an HTTP response from a GetAdminV1OrbitsOrbitIdScopesWithResponse call
+func ParseGetAdminV1OrbitsOrbitIdScopesResponse(rsp *http.Response) (*GetAdminV1OrbitsOrbitIdScopesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminV1OrbitsOrbitIdScopesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ScopeList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAdminV1OrbitsOrbitIdScopesResponse parses an HTTP response from a PostAdminV1OrbitsOrbitIdScopesWithResponse call
+func ParsePostAdminV1OrbitsOrbitIdScopesResponse(rsp *http.Response) (*PostAdminV1OrbitsOrbitIdScopesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAdminV1OrbitsOrbitIdScopesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest Scope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAdminV1OrbitsOrbitIdScopesScopeIdResponse parses an HTTP response from a DeleteAdminV1OrbitsOrbitIdScopesScopeIdWithResponse call
+func ParseDeleteAdminV1OrbitsOrbitIdScopesScopeIdResponse(rsp *http.Response) (*DeleteAdminV1OrbitsOrbitIdScopesScopeIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAdminV1OrbitsOrbitIdScopesScopeIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminV1OrbitsOrbitIdScopesScopeIdResponse parses an HTTP response from a GetAdminV1OrbitsOrbitIdScopesScopeIdWithResponse call
+func ParseGetAdminV1OrbitsOrbitIdScopesScopeIdResponse(rsp *http.Response) (*GetAdminV1OrbitsOrbitIdScopesScopeIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminV1OrbitsOrbitIdScopesScopeIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Scope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutAdminV1OrbitsOrbitIdScopesScopeIdResponse parses an HTTP response from a PutAdminV1OrbitsOrbitIdScopesScopeIdWithResponse call
+func ParsePutAdminV1OrbitsOrbitIdScopesScopeIdResponse(rsp *http.Response) (*PutAdminV1OrbitsOrbitIdScopesScopeIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutAdminV1OrbitsOrbitIdScopesScopeIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Scope
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 428:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON428 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminV1OrbitsOrbitIdUsersResponse parses an HTTP response from a GetAdminV1OrbitsOrbitIdUsersWithResponse call
+func ParseGetAdminV1OrbitsOrbitIdUsersResponse(rsp *http.Response) (*GetAdminV1OrbitsOrbitIdUsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminV1OrbitsOrbitIdUsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest UserList
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAdminV1OrbitsOrbitIdUsersResponse parses an HTTP response from a PostAdminV1OrbitsOrbitIdUsersWithResponse call
+func ParsePostAdminV1OrbitsOrbitIdUsersResponse(rsp *http.Response) (*PostAdminV1OrbitsOrbitIdUsersResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAdminV1OrbitsOrbitIdUsersResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAdminV1OrbitsOrbitIdUsersUserIdResponse parses an HTTP response from a DeleteAdminV1OrbitsOrbitIdUsersUserIdWithResponse call
+func ParseDeleteAdminV1OrbitsOrbitIdUsersUserIdResponse(rsp *http.Response) (*DeleteAdminV1OrbitsOrbitIdUsersUserIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAdminV1OrbitsOrbitIdUsersUserIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminV1OrbitsOrbitIdUsersUserIdResponse parses an HTTP response from a GetAdminV1OrbitsOrbitIdUsersUserIdWithResponse call
+func ParseGetAdminV1OrbitsOrbitIdUsersUserIdResponse(rsp *http.Response) (*GetAdminV1OrbitsOrbitIdUsersUserIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminV1OrbitsOrbitIdUsersUserIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutAdminV1OrbitsOrbitIdUsersUserIdResponse parses an HTTP response from a PutAdminV1OrbitsOrbitIdUsersUserIdWithResponse call
+func ParsePutAdminV1OrbitsOrbitIdUsersUserIdResponse(rsp *http.Response) (*PutAdminV1OrbitsOrbitIdUsersUserIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutAdminV1OrbitsOrbitIdUsersUserIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest User
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 409:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON409 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 412:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON412 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 428:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON428 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminV1OrbitsOrbitIdUsersUserIdPermissionsResponse parses an HTTP response from a GetAdminV1OrbitsOrbitIdUsersUserIdPermissionsWithResponse call
+func ParseGetAdminV1OrbitsOrbitIdUsersUserIdPermissionsResponse(rsp *http.Response) (*GetAdminV1OrbitsOrbitIdUsersUserIdPermissionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminV1OrbitsOrbitIdUsersUserIdPermissionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []string
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAdminV1OrbitsOrbitIdUsersUserIdRolesResponse parses an HTTP response from a GetAdminV1OrbitsOrbitIdUsersUserIdRolesWithResponse call
+func ParseGetAdminV1OrbitsOrbitIdUsersUserIdRolesResponse(rsp *http.Response) (*GetAdminV1OrbitsOrbitIdUsersUserIdRolesResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAdminV1OrbitsOrbitIdUsersUserIdRolesResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest []Role
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteAdminV1OrbitsOrbitIdUsersUserIdRolesRoleIdResponse parses an HTTP response from a DeleteAdminV1OrbitsOrbitIdUsersUserIdRolesRoleIdWithResponse call
+func ParseDeleteAdminV1OrbitsOrbitIdUsersUserIdRolesRoleIdResponse(rsp *http.Response) (*DeleteAdminV1OrbitsOrbitIdUsersUserIdRolesRoleIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteAdminV1OrbitsOrbitIdUsersUserIdRolesRoleIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutAdminV1OrbitsOrbitIdUsersUserIdRolesRoleIdResponse parses an HTTP response from a PutAdminV1OrbitsOrbitIdUsersUserIdRolesRoleIdWithResponse call
+func ParsePutAdminV1OrbitsOrbitIdUsersUserIdRolesRoleIdResponse(rsp *http.Response) (*PutAdminV1OrbitsOrbitIdUsersUserIdRolesRoleIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutAdminV1OrbitsOrbitIdUsersUserIdRolesRoleIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAdminV1OrbitsOrbitIdUsersUserIdUnlockResponse parses an HTTP response from a PostAdminV1OrbitsOrbitIdUsersUserIdUnlockWithResponse call
+func ParsePostAdminV1OrbitsOrbitIdUsersUserIdUnlockResponse(rsp *http.Response) (*PostAdminV1OrbitsOrbitIdUsersUserIdUnlockResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAdminV1OrbitsOrbitIdUsersUserIdUnlockResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 404:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON404 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetAuthorizeResponse parses an HTTP response from a GetAuthorizeWithResponse call
+func ParseGetAuthorizeResponse(rsp *http.Response) (*GetAuthorizeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetAuthorizeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostAuthzEvaluateResponse parses an HTTP response from a PostAuthzEvaluateWithResponse call
+func ParsePostAuthzEvaluateResponse(rsp *http.Response) (*PostAuthzEvaluateResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostAuthzEvaluateResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest AuthzDecision
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error            string  `json:"error"`
+			ErrorDescription *string `json:"error_description,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error            string  `json:"error"`
+			ErrorDescription *string `json:"error_description,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetConsentResponse parses an HTTP response from a GetConsentWithResponse call
+func ParseGetConsentResponse(rsp *http.Response) (*GetConsentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetConsentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostConsentResponse parses an HTTP response from a PostConsentWithResponse call
+func ParsePostConsentResponse(rsp *http.Response) (*PostConsentResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostConsentResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetEmailVerifyResponse parses an HTTP response from a GetEmailVerifyWithResponse call
+func ParseGetEmailVerifyResponse(rsp *http.Response) (*GetEmailVerifyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetEmailVerifyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostIntrospectResponse parses an HTTP response from a PostIntrospectWithResponse call
+func ParsePostIntrospectResponse(rsp *http.Response) (*PostIntrospectResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostIntrospectResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest Introspection
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error            string  `json:"error"`
+			ErrorDescription *string `json:"error_description,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLoginResponse parses an HTTP response from a GetLoginWithResponse call
+func ParseGetLoginResponse(rsp *http.Response) (*GetLoginResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLoginResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostLoginResponse parses an HTTP response from a PostLoginWithResponse call
+func ParsePostLoginResponse(rsp *http.Response) (*PostLoginResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostLoginResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetLoginMfaResponse parses an HTTP response from a GetLoginMfaWithResponse call
+func ParseGetLoginMfaResponse(rsp *http.Response) (*GetLoginMfaResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetLoginMfaResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostLoginMfaResponse parses an HTTP response from a PostLoginMfaWithResponse call
+func ParsePostLoginMfaResponse(rsp *http.Response) (*PostLoginMfaResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostLoginMfaResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostLoginPasskeyResponse parses an HTTP response from a PostLoginPasskeyWithResponse call
+func ParsePostLoginPasskeyResponse(rsp *http.Response) (*PostLoginPasskeyResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostLoginPasskeyResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostLoginPasskeyOptionsResponse parses an HTTP response from a PostLoginPasskeyOptionsWithResponse call
+func ParsePostLoginPasskeyOptionsResponse(rsp *http.Response) (*PostLoginPasskeyOptionsResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostLoginPasskeyOptionsResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest PasskeyOptions
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostLogoutResponse parses an HTTP response from a PostLogoutWithResponse call
+func ParsePostLogoutResponse(rsp *http.Response) (*PostLogoutResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostLogoutResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetPasswordForgotResponse parses an HTTP response from a GetPasswordForgotWithResponse call
+func ParseGetPasswordForgotResponse(rsp *http.Response) (*GetPasswordForgotResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPasswordForgotResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostPasswordForgotResponse parses an HTTP response from a PostPasswordForgotWithResponse call
+func ParsePostPasswordForgotResponse(rsp *http.Response) (*PostPasswordForgotResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPasswordForgotResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParseGetPasswordResetResponse parses an HTTP response from a GetPasswordResetWithResponse call
+func ParseGetPasswordResetResponse(rsp *http.Response) (*GetPasswordResetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetPasswordResetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostPasswordResetResponse parses an HTTP response from a PostPasswordResetWithResponse call
+func ParsePostPasswordResetResponse(rsp *http.Response) (*PostPasswordResetResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostPasswordResetResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostRegisterResponse parses an HTTP response from a PostRegisterWithResponse call
+func ParsePostRegisterResponse(rsp *http.Response) (*PostRegisterResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostRegisterResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 201:
+		var dest ClientInformation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON201 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 403:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON403 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseDeleteRegisterClientIdResponse parses an HTTP response from a DeleteRegisterClientIdWithResponse call
+func ParseDeleteRegisterClientIdResponse(rsp *http.Response) (*DeleteRegisterClientIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &DeleteRegisterClientIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetRegisterClientIdResponse parses an HTTP response from a GetRegisterClientIdWithResponse call
+func ParseGetRegisterClientIdResponse(rsp *http.Response) (*GetRegisterClientIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetRegisterClientIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClientInformation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePutRegisterClientIdResponse parses an HTTP response from a PutRegisterClientIdWithResponse call
+func ParsePutRegisterClientIdResponse(rsp *http.Response) (*PutRegisterClientIdResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PutRegisterClientIdResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest ClientInformation
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest Error
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParsePostRevokeResponse parses an HTTP response from a PostRevokeWithResponse call
+func ParsePostRevokeResponse(rsp *http.Response) (*PostRevokeResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostRevokeResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	return response, nil
+}
+
+// ParsePostTokenResponse parses an HTTP response from a PostTokenWithResponse call
+func ParsePostTokenResponse(rsp *http.Response) (*PostTokenResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &PostTokenResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest TokenResponse
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 400:
+		var dest struct {
+			Error            string  `json:"error"`
+			ErrorDescription *string `json:"error_description,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON400 = &dest
+
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 401:
+		var dest struct {
+			Error            string  `json:"error"`
+			ErrorDescription *string `json:"error_description,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON401 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ParseGetUserinfoResponse parses an HTTP response from a GetUserinfoWithResponse call
+func ParseGetUserinfoResponse(rsp *http.Response) (*GetUserinfoResponse, error) {
+	bodyBytes, err := io.ReadAll(rsp.Body)
+	defer func() { _ = rsp.Body.Close() }()
+	if err != nil {
+		return nil, err
+	}
+
+	response := &GetUserinfoResponse{
+		Body:         bodyBytes,
+		HTTPResponse: rsp,
+	}
+
+	switch {
+	case strings.Contains(rsp.Header.Get("Content-Type"), "json") && rsp.StatusCode == 200:
+		var dest struct {
+			Email         *string `json:"email,omitempty"`
+			EmailVerified *bool   `json:"email_verified,omitempty"`
+			Name          *string `json:"name,omitempty"`
+			Sub           *string `json:"sub,omitempty"`
+		}
+		if err := json.Unmarshal(bodyBytes, &dest); err != nil {
+			return nil, err
+		}
+		response.JSON200 = &dest
+
+	}
+
+	return response, nil
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// JSON Web Key Set
+	// (GET /.well-known/jwks.json)
+	GetWellKnownJwksJson(ctx echo.Context) error
+	// OpenID Provider Metadata
+	// (GET /.well-known/openid-configuration)
+	GetWellKnownOpenidConfiguration(ctx echo.Context) error
+	// List the signed-in user's consents
+	// (GET /account/consents)
+	GetAccountConsents(ctx echo.Context) error
+	// Revoke a consent and the client's refresh tokens
+	// (DELETE /account/consents/{consent_id})
+	DeleteAccountConsentsConsentId(ctx echo.Context, consentId int64) error
+	// Mail a verification link to the signed-in user's address
+	// (POST /account/email/verification)
+	PostAccountEmailVerification(ctx echo.Context) error
+	// List the signed-in user's passkeys
+	// (GET /account/passkeys)
+	GetAccountPasskeys(ctx echo.Context) error
+	// Start registering a passkey
+	// (POST /account/passkeys/registrations)
+	PostAccountPasskeysRegistrations(ctx echo.Context) error
+	// Finish registering a passkey with the authenticator response
+	// (POST /account/passkeys/registrations/{ceremony_id})
+	PostAccountPasskeysRegistrationsCeremonyId(ctx echo.Context, ceremonyId string) error
+	// Remove a passkey
+	// (DELETE /account/passkeys/{passkey_id})
+	DeleteAccountPasskeysPasskeyId(ctx echo.Context, passkeyId int64) error
+	// Number of unused recovery codes of the signed-in user
+	// (GET /account/recovery-codes)
+	GetAccountRecoveryCodes(ctx echo.Context) error
+	// Generate a new batch of recovery codes, invalidating the previous one
+	// (POST /account/recovery-codes)
+	PostAccountRecoveryCodes(ctx echo.Context) error
+	// Sign out of every other session and revoke their refresh tokens
+	// (DELETE /account/sessions)
+	DeleteAccountSessions(ctx echo.Context) error
+	// List the signed-in user's active sessions
+	// (GET /account/sessions)
+	GetAccountSessions(ctx echo.Context) error
+	// Sign out of a session and revoke its refresh tokens
+	// (DELETE /account/sessions/{session_id})
+	DeleteAccountSessionsSessionId(ctx echo.Context, sessionId int64) error
+	// List the signed-in user's TOTP authenticators
+	// (GET /account/totp)
+	GetAccountTotp(ctx echo.Context) error
+	// Enroll a new TOTP authenticator
+	// (POST /account/totp)
+	PostAccountTotp(ctx echo.Context) error
+	// Remove a TOTP authenticator
+	// (DELETE /account/totp/{totp_id})
+	DeleteAccountTotpTotpId(ctx echo.Context, totpId int64) error
+	// Confirm an enrolled authenticator with its first code
+	// (POST /account/totp/{totp_id}/confirm)
+	PostAccountTotpTotpIdConfirm(ctx echo.Context, totpId int64) error
+	// List orbits
+	// (GET /admin/v1/orbits)
+	GetAdminV1Orbits(ctx echo.Context, params GetAdminV1OrbitsParams) error
+	// Create an orbit
+	// (POST /admin/v1/orbits)
+	PostAdminV1Orbits(ctx echo.Context) error
+	// Delete an orbit
+	// (DELETE /admin/v1/orbits/{orbit_id})
+	DeleteAdminV1OrbitsOrbitId(ctx echo.Context, orbitId OrbitId) error
+	// Read an orbit
+	// (GET /admin/v1/orbits/{orbit_id})
+	GetAdminV1OrbitsOrbitId(ctx echo.Context, orbitId OrbitId) error
+	// Replace an orbit
+	// (PUT /admin/v1/orbits/{orbit_id})
+	PutAdminV1OrbitsOrbitId(ctx echo.Context, orbitId OrbitId, params PutAdminV1OrbitsOrbitIdParams) error
+	// List the audit log of an orbit, newest first
+	// (GET /admin/v1/orbits/{orbit_id}/audit-logs)
+	GetAdminV1OrbitsOrbitIdAuditLogs(ctx echo.Context, orbitId OrbitId, params GetAdminV1OrbitsOrbitIdAuditLogsParams) error
+	// List the clients of an orbit
+	// (GET /admin/v1/orbits/{orbit_id}/clients)
+	GetAdminV1OrbitsOrbitIdClients(ctx echo.Context, orbitId OrbitId, params GetAdminV1OrbitsOrbitIdClientsParams) error
+	// Create a client
+	// (POST /admin/v1/orbits/{orbit_id}/clients)
+	PostAdminV1OrbitsOrbitIdClients(ctx echo.Context, orbitId OrbitId) error
+	// Delete a client
+	// (DELETE /admin/v1/orbits/{orbit_id}/clients/{client_id})
+	DeleteAdminV1OrbitsOrbitIdClientsClientId(ctx echo.Context, orbitId OrbitId, clientId string) error
+	// Read a client
+	// (GET /admin/v1/orbits/{orbit_id}/clients/{client_id})
+	GetAdminV1OrbitsOrbitIdClientsClientId(ctx echo.Context, orbitId OrbitId, clientId string) error
+	// Replace a client
+	// (PUT /admin/v1/orbits/{orbit_id}/clients/{client_id})
+	PutAdminV1OrbitsOrbitIdClientsClientId(ctx echo.Context, orbitId OrbitId, clientId string, params PutAdminV1OrbitsOrbitIdClientsClientIdParams) error
+	// Issue a new client secret
+	// (POST /admin/v1/orbits/{orbit_id}/clients/{client_id}/secret)
+	PostAdminV1OrbitsOrbitIdClientsClientIdSecret(ctx echo.Context, orbitId OrbitId, clientId string) error
+	// List the groups of an orbit
+	// (GET /admin/v1/orbits/{orbit_id}/groups)
+	GetAdminV1OrbitsOrbitIdGroups(ctx echo.Context, orbitId OrbitId, params GetAdminV1OrbitsOrbitIdGroupsParams) error
+	// Create a group
+	// (POST /admin/v1/orbits/{orbit_id}/groups)
+	PostAdminV1OrbitsOrbitIdGroups(ctx echo.Context, orbitId OrbitId) error
+	// Delete a group
+	// (DELETE /admin/v1/orbits/{orbit_id}/groups/{group_id})
+	DeleteAdminV1OrbitsOrbitIdGroupsGroupId(ctx echo.Context, orbitId OrbitId, groupId int64) error
+	// Read a group
+	// (GET /admin/v1/orbits/{orbit_id}/groups/{group_id})
+	GetAdminV1OrbitsOrbitIdGroupsGroupId(ctx echo.Context, orbitId OrbitId, groupId int64) error
+	// List the members of a group
+	// (GET /admin/v1/orbits/{orbit_id}/groups/{group_id}/members)
+	GetAdminV1OrbitsOrbitIdGroupsGroupIdMembers(ctx echo.Context, orbitId OrbitId, groupId int64, params GetAdminV1OrbitsOrbitIdGroupsGroupIdMembersParams) error
+	// Remove a user from a group
+	// (DELETE /admin/v1/orbits/{orbit_id}/groups/{group_id}/members/{user_id})
+	DeleteAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserId(ctx echo.Context, orbitId OrbitId, groupId int64, userId int64) error
+	// Add a user to a group
+	// (PUT /admin/v1/orbits/{orbit_id}/groups/{group_id}/members/{user_id})
+	PutAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserId(ctx echo.Context, orbitId OrbitId, groupId int64, userId int64) error
+	// List the roles assigned to a group
+	// (GET /admin/v1/orbits/{orbit_id}/groups/{group_id}/roles)
+	GetAdminV1OrbitsOrbitIdGroupsGroupIdRoles(ctx echo.Context, orbitId OrbitId, groupId int64) error
+	// Take a role away from a group
+	// (DELETE /admin/v1/orbits/{orbit_id}/groups/{group_id}/roles/{role_id})
+	DeleteAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleId(ctx echo.Context, orbitId OrbitId, groupId int64, roleId int64) error
+	// Assign a role to the members of a group
+	// (PUT /admin/v1/orbits/{orbit_id}/groups/{group_id}/roles/{role_id})
+	PutAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleId(ctx echo.Context, orbitId OrbitId, groupId int64, roleId int64) error
+	// List the signing keys of an orbit
+	// (GET /admin/v1/orbits/{orbit_id}/keys)
+	GetAdminV1OrbitsOrbitIdKeys(ctx echo.Context, orbitId OrbitId, params GetAdminV1OrbitsOrbitIdKeysParams) error
+	// Create a signing key
+	// (POST /admin/v1/orbits/{orbit_id}/keys)
+	PostAdminV1OrbitsOrbitIdKeys(ctx echo.Context, orbitId OrbitId) error
+	// Delete a signing key
+	// (DELETE /admin/v1/orbits/{orbit_id}/keys/{key_id})
+	DeleteAdminV1OrbitsOrbitIdKeysKeyId(ctx echo.Context, orbitId OrbitId, keyId int64) error
+	// Read a signing key
+	// (GET /admin/v1/orbits/{orbit_id}/keys/{key_id})
+	GetAdminV1OrbitsOrbitIdKeysKeyId(ctx echo.Context, orbitId OrbitId, keyId int64) error
+	// Replace a signing key
+	// (PUT /admin/v1/orbits/{orbit_id}/keys/{key_id})
+	PutAdminV1OrbitsOrbitIdKeysKeyId(ctx echo.Context, orbitId OrbitId, keyId int64, params PutAdminV1OrbitsOrbitIdKeysKeyIdParams) error
+	// List the permissions of an orbit
+	// (GET /admin/v1/orbits/{orbit_id}/permissions)
+	GetAdminV1OrbitsOrbitIdPermissions(ctx echo.Context, orbitId OrbitId, params GetAdminV1OrbitsOrbitIdPermissionsParams) error
+	// Create a permission
+	// (POST /admin/v1/orbits/{orbit_id}/permissions)
+	PostAdminV1OrbitsOrbitIdPermissions(ctx echo.Context, orbitId OrbitId) error
+	// Delete a permission
+	// (DELETE /admin/v1/orbits/{orbit_id}/permissions/{permission_id})
+	DeleteAdminV1OrbitsOrbitIdPermissionsPermissionId(ctx echo.Context, orbitId OrbitId, permissionId int64) error
+	// Read a permission
+	// (GET /admin/v1/orbits/{orbit_id}/permissions/{permission_id})
+	GetAdminV1OrbitsOrbitIdPermissionsPermissionId(ctx echo.Context, orbitId OrbitId, permissionId int64) error
+	// List the authorization policies of an orbit
+	// (GET /admin/v1/orbits/{orbit_id}/policies)
+	GetAdminV1OrbitsOrbitIdPolicies(ctx echo.Context, orbitId OrbitId, params GetAdminV1OrbitsOrbitIdPoliciesParams) error
+	// Create a policy
+	// (POST /admin/v1/orbits/{orbit_id}/policies)
+	PostAdminV1OrbitsOrbitIdPolicies(ctx echo.Context, orbitId OrbitId) error
+	// Delete a policy
+	// (DELETE /admin/v1/orbits/{orbit_id}/policies/{policy_id})
+	DeleteAdminV1OrbitsOrbitIdPoliciesPolicyId(ctx echo.Context, orbitId OrbitId, policyId int64) error
+	// Read a policy
+	// (GET /admin/v1/orbits/{orbit_id}/policies/{policy_id})
+	GetAdminV1OrbitsOrbitIdPoliciesPolicyId(ctx echo.Context, orbitId OrbitId, policyId int64) error
+	// Replace a policy
+	// (PUT /admin/v1/orbits/{orbit_id}/policies/{policy_id})
+	PutAdminV1OrbitsOrbitIdPoliciesPolicyId(ctx echo.Context, orbitId OrbitId, policyId int64, params PutAdminV1OrbitsOrbitIdPoliciesPolicyIdParams) error
+	// List the decision log of an orbit, newest first
+	// (GET /admin/v1/orbits/{orbit_id}/policy-decisions)
+	GetAdminV1OrbitsOrbitIdPolicyDecisions(ctx echo.Context, orbitId OrbitId, params GetAdminV1OrbitsOrbitIdPolicyDecisionsParams) error
+	// List the roles of an orbit
+	// (GET /admin/v1/orbits/{orbit_id}/roles)
+	GetAdminV1OrbitsOrbitIdRoles(ctx echo.Context, orbitId OrbitId, params GetAdminV1OrbitsOrbitIdRolesParams) error
+	// Create a role
+	// (POST /admin/v1/orbits/{orbit_id}/roles)
+	PostAdminV1OrbitsOrbitIdRoles(ctx echo.Context, orbitId OrbitId) error
+	// Delete a role
+	// (DELETE /admin/v1/orbits/{orbit_id}/roles/{role_id})
+	DeleteAdminV1OrbitsOrbitIdRolesRoleId(ctx echo.Context, orbitId OrbitId, roleId int64) error
+	// Read a role
+	// (GET /admin/v1/orbits/{orbit_id}/roles/{role_id})
+	GetAdminV1OrbitsOrbitIdRolesRoleId(ctx echo.Context, orbitId OrbitId, roleId int64) error
+	// List the roles a role includes
+	// (GET /admin/v1/orbits/{orbit_id}/roles/{role_id}/includes)
+	GetAdminV1OrbitsOrbitIdRolesRoleIdIncludes(ctx echo.Context, orbitId OrbitId, roleId int64) error
+	// Stop a role including another
+	// (DELETE /admin/v1/orbits/{orbit_id}/roles/{role_id}/includes/{included_role_id})
+	DeleteAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleId(ctx echo.Context, orbitId OrbitId, roleId int64, includedRoleId int64) error
+	// Make a role include another
+	// (PUT /admin/v1/orbits/{orbit_id}/roles/{role_id}/includes/{included_role_id})
+	PutAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleId(ctx echo.Context, orbitId OrbitId, roleId int64, includedRoleId int64) error
+	// List the permissions a role grants
+	// (GET /admin/v1/orbits/{orbit_id}/roles/{role_id}/permissions)
+	GetAdminV1OrbitsOrbitIdRolesRoleIdPermissions(ctx echo.Context, orbitId OrbitId, roleId int64) error
+	// Stop granting a permission through a role
+	// (DELETE /admin/v1/orbits/{orbit_id}/roles/{role_id}/permissions/{permission_id})
+	DeleteAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionId(ctx echo.Context, orbitId OrbitId, roleId int64, permissionId int64) error
+	// Grant a permission through a role
+	// (PUT /admin/v1/orbits/{orbit_id}/roles/{role_id}/permissions/{permission_id})
+	PutAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionId(ctx echo.Context, orbitId OrbitId, roleId int64, permissionId int64) error
+	// List the scopes of an orbit
+	// (GET /admin/v1/orbits/{orbit_id}/scopes)
+	GetAdminV1OrbitsOrbitIdScopes(ctx echo.Context, orbitId OrbitId, params GetAdminV1OrbitsOrbitIdScopesParams) error
+	// Create a scope
+	// (POST /admin/v1/orbits/{orbit_id}/scopes)
+	PostAdminV1OrbitsOrbitIdScopes(ctx echo.Context, orbitId OrbitId) error
+	// Delete a scope
+	// (DELETE /admin/v1/orbits/{orbit_id}/scopes/{scope_id})
+	DeleteAdminV1OrbitsOrbitIdScopesScopeId(ctx echo.Context, orbitId OrbitId, scopeId int64) error
+	// Read a scope
+	// (GET /admin/v1/orbits/{orbit_id}/scopes/{scope_id})
+	GetAdminV1OrbitsOrbitIdScopesScopeId(ctx echo.Context, orbitId OrbitId, scopeId int64) error
+	// Replace a scope
+	// (PUT /admin/v1/orbits/{orbit_id}/scopes/{scope_id})
+	PutAdminV1OrbitsOrbitIdScopesScopeId(ctx echo.Context, orbitId OrbitId, scopeId int64, params PutAdminV1OrbitsOrbitIdScopesScopeIdParams) error
+	// List the users of an orbit
+	// (GET /admin/v1/orbits/{orbit_id}/users)
+	GetAdminV1OrbitsOrbitIdUsers(ctx echo.Context, orbitId OrbitId, params GetAdminV1OrbitsOrbitIdUsersParams) error
+	// Create a user
+	// (POST /admin/v1/orbits/{orbit_id}/users)
+	PostAdminV1OrbitsOrbitIdUsers(ctx echo.Context, orbitId OrbitId) error
+	// Delete a user
+	// (DELETE /admin/v1/orbits/{orbit_id}/users/{user_id})
+	DeleteAdminV1OrbitsOrbitIdUsersUserId(ctx echo.Context, orbitId OrbitId, userId int64) error
+	// Read a user
+	// (GET /admin/v1/orbits/{orbit_id}/users/{user_id})
+	GetAdminV1OrbitsOrbitIdUsersUserId(ctx echo.Context, orbitId OrbitId, userId int64) error
+	// Replace a user
+	// (PUT /admin/v1/orbits/{orbit_id}/users/{user_id})
+	PutAdminV1OrbitsOrbitIdUsersUserId(ctx echo.Context, orbitId OrbitId, userId int64, params PutAdminV1OrbitsOrbitIdUsersUserIdParams) error
+	// List the permissions a user holds through their roles
+	// (GET /admin/v1/orbits/{orbit_id}/users/{user_id}/permissions)
+	GetAdminV1OrbitsOrbitIdUsersUserIdPermissions(ctx echo.Context, orbitId OrbitId, userId int64) error
+	// List the roles of a user
+	// (GET /admin/v1/orbits/{orbit_id}/users/{user_id}/roles)
+	GetAdminV1OrbitsOrbitIdUsersUserIdRoles(ctx echo.Context, orbitId OrbitId, userId int64) error
+	// Take a role away from a user
+	// (DELETE /admin/v1/orbits/{orbit_id}/users/{user_id}/roles/{role_id})
+	DeleteAdminV1OrbitsOrbitIdUsersUserIdRolesRoleId(ctx echo.Context, orbitId OrbitId, userId int64, roleId int64) error
+	// Assign a role to a user
+	// (PUT /admin/v1/orbits/{orbit_id}/users/{user_id}/roles/{role_id})
+	PutAdminV1OrbitsOrbitIdUsersUserIdRolesRoleId(ctx echo.Context, orbitId OrbitId, userId int64, roleId int64) error
+	// Lift the lockout of a user and forget failed sign-in attempts
+	// (POST /admin/v1/orbits/{orbit_id}/users/{user_id}/unlock)
+	PostAdminV1OrbitsOrbitIdUsersUserIdUnlock(ctx echo.Context, orbitId OrbitId, userId int64) error
+	// Authorization endpoint
+	// (GET /authorize)
+	GetAuthorize(ctx echo.Context, params GetAuthorizeParams) error
+	// Decide whether a user may perform an action on a resource
+	// (POST /authz/evaluate)
+	PostAuthzEvaluate(ctx echo.Context) error
+	// Consent page for a pending authorization request
+	// (GET /consent)
+	GetConsent(ctx echo.Context, params GetConsentParams) error
+	// Record the user's consent decision and resume the authorization request
+	// (POST /consent)
+	PostConsent(ctx echo.Context) error
+	// Confirm an email address with a mailed verification token
+	// (GET /email/verify)
+	GetEmailVerify(ctx echo.Context, params GetEmailVerifyParams) error
+	// Token introspection
+	// (POST /introspect)
+	PostIntrospect(ctx echo.Context) error
+	// Login page for a pending authorization request
+	// (GET /login)
+	GetLogin(ctx echo.Context, params GetLoginParams) error
+	// Authenticate the user and resume the authorization request
+	// (POST /login)
+	PostLogin(ctx echo.Context) error
+	// Second factor page for a pending authorization request
+	// (GET /login/mfa)
+	GetLoginMfa(ctx echo.Context, params GetLoginMfaParams) error
+	// Verify a second factor and resume the authorization request
+	// (POST /login/mfa)
+	PostLoginMfa(ctx echo.Context) error
+	// Authenticate with a passkey and resume the authorization request
+	// (POST /login/passkey)
+	PostLoginPasskey(ctx echo.Context) error
+	// Start a passkey assertion for a pending authorization request
+	// (POST /login/passkey/options)
+	PostLoginPasskeyOptions(ctx echo.Context) error
+	// End user session
+	// (POST /logout)
+	PostLogout(ctx echo.Context) error
+	// Form asking for the account a password reset link is mailed to
+	// (GET /password/forgot)
+	GetPasswordForgot(ctx echo.Context) error
+	// Mail a password reset link
+	// (POST /password/forgot)
+	PostPasswordForgot(ctx echo.Context) error
+	// Form for choosing a new password with a mailed reset token
+	// (GET /password/reset)
+	GetPasswordReset(ctx echo.Context, params GetPasswordResetParams) error
+	// Set a new password and sign the user out everywhere
+	// (POST /password/reset)
+	PostPasswordReset(ctx echo.Context) error
+	// Dynamic client registration
+	// (POST /register)
+	PostRegister(ctx echo.Context) error
+	// Deregister client
+	// (DELETE /register/{client_id})
+	DeleteRegisterClientId(ctx echo.Context, clientId string) error
+	// Read client configuration
+	// (GET /register/{client_id})
+	GetRegisterClientId(ctx echo.Context, clientId string) error
+	// Update client configuration
+	// (PUT /register/{client_id})
+	PutRegisterClientId(ctx echo.Context, clientId string) error
+	// Token revocation
+	// (POST /revoke)
+	PostRevoke(ctx echo.Context) error
+	// Token endpoint
+	// (POST /token)
+	PostToken(ctx echo.Context) error
+	// UserInfo endpoint
+	// (GET /userinfo)
+	GetUserinfo(ctx echo.Context) error
+}
+
+// ServerInterfaceWrapper converts echo contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler ServerInterface
+}
+
+// GetWellKnownJwksJson converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWellKnownJwksJson(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetWellKnownJwksJson(ctx)
+	return err
+}
+
+// GetWellKnownOpenidConfiguration converts echo context to params.
+func (w *ServerInterfaceWrapper) GetWellKnownOpenidConfiguration(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetWellKnownOpenidConfiguration(ctx)
+	return err
+}
+
+// GetAccountConsents converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAccountConsents(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAccountConsents(ctx)
+	return err
+}
+
+// DeleteAccountConsentsConsentId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAccountConsentsConsentId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "consent_id" -------------
+	var consentId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "consent_id", ctx.Param("consent_id"), &consentId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter consent_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteAccountConsentsConsentId(ctx, consentId)
+	return err
+}
+
+// PostAccountEmailVerification converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAccountEmailVerification(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAccountEmailVerification(ctx)
+	return err
+}
+
+// GetAccountPasskeys converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAccountPasskeys(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAccountPasskeys(ctx)
+	return err
+}
+
+// PostAccountPasskeysRegistrations converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAccountPasskeysRegistrations(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAccountPasskeysRegistrations(ctx)
+	return err
+}
+
+// PostAccountPasskeysRegistrationsCeremonyId converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAccountPasskeysRegistrationsCeremonyId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "ceremony_id" -------------
+	var ceremonyId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "ceremony_id", ctx.Param("ceremony_id"), &ceremonyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter ceremony_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAccountPasskeysRegistrationsCeremonyId(ctx, ceremonyId)
+	return err
+}
+
+// DeleteAccountPasskeysPasskeyId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAccountPasskeysPasskeyId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "passkey_id" -------------
+	var passkeyId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "passkey_id", ctx.Param("passkey_id"), &passkeyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter passkey_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteAccountPasskeysPasskeyId(ctx, passkeyId)
+	return err
+}
+
+// GetAccountRecoveryCodes converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAccountRecoveryCodes(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAccountRecoveryCodes(ctx)
+	return err
+}
+
+// PostAccountRecoveryCodes converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAccountRecoveryCodes(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAccountRecoveryCodes(ctx)
+	return err
+}
+
+// DeleteAccountSessions converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAccountSessions(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteAccountSessions(ctx)
+	return err
+}
+
+// GetAccountSessions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAccountSessions(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAccountSessions(ctx)
+	return err
+}
+
+// DeleteAccountSessionsSessionId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAccountSessionsSessionId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "session_id" -------------
+	var sessionId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "session_id", ctx.Param("session_id"), &sessionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter session_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteAccountSessionsSessionId(ctx, sessionId)
+	return err
+}
+
+// GetAccountTotp converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAccountTotp(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAccountTotp(ctx)
+	return err
+}
+
+// PostAccountTotp converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAccountTotp(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAccountTotp(ctx)
+	return err
+}
+
+// DeleteAccountTotpTotpId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAccountTotpTotpId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "totp_id" -------------
+	var totpId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "totp_id", ctx.Param("totp_id"), &totpId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter totp_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteAccountTotpTotpId(ctx, totpId)
+	return err
+}
+
+// PostAccountTotpTotpIdConfirm converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAccountTotpTotpIdConfirm(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "totp_id" -------------
+	var totpId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "totp_id", ctx.Param("totp_id"), &totpId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter totp_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAccountTotpTotpIdConfirm(ctx, totpId)
+	return err
+}
+
+// GetAdminV1Orbits converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminV1Orbits(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminV1OrbitsParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminV1Orbits(ctx, params)
+	return err
+}
+
+// PostAdminV1Orbits converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAdminV1Orbits(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAdminV1Orbits(ctx)
+	return err
+}
+
+// DeleteAdminV1OrbitsOrbitId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAdminV1OrbitsOrbitId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteAdminV1OrbitsOrbitId(ctx, orbitId)
+	return err
+}
+
+// GetAdminV1OrbitsOrbitId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminV1OrbitsOrbitId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminV1OrbitsOrbitId(ctx, orbitId)
+	return err
+}
+
+// PutAdminV1OrbitsOrbitId converts echo context to params.
+func (w *ServerInterfaceWrapper) PutAdminV1OrbitsOrbitId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutAdminV1OrbitsOrbitIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutAdminV1OrbitsOrbitId(ctx, orbitId, params)
+	return err
+}
+
+// GetAdminV1OrbitsOrbitIdAuditLogs converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminV1OrbitsOrbitIdAuditLogs(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminV1OrbitsOrbitIdAuditLogsParams
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "action", ctx.QueryParams(), &params.Action)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter action: %s", err))
+	}
+
+	// ------------- Optional query parameter "actor_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "actor_id", ctx.QueryParams(), &params.ActorId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter actor_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", ctx.QueryParams(), &params.Since)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", ctx.QueryParams(), &params.Until)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter until: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminV1OrbitsOrbitIdAuditLogs(ctx, orbitId, params)
+	return err
+}
+
+// GetAdminV1OrbitsOrbitIdClients converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminV1OrbitsOrbitIdClients(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminV1OrbitsOrbitIdClientsParams
+	// ------------- Optional query parameter "application_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "application_type", ctx.QueryParams(), &params.ApplicationType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter application_type: %s", err))
+	}
+
+	// ------------- Optional query parameter "is_active" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "is_active", ctx.QueryParams(), &params.IsActive)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter is_active: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminV1OrbitsOrbitIdClients(ctx, orbitId, params)
+	return err
+}
+
+// PostAdminV1OrbitsOrbitIdClients converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAdminV1OrbitsOrbitIdClients(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAdminV1OrbitsOrbitIdClients(ctx, orbitId)
+	return err
+}
+
+// DeleteAdminV1OrbitsOrbitIdClientsClientId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAdminV1OrbitsOrbitIdClientsClientId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	// ------------- Path parameter "client_id" -------------
+	var clientId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "client_id", ctx.Param("client_id"), &clientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter client_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteAdminV1OrbitsOrbitIdClientsClientId(ctx, orbitId, clientId)
+	return err
+}
+
+// GetAdminV1OrbitsOrbitIdClientsClientId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminV1OrbitsOrbitIdClientsClientId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	// ------------- Path parameter "client_id" -------------
+	var clientId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "client_id", ctx.Param("client_id"), &clientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter client_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminV1OrbitsOrbitIdClientsClientId(ctx, orbitId, clientId)
+	return err
+}
+
+// PutAdminV1OrbitsOrbitIdClientsClientId converts echo context to params.
+func (w *ServerInterfaceWrapper) PutAdminV1OrbitsOrbitIdClientsClientId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	// ------------- Path parameter "client_id" -------------
+	var clientId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "client_id", ctx.Param("client_id"), &clientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter client_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutAdminV1OrbitsOrbitIdClientsClientIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutAdminV1OrbitsOrbitIdClientsClientId(ctx, orbitId, clientId, params)
+	return err
+}
+
+// PostAdminV1OrbitsOrbitIdClientsClientIdSecret converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAdminV1OrbitsOrbitIdClientsClientIdSecret(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	// ------------- Path parameter "client_id" -------------
+	var clientId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "client_id", ctx.Param("client_id"), &clientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter client_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAdminV1OrbitsOrbitIdClientsClientIdSecret(ctx, orbitId, clientId)
+	return err
+}
+
+// GetAdminV1OrbitsOrbitIdGroups converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminV1OrbitsOrbitIdGroups(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminV1OrbitsOrbitIdGroupsParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminV1OrbitsOrbitIdGroups(ctx, orbitId, params)
+	return err
+}
+
+// PostAdminV1OrbitsOrbitIdGroups converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAdminV1OrbitsOrbitIdGroups(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAdminV1OrbitsOrbitIdGroups(ctx, orbitId)
+	return err
+}
+
+// DeleteAdminV1OrbitsOrbitIdGroupsGroupId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAdminV1OrbitsOrbitIdGroupsGroupId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	// ------------- Path parameter "group_id" -------------
+	var groupId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "group_id", ctx.Param("group_id"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter group_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteAdminV1OrbitsOrbitIdGroupsGroupId(ctx, orbitId, groupId)
+	return err
+}
+
+// GetAdminV1OrbitsOrbitIdGroupsGroupId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminV1OrbitsOrbitIdGroupsGroupId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	// ------------- Path parameter "group_id" -------------
+	var groupId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "group_id", ctx.Param("group_id"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter group_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminV1OrbitsOrbitIdGroupsGroupId(ctx, orbitId, groupId)
+	return err
+}
+
+// GetAdminV1OrbitsOrbitIdGroupsGroupIdMembers converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminV1OrbitsOrbitIdGroupsGroupIdMembers(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	// ------------- Path parameter "group_id" -------------
+	var groupId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "group_id", ctx.Param("group_id"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter group_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminV1OrbitsOrbitIdGroupsGroupIdMembersParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminV1OrbitsOrbitIdGroupsGroupIdMembers(ctx, orbitId, groupId, params)
+	return err
+}
+
+// DeleteAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	// ------------- Path parameter "group_id" -------------
+	var groupId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "group_id", ctx.Param("group_id"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter group_id: %s", err))
+	}
+
+	// ------------- Path parameter "user_id" -------------
+	var userId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserId(ctx, orbitId, groupId, userId)
+	return err
+}
+
+// PutAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserId converts echo context to params.
+func (w *ServerInterfaceWrapper) PutAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	// ------------- Path parameter "group_id" -------------
+	var groupId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "group_id", ctx.Param("group_id"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter group_id: %s", err))
+	}
+
+	// ------------- Path parameter "user_id" -------------
+	var userId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserId(ctx, orbitId, groupId, userId)
+	return err
+}
+
+// GetAdminV1OrbitsOrbitIdGroupsGroupIdRoles converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminV1OrbitsOrbitIdGroupsGroupIdRoles(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	// ------------- Path parameter "group_id" -------------
+	var groupId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "group_id", ctx.Param("group_id"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter group_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminV1OrbitsOrbitIdGroupsGroupIdRoles(ctx, orbitId, groupId)
+	return err
+}
+
+// DeleteAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	// ------------- Path parameter "group_id" -------------
+	var groupId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "group_id", ctx.Param("group_id"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter group_id: %s", err))
+	}
+
+	// ------------- Path parameter "role_id" -------------
+	var roleId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "role_id", ctx.Param("role_id"), &roleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter role_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleId(ctx, orbitId, groupId, roleId)
+	return err
+}
+
+// PutAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleId converts echo context to params.
+func (w *ServerInterfaceWrapper) PutAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	// ------------- Path parameter "group_id" -------------
+	var groupId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "group_id", ctx.Param("group_id"), &groupId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter group_id: %s", err))
+	}
+
+	// ------------- Path parameter "role_id" -------------
+	var roleId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "role_id", ctx.Param("role_id"), &roleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter role_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleId(ctx, orbitId, groupId, roleId)
+	return err
+}
+
+// GetAdminV1OrbitsOrbitIdKeys converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminV1OrbitsOrbitIdKeys(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminV1OrbitsOrbitIdKeysParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminV1OrbitsOrbitIdKeys(ctx, orbitId, params)
+	return err
+}
+
+// PostAdminV1OrbitsOrbitIdKeys converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAdminV1OrbitsOrbitIdKeys(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAdminV1OrbitsOrbitIdKeys(ctx, orbitId)
+	return err
+}
+
+// DeleteAdminV1OrbitsOrbitIdKeysKeyId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAdminV1OrbitsOrbitIdKeysKeyId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	// ------------- Path parameter "key_id" -------------
+	var keyId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key_id", ctx.Param("key_id"), &keyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter key_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteAdminV1OrbitsOrbitIdKeysKeyId(ctx, orbitId, keyId)
+	return err
+}
+
+// GetAdminV1OrbitsOrbitIdKeysKeyId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminV1OrbitsOrbitIdKeysKeyId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	// ------------- Path parameter "key_id" -------------
+	var keyId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key_id", ctx.Param("key_id"), &keyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter key_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminV1OrbitsOrbitIdKeysKeyId(ctx, orbitId, keyId)
+	return err
+}
+
+// PutAdminV1OrbitsOrbitIdKeysKeyId converts echo context to params.
+func (w *ServerInterfaceWrapper) PutAdminV1OrbitsOrbitIdKeysKeyId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	// ------------- Path parameter "key_id" -------------
+	var keyId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key_id", ctx.Param("key_id"), &keyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter key_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutAdminV1OrbitsOrbitIdKeysKeyIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutAdminV1OrbitsOrbitIdKeysKeyId(ctx, orbitId, keyId, params)
+	return err
+}
+
+// GetAdminV1OrbitsOrbitIdPermissions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminV1OrbitsOrbitIdPermissions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminV1OrbitsOrbitIdPermissionsParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminV1OrbitsOrbitIdPermissions(ctx, orbitId, params)
+	return err
+}
+
+// PostAdminV1OrbitsOrbitIdPermissions converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAdminV1OrbitsOrbitIdPermissions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAdminV1OrbitsOrbitIdPermissions(ctx, orbitId)
+	return err
+}
+
+// DeleteAdminV1OrbitsOrbitIdPermissionsPermissionId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAdminV1OrbitsOrbitIdPermissionsPermissionId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	// ------------- Path parameter "permission_id" -------------
+	var permissionId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "permission_id", ctx.Param("permission_id"), &permissionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter permission_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteAdminV1OrbitsOrbitIdPermissionsPermissionId(ctx, orbitId, permissionId)
+	return err
+}
+
+// GetAdminV1OrbitsOrbitIdPermissionsPermissionId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminV1OrbitsOrbitIdPermissionsPermissionId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	// ------------- Path parameter "permission_id" -------------
+	var permissionId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "permission_id", ctx.Param("permission_id"), &permissionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter permission_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminV1OrbitsOrbitIdPermissionsPermissionId(ctx, orbitId, permissionId)
+	return err
+}
+
+// GetAdminV1OrbitsOrbitIdPolicies converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminV1OrbitsOrbitIdPolicies(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminV1OrbitsOrbitIdPoliciesParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminV1OrbitsOrbitIdPolicies(ctx, orbitId, params)
+	return err
+}
+
+// PostAdminV1OrbitsOrbitIdPolicies converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAdminV1OrbitsOrbitIdPolicies(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAdminV1OrbitsOrbitIdPolicies(ctx, orbitId)
+	return err
+}
+
+// DeleteAdminV1OrbitsOrbitIdPoliciesPolicyId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAdminV1OrbitsOrbitIdPoliciesPolicyId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	// ------------- Path parameter "policy_id" -------------
+	var policyId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "policy_id", ctx.Param("policy_id"), &policyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter policy_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteAdminV1OrbitsOrbitIdPoliciesPolicyId(ctx, orbitId, policyId)
+	return err
+}
+
+// GetAdminV1OrbitsOrbitIdPoliciesPolicyId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminV1OrbitsOrbitIdPoliciesPolicyId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	// ------------- Path parameter "policy_id" -------------
+	var policyId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "policy_id", ctx.Param("policy_id"), &policyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter policy_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminV1OrbitsOrbitIdPoliciesPolicyId(ctx, orbitId, policyId)
+	return err
+}
+
+// PutAdminV1OrbitsOrbitIdPoliciesPolicyId converts echo context to params.
+func (w *ServerInterfaceWrapper) PutAdminV1OrbitsOrbitIdPoliciesPolicyId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	// ------------- Path parameter "policy_id" -------------
+	var policyId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "policy_id", ctx.Param("policy_id"), &policyId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter policy_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutAdminV1OrbitsOrbitIdPoliciesPolicyIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutAdminV1OrbitsOrbitIdPoliciesPolicyId(ctx, orbitId, policyId, params)
+	return err
+}
+
+// GetAdminV1OrbitsOrbitIdPolicyDecisions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminV1OrbitsOrbitIdPolicyDecisions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminV1OrbitsOrbitIdPolicyDecisionsParams
+	// ------------- Optional query parameter "action" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "action", ctx.QueryParams(), &params.Action)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter action: %s", err))
+	}
+
+	// ------------- Optional query parameter "decision" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "decision", ctx.QueryParams(), &params.Decision)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter decision: %s", err))
+	}
+
+	// ------------- Optional query parameter "user_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "user_id", ctx.QueryParams(), &params.UserId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// ------------- Optional query parameter "since" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "since", ctx.QueryParams(), &params.Since)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter since: %s", err))
+	}
+
+	// ------------- Optional query parameter "until" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "until", ctx.QueryParams(), &params.Until)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter until: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminV1OrbitsOrbitIdPolicyDecisions(ctx, orbitId, params)
+	return err
+}
+
+// GetAdminV1OrbitsOrbitIdRoles converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminV1OrbitsOrbitIdRoles(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminV1OrbitsOrbitIdRolesParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminV1OrbitsOrbitIdRoles(ctx, orbitId, params)
+	return err
+}
+
+// PostAdminV1OrbitsOrbitIdRoles converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAdminV1OrbitsOrbitIdRoles(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAdminV1OrbitsOrbitIdRoles(ctx, orbitId)
+	return err
+}
+
+// DeleteAdminV1OrbitsOrbitIdRolesRoleId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAdminV1OrbitsOrbitIdRolesRoleId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	// ------------- Path parameter "role_id" -------------
+	var roleId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "role_id", ctx.Param("role_id"), &roleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter role_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteAdminV1OrbitsOrbitIdRolesRoleId(ctx, orbitId, roleId)
+	return err
+}
+
+// GetAdminV1OrbitsOrbitIdRolesRoleId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminV1OrbitsOrbitIdRolesRoleId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	// ------------- Path parameter "role_id" -------------
+	var roleId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "role_id", ctx.Param("role_id"), &roleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter role_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminV1OrbitsOrbitIdRolesRoleId(ctx, orbitId, roleId)
+	return err
+}
+
+// GetAdminV1OrbitsOrbitIdRolesRoleIdIncludes converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminV1OrbitsOrbitIdRolesRoleIdIncludes(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	// ------------- Path parameter "role_id" -------------
+	var roleId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "role_id", ctx.Param("role_id"), &roleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter role_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminV1OrbitsOrbitIdRolesRoleIdIncludes(ctx, orbitId, roleId)
+	return err
+}
+
+// DeleteAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	// ------------- Path parameter "role_id" -------------
+	var roleId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "role_id", ctx.Param("role_id"), &roleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter role_id: %s", err))
+	}
+
+	// ------------- Path parameter "included_role_id" -------------
+	var includedRoleId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "included_role_id", ctx.Param("included_role_id"), &includedRoleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter included_role_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleId(ctx, orbitId, roleId, includedRoleId)
+	return err
+}
+
+// PutAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleId converts echo context to params.
+func (w *ServerInterfaceWrapper) PutAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	// ------------- Path parameter "role_id" -------------
+	var roleId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "role_id", ctx.Param("role_id"), &roleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter role_id: %s", err))
+	}
+
+	// ------------- Path parameter "included_role_id" -------------
+	var includedRoleId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "included_role_id", ctx.Param("included_role_id"), &includedRoleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter included_role_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleId(ctx, orbitId, roleId, includedRoleId)
+	return err
+}
+
+// GetAdminV1OrbitsOrbitIdRolesRoleIdPermissions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminV1OrbitsOrbitIdRolesRoleIdPermissions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	// ------------- Path parameter "role_id" -------------
+	var roleId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "role_id", ctx.Param("role_id"), &roleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter role_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminV1OrbitsOrbitIdRolesRoleIdPermissions(ctx, orbitId, roleId)
+	return err
+}
+
+// DeleteAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	// ------------- Path parameter "role_id" -------------
+	var roleId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "role_id", ctx.Param("role_id"), &roleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter role_id: %s", err))
+	}
+
+	// ------------- Path parameter "permission_id" -------------
+	var permissionId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "permission_id", ctx.Param("permission_id"), &permissionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter permission_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionId(ctx, orbitId, roleId, permissionId)
+	return err
+}
+
+// PutAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionId converts echo context to params.
+func (w *ServerInterfaceWrapper) PutAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	// ------------- Path parameter "role_id" -------------
+	var roleId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "role_id", ctx.Param("role_id"), &roleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter role_id: %s", err))
+	}
+
+	// ------------- Path parameter "permission_id" -------------
+	var permissionId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "permission_id", ctx.Param("permission_id"), &permissionId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter permission_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionId(ctx, orbitId, roleId, permissionId)
+	return err
+}
+
+// GetAdminV1OrbitsOrbitIdScopes converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminV1OrbitsOrbitIdScopes(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminV1OrbitsOrbitIdScopesParams
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminV1OrbitsOrbitIdScopes(ctx, orbitId, params)
+	return err
+}
+
+// PostAdminV1OrbitsOrbitIdScopes converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAdminV1OrbitsOrbitIdScopes(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAdminV1OrbitsOrbitIdScopes(ctx, orbitId)
+	return err
+}
+
+// DeleteAdminV1OrbitsOrbitIdScopesScopeId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAdminV1OrbitsOrbitIdScopesScopeId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	// ------------- Path parameter "scope_id" -------------
+	var scopeId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "scope_id", ctx.Param("scope_id"), &scopeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scope_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteAdminV1OrbitsOrbitIdScopesScopeId(ctx, orbitId, scopeId)
+	return err
+}
+
+// GetAdminV1OrbitsOrbitIdScopesScopeId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminV1OrbitsOrbitIdScopesScopeId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	// ------------- Path parameter "scope_id" -------------
+	var scopeId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "scope_id", ctx.Param("scope_id"), &scopeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scope_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminV1OrbitsOrbitIdScopesScopeId(ctx, orbitId, scopeId)
+	return err
+}
+
+// PutAdminV1OrbitsOrbitIdScopesScopeId converts echo context to params.
+func (w *ServerInterfaceWrapper) PutAdminV1OrbitsOrbitIdScopesScopeId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	// ------------- Path parameter "scope_id" -------------
+	var scopeId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "scope_id", ctx.Param("scope_id"), &scopeId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scope_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutAdminV1OrbitsOrbitIdScopesScopeIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutAdminV1OrbitsOrbitIdScopesScopeId(ctx, orbitId, scopeId, params)
+	return err
+}
+
+// GetAdminV1OrbitsOrbitIdUsers converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminV1OrbitsOrbitIdUsers(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAdminV1OrbitsOrbitIdUsersParams
+	// ------------- Optional query parameter "email_prefix" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "email_prefix", ctx.QueryParams(), &params.EmailPrefix)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter email_prefix: %s", err))
+	}
+
+	// ------------- Optional query parameter "is_active" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "is_active", ctx.QueryParams(), &params.IsActive)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter is_active: %s", err))
+	}
+
+	// ------------- Optional query parameter "created_after" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_after", ctx.QueryParams(), &params.CreatedAfter)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter created_after: %s", err))
+	}
+
+	// ------------- Optional query parameter "created_before" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "created_before", ctx.QueryParams(), &params.CreatedBefore)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter created_before: %s", err))
+	}
+
+	// ------------- Optional query parameter "limit" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "limit", ctx.QueryParams(), &params.Limit)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter limit: %s", err))
+	}
+
+	// ------------- Optional query parameter "cursor" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "cursor", ctx.QueryParams(), &params.Cursor)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter cursor: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminV1OrbitsOrbitIdUsers(ctx, orbitId, params)
+	return err
+}
+
+// PostAdminV1OrbitsOrbitIdUsers converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAdminV1OrbitsOrbitIdUsers(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAdminV1OrbitsOrbitIdUsers(ctx, orbitId)
+	return err
+}
+
+// DeleteAdminV1OrbitsOrbitIdUsersUserId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAdminV1OrbitsOrbitIdUsersUserId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	// ------------- Path parameter "user_id" -------------
+	var userId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteAdminV1OrbitsOrbitIdUsersUserId(ctx, orbitId, userId)
+	return err
+}
+
+// GetAdminV1OrbitsOrbitIdUsersUserId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminV1OrbitsOrbitIdUsersUserId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	// ------------- Path parameter "user_id" -------------
+	var userId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminV1OrbitsOrbitIdUsersUserId(ctx, orbitId, userId)
+	return err
+}
+
+// PutAdminV1OrbitsOrbitIdUsersUserId converts echo context to params.
+func (w *ServerInterfaceWrapper) PutAdminV1OrbitsOrbitIdUsersUserId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	// ------------- Path parameter "user_id" -------------
+	var userId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PutAdminV1OrbitsOrbitIdUsersUserIdParams
+
+	headers := ctx.Request().Header
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for If-Match, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter If-Match: %s", err))
+		}
+
+		params.IfMatch = &IfMatch
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutAdminV1OrbitsOrbitIdUsersUserId(ctx, orbitId, userId, params)
+	return err
+}
+
+// GetAdminV1OrbitsOrbitIdUsersUserIdPermissions converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminV1OrbitsOrbitIdUsersUserIdPermissions(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	// ------------- Path parameter "user_id" -------------
+	var userId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminV1OrbitsOrbitIdUsersUserIdPermissions(ctx, orbitId, userId)
+	return err
+}
+
+// GetAdminV1OrbitsOrbitIdUsersUserIdRoles converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAdminV1OrbitsOrbitIdUsersUserIdRoles(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	// ------------- Path parameter "user_id" -------------
+	var userId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAdminV1OrbitsOrbitIdUsersUserIdRoles(ctx, orbitId, userId)
+	return err
+}
+
+// DeleteAdminV1OrbitsOrbitIdUsersUserIdRolesRoleId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteAdminV1OrbitsOrbitIdUsersUserIdRolesRoleId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	// ------------- Path parameter "user_id" -------------
+	var userId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// ------------- Path parameter "role_id" -------------
+	var roleId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "role_id", ctx.Param("role_id"), &roleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter role_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteAdminV1OrbitsOrbitIdUsersUserIdRolesRoleId(ctx, orbitId, userId, roleId)
+	return err
+}
+
+// PutAdminV1OrbitsOrbitIdUsersUserIdRolesRoleId converts echo context to params.
+func (w *ServerInterfaceWrapper) PutAdminV1OrbitsOrbitIdUsersUserIdRolesRoleId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	// ------------- Path parameter "user_id" -------------
+	var userId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	// ------------- Path parameter "role_id" -------------
+	var roleId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "role_id", ctx.Param("role_id"), &roleId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter role_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutAdminV1OrbitsOrbitIdUsersUserIdRolesRoleId(ctx, orbitId, userId, roleId)
+	return err
+}
+
+// PostAdminV1OrbitsOrbitIdUsersUserIdUnlock converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAdminV1OrbitsOrbitIdUsersUserIdUnlock(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "orbit_id" -------------
+	var orbitId OrbitId
+
+	err = runtime.BindStyledParameterWithOptions("simple", "orbit_id", ctx.Param("orbit_id"), &orbitId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter orbit_id: %s", err))
+	}
+
+	// ------------- Path parameter "user_id" -------------
+	var userId int64
+
+	err = runtime.BindStyledParameterWithOptions("simple", "user_id", ctx.Param("user_id"), &userId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter user_id: %s", err))
+	}
+
+	ctx.Set(AdminAuthScopes, []string{})
+
+	ctx.Set(AdminSessionScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAdminV1OrbitsOrbitIdUsersUserIdUnlock(ctx, orbitId, userId)
+	return err
+}
+
+// GetAuthorize converts echo context to params.
+func (w *ServerInterfaceWrapper) GetAuthorize(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAuthorizeParams
+	// ------------- Required query parameter "response_type" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "response_type", ctx.QueryParams(), &params.ResponseType)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter response_type: %s", err))
+	}
+
+	// ------------- Required query parameter "client_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "client_id", ctx.QueryParams(), &params.ClientId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter client_id: %s", err))
+	}
+
+	// ------------- Required query parameter "redirect_uri" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "redirect_uri", ctx.QueryParams(), &params.RedirectUri)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter redirect_uri: %s", err))
+	}
+
+	// ------------- Optional query parameter "scope" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "scope", ctx.QueryParams(), &params.Scope)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter scope: %s", err))
+	}
+
+	// ------------- Optional query parameter "state" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "state", ctx.QueryParams(), &params.State)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter state: %s", err))
+	}
+
+	// ------------- Optional query parameter "nonce" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "nonce", ctx.QueryParams(), &params.Nonce)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter nonce: %s", err))
+	}
+
+	// ------------- Optional query parameter "code_challenge" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "code_challenge", ctx.QueryParams(), &params.CodeChallenge)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter code_challenge: %s", err))
+	}
+
+	// ------------- Optional query parameter "code_challenge_method" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "code_challenge_method", ctx.QueryParams(), &params.CodeChallengeMethod)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter code_challenge_method: %s", err))
+	}
+
+	// ------------- Optional query parameter "resource" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "resource", ctx.QueryParams(), &params.Resource)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter resource: %s", err))
+	}
+
+	// ------------- Optional query parameter "prompt" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "prompt", ctx.QueryParams(), &params.Prompt)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter prompt: %s", err))
+	}
+
+	// ------------- Optional query parameter "max_age" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "max_age", ctx.QueryParams(), &params.MaxAge)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter max_age: %s", err))
+	}
+
+	// ------------- Optional query parameter "login_hint" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "login_hint", ctx.QueryParams(), &params.LoginHint)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter login_hint: %s", err))
+	}
+
+	// ------------- Optional query parameter "acr_values" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "acr_values", ctx.QueryParams(), &params.AcrValues)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter acr_values: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetAuthorize(ctx, params)
+	return err
+}
+
+// PostAuthzEvaluate converts echo context to params.
+func (w *ServerInterfaceWrapper) PostAuthzEvaluate(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ClientAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostAuthzEvaluate(ctx)
+	return err
+}
+
+// GetConsent converts echo context to params.
+func (w *ServerInterfaceWrapper) GetConsent(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetConsentParams
+	// ------------- Required query parameter "request_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "request_id", ctx.QueryParams(), &params.RequestId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter request_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetConsent(ctx, params)
+	return err
+}
+
+// PostConsent converts echo context to params.
+func (w *ServerInterfaceWrapper) PostConsent(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostConsent(ctx)
+	return err
+}
+
+// GetEmailVerify converts echo context to params.
+func (w *ServerInterfaceWrapper) GetEmailVerify(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetEmailVerifyParams
+	// ------------- Required query parameter "token" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "token", ctx.QueryParams(), &params.Token)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetEmailVerify(ctx, params)
+	return err
+}
+
+// PostIntrospect converts echo context to params.
+func (w *ServerInterfaceWrapper) PostIntrospect(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(ClientAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostIntrospect(ctx)
+	return err
+}
+
+// GetLogin converts echo context to params.
+func (w *ServerInterfaceWrapper) GetLogin(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLoginParams
+	// ------------- Required query parameter "request_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "request_id", ctx.QueryParams(), &params.RequestId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter request_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetLogin(ctx, params)
+	return err
+}
+
+// PostLogin converts echo context to params.
+func (w *ServerInterfaceWrapper) PostLogin(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostLogin(ctx)
+	return err
+}
+
+// GetLoginMfa converts echo context to params.
+func (w *ServerInterfaceWrapper) GetLoginMfa(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetLoginMfaParams
+	// ------------- Required query parameter "request_id" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "request_id", ctx.QueryParams(), &params.RequestId)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter request_id: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetLoginMfa(ctx, params)
+	return err
+}
+
+// PostLoginMfa converts echo context to params.
+func (w *ServerInterfaceWrapper) PostLoginMfa(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostLoginMfa(ctx)
+	return err
+}
+
+// PostLoginPasskey converts echo context to params.
+func (w *ServerInterfaceWrapper) PostLoginPasskey(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostLoginPasskey(ctx)
+	return err
+}
+
+// PostLoginPasskeyOptions converts echo context to params.
+func (w *ServerInterfaceWrapper) PostLoginPasskeyOptions(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostLoginPasskeyOptions(ctx)
+	return err
+}
+
+// PostLogout converts echo context to params.
+func (w *ServerInterfaceWrapper) PostLogout(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostLogout(ctx)
+	return err
+}
+
+// GetPasswordForgot converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPasswordForgot(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPasswordForgot(ctx)
+	return err
+}
+
+// PostPasswordForgot converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPasswordForgot(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPasswordForgot(ctx)
+	return err
+}
+
+// GetPasswordReset converts echo context to params.
+func (w *ServerInterfaceWrapper) GetPasswordReset(ctx echo.Context) error {
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPasswordResetParams
+	// ------------- Required query parameter "token" -------------
+
+	err = runtime.BindQueryParameter("form", true, true, "token", ctx.QueryParams(), &params.Token)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter token: %s", err))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetPasswordReset(ctx, params)
+	return err
+}
+
+// PostPasswordReset converts echo context to params.
+func (w *ServerInterfaceWrapper) PostPasswordReset(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostPasswordReset(ctx)
+	return err
+}
+
+// PostRegister converts echo context to params.
+func (w *ServerInterfaceWrapper) PostRegister(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostRegister(ctx)
+	return err
+}
+
+// DeleteRegisterClientId converts echo context to params.
+func (w *ServerInterfaceWrapper) DeleteRegisterClientId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "client_id" -------------
+	var clientId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "client_id", ctx.Param("client_id"), &clientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter client_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.DeleteRegisterClientId(ctx, clientId)
+	return err
+}
+
+// GetRegisterClientId converts echo context to params.
+func (w *ServerInterfaceWrapper) GetRegisterClientId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "client_id" -------------
+	var clientId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "client_id", ctx.Param("client_id"), &clientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter client_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetRegisterClientId(ctx, clientId)
+	return err
+}
+
+// PutRegisterClientId converts echo context to params.
+func (w *ServerInterfaceWrapper) PutRegisterClientId(ctx echo.Context) error {
+	var err error
+	// ------------- Path parameter "client_id" -------------
+	var clientId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "client_id", ctx.Param("client_id"), &clientId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter client_id: %s", err))
+	}
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PutRegisterClientId(ctx, clientId)
+	return err
+}
+
+// PostRevoke converts echo context to params.
+func (w *ServerInterfaceWrapper) PostRevoke(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostRevoke(ctx)
+	return err
+}
+
+// PostToken converts echo context to params.
+func (w *ServerInterfaceWrapper) PostToken(ctx echo.Context) error {
+	var err error
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostToken(ctx)
+	return err
+}
+
+// GetUserinfo converts echo context to params.
+func (w *ServerInterfaceWrapper) GetUserinfo(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.GetUserinfo(ctx)
+	return err
+}
+
+// This is a simple interface which specifies echo.Route addition functions which
+// are present on both echo.Echo and echo.Group, since we want to allow using
+// either of them for path registration
+type EchoRouter interface {
+	CONNECT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	DELETE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	GET(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	HEAD(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	OPTIONS(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PATCH(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	POST(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	PUT(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+	TRACE(path string, h echo.HandlerFunc, m ...echo.MiddlewareFunc) *echo.Route
+}
+
+// RegisterHandlers adds each server route to the EchoRouter.
+func RegisterHandlers(router EchoRouter, si ServerInterface) {
+	RegisterHandlersWithBaseURL(router, si, "")
+}
+
+// Registers handlers, and prepends BaseURL to the paths, so that the paths
+// can be served under a prefix.
+func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL string) {
+
+	wrapper := ServerInterfaceWrapper{
+		Handler: si,
+	}
+
+	router.GET(baseURL+"/.well-known/jwks.json", wrapper.GetWellKnownJwksJson)
+	router.GET(baseURL+"/.well-known/openid-configuration", wrapper.GetWellKnownOpenidConfiguration)
+	router.GET(baseURL+"/account/consents", wrapper.GetAccountConsents)
+	router.DELETE(baseURL+"/account/consents/:consent_id", wrapper.DeleteAccountConsentsConsentId)
+	router.POST(baseURL+"/account/email/verification", wrapper.PostAccountEmailVerification)
+	router.GET(baseURL+"/account/passkeys", wrapper.GetAccountPasskeys)
+	router.POST(baseURL+"/account/passkeys/registrations", wrapper.PostAccountPasskeysRegistrations)
+	router.POST(baseURL+"/account/passkeys/registrations/:ceremony_id", wrapper.PostAccountPasskeysRegistrationsCeremonyId)
+	router.DELETE(baseURL+"/account/passkeys/:passkey_id", wrapper.DeleteAccountPasskeysPasskeyId)
+	router.GET(baseURL+"/account/recovery-codes", wrapper.GetAccountRecoveryCodes)
+	router.POST(baseURL+"/account/recovery-codes", wrapper.PostAccountRecoveryCodes)
+	router.DELETE(baseURL+"/account/sessions", wrapper.DeleteAccountSessions)
+	router.GET(baseURL+"/account/sessions", wrapper.GetAccountSessions)
+	router.DELETE(baseURL+"/account/sessions/:session_id", wrapper.DeleteAccountSessionsSessionId)
+	router.GET(baseURL+"/account/totp", wrapper.GetAccountTotp)
+	router.POST(baseURL+"/account/totp", wrapper.PostAccountTotp)
+	router.DELETE(baseURL+"/account/totp/:totp_id", wrapper.DeleteAccountTotpTotpId)
+	router.POST(baseURL+"/account/totp/:totp_id/confirm", wrapper.PostAccountTotpTotpIdConfirm)
+	router.GET(baseURL+"/admin/v1/orbits", wrapper.GetAdminV1Orbits)
+	router.POST(baseURL+"/admin/v1/orbits", wrapper.PostAdminV1Orbits)
+	router.DELETE(baseURL+"/admin/v1/orbits/:orbit_id", wrapper.DeleteAdminV1OrbitsOrbitId)
+	router.GET(baseURL+"/admin/v1/orbits/:orbit_id", wrapper.GetAdminV1OrbitsOrbitId)
+	router.PUT(baseURL+"/admin/v1/orbits/:orbit_id", wrapper.PutAdminV1OrbitsOrbitId)
+	router.GET(baseURL+"/admin/v1/orbits/:orbit_id/audit-logs", wrapper.GetAdminV1OrbitsOrbitIdAuditLogs)
+	router.GET(baseURL+"/admin/v1/orbits/:orbit_id/clients", wrapper.GetAdminV1OrbitsOrbitIdClients)
+	router.POST(baseURL+"/admin/v1/orbits/:orbit_id/clients", wrapper.PostAdminV1OrbitsOrbitIdClients)
+	router.DELETE(baseURL+"/admin/v1/orbits/:orbit_id/clients/:client_id", wrapper.DeleteAdminV1OrbitsOrbitIdClientsClientId)
+	router.GET(baseURL+"/admin/v1/orbits/:orbit_id/clients/:client_id", wrapper.GetAdminV1OrbitsOrbitIdClientsClientId)
+	router.PUT(baseURL+"/admin/v1/orbits/:orbit_id/clients/:client_id", wrapper.PutAdminV1OrbitsOrbitIdClientsClientId)
+	router.POST(baseURL+"/admin/v1/orbits/:orbit_id/clients/:client_id/secret", wrapper.PostAdminV1OrbitsOrbitIdClientsClientIdSecret)
+	router.GET(baseURL+"/admin/v1/orbits/:orbit_id/groups", wrapper.GetAdminV1OrbitsOrbitIdGroups)
+	router.POST(baseURL+"/admin/v1/orbits/:orbit_id/groups", wrapper.PostAdminV1OrbitsOrbitIdGroups)
+	router.DELETE(baseURL+"/admin/v1/orbits/:orbit_id/groups/:group_id", wrapper.DeleteAdminV1OrbitsOrbitIdGroupsGroupId)
+	router.GET(baseURL+"/admin/v1/orbits/:orbit_id/groups/:group_id", wrapper.GetAdminV1OrbitsOrbitIdGroupsGroupId)
+	router.GET(baseURL+"/admin/v1/orbits/:orbit_id/groups/:group_id/members", wrapper.GetAdminV1OrbitsOrbitIdGroupsGroupIdMembers)
+	router.DELETE(baseURL+"/admin/v1/orbits/:orbit_id/groups/:group_id/members/:user_id", wrapper.DeleteAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserId)
+	router.PUT(baseURL+"/admin/v1/orbits/:orbit_id/groups/:group_id/members/:user_id", wrapper.PutAdminV1OrbitsOrbitIdGroupsGroupIdMembersUserId)
+	router.GET(baseURL+"/admin/v1/orbits/:orbit_id/groups/:group_id/roles", wrapper.GetAdminV1OrbitsOrbitIdGroupsGroupIdRoles)
+	router.DELETE(baseURL+"/admin/v1/orbits/:orbit_id/groups/:group_id/roles/:role_id", wrapper.DeleteAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleId)
+	router.PUT(baseURL+"/admin/v1/orbits/:orbit_id/groups/:group_id/roles/:role_id", wrapper.PutAdminV1OrbitsOrbitIdGroupsGroupIdRolesRoleId)
+	router.GET(baseURL+"/admin/v1/orbits/:orbit_id/keys", wrapper.GetAdminV1OrbitsOrbitIdKeys)
+	router.POST(baseURL+"/admin/v1/orbits/:orbit_id/keys", wrapper.PostAdminV1OrbitsOrbitIdKeys)
+	router.DELETE(baseURL+"/admin/v1/orbits/:orbit_id/keys/:key_id", wrapper.DeleteAdminV1OrbitsOrbitIdKeysKeyId)
+	router.GET(baseURL+"/admin/v1/orbits/:orbit_id/keys/:key_id", wrapper.GetAdminV1OrbitsOrbitIdKeysKeyId)
+	router.PUT(baseURL+"/admin/v1/orbits/:orbit_id/keys/:key_id", wrapper.PutAdminV1OrbitsOrbitIdKeysKeyId)
+	router.GET(baseURL+"/admin/v1/orbits/:orbit_id/permissions", wrapper.GetAdminV1OrbitsOrbitIdPermissions)
+	router.POST(baseURL+"/admin/v1/orbits/:orbit_id/permissions", wrapper.PostAdminV1OrbitsOrbitIdPermissions)
+	router.DELETE(baseURL+"/admin/v1/orbits/:orbit_id/permissions/:permission_id", wrapper.DeleteAdminV1OrbitsOrbitIdPermissionsPermissionId)
+	router.GET(baseURL+"/admin/v1/orbits/:orbit_id/permissions/:permission_id", wrapper.GetAdminV1OrbitsOrbitIdPermissionsPermissionId)
+	router.GET(baseURL+"/admin/v1/orbits/:orbit_id/policies", wrapper.GetAdminV1OrbitsOrbitIdPolicies)
+	router.POST(baseURL+"/admin/v1/orbits/:orbit_id/policies", wrapper.PostAdminV1OrbitsOrbitIdPolicies)
+	router.DELETE(baseURL+"/admin/v1/orbits/:orbit_id/policies/:policy_id", wrapper.DeleteAdminV1OrbitsOrbitIdPoliciesPolicyId)
+	router.GET(baseURL+"/admin/v1/orbits/:orbit_id/policies/:policy_id", wrapper.GetAdminV1OrbitsOrbitIdPoliciesPolicyId)
+	router.PUT(baseURL+"/admin/v1/orbits/:orbit_id/policies/:policy_id", wrapper.PutAdminV1OrbitsOrbitIdPoliciesPolicyId)
+	router.GET(baseURL+"/admin/v1/orbits/:orbit_id/policy-decisions", wrapper.GetAdminV1OrbitsOrbitIdPolicyDecisions)
+	router.GET(baseURL+"/admin/v1/orbits/:orbit_id/roles", wrapper.GetAdminV1OrbitsOrbitIdRoles)
+	router.POST(baseURL+"/admin/v1/orbits/:orbit_id/roles", wrapper.PostAdminV1OrbitsOrbitIdRoles)
+	router.DELETE(baseURL+"/admin/v1/orbits/:orbit_id/roles/:role_id", wrapper.DeleteAdminV1OrbitsOrbitIdRolesRoleId)
+	router.GET(baseURL+"/admin/v1/orbits/:orbit_id/roles/:role_id", wrapper.GetAdminV1OrbitsOrbitIdRolesRoleId)
+	router.GET(baseURL+"/admin/v1/orbits/:orbit_id/roles/:role_id/includes", wrapper.GetAdminV1OrbitsOrbitIdRolesRoleIdIncludes)
+	router.DELETE(baseURL+"/admin/v1/orbits/:orbit_id/roles/:role_id/includes/:included_role_id", wrapper.DeleteAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleId)
+	router.PUT(baseURL+"/admin/v1/orbits/:orbit_id/roles/:role_id/includes/:included_role_id", wrapper.PutAdminV1OrbitsOrbitIdRolesRoleIdIncludesIncludedRoleId)
+	router.GET(baseURL+"/admin/v1/orbits/:orbit_id/roles/:role_id/permissions", wrapper.GetAdminV1OrbitsOrbitIdRolesRoleIdPermissions)
+	router.DELETE(baseURL+"/admin/v1/orbits/:orbit_id/roles/:role_id/permissions/:permission_id", wrapper.DeleteAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionId)
+	router.PUT(baseURL+"/admin/v1/orbits/:orbit_id/roles/:role_id/permissions/:permission_id", wrapper.PutAdminV1OrbitsOrbitIdRolesRoleIdPermissionsPermissionId)
+	router.GET(baseURL+"/admin/v1/orbits/:orbit_id/scopes", wrapper.GetAdminV1OrbitsOrbitIdScopes)
+	router.POST(baseURL+"/admin/v1/orbits/:orbit_id/scopes", wrapper.PostAdminV1OrbitsOrbitIdScopes)
+	router.DELETE(baseURL+"/admin/v1/orbits/:orbit_id/scopes/:scope_id", wrapper.DeleteAdminV1OrbitsOrbitIdScopesScopeId)
+	router.GET(baseURL+"/admin/v1/orbits/:orbit_id/scopes/:scope_id", wrapper.GetAdminV1OrbitsOrbitIdScopesScopeId)
+	router.PUT(baseURL+"/admin/v1/orbits/:orbit_id/scopes/:scope_id", wrapper.PutAdminV1OrbitsOrbitIdScopesScopeId)
+	router.GET(baseURL+"/admin/v1/orbits/:orbit_id/users", wrapper.GetAdminV1OrbitsOrbitIdUsers)
+	router.POST(baseURL+"/admin/v1/orbits/:orbit_id/users", wrapper.PostAdminV1OrbitsOrbitIdUsers)
+	router.DELETE(baseURL+"/admin/v1/orbits/:orbit_id/users/:user_id", wrapper.DeleteAdminV1OrbitsOrbitIdUsersUserId)
+	router.GET(baseURL+"/admin/v1/orbits/:orbit_id/users/:user_id", wrapper.GetAdminV1OrbitsOrbitIdUsersUserId)
+	router.PUT(baseURL+"/admin/v1/orbits/:orbit_id/users/:user_id", wrapper.PutAdminV1OrbitsOrbitIdUsersUserId)
+	router.GET(baseURL+"/admin/v1/orbits/:orbit_id/users/:user_id/permissions", wrapper.GetAdminV1OrbitsOrbitIdUsersUserIdPermissions)
+	router.GET(baseURL+"/admin/v1/orbits/:orbit_id/users/:user_id/roles", wrapper.GetAdminV1OrbitsOrbitIdUsersUserIdRoles)
+	router.DELETE(baseURL+"/admin/v1/orbits/:orbit_id/users/:user_id/roles/:role_id", wrapper.DeleteAdminV1OrbitsOrbitIdUsersUserIdRolesRoleId)
+	router.PUT(baseURL+"/admin/v1/orbits/:orbit_id/users/:user_id/roles/:role_id", wrapper.PutAdminV1OrbitsOrbitIdUsersUserIdRolesRoleId)
+	router.POST(baseURL+"/admin/v1/orbits/:orbit_id/users/:user_id/unlock", wrapper.PostAdminV1OrbitsOrbitIdUsersUserIdUnlock)
+	router.GET(baseURL+"/authorize", wrapper.GetAuthorize)
+	router.POST(baseURL+"/authz/evaluate", wrapper.PostAuthzEvaluate)
+	router.GET(baseURL+"/consent", wrapper.GetConsent)
+	router.POST(baseURL+"/consent", wrapper.PostConsent)
+	router.GET(baseURL+"/email/verify", wrapper.GetEmailVerify)
+	router.POST(baseURL+"/introspect", wrapper.PostIntrospect)
+	router.GET(baseURL+"/login", wrapper.GetLogin)
+	router.POST(baseURL+"/login", wrapper.PostLogin)
+	router.GET(baseURL+"/login/mfa", wrapper.GetLoginMfa)
+	router.POST(baseURL+"/login/mfa", wrapper.PostLoginMfa)
+	router.POST(baseURL+"/login/passkey", wrapper.PostLoginPasskey)
+	router.POST(baseURL+"/login/passkey/options", wrapper.PostLoginPasskeyOptions)
+	router.POST(baseURL+"/logout", wrapper.PostLogout)
+	router.GET(baseURL+"/password/forgot", wrapper.GetPasswordForgot)
+	router.POST(baseURL+"/password/forgot", wrapper.PostPasswordForgot)
+	router.GET(baseURL+"/password/reset", wrapper.GetPasswordReset)
+	router.POST(baseURL+"/password/reset", wrapper.PostPasswordReset)
+	router.POST(baseURL+"/register", wrapper.PostRegister)
+	router.DELETE(baseURL+"/register/:client_id", wrapper.DeleteRegisterClientId)
+	router.GET(baseURL+"/register/:client_id", wrapper.GetRegisterClientId)
+	router.PUT(baseURL+"/register/:client_id", wrapper.PutRegisterClientId)
+	router.POST(baseURL+"/revoke", wrapper.PostRevoke)
+	router.POST(baseURL+"/token", wrapper.PostToken)
+	router.GET(baseURL+"/userinfo", wrapper.GetUserinfo)
+
+}
+
+// Base64 encoded, gzipped, json marshaled Swagger object
+var swaggerSpec = []string{
+
+	"H4sIAAAAAAAC/+y9WXPbxtog/Fe68H1VmZmCRNlZZo5PvRc+tpNXsR15JOfkInaxWsBDsiOwG6e7IYpR",
+	"6b9P9YaNDRLgTgsXiW0C6OXpZ9/6MYjYNGUUqBTBq8cgxRxPQQLX/3qTccG4+lsMIuIklYTR4FVA4UEO",
+	"I/0QsRGSE0Aph3vCMoFSPIYgDIh67z8Z8HkQBhRPIXgVmC+CMBDRBKZYjSvnqXoiJCd0HDw9hcHl6COW",
+	"0WRx0nef8djNxiHlIIBKrB7qn6IJpmNARKBbLCBGjLpVTADHwItlXI7OzBTLF/KBTIlcXMYnPAYkyN8Q",
+	"oh8v0O0cxTDCWSIRpjHCEk2ZkOjHi4sGGCR61PLMU/xAptk0ePXjxUUYTAk1/3oRujURKmEMXC/qit8S",
+	"eRmr7/ToKZaTYnCmng5JHIQBh/9khEMcvJI8g/J8I8anWJpxf/oh8Ezz5F7XSPA6i4n8wMbq7ylnKXBJ",
+	"QD/BkYHJAvRC9YhxtZIF+P0ugKPZhKEpjqF0ciHCt+pE0Yhx/TOOp4Si158ukWR3oE5z5crDIOKAJcRD",
+	"LCtbjbGEM0mmEISLayVx5d3mwacgcYylhiKOY6J2hJNPJZgYWNsv2e1fEEn1ocR8DNKPZsVB/Rnok7NQ",
+	"zb+qbOqrZ3R3QB+IkIuHRCRMq3/5/zmMglfB/zcoSH9gz3uQH/ZTPhHmHM/Vv0tUv3isbyrcQL2qOUF+",
+	"qpZKEyykYxErYKGX69+vnPz9FiIiLPZVNxyXngBVpPRngJOEzYIwiIHOg68LU4f5R16UfUcln7utuTcR",
+	"odV/J2zcDkfZbULGmnGJxbmuiodmRiYApSwhEQERotmERBNDNThJgKNpJiQaZcmIJGg2AYqIRDiSArHq",
+	"8oKwQIBuuFvDAreWxaX/hqcgcnlgX0NygqVeRQxxiGCayrlZKGUUEE7ThEBcXt3C2VRXUEOT0v7ylVVB",
+	"3IhC1/CfDHwkU/A1eMDTNDHnd89IBK9wmnJ2D0Go+PYHoGM5CV69vLjIJynWHTEq4UGugngViK+l5OQ2",
+	"kwUkuVlmiDjgWAmciFEzmkBYIDeLZ5ccBMt4BJuvwIyjEEtL1qa15BOGBeQeAzxlGZXBqxcaTDGkmMsp",
+	"qF+CEaGY6vdJbIB89uLih5fBk2c3mYAlEsUuVUvAGp0KhG9ZJisCxj21zxBlSI3fhoBrCGiRxYdlbxJM",
+	"ph9xmgJfRLJIPfQTkduMfuWfiMOYCAkcYvTrH5+1nnF1+faNeSxQhCllEt2C2kFcRcwXXsRMOYzIg0e1",
+	"4ZACjSFGkiG4Bz5H9zjJIEQiiybqgK+vPrwbagDepGo0dANRxomcV6f90TdrgYvVWa9ZAgKlmRRGdJS5",
+	"iDqT7wTi+hXLcPW2z9En4FMizBn6P05Lb7jB0IQlsfonZ9l4YgdWEJ1pRuskPEqI0Dw0Pwutg3wnEM5i",
+	"AjQCgTIaA0dfgvyXL8F5EOYiRw8dhEFpFV7Jowf2cNPP+vdixxqPY3M4IcJJYlc2NdyUTYmUVUaaC78o",
+	"AiGGTosisf2rdzXLeG1O3AZ3/ShPgMpLaqjIclGcJFej4NWfy1UP8+lHewLBU7hIMeqFIYmHRIhsUclb",
+	"ohWaLwVE3KuG1d4YwkNKOIj2ExgS5XrHwwq8fZNV3rYzZ5xU5lL/XqUi5QDxHMXX/DA+lrTWmpxTwjcy",
+	"yzCfF0gzg1ttV0hyD15MKSZfAk9jlzQ/b7VrI0mVUlNRY1fgbhiMOaZSb6zjh3/N7rrrSeqjtvtJ2Ji1",
+	"fTdlQg7VB5kccogJh0jDrbqnVaPUt7jNkUTKqIB1AC0ilvrxQ7CRnGEOTfiVPxcSSzDaRJ2D3pAxdTJT",
+	"CHV8dIwM4uWMPkSGlyhdBiPJM6E0HAMbpu325qnvgYsm+1cT/xBonDJC5RBncjKcgpywuExkSgUO6szn",
+	"FgsSLfyq0CBQopvcYwnDO5gP/5pJD2U+NXLlm5z9efnqptxxiY3t51t2Qq8YURhFm9e6JtfpvnLLRXbl",
+	"UdAE0IlofB6DAij5iJV1+yD8jnPm0UrB/bwIO/VkWKGwVe4MM5hv9l84y1LP6R6l96YBnXwnoV9d6bDR",
+	"u7+kaeZB8I1XucIsra1af9W4xm14lMxRH7E76ZJKzkQKudG/6Ay4L5//LWMJYKo+xVncTeAtZ1/wkJZ+",
+	"L6EuwbLhgfDP+5ck3t+XCNzsdokgc9rhwuNMAG+mjwVY//rH+xufCLqDeXuMKn4a2p+GuRLy1+xuJc/U",
+	"k/kw4Uq5hYys9OCBciFCPIwYF0PGyZjQjtqOG6E70w+9qrpH/GEyHU61w8FjUb5x/gLO50oVWjCwlR1c",
+	"NpqVwW2sUKskSWbtUQ2icIPj0i6tYXnBC7sOg4ezMTuzv/35texN2Zb9Ueg8NS8sTeaIg8y40iC1gY2R",
+	"eRkRB44g3L6mVLeMtmQKrSNYl4v6DcwrIobLmCoRwzS7TUjkf7yxcbbUGlvT/NrA3tqpgbXcDFnk5mnc",
+	"EU0aPRL1LVfxZWFXS5fqYX8L3DT0M+gyOpUxr0ISlY2vEAwNatvxSYcOjpznKjWqG/0FKHAsHb+3DlWl",
+	"aWpUIYz+s+RrN0HruFUcqCqLyu//+GN4GN/Yfpi7TY2oseVtMvP9e9pOk9Xv2+NU4pjbMCJLwx2zKamz",
+	"cxb3GjE6IuNuUdhPwM9MMFOAVJ5LkQfhUizEjPE4RAmL7lT0UrHgclABlULhC4tcTxfUhLyWcFrFamIi",
+	"0gTPm3X1mE0xoYsH+99MSB3xKyK/3wnkKEAgzAEJ4PcuGWx915EWZLwND2ncxMaqVdm/ZNeTgyZ0KLZw",
+	"Uh31HJ1d5tdw2iHxwpD7xJyVkrXApJWvdj/yNRxviyfZeCpb4aJqoGPmn5+wEHcwX9znLY7uIB5m6eLy",
+	"/piAnIBNGczkBKhUqjDjiEPKuFLh1KPUDK34p5jTSPEEjsywKEsDn3KyFqMkImL3wPFtAsvX6hYUYepy",
+	"N9CMyIni50AlqBERRrmDzbfC1gxMHc4wEx03093xXdl+WDq2lT5xe/RXaZ4XV2M/wGHK6Nyrul/G6tRH",
+	"Jt0MkHvXKPI+xDBqFiJKqN7mmRMLAGBpU5aeeaAzYSi+J2M17nnEQS8EJ+LcbBc1Ph+DDPV563Tln37I",
+	"eIKARkwleNxmoxHwReFdtW3+EoyeX+PZRxBCkd2CLV6CWLEVL+xz6+3ZhmQKEGwYl6nl0tFqgpDOK7JZ",
+	"SgYv7aNawpLCzDSTJVtaYZrKt9OpQNZY9yU6mRyd82D3EaICZNuQTcVoRy2glF49b8oY7eqUdbmT3rd3",
+	"4bKF0UhtpEtidAcdealXt1ExrmVDbydJeavatoVamJ9y+eiqG1jfxWgwqzmrfUmtxRKf1iWVwClOEImL",
+	"pFL1tknLxuIO4hKdRICIDnDEkICRiTuquVgrSV/nE/hclEUSst5WxLIkRtZRByqFFctOOeYd0H6TdPhO",
+	"K+JFtnp7+fO5kr1NY5ctjnCR5F1Jlp5hYQpzyhazNwe7a550tbbFn7a/SkhXaGQrUqcy4vFLnqboQ9Sg",
+	"p76OZJ7+rOGsMvZV3lqR0l0rbDhHr5HkmCTK/PhfaKoK5UDYbHAzj+IRJoUcxPlSHJ7ih0vz8EdT2mb/",
+	"9WKFMKyV3zykHIxapKlHl7yoPO68VMxuTTJdTzIPkdqjflLCc4W7oWV/od1KmFNHWJAGVbxjFurRbRmD",
+	"/vg85WxEEjgvShjQf/1XPkL55y/ZxcXLn8z/lQZoYMu/BEqj02Pp2Mk5ek1tSUy+f4STGZ4LvUEN3pK6",
+	"9sPFP37aSOxX3gzegrKRCBUGXpr//tNCRqiSCAtX/cD402KgplynvQLRKSDQUkddUUR1nUfulYFVZnAV",
+	"UpjiOxBhjkW2okoyW091jt7haGK1dSIFuiM0tmn/aj0FDT1+0Qv8ErxCXwLKJBnNvwQh+hJIZn5Ter78",
+	"Ejydr12GVZDSy4sV+S1NakszW9keKz1mFnoNmvfN37AYbiSWmcfNwEG54dS4nnyr2kzFu6tmEz53arxR",
+	"4qf53jsxS8Az3/Ow49XmjzyzUi1xGwSnz/mIye3G5RpujoirBNy2jFQihrmE6mTEbjm6k69ifXtSQ7+B",
+	"ElaCs5PIrgIt/+sIJ2KpiK8hZj2zwpijdsur0yzaUp+GyzbITw901PQHTS7dZn/B57KDwFZMClNGQ6ir",
+	"mgzCNfItM869RTpFSETnVeppRa7t59XPhWGqVDpvMCSGexLBkNARa6gRxmMNYgP7W85mQk+MZbFH397W",
+	"yeJsz5E8Ia3XccxBCN8RjDib+ubTAR5DtHad1SE/KITKBISIQ8S4ijK4XiWazlLglSi6ZEq1Vevk9zgJ",
+	"wpbbFhLzzfmgQ5bKeAt79OI8GSuF7L0vhoiT8dYcrbtFieVC6q4h5ZkyObyFEePQfk0mM1JnzXfVirqL",
+	"PO0+aqnt3en/qyMzX1WWuoFUzPGjMY9zXJFiwbublz/+FIS+/PBMVNMDS1ax++pa//l1WxjUzZTujA9P",
+	"SyG2FaGZj3bMkvPz1edPr8sR44ZsGD6F2E+jO7W21jCa8tWuNKDU3t9RzpJk6i2D2XSVYcBkqpMTbVZm",
+	"9ZTfwxz9fn2pfTLVoD1OU6H6HgiTTfx/r5EygVGK5wnD3uh9U3HHv7CA71/m0XYxwVz9od8OEauUfjDT",
+	"naQ9qO2k1W16Aa3iutc2E8Hn0l3RRMAxEEIbSsPiJR9zGHEQkyVvNFeKVSvCig45/wLMga8EVq0bRWm0",
+	"yp58IFNK3JYMylU5hzDFJGl+omq/yYg0kf8W7VGV4tk0jVaIXDbo0NhN7WEwHeEhUJWp0zC8dXfvSzPg",
+	"Hfha/vrCeVR1gwKA1f120xoU3jWZ0l1TEHPEavdmBdFW29bdNASHOh5hOwEFNYTHmFAhK6aB+8p60c+R",
+	"VoYUWacL9RLqnESe1sYoaFNGGTK3c8S04TcFTMW5VzldF/9KuNTNa5B/2YQF29CB1DjHrP20rbzpO1r1",
+	"Ha2eQUerZeTQ1KVkrewWN6rXvF8IPeXvlnIpOi6f5I0PfHGwArlXdgRqVCIL5W44IbRFA9T6MbbaR8LG",
+	"hPosFaBSEbJvZWXRl+8v/3Gbp5MvozRp9w2yrHMzHEcVOegXUlMv3xomUIh3b8lmiMweRpX6T8tfnK8W",
+	"aCy6FNV5lywklm1bSSyB13SE/QFX76SLRXKSyTRQR2oiuEP96VZJNi9srg3danu2cmBYpJN7BJ/2lr2H",
+	"+Zv8pcKovZ0vTVoPkQBOcEJcScL2UtRb7auBnmsVAD4/7pbBoXP0S7DAAv16c/XbVtlDNUu/tIc1kUID",
+	"b8iayijKTHExQqKL6RhH2vT4p5bAxhOTl6EkIATScxjMwKhc8oFK698ekNaERLkgchEQHgPhxQZhxRXr",
+	"0Qb6iPExk50E1YLta99cdwkchK/9TlkathbvXtG9vpDjcM/uPI6wQ2sX+fytg6ilaiQTtLBy1Kjl7u+Z",
+	"KNoR1kquu3arMY39lPa81uBNglE9cP4HvqJLQEXTzeSEcfK37W+qhq97HPMtldhtg4RdoTKs9mSWVdm1",
+	"u1w2uUNrGFYCSGc0k+nQHUUrzcWTELbWpLDE278PLmlbHsBBGxKu6inWIZp87/3dT2JNsdy7BrOFNjVh",
+	"8/7+4P11vomCbUGSCeAu0aJ2Xhs5zhv98f72dF2XPYMkGd5RNvMW2PCh9g2JochSUzncsQtPhe+5PgTL",
+	"bTNhAqJDnIw3nL0oWF94dWn3q2rPkXVnN+X9a3+d6ePbbAnVBihNhKLRdtlbizhlQonaKXijMMrii7qe",
+	"RkWJ/VlUr99+vPxt+PrT5fDz1ft3vxW3h6QJm0+1VW3u8pjiOYoZwnQuJ2oB9u4dTRi1iNpESt1JU09d",
+	"Su+qawP6gZrR6O3OOTzR2T56HYQbe/8cfXbmPAWIF8pQ1T8VkWiURpaVihDZ5rJVr+O5u/IoYuyOQO1a",
+	"omw6tL6CYkM4JTYVwGzVgdP862cno3/943MbsBiVwn8kpneMKoNTOpjIFSaMdGzc2YRmiMplEpWZbaOc",
+	"ysQKPxwvlETqWOiV2THSfWteogG6SoFevkVvGKUQSfSJs3tiLqXKu0gHL84vzi9M2TlQnJLgVfD9+cX5",
+	"91qplhONdYNzxcPONA8bKLo+Vza2emIvGMpPS10SFfwC8g9Ikvfq9V9nd+JXocHvaF4P+fLiwmY0SKsD",
+	"lNqJDdzwxf1Ry2Ibtr+nhsliYF+ApaZsOsV8rk725uo39AfcIvX4xj6u7FHBgsRn+pTGWWHNrdzulf7w",
+	"TeW7He68pdjxQMaiRnWHVTDZVxzWoOKSBAUuHEUso3Jg3dFiGXhem3ffuFc3hEirgJedzOOMfwo99Wr3",
+	"gPKdPIXBDxcvtnZIpum1Z97fGDJxU+fPrB2AivuZ1FSdiXlmq7i+E6Wl+o5i8Gj/NiTxk+FKCUhYPJq3",
+	"+vfa6dg/L+MgrFzM96f38rdipg2vf/u6gBQ/eGtu1WzImO3xIU9KzfzD7mf+nWoSdidew5BrDQeE3WMt",
+	"Zwofugr5GUvVNnSo4ovWjQdGN44KjxUTHir+xIQj43fqs3+Xv1o4upeeXGBC75D6EuJQNyhhWvNQTv+Y",
+	"oVuIcCbAXsFnEpGJQLnersF9sXtw54rJBOtaRA0it6IDo9vLf+xh/0xdkUjnKCH0Trg8eIgRhwioTOY1",
+	"BPyowYPKOKQ/dbGcBc6Vg7KMiNZ52kaGfHKv7kOG2MnayJDrIrkg383xy5Fiqb7jGJSd2aIVb3DHc135",
+	"MnfC/4vF890qP0u88U9PT3UR9bRD3azWW8pzbm9sxhJiLRs77Y0NXtJ7nJAYudCMwwiUUXyPSaI7bR1Y",
+	"+O6BG+okIpxwwPHc1tCXoIH0rbqIA44mENdo7kZiLvOUI9NVLXXsZDWxDR5LIbqn+h3JDapYJajXrIvV",
+	"XQ9fwzXp+o2d8DI+HIUXRNKOvl9sm759eGMflTLO9k65uotEqiCBqkywJ9pmov2ZUCImfqotujr42/o1",
+	"UPWj/VsnU8xRm/3T0tcq46jAuim7f1bGUZmxlo0jBYcy321j0BbntalBW8YHl9VzlndgWKHnVhs57FBN",
+	"8fSn8Kq35i1T/iLse0ei3/6WTW/NZbwZ1eVxvLzaPI+1qv26NLGlYm/FKbzYySl4D+A3mNW2FSIxUciv",
+	"S4d0xdCxnIe70wBhRGGGblU3J3UI9fUTI6uwdBl+KYd7wjKdMFzlp3Yi0ZqF3rgP2nDOK10T4OY4Bu9S",
+	"VZdU1Qu6lGFk08lZecG2Ebtas42y1J0+4Spu0wytHVjVN9DQcbPRM+tOJjS1484XYWphR4QLeQK2dvUz",
+	"4cfvwaP9Wyd9wR2f/bOl67aYaR+uW7u2Z+i6XU3U2EfKRC733up84dWKxGeXVrxrsl6sX25B4KboF+Kq",
+	"Un0KrjO1Xc+qV6oU+Xns1Vyu51zt2Vau1Xf7OH3FqAKLFiFKgcY6q9AUk5eM2D27wZ6v5Vy1d5fZz+aI",
+	"rdq3SCCL/GvwqP7fSdYp+lH/tTSKX9eM9edmGi8egMdA9h1VGw3CHt42DeUqVgws2bdzgG5pOS2ZuEHC",
+	"N3aBh+HoOv22FS9fSRpFt459c1e1iUU+4/hQbV3PnmgtwiFMcylZg5x2VBIpjGWELI4oGlMZfIP7FwOd",
+	"YrbcC6Ve/feLK/PiAi/w7a54ZfBByYjgKVz5oikwDzwWxPbwr7jIyJcGRUFXrist3EJl7+hvYbAv7Aai",
+	"nQcYmek1UqDXny5tVSbVlVcVzP9+P3kXVRUbJTi6a5kaWsmW1QhaypP98+tTaH/Is1f//Pr0tUxUWs0v",
+	"zn8J/6/RxS6Yfuk+tD2r6faqLg+ZqAeltpgTwLG9t/XdZzyuzlCPLz7tP/ZlbxzrCWptgtqnycE4MhfR",
+	"1YyPjclaJ1iAEpUWJTxScPCo/2xpg5TJ39BpOzPEEJC74KRHzU1Rc4/KWIE6m6CiwZ8SKobtdK9GJLvY",
+	"E9dfj9v3yP2ckPsacFxB7W7mgsNxNajtd1bTvLImoug20eXoo4pFWnvj8JrbvmgY2VZzvebWc5AdcZDj",
+	"0BfD4IcXL/dzRMyYRPbaAEHsNXaqY7xak8nY/z97IJ3RmWZqiAikUYOOt8DP0wRH7RXngb5p6Cxh4/ZO",
+	"JcvDX6svP6gP/Q7n/2TA54WLN7/KrZlphY1fMm68w128wQ2j6fP2D7W01bV/tIxKkqw12ik54txRt/HF",
+	"aYRSLX8QUMkJ7N8tNyKJBK54Te+ge656bR79L9BR4aYdPlRhThCyyEBaV+1dwV1tf52urPWN/awdYy3O",
+	"wvXF7sxiyz2QFz7O+22cGtvS1fQGlm04lzusnl/1/Opg/MoiYZlbbWiWt4uILHCenVjZBUEeJkpSzO+t",
+	"6tNPXLDE9DpZ1fqDiNoNEBPgcH705npkgdBzmt5eZ3wJMZB4wVTfUmgnx8F2OtTgMW+huGa4x/I2y3/a",
+	"RX8sFPrwz6nSi1arCkTbRiCoxDzXUOqb0e9iv3KuDw/1yN81UFRC/TU10tBfAO9Ye+fy9w5Bp0UCPLIY",
+	"1Fra8Z65xskEpHoN9xtgWnuMCkXW+Pu2w0IbKN2Dop34kfB+69lYPMu8Gtla70LiubCEqTr1lK8n40wa",
+	"rB9zbC42JixWBnwnh4kTKjfuOsed+TDMTHaehnJzdzelt8z8Yq/0FDMQiDJ9mTTC1csze+b8nDXKSyEy",
+	"12jAIotDixXMacxZlnYOqvxivjrpigi9hzaxDAuiviKip+s9BzAM5h0qfpET+S4MND34QQIXembfIeoH",
+	"eXnHvsl9bJbVU3sfRGC8GT3pYnX69qIIFgdbqQyDR/3nYgihuuqPML0FLlDCbF9jc0mqnMAcTSAprjHI",
+	"+d2ivdAchjAsyvCSdkEIA8U+BnHKSnOBplsJQeSct7sG3Ih5F3sSVz0GP2MMtnGEHH+36kpy3H0rbS06",
+	"CJPB1EiM9UxSS5BW6py2gZpfer/CPnUA6w3UngPtmQPllqrFQZPb9W0ypMGjOt31U2Z8DEqReEvFNb+T",
+	"g+grORJGx5oYzOpcd9feijxNkgzNySnKNG15N1YNbFMrvf0RZ9Njp0v/4JbkttDMqkNywxYptafPnj59",
+	"9Pk6jh1xSra+52WgvSkbqcrXeoR9tEZVM7W6REitqCeYXses6ZjGc4iFAUWFbr4VVVNvcfCo/tiSoqmJ",
+	"Sf2vg/AyrK5QMvWNsyI/g54aT1l8mRPckCY/Y33fohoL4Rmen7CGaWntgBrmOiRasrdzKYkmLIl7Ku2p",
+	"NFcytah0dGrvovR5albIqZWXUnow/D3MT9z9qq4mIHT8HuZtnLDCvI2KKy/7quee9g+RNFTGxEOlDr2H",
+	"+a4ShwqyPEj2UDF90037KSYcje3lT8df21FCl55tPD+2kef+VPCghUIweGx/n2MDh3jf+lLHm2Jxfe7O",
+	"SfuE6mi2lQyeGg/rqqc24OHFnoRGCbn7OuKeCNZLAqqRwFb9L9u5CLabh6RMl8dVWryWAnoAXnIy1cW9",
+	"BvpN8bM91hmX5v3mi4276OcFqnT2230qfXrS7rtiI23cd2WI9d67ngkezHtXQsRDOe+qLGAXKlQxw0F8",
+	"eMX0voMtnp7MVU9paUM97+gLCP2zlhB7p1WEZWxsr6gMHot/rO9WLPYoir+2dDKW4NP7GE/ZHKkh4FZc",
+	"jFUWu6ZGvRwlL/Yu4Hr8fu74bb2HVezeqvOwwtV3nFCZsoREpHtC9Cf33WlbvGoXrZJVcjj1JYM929n7",
+	"JSJywjj52+zKYeLBbN2C8ndi6GqSPIyRq6f2yn/95HSMW73cEIksmiAszN0BMZGGRHCpLZ4anfSZr70N",
+	"vMQGNri/W/vXUV47ZWXwaD7YwOi1I1lu09LaNZDoLd2TtgQKVNuOlWvHC9dSn5vx72JvYq3PoOkRv7v5",
+	"m6P9dk1fx9f3mzrjIcfjyqDprBXvj32cTNJMgbI99zpZ7nUESu8es3VSa3d+44k6XdT/+VkMEVkvUUd/",
+	"/zb/fMcXR7t1Vr4Fmk2DV38GOEnYLFBgpvPga/sLn/PmLv1d1Af0GDscauM5LtC1z5LqRd/BnMgODQ92",
+	"GfVafXdcq50TjjGpLbThEwY+PY/oecTBeAR3baQOEVcqumpt33xWYx8kpHRtmlJ4G3adTDip743SR4iW",
+	"zaqReafxoby3y0oFY9NOYF07C+m99xGhU3YtbaVxUB4Pcsyys5bbhHQXexFGPe4+X9y1IR2HuVsN6Gyp",
+	"V1w31j8gNEqyeE1z0xDipRvi+Pq8OlUduW2imHCIZNIHN54xFdebvlZR5Bsj7MGj/Vs83Jq+5yje/hl3",
+	"7C+pwV10gM1pUyObIpaeOJ8vcd5IllZJUhWmY5ojxhHSpn/sOt1tLVGjegz/zZK41K1VAw4nghUdW91C",
+	"9LMQYRojTOfmTZ3kymj+kvDcqJ3JPfCDngt8U1xgTw6VHH1mLEtiBDRGWVriG0QKSEYbM6WPpZbUFlEL",
+	"ltRRMG/QP6NEcvU6+l3r3dW6vlXa96dqo4G+bXQv171NKCxJmTsAvhHFe+tl536q71yB7tG8S3cv9F0e",
+	"Trq3uz7Y7VbvakVcY4hWwKt7N9cqH7UnbA8Vw91Sp7dNxz319tTbSL2/KORYTrUr5JqIWNrdL3xjvjrt",
+	"yxnUHlrdy2A222ci9TR/uBsZNA4eKhUpJ/edNMNVgx/mIgY1s7cFrnpwMulIwmyj5w59PhLjzei804Qk",
+	"i4OtlI3Bo/5zfVPZsCP9/7aXP2gI9GlJJ90hOkex7Vz44Lhmd723EfMu9iSa+gr1HuM73+7g8H2rThzH",
+	"yffrY6mT4ZHd7tBRod0X1zid2xx6hfbUedY+b3Awxtq3fndDKwU7E5aquyg1v+uPFrhojYNIzKWL8sIU",
+	"kwThOOYgRIjImDLFK1CEBQShtxpafzJMOYzIwzrF40QMcSTJPfg+vmUsAUybv7am/BCPJPCtVXi7UW9h",
+	"xDh886XeCk/a+EsNEvbu0l5+HMxdqlHwUN5Sx093oVuqsQ/iK1UTew9QYdapeEoz0ecb9o7SJbMK4NpN",
+	"yrjTcXbkLzWY2EabGzzaHjdrOks1N1L/a+kq1QTde0pP2QbLkWsrjlLHNDubFE1Id7EXodT7SHtc7+gi",
+	"dZi+VQ9p0aFsjw7SKv0dl3u0owq7H25xMr7RXoU9cVZ1NKrsHl20+oy+dQ/tOir9JmVCJR6/izKhGqfr",
+	"Ug2kU01EiATjvRHxrBWrhnIgDQVVuCrydGU5AcKLnn/HqIJ1JO21+juWiLro/3Zc3TZsDKhXRHrSrjVJ",
+	"PGoDah3q3bR5Wp2aO1bMa4wq6vgcw+yrbE+2BEivc1u1tp9L5ep4hudoxNn0uKlwpxW8a7tI1qbNniJ7",
+	"iixT5GuhAOBoUrJ1rcKMJiy6U1s8Uldk93i4obTfzcZax6EMIHob8nkrmiOjaCpUYJksVE3d42jE+Bgk",
+	"GmGSaOfSmCoYYSlhmkphSc9eGAtLzbH8pVb3oDgMHmoCWUZS7oqTiMXQ4WqTKCFA5Sp6bTkYB9MScZhx",
+	"0o7+zYsthxe2KqHzuoTEcuWHVSz7N04y0Ahx+dZSJYcUsBQhuiXUNgRS3Fe9Y6CYU6w/OZEyGq1chfeM",
+	"WAzDaIKTBOh4CyMMpyAnLPbekHPz8sefFGommNAGNIKHNFFIZk+1AW1Zxmu7zb0Ey0+/7iEIAyHnifpB",
+	"fRcsHtVNqlyjAhQ5SYhRQoQmX8oohOriDUJDFCkqolITs4BEISmOIpZR2XBaKWfTVK4D7Cl+GOLaOU0J",
+	"JVMF4Yv2FwXplQ8nhMpuqFuHh+JLQKVlw0hz5weJogQLAQIRxUpj4ApkKnMWOBg89d/LxIf3ijTE0jXV",
+	"E0S/v3jpaeJtuQWaETmpXbetEFbxdktfGhQ69XNvGZ9OzFjSVnKGchgTIYFDjByrQ79fXxrhU+hnlZ0A",
+	"jVOmzjCXEX8PQIEQGyvfaTnV2d/ZN6zwLV0+rv5tRN9IawmO1JAAfg88RLMJiSblQweR30Y9IrH6ESf5",
+	"tkoDnqO3QOdoRqhA7F4JPnVblqYYcx2XQJS5y9H0M4EwBxQDJRCfo3f3wOfFjTdEoDtIpcIv3004xbRB",
+	"6FPxFKAcFHaU5qjnuDYD7ztMrOd2F1r58K94tl2EL34a2p+GuZIBTcTwESeK+Wq81+Ay9GAoZBc+2rVW",
+	"+cbgdI3fGZ1tUUE0FJBriLW0pIjEgGYTsHq6VgSneK70YAUJhKklCsRoiQoNlVths0wPfGNfaakFaqB3",
+	"1dJWp+krQTCYyGmyIv9gAdJWmlqJvF+OzDiCh1SBoCY0eE7KlWxIu1ZdOGB4ZgpGgWv4fJnpWZxbO470",
+	"cDabzc4UnM4yngBVgi3ekA4MMjgka8G4lovfWxzdVRXZYz/Sa4gYj/MA0XciV+9yKaOkFgeRTY0S3zzq",
+	"QOctDO6Bk9F8GcW+U+/927zWimq11XAkBPsbkySyHXo1PExVlRLSeufEeT92NWFC6J2ajZgMozA/b2PA",
+	"x4tEOyKGzVbKwKyuiKbGEDdrt4zewFufKaGSM5FCJJtVrGtIGZdC82/FzCM1POOIw4iDmJjhqhrX/7j+",
+	"+Q363z/99PJ/nqMrmsx9KlVNSVMyo1jNOfpDrb+ks8nQTa3nEwZiM+CAKJOICJGBdj+YB/mXSvHiegeK",
+	"ZhRcTeGaX5u6LOBxeMZVOpw9K10FGBqUrptMn8U3ps181phMapsPg4E2q5bxvA/6hdPUUfTaT0JD+ZBb",
+	"t5vrJ+7EDk3kBrWeh27iYxabY60VdRRpDoGmIITzf7z8x/Znw+JOoZxkaIaJRLqGGUnG0FT197eO79zh",
+	"XUlgvgbJ52ev1Rfe+QtP19Oip8Q5KXJtroPqppFsMB3hlTzs4wifKBu7gYipAASOJOMnwc6qK94iWzOH",
+	"eGjOptBtB3yt5O2MShbrN87uFrF7H2zPM+sh2J8xJnWkt7yervwvxULcwbxs6TQQ0Cf75uGJyK65VxN2",
+	"piY0C1rzAbJHsC66DZhei2iPdlf2g90489si29Cte8+2Zw0InjN9LQRw9Xdkl6jFJsX3ZIwl4+cRB+tq",
+	"EOdjsAh1RBgeIi3u9TYFyii+xyTBt0kdGU2TnxIC5vtupSZYTGSZXIl66p29Y5td29PT0yI6eTKQPrCx",
+	"qlfSn4TB9xffL3slzOOOVliZ6KCQZ2bWWljyOEKly9e3r8Qmk5qhy8EokyosCzG6hQhnwjA+F9mKMOcE",
+	"BKIuSYsiEg+1d04H45V/j0jh8qYVxgo8hTPGieLJV/qPGsq/o6amssjpUlisCGDGeDxQ2UVsabjok331",
+	"Z/PmzqwNM74EitzirMlR2c7PJY3JuCYB2WwKhIsvOQiQuefXumwlK2v6NQ5IxQy4iXkrmOYBOMUHmazM",
+	"Aw9ESOF3d3qgdXh1Ry1oaE96LdmzDVc8Nscxw/l5kJEHrLXz/qg98L6DrSGyftAGj6/1i6cXRoFZnTCO",
+	"KGiiyVLRYzRhTJibdmh5xdXgiTlFGzVZZnzXD+1oqMmg28GISQvfHLZYuOLjLSNFHed0gps6XZuKc09Y",
+	"okEuQssolyHRgrNG1rFEp8ipDPPcI6ckN6jsntkEuM1xcAJ+uRJ27d7ajRpmYiQfQeIYS7zvbmVm9ktq",
+	"8hkbQkrmJVToQ3vvWWjVsmkOpT1luX80xe8KJy3uIUKJDpWWQ557UwMNLtq0dSJQTISyTlx0lYim/oC3",
+	"gDnwUi55LVloTvGURCgqnzTHRZjNnf3gMc+2blHq50jHYlm7oiGLbfvurOVQrbz32iGvAGoVouBAVvLm",
+	"NKkUq+F0cRCK17kB48whwmmehO5ZFDVsyKe8VWuB1qsuWFJj5z3s45ArR4BleX+h5yJitoztv2vwNeC7",
+	"4eT37A5WqTz6ncOryXax7ZG35jWxRS9qkLrWWDyLStAxEF8KnM8lY+6QsHHIsVe61pu/tqN7HVUarCbn",
+	"6yjyvv+F4+Xxi6PLiaqhaLX6QhkzhI7YMg/F7+6dHSLCsr3na/Rs/xeQxiCzL3Rhbbof3oiVIaK/5/dO",
+	"fmc8CV4FEylT8WqgK1XO4QFP0wTOIzYNnr4+/b8BANkF4qMJvAEA",
+}
+
+// GetSwagger returns the content of the embedded swagger specification file
+// or error if failed to decode
+func decodeSpec() ([]byte, error) {
+	zipped, err := base64.StdEncoding.DecodeString(strings.Join(swaggerSpec, ""))
+	if err != nil {
+		return nil, fmt.Errorf("error base64 decoding spec: %w", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(zipped))
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+	var buf bytes.Buffer
+	_, err = buf.ReadFrom(zr)
+	if err != nil {
+		return nil, fmt.Errorf("error decompressing spec: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+var rawSpec = decodeSpecCached()
+
+// a naive cached of a decoded swagger spec
+func decodeSpecCached() func() ([]byte, error) {
+	data, err := decodeSpec()
+	return func() ([]byte, error) {
+		return data, err
+	}
+}
+
+// Constructs a synthetic filesystem for resolving external references when loading openapi specifications.
+func PathToRawSpec(pathToFile string) map[string]func() ([]byte, error) {
+	res := make(map[string]func() ([]byte, error))
+	if len(pathToFile) > 0 {
+		res[pathToFile] = rawSpec
+	}
+
+	return res
+}
+
+// GetSwagger returns the Swagger specification corresponding to the generated code
+// in this file. The external references of Swagger specification are resolved.
+// The logic of resolving external references is tightly connected to "import-mapping" feature.
+// Externally referenced files must be embedded in the corresponding golang packages.
+// Urls can be supported but this task was out of the scope.
+func GetSwagger() (swagger *openapi3.T, err error) {
+	resolvePath := PathToRawSpec("")
+
+	loader := openapi3.NewLoader()
+	loader.IsExternalRefsAllowed = true
+	loader.ReadFromURIFunc = func(loader *openapi3.Loader, url *url.URL) ([]byte, error) {
+		pathToFile := url.String()
+		pathToFile = path.Clean(pathToFile)
+		getSpec, ok := resolvePath[pathToFile]
+		if !ok {
+			err1 := fmt.Errorf("path not found: %s", pathToFile)
+			return nil, err1
+		}
+		return getSpec()
+	}
+	var specData []byte
+	specData, err = rawSpec()
+	if err != nil {
+		return
+	}
+	swagger, err = loader.LoadFromData(specData)
+	if err != nil {
+		return
+	}
+	return
+}
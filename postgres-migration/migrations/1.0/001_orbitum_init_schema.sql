@@ -1 +1,5 @@
-CREATE SCHEMA IF NOT EXISTS orbitum;
\ No newline at end of file
+CREATE SCHEMA IF NOT EXISTS {{.schema}};
+
+---- create above / drop below ----
+
+-- The schema also holds the migration version table, so it is kept.
@@ -1,4 +1,4 @@
-CREATE TABLE orbitum.orbits
+CREATE TABLE {{.schema}}.orbits
 (
     id             BIGSERIAL PRIMARY KEY,
     created_at     TIMESTAMPTZ  NOT NULL,
@@ -12,3 +12,7 @@ CREATE TABLE orbitum.orbits
     config         JSONB,
     default_scopes JSONB
 );
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS {{.schema}}.orbits;
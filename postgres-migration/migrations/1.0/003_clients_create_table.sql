@@ -1,10 +1,10 @@
-CREATE TABLE orbitum.clients
+CREATE TABLE {{.schema}}.clients
 (
     id                         BIGSERIAL PRIMARY KEY,
     created_at                 TIMESTAMPTZ  NOT NULL,
     updated_at                 TIMESTAMPTZ  NOT NULL,
     deleted_at                 TIMESTAMPTZ,
-    orbit_id                   BIGINT       NOT NULL REFERENCES orbitum.orbits (id),
+    orbit_id                   BIGINT       NOT NULL REFERENCES {{.schema}}.orbits (id),
     client_id                  VARCHAR(200) NOT NULL,
     client_secret_hash         VARCHAR(512),
     name                       VARCHAR(255),
@@ -24,3 +24,7 @@ CREATE TABLE orbitum.clients
     metadata                   JSONB,
     UNIQUE (orbit_id, client_id)
 );
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS {{.schema}}.clients;
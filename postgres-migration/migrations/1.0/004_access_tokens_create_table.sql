@@ -1,11 +1,11 @@
-CREATE TABLE orbitum.access_tokens
+CREATE TABLE {{.schema}}.access_tokens
 (
     id               BIGSERIAL PRIMARY KEY,
     created_at       TIMESTAMPTZ         NOT NULL,
     expires_at       TIMESTAMPTZ         NOT NULL,
     jti              VARCHAR(200) UNIQUE NOT NULL,
-    orbit_id         BIGINT              NOT NULL REFERENCES orbitum.orbits (id),
-    client_id        BIGINT              NOT NULL REFERENCES orbitum.clients (id),
+    orbit_id         BIGINT              NOT NULL REFERENCES {{.schema}}.orbits (id),
+    client_id        BIGINT              NOT NULL REFERENCES {{.schema}}.clients (id),
     user_id          BIGINT,
     is_jwt           BOOLEAN,
     token_string     TEXT,
@@ -16,3 +16,7 @@ CREATE TABLE orbitum.access_tokens
     metadata         JSONB,
     refresh_token_id BIGINT
 );
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS {{.schema}}.access_tokens;
@@ -1,12 +1,12 @@
-CREATE TABLE orbitum.refresh_tokens
+CREATE TABLE {{.schema}}.refresh_tokens
 (
     id              BIGSERIAL PRIMARY KEY,
     created_at      TIMESTAMPTZ         NOT NULL,
     expires_at      TIMESTAMPTZ         NOT NULL,
     token_string    TEXT                NOT NULL,
     jti             VARCHAR(200) UNIQUE NOT NULL,
-    orbit_id        BIGINT              NOT NULL REFERENCES orbitum.orbits (id),
-    client_id       BIGINT              NOT NULL REFERENCES orbitum.clients (id),
+    orbit_id        BIGINT              NOT NULL REFERENCES {{.schema}}.orbits (id),
+    client_id       BIGINT              NOT NULL REFERENCES {{.schema}}.clients (id),
     user_id         BIGINT,
     revoked         BOOLEAN,
     rotated_from_id BIGINT,
@@ -16,3 +16,7 @@ CREATE TABLE orbitum.refresh_tokens
     last_used_at    TIMESTAMPTZ,
     use_count       INT
 );
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS {{.schema}}.refresh_tokens;
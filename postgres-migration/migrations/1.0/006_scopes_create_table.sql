@@ -1,12 +1,16 @@
-CREATE TABLE orbitum.scopes
+CREATE TABLE {{.schema}}.scopes
 (
     id          BIGSERIAL PRIMARY KEY,
     created_at  TIMESTAMPTZ  NOT NULL,
     updated_at  TIMESTAMPTZ  NOT NULL,
-    orbit_id    BIGINT       NOT NULL REFERENCES orbitum.orbits (id) ON DELETE CASCADE,
+    orbit_id    BIGINT       NOT NULL REFERENCES {{.schema}}.orbits (id) ON DELETE CASCADE,
     name        VARCHAR(200) NOT NULL,
     description TEXT,
     is_default  BOOLEAN      NOT NULL DEFAULT FALSE,
     is_required BOOLEAN      NOT NULL DEFAULT FALSE,
     UNIQUE (orbit_id, name)
 );
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS {{.schema}}.scopes;
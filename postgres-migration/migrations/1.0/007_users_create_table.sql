@@ -1,10 +1,10 @@
-CREATE TABLE orbitum.users
+CREATE TABLE {{.schema}}.users
 (
     id                   BIGSERIAL PRIMARY KEY,
     created_at           TIMESTAMPTZ  NOT NULL,
     updated_at           TIMESTAMPTZ  NOT NULL,
     deleted_at           TIMESTAMPTZ,
-    orbit_id             BIGINT       NOT NULL REFERENCES orbitum.orbits (id) ON DELETE CASCADE,
+    orbit_id             BIGINT       NOT NULL REFERENCES {{.schema}}.orbits (id) ON DELETE CASCADE,
     username             VARCHAR(200) NOT NULL,
     email                VARCHAR(255),
     email_verified       BOOLEAN      NOT NULL DEFAULT FALSE,
@@ -19,3 +19,7 @@ CREATE TABLE orbitum.users
     metadata             JSONB,
     UNIQUE (orbit_id, username)
 );
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS {{.schema}}.users;
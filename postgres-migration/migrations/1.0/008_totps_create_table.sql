@@ -1,10 +1,10 @@
-CREATE TABLE orbitum.totps
+CREATE TABLE {{.schema}}.totps
 (
     id             BIGSERIAL PRIMARY KEY,
     created_at     TIMESTAMPTZ NOT NULL,
     updated_at     TIMESTAMPTZ NOT NULL,
-    user_id        BIGINT      NOT NULL REFERENCES orbitum.users (id) ON DELETE CASCADE,
-    orbit_id       BIGINT      NOT NULL REFERENCES orbitum.orbits (id) ON DELETE CASCADE,
+    user_id        BIGINT      NOT NULL REFERENCES {{.schema}}.users (id) ON DELETE CASCADE,
+    orbit_id       BIGINT      NOT NULL REFERENCES {{.schema}}.orbits (id) ON DELETE CASCADE,
     secret_cipher  TEXT        NOT NULL,
     algorithm      VARCHAR(20) NOT NULL,
     digits         INT         NOT NULL,
@@ -15,3 +15,7 @@ CREATE TABLE orbitum.totps
     is_confirmed   BOOLEAN     NOT NULL DEFAULT FALSE,
     name           VARCHAR(100)
 );
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS {{.schema}}.totps;
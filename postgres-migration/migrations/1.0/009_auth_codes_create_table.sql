@@ -1,12 +1,12 @@
-CREATE TABLE orbitum.auth_codes
+CREATE TABLE {{.schema}}.auth_codes
 (
     id                    BIGSERIAL PRIMARY KEY,
     created_at            TIMESTAMPTZ  NOT NULL,
     expires_at            TIMESTAMPTZ  NOT NULL,
     code                  VARCHAR(512) NOT NULL UNIQUE,
-    orbit_id              BIGINT       NOT NULL REFERENCES orbitum.orbits (id) ON DELETE CASCADE,
-    client_id             BIGINT       NOT NULL REFERENCES orbitum.clients (id) ON DELETE CASCADE,
-    user_id               BIGINT       REFERENCES orbitum.users (id) ON DELETE SET NULL,
+    orbit_id              BIGINT       NOT NULL REFERENCES {{.schema}}.orbits (id) ON DELETE CASCADE,
+    client_id             BIGINT       NOT NULL REFERENCES {{.schema}}.clients (id) ON DELETE CASCADE,
+    user_id               BIGINT       REFERENCES {{.schema}}.users (id) ON DELETE SET NULL,
     redirect_uri          TEXT         NOT NULL,
     scope                 JSONB,
     code_challenge        TEXT,
@@ -16,4 +16,8 @@ CREATE TABLE orbitum.auth_codes
 );
 
 CREATE INDEX idx_auth_codes_expires_at
-    ON orbitum.auth_codes (expires_at);
+    ON {{.schema}}.auth_codes (expires_at);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS {{.schema}}.auth_codes;
@@ -1,14 +1,18 @@
-CREATE TABLE orbitum.consents
+CREATE TABLE {{.schema}}.consents
 (
     id         BIGSERIAL PRIMARY KEY,
     created_at TIMESTAMPTZ NOT NULL,
     updated_at TIMESTAMPTZ NOT NULL,
-    orbit_id   BIGINT      NOT NULL REFERENCES orbitum.orbits (id) ON DELETE CASCADE,
-    user_id    BIGINT      NOT NULL REFERENCES orbitum.users (id) ON DELETE CASCADE,
-    client_id  BIGINT      NOT NULL REFERENCES orbitum.clients (id) ON DELETE CASCADE,
+    orbit_id   BIGINT      NOT NULL REFERENCES {{.schema}}.orbits (id) ON DELETE CASCADE,
+    user_id    BIGINT      NOT NULL REFERENCES {{.schema}}.users (id) ON DELETE CASCADE,
+    client_id  BIGINT      NOT NULL REFERENCES {{.schema}}.clients (id) ON DELETE CASCADE,
     scopes     JSONB       NOT NULL,
     granted_at TIMESTAMPTZ NOT NULL,
     expires_at TIMESTAMPTZ,
     revoked    BOOLEAN     NOT NULL DEFAULT FALSE,
     UNIQUE (user_id, client_id)
 );
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS {{.schema}}.consents;
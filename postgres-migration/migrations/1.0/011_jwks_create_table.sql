@@ -1,9 +1,9 @@
-CREATE TABLE orbitum.jwks
+CREATE TABLE {{.schema}}.jwks
 (
     id                 BIGSERIAL PRIMARY KEY,
     created_at         TIMESTAMPTZ  NOT NULL,
     updated_at         TIMESTAMPTZ  NOT NULL,
-    orbit_id           BIGINT       NOT NULL REFERENCES orbitum.orbits (id) ON DELETE CASCADE,
+    orbit_id           BIGINT       NOT NULL REFERENCES {{.schema}}.orbits (id) ON DELETE CASCADE,
     kid                VARCHAR(200) NOT NULL,
     use                VARCHAR(50),
     alg                VARCHAR(50),
@@ -18,4 +18,8 @@ CREATE TABLE orbitum.jwks
 );
 
 CREATE INDEX idx_jwks_active
-    ON orbitum.jwks (orbit_id, is_active);
+    ON {{.schema}}.jwks (orbit_id, is_active);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS {{.schema}}.jwks;
@@ -1,11 +1,11 @@
-CREATE TABLE orbitum.sessions
+CREATE TABLE {{.schema}}.sessions
 (
     id             BIGSERIAL PRIMARY KEY,
     created_at     TIMESTAMPTZ NOT NULL,
     updated_at     TIMESTAMPTZ NOT NULL,
-    orbit_id       BIGINT      NOT NULL REFERENCES orbitum.orbits (id) ON DELETE CASCADE,
-    user_id        BIGINT      NOT NULL REFERENCES orbitum.users (id) ON DELETE CASCADE,
-    client_id      BIGINT      REFERENCES orbitum.clients (id) ON DELETE SET NULL,
+    orbit_id       BIGINT      NOT NULL REFERENCES {{.schema}}.orbits (id) ON DELETE CASCADE,
+    user_id        BIGINT      NOT NULL REFERENCES {{.schema}}.users (id) ON DELETE CASCADE,
+    client_id      BIGINT      REFERENCES {{.schema}}.clients (id) ON DELETE SET NULL,
     started_at     TIMESTAMPTZ NOT NULL,
     last_active_at TIMESTAMPTZ NOT NULL,
     expires_at     TIMESTAMPTZ,
@@ -16,4 +16,8 @@ CREATE TABLE orbitum.sessions
 );
 
 CREATE INDEX idx_sessions_active
-    ON orbitum.sessions (user_id, revoked);
+    ON {{.schema}}.sessions (user_id, revoked);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS {{.schema}}.sessions;
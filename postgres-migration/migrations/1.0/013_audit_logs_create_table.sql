@@ -1,18 +1,22 @@
-CREATE TABLE orbitum.audit_logs
+CREATE TABLE {{.schema}}.audit_logs
 (
     id              BIGSERIAL PRIMARY KEY,
     created_at      TIMESTAMPTZ  NOT NULL,
-    actor_user_id   BIGINT       REFERENCES orbitum.users (id) ON DELETE SET NULL,
-    actor_client_id BIGINT       REFERENCES orbitum.clients (id) ON DELETE SET NULL,
+    actor_user_id   BIGINT       REFERENCES {{.schema}}.users (id) ON DELETE SET NULL,
+    actor_client_id BIGINT       REFERENCES {{.schema}}.clients (id) ON DELETE SET NULL,
     action          VARCHAR(200) NOT NULL,
     result          VARCHAR(100),
     ip              VARCHAR(100),
-    orbit_id        BIGINT       NOT NULL REFERENCES orbitum.orbits (id) ON DELETE CASCADE,
+    orbit_id        BIGINT       NOT NULL REFERENCES {{.schema}}.orbits (id) ON DELETE CASCADE,
     details         JSONB
 );
 
 CREATE INDEX idx_audit_logs_action
-    ON orbitum.audit_logs (action);
+    ON {{.schema}}.audit_logs (action);
 
 CREATE INDEX idx_audit_logs_contour
-    ON orbitum.audit_logs (orbit_id);
+    ON {{.schema}}.audit_logs (orbit_id);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS {{.schema}}.audit_logs;
@@ -0,0 +1,11 @@
+CREATE INDEX idx_access_tokens_active
+    ON {{.schema}}.access_tokens (jti)
+    WHERE revoked = FALSE;
+
+CREATE INDEX idx_access_tokens_expires
+    ON {{.schema}}.access_tokens (expires_at);
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS {{.schema}}.idx_access_tokens_active;
+DROP INDEX IF EXISTS {{.schema}}.idx_access_tokens_expires;
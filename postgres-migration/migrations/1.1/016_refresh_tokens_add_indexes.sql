@@ -0,0 +1,11 @@
+CREATE INDEX idx_refresh_tokens_active
+    ON {{.schema}}.refresh_tokens (jti)
+    WHERE revoked = FALSE;
+
+CREATE INDEX idx_refresh_tokens_expires
+    ON {{.schema}}.refresh_tokens (expires_at);
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS {{.schema}}.idx_refresh_tokens_active;
+DROP INDEX IF EXISTS {{.schema}}.idx_refresh_tokens_expires;
@@ -0,0 +1,21 @@
+CREATE TABLE {{.schema}}.resource_servers
+(
+    id               BIGSERIAL PRIMARY KEY,
+    created_at       TIMESTAMPTZ   NOT NULL,
+    updated_at       TIMESTAMPTZ   NOT NULL,
+    deleted_at       TIMESTAMPTZ,
+    orbit_id         BIGINT        NOT NULL REFERENCES {{.schema}}.orbits (id) ON DELETE CASCADE,
+    identifier       VARCHAR(1024) NOT NULL,
+    name             VARCHAR(255),
+    description      TEXT,
+    allowed_scopes   JSONB,
+    token_format     VARCHAR(20)   NOT NULL DEFAULT 'jwt',
+    access_token_ttl INT           NOT NULL DEFAULT 0,
+    is_active        BOOLEAN       NOT NULL DEFAULT TRUE,
+    metadata         JSONB,
+    UNIQUE (orbit_id, identifier)
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS {{.schema}}.resource_servers;
@@ -0,0 +1,19 @@
+ALTER TABLE {{.schema}}.access_tokens
+    ADD COLUMN audience JSONB;
+
+ALTER TABLE {{.schema}}.auth_codes
+    ADD COLUMN resources JSONB;
+
+ALTER TABLE {{.schema}}.refresh_tokens
+    ADD COLUMN resources JSONB;
+
+---- create above / drop below ----
+
+ALTER TABLE {{.schema}}.refresh_tokens
+    DROP COLUMN IF EXISTS resources;
+
+ALTER TABLE {{.schema}}.auth_codes
+    DROP COLUMN IF EXISTS resources;
+
+ALTER TABLE {{.schema}}.access_tokens
+    DROP COLUMN IF EXISTS audience;
@@ -0,0 +1,13 @@
+ALTER TABLE {{.schema}}.orbits
+    RENAME COLUMN models TO domain;
+
+CREATE UNIQUE INDEX idx_orbits_domain
+    ON {{.schema}}.orbits (domain)
+    WHERE deleted_at IS NULL;
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS {{.schema}}.idx_orbits_domain;
+
+ALTER TABLE {{.schema}}.orbits
+    RENAME COLUMN domain TO models;
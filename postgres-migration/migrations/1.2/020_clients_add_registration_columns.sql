@@ -0,0 +1,11 @@
+ALTER TABLE {{.schema}}.clients
+    ADD COLUMN jwks                           JSONB,
+    ADD COLUMN jwks_uri                       VARCHAR(1024) NOT NULL DEFAULT '',
+    ADD COLUMN registration_access_token_hash VARCHAR(128)  NOT NULL DEFAULT '';
+
+---- create above / drop below ----
+
+ALTER TABLE {{.schema}}.clients
+    DROP COLUMN IF EXISTS registration_access_token_hash,
+    DROP COLUMN IF EXISTS jwks_uri,
+    DROP COLUMN IF EXISTS jwks;
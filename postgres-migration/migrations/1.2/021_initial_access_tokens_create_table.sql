@@ -0,0 +1,21 @@
+CREATE TABLE {{.schema}}.initial_access_tokens
+(
+    id          BIGSERIAL PRIMARY KEY,
+    created_at  TIMESTAMPTZ  NOT NULL,
+    updated_at  TIMESTAMPTZ  NOT NULL,
+    orbit_id    BIGINT       NOT NULL REFERENCES {{.schema}}.orbits (id) ON DELETE CASCADE,
+    token_hash  VARCHAR(128) NOT NULL UNIQUE,
+    description TEXT,
+    max_uses    INT          NOT NULL DEFAULT 0,
+    use_count   INT          NOT NULL DEFAULT 0,
+    expires_at  TIMESTAMPTZ,
+    revoked     BOOLEAN      NOT NULL DEFAULT FALSE,
+    created_by  BIGINT       REFERENCES {{.schema}}.users (id) ON DELETE SET NULL
+);
+
+CREATE INDEX idx_initial_access_tokens_orbit
+    ON {{.schema}}.initial_access_tokens (orbit_id);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS {{.schema}}.initial_access_tokens;
@@ -0,0 +1,17 @@
+CREATE TABLE {{.schema}}.security_events
+(
+    id         BIGSERIAL PRIMARY KEY,
+    created_at TIMESTAMPTZ  NOT NULL,
+    orbit_id   BIGINT       NOT NULL REFERENCES {{.schema}}.orbits (id) ON DELETE CASCADE,
+    user_id    BIGINT       REFERENCES {{.schema}}.users (id) ON DELETE SET NULL,
+    event_type VARCHAR(100) NOT NULL,
+    severity   VARCHAR(20)  NOT NULL,
+    metadata   JSONB
+);
+
+CREATE INDEX idx_security_events_orbit_created
+    ON {{.schema}}.security_events (orbit_id, created_at DESC);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS {{.schema}}.security_events;
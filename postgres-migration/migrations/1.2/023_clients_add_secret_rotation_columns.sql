@@ -0,0 +1,11 @@
+ALTER TABLE {{.schema}}.clients
+    ADD COLUMN client_secret_expires_at   TIMESTAMPTZ,
+    ADD COLUMN previous_secret_hash       VARCHAR(512) NOT NULL DEFAULT '',
+    ADD COLUMN previous_secret_expires_at TIMESTAMPTZ;
+
+---- create above / drop below ----
+
+ALTER TABLE {{.schema}}.clients
+    DROP COLUMN IF EXISTS previous_secret_expires_at,
+    DROP COLUMN IF EXISTS previous_secret_hash,
+    DROP COLUMN IF EXISTS client_secret_expires_at;
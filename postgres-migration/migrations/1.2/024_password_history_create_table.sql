@@ -0,0 +1,15 @@
+CREATE TABLE {{.schema}}.password_history
+(
+    id            BIGSERIAL PRIMARY KEY,
+    created_at    TIMESTAMPTZ  NOT NULL,
+    user_id       BIGINT       NOT NULL REFERENCES {{.schema}}.users (id) ON DELETE CASCADE,
+    password_hash VARCHAR(512) NOT NULL,
+    password_algo VARCHAR(50)  NOT NULL
+);
+
+CREATE INDEX idx_password_history_user_created
+    ON {{.schema}}.password_history (user_id, created_at DESC);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS {{.schema}}.password_history;
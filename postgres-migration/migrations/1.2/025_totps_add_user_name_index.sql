@@ -0,0 +1,6 @@
+CREATE UNIQUE INDEX uq_totps_user_name
+    ON {{.schema}}.totps (user_id, name);
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS {{.schema}}.uq_totps_user_name;
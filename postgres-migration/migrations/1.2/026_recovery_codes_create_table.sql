@@ -0,0 +1,15 @@
+CREATE TABLE {{.schema}}.recovery_codes
+(
+    id         BIGSERIAL PRIMARY KEY,
+    created_at TIMESTAMPTZ NOT NULL,
+    user_id    BIGINT      NOT NULL REFERENCES {{.schema}}.users (id) ON DELETE CASCADE,
+    code_hash  VARCHAR(64) NOT NULL,
+    used_at    TIMESTAMPTZ
+);
+
+CREATE INDEX idx_recovery_codes_user
+    ON {{.schema}}.recovery_codes (user_id);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS {{.schema}}.recovery_codes;
@@ -0,0 +1,32 @@
+CREATE TABLE {{.schema}}.webauthn_credentials
+(
+    id                 BIGSERIAL PRIMARY KEY,
+    created_at         TIMESTAMPTZ  NOT NULL,
+    updated_at         TIMESTAMPTZ  NOT NULL,
+    orbit_id           BIGINT       NOT NULL REFERENCES {{.schema}}.orbits (id) ON DELETE CASCADE,
+    user_id            BIGINT       NOT NULL REFERENCES {{.schema}}.users (id) ON DELETE CASCADE,
+    user_handle        BYTEA        NOT NULL,
+    credential_id      BYTEA        NOT NULL,
+    public_key         BYTEA        NOT NULL,
+    attestation_format VARCHAR(50)  NOT NULL,
+    aaguid             BYTEA,
+    sign_count         BIGINT       NOT NULL DEFAULT 0,
+    transports         JSONB        NOT NULL DEFAULT '[]',
+    user_verified      BOOLEAN      NOT NULL DEFAULT FALSE,
+    backup_eligible    BOOLEAN      NOT NULL DEFAULT FALSE,
+    backup_state       BOOLEAN      NOT NULL DEFAULT FALSE,
+    discoverable       BOOLEAN      NOT NULL DEFAULT FALSE,
+    name               VARCHAR(100) NOT NULL,
+    last_used_at       TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX uq_webauthn_credentials_credential_id
+    ON {{.schema}}.webauthn_credentials (orbit_id, credential_id);
+CREATE UNIQUE INDEX uq_webauthn_credentials_user_name
+    ON {{.schema}}.webauthn_credentials (user_id, name);
+CREATE INDEX idx_webauthn_credentials_user_handle
+    ON {{.schema}}.webauthn_credentials (orbit_id, user_handle);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS {{.schema}}.webauthn_credentials;
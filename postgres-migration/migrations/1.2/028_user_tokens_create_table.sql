@@ -0,0 +1,23 @@
+CREATE TABLE {{.schema}}.user_tokens
+(
+    id          BIGSERIAL PRIMARY KEY,
+    created_at  TIMESTAMPTZ  NOT NULL,
+    orbit_id    BIGINT       NOT NULL REFERENCES {{.schema}}.orbits (id) ON DELETE CASCADE,
+    user_id     BIGINT       NOT NULL REFERENCES {{.schema}}.users (id) ON DELETE CASCADE,
+    purpose     VARCHAR(32)  NOT NULL,
+    token_hash  VARCHAR(64)  NOT NULL,
+    email       VARCHAR(255) NOT NULL,
+    expires_at  TIMESTAMPTZ  NOT NULL,
+    consumed_at TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX uq_user_tokens_token_hash
+    ON {{.schema}}.user_tokens (token_hash);
+
+CREATE INDEX idx_user_tokens_user_purpose
+    ON {{.schema}}.user_tokens (user_id, purpose)
+    WHERE consumed_at IS NULL;
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS {{.schema}}.user_tokens;
@@ -0,0 +1,7 @@
+ALTER TABLE {{.schema}}.auth_codes
+    ADD COLUMN deleted_at TIMESTAMPTZ;
+
+---- create above / drop below ----
+
+ALTER TABLE {{.schema}}.auth_codes
+    DROP COLUMN IF EXISTS deleted_at;
@@ -0,0 +1,26 @@
+ALTER TABLE {{.schema}}.scopes
+    ADD COLUMN is_active  BOOLEAN NOT NULL DEFAULT TRUE,
+    ADD COLUMN metadata   JSONB,
+    ADD COLUMN deleted_at TIMESTAMPTZ;
+
+ALTER TABLE {{.schema}}.scopes
+    DROP CONSTRAINT IF EXISTS scopes_orbit_id_name_key;
+
+CREATE UNIQUE INDEX uq_scopes_orbit_name
+    ON {{.schema}}.scopes (orbit_id, name)
+    WHERE deleted_at IS NULL;
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS {{.schema}}.uq_scopes_orbit_name;
+
+DELETE FROM {{.schema}}.scopes
+WHERE deleted_at IS NOT NULL;
+
+ALTER TABLE {{.schema}}.scopes
+    ADD CONSTRAINT scopes_orbit_id_name_key UNIQUE (orbit_id, name);
+
+ALTER TABLE {{.schema}}.scopes
+    DROP COLUMN IF EXISTS deleted_at,
+    DROP COLUMN IF EXISTS metadata,
+    DROP COLUMN IF EXISTS is_active;
@@ -0,0 +1,24 @@
+ALTER TABLE {{.schema}}.audit_logs
+    RENAME COLUMN actor_user_id TO actor_id;
+
+ALTER TABLE {{.schema}}.audit_logs
+    RENAME COLUMN details TO metadata;
+
+ALTER TABLE {{.schema}}.audit_logs
+    ADD COLUMN target VARCHAR(512) NOT NULL DEFAULT '';
+
+CREATE INDEX idx_audit_logs_orbit_created
+    ON {{.schema}}.audit_logs (orbit_id, id DESC);
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS {{.schema}}.idx_audit_logs_orbit_created;
+
+ALTER TABLE {{.schema}}.audit_logs
+    DROP COLUMN IF EXISTS target;
+
+ALTER TABLE {{.schema}}.audit_logs
+    RENAME COLUMN metadata TO details;
+
+ALTER TABLE {{.schema}}.audit_logs
+    RENAME COLUMN actor_id TO actor_user_id;
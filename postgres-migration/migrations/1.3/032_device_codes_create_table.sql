@@ -0,0 +1,26 @@
+CREATE TABLE {{.schema}}.device_codes
+(
+    id                BIGSERIAL PRIMARY KEY,
+    created_at        TIMESTAMPTZ  NOT NULL,
+    updated_at        TIMESTAMPTZ  NOT NULL,
+    orbit_id          BIGINT       NOT NULL REFERENCES {{.schema}}.orbits (id) ON DELETE CASCADE,
+    client_id         BIGINT       NOT NULL REFERENCES {{.schema}}.clients (id) ON DELETE CASCADE,
+    device_code_hash  VARCHAR(128) NOT NULL UNIQUE,
+    user_code         VARCHAR(32)  NOT NULL,
+    scopes            JSONB,
+    expires_at        TIMESTAMPTZ  NOT NULL,
+    poll_interval_sec INT          NOT NULL DEFAULT 5,
+    status            VARCHAR(20)  NOT NULL DEFAULT 'pending',
+    user_id           BIGINT       REFERENCES {{.schema}}.users (id) ON DELETE SET NULL,
+    metadata          JSONB
+);
+
+CREATE UNIQUE INDEX uq_device_codes_orbit_user_code
+    ON {{.schema}}.device_codes (orbit_id, user_code);
+
+CREATE INDEX idx_device_codes_expires_at
+    ON {{.schema}}.device_codes (expires_at);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS {{.schema}}.device_codes;
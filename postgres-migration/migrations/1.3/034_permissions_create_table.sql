@@ -0,0 +1,13 @@
+CREATE TABLE {{.schema}}.permissions
+(
+    id         BIGSERIAL PRIMARY KEY,
+    created_at TIMESTAMPTZ  NOT NULL,
+    orbit_id   BIGINT       NOT NULL REFERENCES {{.schema}}.orbits (id) ON DELETE CASCADE,
+    name       VARCHAR(200) NOT NULL,
+    metadata   JSONB,
+    UNIQUE (orbit_id, name)
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS {{.schema}}.permissions;
@@ -0,0 +1,14 @@
+CREATE TABLE {{.schema}}.role_permissions
+(
+    role_id       BIGINT      NOT NULL REFERENCES {{.schema}}.roles (id) ON DELETE CASCADE,
+    permission_id BIGINT      NOT NULL REFERENCES {{.schema}}.permissions (id) ON DELETE CASCADE,
+    created_at    TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (role_id, permission_id)
+);
+
+CREATE INDEX idx_role_permissions_permission
+    ON {{.schema}}.role_permissions (permission_id);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS {{.schema}}.role_permissions;
@@ -0,0 +1,14 @@
+CREATE TABLE {{.schema}}.user_roles
+(
+    user_id    BIGINT      NOT NULL REFERENCES {{.schema}}.users (id) ON DELETE CASCADE,
+    role_id    BIGINT      NOT NULL REFERENCES {{.schema}}.roles (id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (user_id, role_id)
+);
+
+CREATE INDEX idx_user_roles_role
+    ON {{.schema}}.user_roles (role_id);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS {{.schema}}.user_roles;
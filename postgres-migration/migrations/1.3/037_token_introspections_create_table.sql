@@ -0,0 +1,20 @@
+CREATE TABLE {{.schema}}.token_introspections
+(
+    id         BIGSERIAL PRIMARY KEY,
+    created_at TIMESTAMPTZ  NOT NULL,
+    orbit_id   BIGINT       NOT NULL REFERENCES {{.schema}}.orbits (id) ON DELETE CASCADE,
+    token_jti  VARCHAR(200) NOT NULL,
+    active     BOOLEAN      NOT NULL,
+    response   JSONB,
+    expires_at TIMESTAMPTZ  NOT NULL
+);
+
+CREATE INDEX idx_token_introspections_jti
+    ON {{.schema}}.token_introspections (orbit_id, token_jti);
+
+CREATE INDEX idx_token_introspections_expires
+    ON {{.schema}}.token_introspections (expires_at);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS {{.schema}}.token_introspections;
@@ -0,0 +1,18 @@
+CREATE TABLE {{.schema}}.token_revocations
+(
+    id         BIGSERIAL PRIMARY KEY,
+    orbit_id   BIGINT       NOT NULL REFERENCES {{.schema}}.orbits (id) ON DELETE CASCADE,
+    token_jti  VARCHAR(200) NOT NULL,
+    token_type VARCHAR(50)  NOT NULL,
+    reason     VARCHAR(255),
+    revoked_at TIMESTAMPTZ  NOT NULL,
+    revoked_by BIGINT       REFERENCES {{.schema}}.users (id) ON DELETE SET NULL,
+    metadata   JSONB
+);
+
+CREATE INDEX idx_token_revocations_jti
+    ON {{.schema}}.token_revocations (orbit_id, token_jti);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS {{.schema}}.token_revocations;
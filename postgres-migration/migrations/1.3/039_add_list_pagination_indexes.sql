@@ -0,0 +1,21 @@
+CREATE INDEX idx_users_orbit_id
+    ON {{.schema}}.users (orbit_id, id)
+    WHERE deleted_at IS NULL;
+
+CREATE INDEX idx_users_orbit_email_prefix
+    ON {{.schema}}.users (orbit_id, lower(email) text_pattern_ops)
+    WHERE deleted_at IS NULL;
+
+CREATE INDEX idx_clients_orbit_id
+    ON {{.schema}}.clients (orbit_id, id)
+    WHERE deleted_at IS NULL;
+
+CREATE INDEX idx_security_events_orbit_id
+    ON {{.schema}}.security_events (orbit_id, id DESC);
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS {{.schema}}.idx_security_events_orbit_id;
+DROP INDEX IF EXISTS {{.schema}}.idx_clients_orbit_id;
+DROP INDEX IF EXISTS {{.schema}}.idx_users_orbit_email_prefix;
+DROP INDEX IF EXISTS {{.schema}}.idx_users_orbit_id;
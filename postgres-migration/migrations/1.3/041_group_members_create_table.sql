@@ -0,0 +1,14 @@
+CREATE TABLE {{.schema}}.group_members
+(
+    group_id   BIGINT      NOT NULL REFERENCES {{.schema}}.groups (id) ON DELETE CASCADE,
+    user_id    BIGINT      NOT NULL REFERENCES {{.schema}}.users (id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (group_id, user_id)
+);
+
+CREATE INDEX idx_group_members_user
+    ON {{.schema}}.group_members (user_id);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS {{.schema}}.group_members;
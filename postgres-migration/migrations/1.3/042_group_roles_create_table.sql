@@ -0,0 +1,14 @@
+CREATE TABLE {{.schema}}.group_roles
+(
+    group_id   BIGINT      NOT NULL REFERENCES {{.schema}}.groups (id) ON DELETE CASCADE,
+    role_id    BIGINT      NOT NULL REFERENCES {{.schema}}.roles (id) ON DELETE CASCADE,
+    created_at TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (group_id, role_id)
+);
+
+CREATE INDEX idx_group_roles_role
+    ON {{.schema}}.group_roles (role_id);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS {{.schema}}.group_roles;
@@ -0,0 +1,15 @@
+CREATE TABLE {{.schema}}.role_inclusions
+(
+    role_id          BIGINT      NOT NULL REFERENCES {{.schema}}.roles (id) ON DELETE CASCADE,
+    included_role_id BIGINT      NOT NULL REFERENCES {{.schema}}.roles (id) ON DELETE CASCADE,
+    created_at       TIMESTAMPTZ NOT NULL,
+    PRIMARY KEY (role_id, included_role_id),
+    CHECK (role_id <> included_role_id)
+);
+
+CREATE INDEX idx_role_inclusions_included_role
+    ON {{.schema}}.role_inclusions (included_role_id);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS {{.schema}}.role_inclusions;
@@ -0,0 +1,19 @@
+CREATE TABLE {{.schema}}.policies
+(
+    id          BIGSERIAL PRIMARY KEY,
+    created_at  TIMESTAMPTZ  NOT NULL,
+    updated_at  TIMESTAMPTZ  NOT NULL,
+    orbit_id    BIGINT       NOT NULL REFERENCES {{.schema}}.orbits (id) ON DELETE CASCADE,
+    name        VARCHAR(200) NOT NULL,
+    description TEXT,
+    effect      VARCHAR(10)  NOT NULL CHECK (effect IN ('allow', 'deny')),
+    actions     JSONB        NOT NULL,
+    condition   TEXT         NOT NULL DEFAULT '',
+    obligations JSONB,
+    is_active   BOOLEAN      NOT NULL DEFAULT TRUE,
+    UNIQUE (orbit_id, name)
+);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS {{.schema}}.policies;
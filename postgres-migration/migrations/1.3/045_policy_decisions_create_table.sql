@@ -0,0 +1,20 @@
+CREATE TABLE {{.schema}}.policy_decisions
+(
+    id         BIGSERIAL PRIMARY KEY,
+    created_at TIMESTAMPTZ  NOT NULL,
+    orbit_id   BIGINT       NOT NULL REFERENCES {{.schema}}.orbits (id) ON DELETE CASCADE,
+    client_id  BIGINT       REFERENCES {{.schema}}.clients (id) ON DELETE SET NULL,
+    user_id    BIGINT       REFERENCES {{.schema}}.users (id) ON DELETE SET NULL,
+    action     VARCHAR(200) NOT NULL,
+    decision   VARCHAR(10)  NOT NULL,
+    policies   JSONB,
+    request    JSONB,
+    errors     JSONB
+);
+
+CREATE INDEX idx_policy_decisions_orbit_id
+    ON {{.schema}}.policy_decisions (orbit_id, id DESC);
+
+---- create above / drop below ----
+
+DROP TABLE IF EXISTS {{.schema}}.policy_decisions;
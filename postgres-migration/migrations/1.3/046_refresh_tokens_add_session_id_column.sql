@@ -0,0 +1,13 @@
+ALTER TABLE {{.schema}}.refresh_tokens
+    ADD COLUMN session_id BIGINT REFERENCES {{.schema}}.sessions (id) ON DELETE SET NULL;
+
+CREATE INDEX idx_refresh_tokens_session_id
+    ON {{.schema}}.refresh_tokens (session_id)
+    WHERE session_id IS NOT NULL;
+
+---- create above / drop below ----
+
+DROP INDEX IF EXISTS {{.schema}}.idx_refresh_tokens_session_id;
+
+ALTER TABLE {{.schema}}.refresh_tokens
+    DROP COLUMN IF EXISTS session_id;
@@ -0,0 +1,102 @@
+// Package migrations embeds the versioned schema migrations. The release
+// folders (1.0, 1.1, ...) only group files; the numeric prefix is the global
+// sequence number and must run from 1 without gaps or duplicates. Table names
+// are qualified with {{.schema}}, which is filled in the way tern fills its
+// [data] section, so the same files can migrate side-by-side schemas.
+package migrations
+
+import (
+	"embed"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+)
+
+// Separator splits a file into its up and down parts, as tern does.
+const Separator = "---- create above / drop below ----"
+
+//go:embed */*.sql
+var files embed.FS
+
+var migrationPattern = regexp.MustCompile(`^(\d+)_.+\.sql$`)
+
+type Migration struct {
+	Version int32
+	Name    string
+	Up      string
+	Down    string
+}
+
+// All returns the embedded migrations ordered by version.
+func All() ([]Migration, error) {
+	return Load(files)
+}
+
+// Load reads migrations from the release folders of fsys.
+func Load(fsys fs.FS) ([]Migration, error) {
+	paths, err := fs.Glob(fsys, "*/*.sql")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[int32]string, len(paths))
+	var list []Migration
+	for _, p := range paths {
+		name := path.Base(p)
+		m := migrationPattern.FindStringSubmatch(name)
+		if m == nil {
+			return nil, fmt.Errorf("migration %s: name must start with a sequence number", p)
+		}
+		n, err := strconv.ParseInt(m[1], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("migration %s: %w", p, err)
+		}
+		version := int32(n)
+		if prev, ok := byVersion[version]; ok {
+			return nil, fmt.Errorf("duplicate migration %d: %s and %s", version, prev, p)
+		}
+		byVersion[version] = p
+
+		body, err := fs.ReadFile(fsys, p)
+		if err != nil {
+			return nil, err
+		}
+		up, down, _ := strings.Cut(string(body), Separator)
+		if strings.TrimSpace(up) == "" {
+			return nil, fmt.Errorf("migration %s: empty up section", p)
+		}
+		list = append(list, Migration{
+			Version: version,
+			Name:    name,
+			Up:      strings.TrimSpace(up),
+			Down:    strings.TrimSpace(down),
+		})
+	}
+
+	sort.Slice(list, func(i, j int) bool { return list[i].Version < list[j].Version })
+	for i, m := range list {
+		if m.Version != int32(i+1) {
+			return nil, fmt.Errorf("missing migration %d", i+1)
+		}
+	}
+	return list, nil
+}
+
+// Render fills the template placeholders of sql. schema must already be a
+// quoted identifier.
+func Render(name, sql, schema string) (string, error) {
+	tmpl, err := template.New(name).Option("missingkey=error").Parse(sql)
+	if err != nil {
+		return "", fmt.Errorf("migration %s: %w", name, err)
+	}
+	var buf strings.Builder
+	if err := tmpl.Execute(&buf, map[string]string{"schema": schema}); err != nil {
+		return "", fmt.Errorf("migration %s: %w", name, err)
+	}
+	return buf.String(), nil
+}
@@ -1,20 +1,21 @@
 #!/bin/bash
-set -e
+set -euo pipefail
 
-echo "Starting migrations..."
+# Release folders only group the files; tern expects a single directory, so
+# the migrations are flattened before running it.
+MIGRATIONS_DIR=$(mktemp -d)
+trap 'rm -rf "$MIGRATIONS_DIR"' EXIT
 
-for dir in /app/migrations/*/; do
-  if [ -d "$dir" ]; then
-    echo "Processing $dir"
-    for file in "$dir"/*.sql; do
-      if [[ "$(basename "$file")" =~ ^0*([0-9]+)_ ]]; then
-        num="${BASH_REMATCH[1]}"
-        newname="$dir/$num${file#$dir/0*_*}"
-        mv "$file" "$newname"
-        echo "Renamed $file → $newname"
-      fi
-    done
-  fi
+for file in /app/migrations/*/*.sql; do
+  cp "$file" "$MIGRATIONS_DIR/"
 done
-        
+
+echo "Starting migrations..."
+
+# The version table lives in the schema, so it has to exist before tern runs.
+psql "$ORBITUM_MIGRATOR_CONN_STRING" -v ON_ERROR_STOP=1 -q -c 'CREATE SCHEMA IF NOT EXISTS orbitum'
+
+tern migrate --config /app/tern.conf --migrations "$MIGRATIONS_DIR" "$@"
+tern status --config /app/tern.conf --migrations "$MIGRATIONS_DIR"
+
 echo "All migrations completed successfully"